@@ -21,7 +21,9 @@ Usage:
 
 	go tool compile [flags] file...
 
-The specified files must be Go source files and all part of the same package.
+The specified files must be Go or Wo source files and all part of the same package.
+Files whose names end in .wo, or that start with a //wo:dialect directive,
+are written in the Wo dialect of Go.
 The same compiler is used for all target operating systems and architectures.
 The GOOS and GOARCH environment variables set the desired target.
 
@@ -60,6 +62,10 @@ Flags:
 		Allow references to Go symbols in shared libraries (experimental).
 	-e
 		Remove the limit on the number of errors reported (default limit is 10).
+	-entry name
+		When compiling package main, check that it declares a function
+		name, such as otherMain, suitable as the program entry point
+		in place of main. See the linker's -entry flag.
 	-goversion string
 		Specify required go tool version of the runtime.
 		Exits when the runtime go version does not match goversion.
@@ -88,6 +94,10 @@ Flags:
 		Write memory profile for the compilation to file.
 	-memprofilerate rate
 		Set runtime.MemProfileRate for the compilation to rate.
+	-module path
+		Set the path of the module containing the package being compiled.
+		Objects declared with the Wo pkg modifier in imported packages
+		are visible if they belong to the same module.
 	-msan
 		Insert calls to C/C++ memory sanitizer.
 	-mutexprofile file
@@ -113,6 +123,16 @@ Flags:
 		Write an execution trace to file.
 	-trimpath prefix
 		Remove prefix from recorded source file paths.
+	-Werror codes
+		Report warnings with the error codes in the comma-separated
+		list codes, such as UnusedVar, as errors. The list may be "all".
+	-Wno codes
+		Do not report warnings with the error codes in codes.
+		If a code is also listed in -Werror, -Werror takes precedence.
+	-wo list
+		Enable only the Wo language features in the comma-separated
+		list in Wo files. A feature name prefixed with - is disabled
+		instead. Default is all features.
 
 Flags related to debugging information:
 
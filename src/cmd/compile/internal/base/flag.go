@@ -12,12 +12,14 @@ import (
 	"fmt"
 	"internal/buildcfg"
 	"internal/platform"
+	"internal/types/errors"
 	"log"
 	"os"
 	"reflect"
 	"runtime"
 	"strings"
 
+	"cmd/compile/internal/syntax"
 	"cmd/internal/obj"
 	"cmd/internal/objabi"
 	"cmd/internal/sys"
@@ -99,6 +101,7 @@ type CmdFlags struct {
 	DwarfLocationLists *bool        "help:\"add location lists to DWARF in optimized mode\""                      // &Ctxt.Flag_locationlists, set below
 	Dynlink            *bool        "help:\"support references to Go symbols defined in other shared libraries\"" // &Ctxt.Flag_dynlink, set below
 	EmbedCfg           func(string) "help:\"read go:embed configuration from `file`\""
+	Entry              string       "help:\"use function `name` of package main as the program entry point\""
 	Env                func(string) "help:\"add `definition` of the form key=value to environment\""
 	GenDwarfInl        int          "help:\"generate DWARF inline info records\"" // 0=disabled, 1=funcs, 2=funcs+formals/locals
 	GoVersion          string       "help:\"required version of the runtime\""
@@ -112,6 +115,7 @@ type CmdFlags struct {
 	MSan               bool         "help:\"build code compatible with C/C++ memory sanitizer\""
 	MemProfile         string       "help:\"write memory profile to `file`\""
 	MemProfileRate     int          "help:\"set runtime.MemProfileRate to `rate`\""
+	Module             string       "help:\"set path of the module containing the package to `path`\""
 	MutexProfile       string       "help:\"write mutex profile to `file`\""
 	NoLocalImports     bool         "help:\"reject local (relative) imports\""
 	CoverageCfg        func(string) "help:\"read coverage configuration from `file`\""
@@ -125,6 +129,9 @@ type CmdFlags struct {
 	TraceProfile       string       "help:\"write an execution trace to `file`\""
 	TrimPath           string       "help:\"remove `prefix` from recorded source file paths\""
 	WB                 bool         "help:\"enable write barrier\"" // TODO: remove
+	Werror             string       "flag:\"Werror\" help:\"report warnings with the given error `codes` as errors (comma-separated list, or all)\""
+	Wno                string       "flag:\"Wno\" help:\"do not report warnings with the given error `codes` (comma-separated list, or all)\""
+	Wo                 string       "help:\"enable Wo language `features` in Wo files (comma-separated list, default all)\""
 	PgoProfile         string       "help:\"read profile or pre-process profile from `file`\""
 	ErrorURL           bool         "help:\"print explanatory URL with error message if applicable\""
 
@@ -139,6 +146,9 @@ type CmdFlags struct {
 		PackageFile  map[string]string        // set by -importcfg; nil means not in use
 		CoverageInfo *covcmd.CoverFixupConfig // set by -coveragecfg
 		SpectreIndex bool                     // set by -spectre=index or -spectre=all
+		WoFeatures   syntax.Features          // set by -wo
+		WarnErrors   WarnCodes                // set by -Werror
+		WarnIgnore   WarnCodes                // set by -Wno
 		// Whether we are adding any sort of code instrumentation, such as
 		// when the race detector is enabled.
 		Instrumenting bool
@@ -284,6 +294,9 @@ func ParseFlags() {
 		log.Fatalf("%s/%s does not support -shared", buildcfg.GOOS, buildcfg.GOARCH)
 	}
 	parseSpectre(Flag.Spectre) // left as string for RecordFlags
+	parseWo(Flag.Wo)           // left as string for RecordFlags
+	Flag.Cfg.WarnErrors = parseWarnCodes("Werror", Flag.Werror)
+	Flag.Cfg.WarnIgnore = parseWarnCodes("Wno", Flag.Wno)
 
 	Ctxt.Flag_shared = Ctxt.Flag_dynlink || Ctxt.Flag_shared
 	Ctxt.Flag_optimize = Flag.N == 0
@@ -588,3 +601,48 @@ func parseSpectre(s string) {
 		}
 	}
 }
+
+// parseWo parses the -wo flag into Flag.Cfg.WoFeatures.
+func parseWo(s string) {
+	f, err := syntax.ParseFeatures(s)
+	if err != nil {
+		log.Fatalf("invalid setting -wo=%s: %v", s, err)
+	}
+	Flag.Cfg.WoFeatures = f
+}
+
+// A WarnCodes is a set of error codes of warnings, as set by -Werror and -Wno.
+type WarnCodes struct {
+	all   bool
+	codes map[errors.Code]bool
+}
+
+// Has reports whether w contains code.
+func (w *WarnCodes) Has(code errors.Code) bool {
+	return w.all || w.codes[code]
+}
+
+// parseWarnCodes parses the value s of the flag with the given name
+// as a comma-separated list of error code names, or "all".
+func parseWarnCodes(name, s string) WarnCodes {
+	var w WarnCodes
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		switch f {
+		case "":
+			// nothing
+		case "all":
+			w.all = true
+		default:
+			code, ok := errors.CodeByName(f)
+			if !ok {
+				log.Fatalf("invalid setting -%s=%s: unknown error code %s", name, s, f)
+			}
+			if w.codes == nil {
+				w.codes = make(map[errors.Code]bool)
+			}
+			w.codes[code] = true
+		}
+	}
+	return w
+}
@@ -179,6 +179,20 @@ func WarnfAt(pos src.XPos, format string, args ...interface{}) {
 	}
 }
 
+// WarnfCodeAt reports a formatted warning with the given error code at pos.
+// If code is listed in -Werror, the warning is reported as an error instead;
+// otherwise, if code is listed in -Wno, the warning is not reported at all.
+func WarnfCodeAt(pos src.XPos, code errors.Code, format string, args ...interface{}) {
+	switch {
+	case Flag.Cfg.WarnErrors.Has(code):
+		ErrorfAt(pos, code, format, args...)
+	case Flag.Cfg.WarnIgnore.Has(code):
+		// silenced
+	default:
+		WarnfAt(pos, "warning: "+format, args...)
+	}
+}
+
 // Fatalf reports a fatal error - an internal problem - at the current line and exits.
 // If other errors have already been printed, then Fatalf just quietly exits.
 // (The internal problem may have been caused by incomplete information
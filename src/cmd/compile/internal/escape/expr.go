@@ -59,6 +59,11 @@ func (e *escape) exprSkipInit(k hole, n ir.Node) {
 		n := n.(*ir.LogicalExpr)
 		e.discard(n.X)
 		e.discard(n.Y)
+	case ir.OCOND:
+		n := n.(*ir.CondExpr)
+		e.discard(n.Cond)
+		e.expr(k, n.X)
+		e.expr(k, n.Y)
 	case ir.OADDR:
 		n := n.(*ir.AddrExpr)
 		e.expr(k.addr(n, "address-of"), n.X) // "address-of"
@@ -87,7 +92,7 @@ func (e *escape) exprSkipInit(k hole, n ir.Node) {
 			e.expr(k.deref(n, "dot of pointer"), n.X)
 		}
 		e.discard(n.Index)
-	case ir.OINDEXMAP:
+	case ir.OINDEXMAP, ir.OINDEXSET:
 		n := n.(*ir.IndexExpr)
 		e.discard(n.X)
 		e.discard(n.Index)
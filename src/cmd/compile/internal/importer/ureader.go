@@ -6,6 +6,8 @@
 package importer
 
 import (
+	"go/constant"
+
 	"cmd/compile/internal/base"
 	"cmd/compile/internal/syntax"
 	"cmd/compile/internal/types2"
@@ -182,6 +184,7 @@ func (r *reader) doPkg() *types2.Package {
 
 	name := r.String()
 	pkg := types2.NewPackage(path, name)
+	pkg.SetModule(r.String())
 	r.p.imports[path] = pkg
 
 	// TODO(mdempsky): The list of imported packages is important for
@@ -271,6 +274,12 @@ func (r *reader) doTyp() (res types2.Type) {
 		return types2.NewChan(dir, r.typ())
 	case pkgbits.TypeMap:
 		return types2.NewMap(r.typ(), r.typ())
+	case pkgbits.TypeSet:
+		return types2.NewSet(r.typ())
+	case pkgbits.TypeOptional:
+		return types2.NewOptional(r.typ())
+	case pkgbits.TypeEnum:
+		return r.enumType()
 	case pkgbits.TypePointer:
 		return types2.NewPointer(r.typ())
 	case pkgbits.TypeSignature:
@@ -286,6 +295,35 @@ func (r *reader) doTyp() (res types2.Type) {
 	}
 }
 
+func (r *reader) enumType() *types2.Enum {
+	fields := make([]*types2.Var, r.Len())
+	for i := range fields {
+		pos := r.pos()
+		pkg, name := r.selector()
+		fields[i] = types2.NewField(pos, pkg, name, r.typ(), false)
+	}
+
+	// The type of the variants is set when the enum becomes the
+	// underlying type of its defined type.
+	variants := make([]*types2.Variant, r.Len())
+	for i := range variants {
+		pos := r.pos()
+		pkg, name := r.selector()
+		values := make([]constant.Value, len(fields))
+		for j := range values {
+			values[j] = r.Value()
+		}
+		vfields := make([]*types2.Var, r.Len())
+		for j := range vfields {
+			pos := r.pos()
+			pkg, name := r.selector()
+			vfields[j] = types2.NewField(pos, pkg, name, r.typ(), false)
+		}
+		variants[i] = types2.NewVariant(pos, pkg, name, nil, i, values, vfields)
+	}
+	return types2.NewEnum(variants, fields)
+}
+
 func (r *reader) structType() *types2.Struct {
 	fields := make([]*types2.Var, r.Len())
 	var tags []string
@@ -345,7 +383,45 @@ func (r *reader) signature(recv *types2.Var, rtparams, tparams []*types2.TypePar
 	results := r.params()
 	variadic := r.Bool()
 
-	return types2.NewSignatureType(recv, rtparams, tparams, params, results, variadic)
+	sig := types2.NewSignatureType(recv, rtparams, tparams, params, results, variadic)
+	sig.SetDefaults(r.defaults(params))
+	sig.SetSkippable(r.skips(results))
+	return sig
+}
+
+// defaults reads the default values of the Wo parameters params.
+func (r *reader) defaults(params *types2.Tuple) []types2.Object {
+	if !r.Bool() {
+		return nil
+	}
+	defaults := make([]types2.Object, params.Len())
+	for i := range defaults {
+		if !r.Bool() {
+			continue
+		}
+		switch pkgbits.CodeDefault(r.Code(pkgbits.SyncCodeDefault)) {
+		case pkgbits.DefaultConst:
+			par := params.At(i)
+			defaults[i] = types2.NewConst(par.Pos(), nil, "", par.Type(), r.Value())
+		case pkgbits.DefaultNil:
+			defaults[i] = types2.Universe.Lookup(r.String())
+		case pkgbits.DefaultObj:
+			defaults[i], _ = r.obj()
+		}
+	}
+	return defaults
+}
+
+// skips reads the skip modifiers of the Wo results results.
+func (r *reader) skips(results *types2.Tuple) []bool {
+	if !r.Bool() {
+		return nil
+	}
+	skips := make([]bool, results.Len())
+	for i := range skips {
+		skips[i] = r.Bool()
+	}
+	return skips
 }
 
 func (r *reader) params() *types2.Tuple {
@@ -391,6 +467,7 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types2.Package, string) {
 	var objPkg *types2.Package
 	var objName string
 	var tag pkgbits.CodeObj
+	var vis pkgbits.CodeVis
 	{
 		rname := pr.tempReader(pkgbits.RelocName, idx, pkgbits.SyncObject1)
 
@@ -398,6 +475,7 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types2.Package, string) {
 		assert(objName != "")
 
 		tag = pkgbits.CodeObj(rname.Code(pkgbits.SyncCodeObj))
+		vis = pkgbits.CodeVis(rname.Code(pkgbits.SyncCodeVis))
 		pr.retireReader(rname)
 	}
 
@@ -406,65 +484,126 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types2.Package, string) {
 		return objPkg, objName
 	}
 
+	if tag == pkgbits.ObjMethod {
+		// Wo methods with type parameters are read with their receiver
+		// base type (see genericMethod).
+		return objPkg, objName
+	}
+
 	objPkg.Scope().InsertLazy(objName, func() types2.Object {
-		dict := pr.objDictIdx(idx)
+		obj := pr.doObj(idx, tag, objPkg, objName)
+		setVis(obj, vis)
+		return obj
+	})
 
-		r := pr.newReader(pkgbits.RelocObj, idx, pkgbits.SyncObject1)
-		r.dict = dict
+	return objPkg, objName
+}
 
-		switch tag {
-		default:
-			panic("weird")
+// doObj reads the object with the given index, tag, package and name.
+func (pr *pkgReader) doObj(idx pkgbits.Index, tag pkgbits.CodeObj, objPkg *types2.Package, objName string) types2.Object {
+	dict := pr.objDictIdx(idx)
 
-		case pkgbits.ObjAlias:
-			pos := r.pos()
-			var tparams []*types2.TypeParam
-			if r.Version().Has(pkgbits.AliasTypeParamNames) {
-				tparams = r.typeParamNames()
-			}
-			typ := r.typ()
-			return newAliasTypeName(pr.enableAlias, pos, objPkg, objName, typ, tparams)
+	r := pr.newReader(pkgbits.RelocObj, idx, pkgbits.SyncObject1)
+	r.dict = dict
 
-		case pkgbits.ObjConst:
-			pos := r.pos()
-			typ := r.typ()
-			val := r.Value()
-			return types2.NewConst(pos, objPkg, objName, typ, val)
+	switch tag {
+	default:
+		panic("weird")
 
-		case pkgbits.ObjFunc:
-			pos := r.pos()
-			tparams := r.typeParamNames()
-			sig := r.signature(nil, nil, tparams)
-			return types2.NewFunc(pos, objPkg, objName, sig)
+	case pkgbits.ObjAlias:
+		pos := r.pos()
+		var tparams []*types2.TypeParam
+		if r.Version().Has(pkgbits.AliasTypeParamNames) {
+			tparams = r.typeParamNames()
+		}
+		typ := r.typ()
+		return newAliasTypeName(pr.enableAlias, pos, objPkg, objName, typ, tparams)
 
-		case pkgbits.ObjType:
-			pos := r.pos()
+	case pkgbits.ObjConst:
+		pos := r.pos()
+		typ := r.typ()
+		val := r.Value()
+		return types2.NewConst(pos, objPkg, objName, typ, val)
 
-			return types2.NewTypeNameLazy(pos, objPkg, objName, func(named *types2.Named) (tparams []*types2.TypeParam, underlying types2.Type, methods []*types2.Func) {
-				tparams = r.typeParamNames()
+	case pkgbits.ObjFunc:
+		pos := r.pos()
+		tparams := r.typeParamNames()
+		sig := r.signature(nil, nil, tparams)
+		return types2.NewFunc(pos, objPkg, objName, sig)
 
-				// TODO(mdempsky): Rewrite receiver types to underlying is an
-				// Interface? The go/types importer does this (I think because
-				// unit tests expected that), but cmd/compile doesn't care
-				// about it, so maybe we can avoid worrying about that here.
-				underlying = r.typ().Underlying()
+	case pkgbits.ObjType:
+		pos := r.pos()
 
-				methods = make([]*types2.Func, r.Len())
-				for i := range methods {
-					methods[i] = r.method()
-				}
+		return types2.NewTypeNameLazy(pos, objPkg, objName, func(named *types2.Named) (tparams []*types2.TypeParam, underlying types2.Type, methods []*types2.Func) {
+			tparams = r.typeParamNames()
 
-				return
-			})
+			// TODO(mdempsky): Rewrite receiver types to underlying is an
+			// Interface? The go/types importer does this (I think because
+			// unit tests expected that), but cmd/compile doesn't care
+			// about it, so maybe we can avoid worrying about that here.
+			underlying = r.typ().Underlying()
 
-		case pkgbits.ObjVar:
-			pos := r.pos()
-			typ := r.typ()
-			return types2.NewVar(pos, objPkg, objName, typ)
+			methods = make([]*types2.Func, r.Len())
+			for i := range methods {
+				methods[i] = r.method()
+			}
+			for i := r.Len(); i > 0; i-- {
+				methods = append(methods, pr.genericMethod(r.Reloc(pkgbits.RelocObj), len(tparams)))
+			}
+
+			return
+		})
+
+	case pkgbits.ObjVar:
+		pos := r.pos()
+		typ := r.typ()
+		return types2.NewVar(pos, objPkg, objName, typ)
+
+	case pkgbits.ObjVariant:
+		r.pos()
+		typ := r.typ()
+		return typ.Underlying().(*types2.Enum).Variant(r.Len())
+
+	case pkgbits.ObjOverload:
+		pos := r.pos()
+		funcs := make([]*types2.Func, r.Len())
+		for i := range funcs {
+			obj, _ := r.obj()
+			funcs[i] = obj.(*types2.Func)
 		}
-	})
+		return types2.NewOverload(pos, objPkg, objName, funcs)
+	}
+}
 
-	return objPkg, objName
+// genericMethod reads the Wo method with type parameters with the
+// given index, whose receiver base type has n type parameters.
+func (pr *pkgReader) genericMethod(idx pkgbits.Index, n int) *types2.Func {
+	r := pr.newReader(pkgbits.RelocObj, idx, pkgbits.SyncObject1)
+	r.dict = pr.objDictIdx(idx)
+
+	r.Sync(pkgbits.SyncMethod)
+	pos := r.pos()
+	pkg, name := r.selector()
+
+	tparams := r.typeParamNames()
+	sig := r.signature(r.param(), tparams[:n], tparams[n:])
+
+	_ = r.pos() // TODO(mdempsky): Remove; this is a hacker for linker.go.
+	return types2.NewFunc(pos, pkg, name, sig)
+}
+
+// setVis sets the visibility of obj to the one encoded by vis.
+func setVis(obj types2.Object, vis pkgbits.CodeVis) {
+	var v types2.Visibility
+	switch vis {
+	case pkgbits.VisExport:
+		v = types2.ExportVis
+	case pkgbits.VisPkg:
+		v = types2.PkgVis
+	default:
+		return
+	}
+	obj.(interface{ SetVisibility(types2.Visibility) }).SetVisibility(v)
 }
 
 func (pr *pkgReader) objDictIdx(idx pkgbits.Index) *readerDict {
@@ -261,6 +261,22 @@ func (n *CompLitExpr) SetOp(op Op) {
 	}
 }
 
+// A CondExpr is a Wo conditional expression: if Cond then X else Y.
+// Exactly one of X and Y is evaluated, after Cond.
+type CondExpr struct {
+	miniExpr
+	Cond Node
+	X    Node
+	Y    Node
+}
+
+func NewCondExpr(pos src.XPos, cond, x, y Node) *CondExpr {
+	n := &CondExpr{Cond: cond, X: x, Y: y}
+	n.pos = pos
+	n.op = OCOND
+	return n
+}
+
 // A ConvExpr is a conversion Type(X).
 // It may end up being a value or a type.
 type ConvExpr struct {
@@ -331,7 +347,7 @@ func (n *IndexExpr) SetOp(op Op) {
 	switch op {
 	default:
 		panic(n.no("SetOp " + op.String()))
-	case OINDEX, OINDEXMAP:
+	case OINDEX, OINDEXMAP, OINDEXSET:
 		n.op = op
 	}
 }
@@ -1070,7 +1086,7 @@ func SameSafeExpr(l Node, r Node) bool {
 		// Allow only numeric-ish types. This is a bit conservative.
 		return types.IsSimple[l.Type().Kind()] && SameSafeExpr(l.X, r.X)
 
-	case OINDEX, OINDEXMAP:
+	case OINDEX, OINDEXMAP, OINDEXSET:
 		l := l.(*IndexExpr)
 		r := r.(*IndexExpr)
 		return SameSafeExpr(l.X, r.X) && SameSafeExpr(l.Index, r.Index)
@@ -218,6 +218,7 @@ var OpPrec = []int{
 	OUNSAFESTRING:     8,
 	OUNSAFESTRINGDATA: 8,
 	OINDEXMAP:         8,
+	OINDEXSET:         8,
 	OINDEX:            8,
 	OSLICE:            8,
 	OSLICESTR:         8,
@@ -262,6 +263,7 @@ var OpPrec = []int{
 	OSEND:             3,
 	OANDAND:           2,
 	OOROR:             1,
+	OCOND:             0,
 
 	// Statements handled by stmtfmt
 	OAS:         -1,
@@ -673,7 +675,7 @@ func exprFmt(n Node, s fmt.State, prec int) {
 		exprFmt(n.X, s, nprec)
 		fmt.Fprintf(s, ".(%v)", n.Type())
 
-	case OINDEX, OINDEXMAP:
+	case OINDEX, OINDEXMAP, OINDEXSET:
 		n := n.(*IndexExpr)
 		exprFmt(n.X, s, nprec)
 		fmt.Fprintf(s, "[%v]", n.Index)
@@ -835,6 +837,10 @@ func exprFmt(n Node, s fmt.State, prec int) {
 		fmt.Fprintf(s, " %v ", n.Op())
 		exprFmt(n.Y, s, nprec+1)
 
+	case OCOND:
+		n := n.(*CondExpr)
+		fmt.Fprintf(s, "if %v then %v else %v", n.Cond, n.X, n.Y)
+
 	case OSEND:
 		n := n.(*SendStmt)
 		exprFmt(n.Chan, s, nprec)
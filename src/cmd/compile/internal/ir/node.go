@@ -162,6 +162,7 @@ const (
 	OCLOSE     // close(X)
 	OCLOSURE   // func Type { Func.Closure.Body } (func literal)
 	OCOMPLIT   // Type{List} (composite literal, not yet lowered to specific form)
+	OCOND      // if Cond then X else Y (Wo conditional expression)
 	OMAPLIT    // Type{List} (composite literal, Type is map)
 	OSTRUCTLIT // Type{List} (composite literal, Type is struct)
 	OARRAYLIT  // Type{List} (composite literal, Type is array)
@@ -193,6 +194,7 @@ const (
 	ODEREF         // *X
 	OINDEX         // X[Index] (index of array or slice)
 	OINDEXMAP      // X[Index] (index of map)
+	OINDEXSET      // X[Index] (membership test for Wo set; X is a map with zero-size elements)
 	OKEY           // Key:Value (key:value in struct/array/map literal)
 	OSTRUCTKEY     // Field:Value (key:value in struct literal, after type checking)
 	OLEN           // len(X)
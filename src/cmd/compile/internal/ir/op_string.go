@@ -46,124 +46,126 @@ func _() {
 	_ = x[OCLOSE-35]
 	_ = x[OCLOSURE-36]
 	_ = x[OCOMPLIT-37]
-	_ = x[OMAPLIT-38]
-	_ = x[OSTRUCTLIT-39]
-	_ = x[OARRAYLIT-40]
-	_ = x[OSLICELIT-41]
-	_ = x[OPTRLIT-42]
-	_ = x[OCONV-43]
-	_ = x[OCONVIFACE-44]
-	_ = x[OCONVNOP-45]
-	_ = x[OCOPY-46]
-	_ = x[ODCL-47]
-	_ = x[ODCLFUNC-48]
-	_ = x[ODELETE-49]
-	_ = x[ODOT-50]
-	_ = x[ODOTPTR-51]
-	_ = x[ODOTMETH-52]
-	_ = x[ODOTINTER-53]
-	_ = x[OXDOT-54]
-	_ = x[ODOTTYPE-55]
-	_ = x[ODOTTYPE2-56]
-	_ = x[OEQ-57]
-	_ = x[ONE-58]
-	_ = x[OLT-59]
-	_ = x[OLE-60]
-	_ = x[OGE-61]
-	_ = x[OGT-62]
-	_ = x[ODEREF-63]
-	_ = x[OINDEX-64]
-	_ = x[OINDEXMAP-65]
-	_ = x[OKEY-66]
-	_ = x[OSTRUCTKEY-67]
-	_ = x[OLEN-68]
-	_ = x[OMAKE-69]
-	_ = x[OMAKECHAN-70]
-	_ = x[OMAKEMAP-71]
-	_ = x[OMAKESLICE-72]
-	_ = x[OMAKESLICECOPY-73]
-	_ = x[OMUL-74]
-	_ = x[ODIV-75]
-	_ = x[OMOD-76]
-	_ = x[OLSH-77]
-	_ = x[ORSH-78]
-	_ = x[OAND-79]
-	_ = x[OANDNOT-80]
-	_ = x[ONEW-81]
-	_ = x[ONOT-82]
-	_ = x[OBITNOT-83]
-	_ = x[OPLUS-84]
-	_ = x[ONEG-85]
-	_ = x[OOROR-86]
-	_ = x[OPANIC-87]
-	_ = x[OPRINT-88]
-	_ = x[OPRINTLN-89]
-	_ = x[OPAREN-90]
-	_ = x[OSEND-91]
-	_ = x[OSLICE-92]
-	_ = x[OSLICEARR-93]
-	_ = x[OSLICESTR-94]
-	_ = x[OSLICE3-95]
-	_ = x[OSLICE3ARR-96]
-	_ = x[OSLICEHEADER-97]
-	_ = x[OSTRINGHEADER-98]
-	_ = x[ORECOVER-99]
-	_ = x[ORECOVERFP-100]
-	_ = x[ORECV-101]
-	_ = x[ORUNESTR-102]
-	_ = x[OSELRECV2-103]
-	_ = x[OMIN-104]
-	_ = x[OMAX-105]
-	_ = x[OREAL-106]
-	_ = x[OIMAG-107]
-	_ = x[OCOMPLEX-108]
-	_ = x[OUNSAFEADD-109]
-	_ = x[OUNSAFESLICE-110]
-	_ = x[OUNSAFESLICEDATA-111]
-	_ = x[OUNSAFESTRING-112]
-	_ = x[OUNSAFESTRINGDATA-113]
-	_ = x[OMETHEXPR-114]
-	_ = x[OMETHVALUE-115]
-	_ = x[OBLOCK-116]
-	_ = x[OBREAK-117]
-	_ = x[OCASE-118]
-	_ = x[OCONTINUE-119]
-	_ = x[ODEFER-120]
-	_ = x[OFALL-121]
-	_ = x[OFOR-122]
-	_ = x[OGOTO-123]
-	_ = x[OIF-124]
-	_ = x[OLABEL-125]
-	_ = x[OGO-126]
-	_ = x[ORANGE-127]
-	_ = x[ORETURN-128]
-	_ = x[OSELECT-129]
-	_ = x[OSWITCH-130]
-	_ = x[OTYPESW-131]
-	_ = x[OINLCALL-132]
-	_ = x[OMAKEFACE-133]
-	_ = x[OITAB-134]
-	_ = x[OIDATA-135]
-	_ = x[OSPTR-136]
-	_ = x[OCFUNC-137]
-	_ = x[OCHECKNIL-138]
-	_ = x[ORESULT-139]
-	_ = x[OINLMARK-140]
-	_ = x[OLINKSYMOFFSET-141]
-	_ = x[OJUMPTABLE-142]
-	_ = x[OINTERFACESWITCH-143]
-	_ = x[ODYNAMICDOTTYPE-144]
-	_ = x[ODYNAMICDOTTYPE2-145]
-	_ = x[ODYNAMICTYPE-146]
-	_ = x[OTAILCALL-147]
-	_ = x[OGETG-148]
-	_ = x[OGETCALLERSP-149]
-	_ = x[OEND-150]
+	_ = x[OCOND-38]
+	_ = x[OMAPLIT-39]
+	_ = x[OSTRUCTLIT-40]
+	_ = x[OARRAYLIT-41]
+	_ = x[OSLICELIT-42]
+	_ = x[OPTRLIT-43]
+	_ = x[OCONV-44]
+	_ = x[OCONVIFACE-45]
+	_ = x[OCONVNOP-46]
+	_ = x[OCOPY-47]
+	_ = x[ODCL-48]
+	_ = x[ODCLFUNC-49]
+	_ = x[ODELETE-50]
+	_ = x[ODOT-51]
+	_ = x[ODOTPTR-52]
+	_ = x[ODOTMETH-53]
+	_ = x[ODOTINTER-54]
+	_ = x[OXDOT-55]
+	_ = x[ODOTTYPE-56]
+	_ = x[ODOTTYPE2-57]
+	_ = x[OEQ-58]
+	_ = x[ONE-59]
+	_ = x[OLT-60]
+	_ = x[OLE-61]
+	_ = x[OGE-62]
+	_ = x[OGT-63]
+	_ = x[ODEREF-64]
+	_ = x[OINDEX-65]
+	_ = x[OINDEXMAP-66]
+	_ = x[OINDEXSET-67]
+	_ = x[OKEY-68]
+	_ = x[OSTRUCTKEY-69]
+	_ = x[OLEN-70]
+	_ = x[OMAKE-71]
+	_ = x[OMAKECHAN-72]
+	_ = x[OMAKEMAP-73]
+	_ = x[OMAKESLICE-74]
+	_ = x[OMAKESLICECOPY-75]
+	_ = x[OMUL-76]
+	_ = x[ODIV-77]
+	_ = x[OMOD-78]
+	_ = x[OLSH-79]
+	_ = x[ORSH-80]
+	_ = x[OAND-81]
+	_ = x[OANDNOT-82]
+	_ = x[ONEW-83]
+	_ = x[ONOT-84]
+	_ = x[OBITNOT-85]
+	_ = x[OPLUS-86]
+	_ = x[ONEG-87]
+	_ = x[OOROR-88]
+	_ = x[OPANIC-89]
+	_ = x[OPRINT-90]
+	_ = x[OPRINTLN-91]
+	_ = x[OPAREN-92]
+	_ = x[OSEND-93]
+	_ = x[OSLICE-94]
+	_ = x[OSLICEARR-95]
+	_ = x[OSLICESTR-96]
+	_ = x[OSLICE3-97]
+	_ = x[OSLICE3ARR-98]
+	_ = x[OSLICEHEADER-99]
+	_ = x[OSTRINGHEADER-100]
+	_ = x[ORECOVER-101]
+	_ = x[ORECOVERFP-102]
+	_ = x[ORECV-103]
+	_ = x[ORUNESTR-104]
+	_ = x[OSELRECV2-105]
+	_ = x[OMIN-106]
+	_ = x[OMAX-107]
+	_ = x[OREAL-108]
+	_ = x[OIMAG-109]
+	_ = x[OCOMPLEX-110]
+	_ = x[OUNSAFEADD-111]
+	_ = x[OUNSAFESLICE-112]
+	_ = x[OUNSAFESLICEDATA-113]
+	_ = x[OUNSAFESTRING-114]
+	_ = x[OUNSAFESTRINGDATA-115]
+	_ = x[OMETHEXPR-116]
+	_ = x[OMETHVALUE-117]
+	_ = x[OBLOCK-118]
+	_ = x[OBREAK-119]
+	_ = x[OCASE-120]
+	_ = x[OCONTINUE-121]
+	_ = x[ODEFER-122]
+	_ = x[OFALL-123]
+	_ = x[OFOR-124]
+	_ = x[OGOTO-125]
+	_ = x[OIF-126]
+	_ = x[OLABEL-127]
+	_ = x[OGO-128]
+	_ = x[ORANGE-129]
+	_ = x[ORETURN-130]
+	_ = x[OSELECT-131]
+	_ = x[OSWITCH-132]
+	_ = x[OTYPESW-133]
+	_ = x[OINLCALL-134]
+	_ = x[OMAKEFACE-135]
+	_ = x[OITAB-136]
+	_ = x[OIDATA-137]
+	_ = x[OSPTR-138]
+	_ = x[OCFUNC-139]
+	_ = x[OCHECKNIL-140]
+	_ = x[ORESULT-141]
+	_ = x[OINLMARK-142]
+	_ = x[OLINKSYMOFFSET-143]
+	_ = x[OJUMPTABLE-144]
+	_ = x[OINTERFACESWITCH-145]
+	_ = x[ODYNAMICDOTTYPE-146]
+	_ = x[ODYNAMICDOTTYPE2-147]
+	_ = x[ODYNAMICTYPE-148]
+	_ = x[OTAILCALL-149]
+	_ = x[OGETG-150]
+	_ = x[OGETCALLERSP-151]
+	_ = x[OEND-152]
 }
 
-const _Op_name = "XXXNAMENONAMETYPELITERALNILADDSUBORXORADDSTRADDRANDANDAPPENDBYTES2STRBYTES2STRTMPRUNES2STRSTR2BYTESSTR2BYTESTMPSTR2RUNESSLICE2ARRSLICE2ARRPTRASAS2AS2DOTTYPEAS2FUNCAS2MAPRAS2RECVASOPCALLCALLFUNCCALLMETHCALLINTERCAPCLEARCLOSECLOSURECOMPLITMAPLITSTRUCTLITARRAYLITSLICELITPTRLITCONVCONVIFACECONVNOPCOPYDCLDCLFUNCDELETEDOTDOTPTRDOTMETHDOTINTERXDOTDOTTYPEDOTTYPE2EQNELTLEGEGTDEREFINDEXINDEXMAPKEYSTRUCTKEYLENMAKEMAKECHANMAKEMAPMAKESLICEMAKESLICECOPYMULDIVMODLSHRSHANDANDNOTNEWNOTBITNOTPLUSNEGORORPANICPRINTPRINTLNPARENSENDSLICESLICEARRSLICESTRSLICE3SLICE3ARRSLICEHEADERSTRINGHEADERRECOVERRECOVERFPRECVRUNESTRSELRECV2MINMAXREALIMAGCOMPLEXUNSAFEADDUNSAFESLICEUNSAFESLICEDATAUNSAFESTRINGUNSAFESTRINGDATAMETHEXPRMETHVALUEBLOCKBREAKCASECONTINUEDEFERFALLFORGOTOIFLABELGORANGERETURNSELECTSWITCHTYPESWINLCALLMAKEFACEITABIDATASPTRCFUNCCHECKNILRESULTINLMARKLINKSYMOFFSETJUMPTABLEINTERFACESWITCHDYNAMICDOTTYPEDYNAMICDOTTYPE2DYNAMICTYPETAILCALLGETGGETCALLERSPEND"
+const _Op_name = "XXXNAMENONAMETYPELITERALNILADDSUBORXORADDSTRADDRANDANDAPPENDBYTES2STRBYTES2STRTMPRUNES2STRSTR2BYTESSTR2BYTESTMPSTR2RUNESSLICE2ARRSLICE2ARRPTRASAS2AS2DOTTYPEAS2FUNCAS2MAPRAS2RECVASOPCALLCALLFUNCCALLMETHCALLINTERCAPCLEARCLOSECLOSURECOMPLITCONDMAPLITSTRUCTLITARRAYLITSLICELITPTRLITCONVCONVIFACECONVNOPCOPYDCLDCLFUNCDELETEDOTDOTPTRDOTMETHDOTINTERXDOTDOTTYPEDOTTYPE2EQNELTLEGEGTDEREFINDEXINDEXMAPINDEXSETKEYSTRUCTKEYLENMAKEMAKECHANMAKEMAPMAKESLICEMAKESLICECOPYMULDIVMODLSHRSHANDANDNOTNEWNOTBITNOTPLUSNEGORORPANICPRINTPRINTLNPARENSENDSLICESLICEARRSLICESTRSLICE3SLICE3ARRSLICEHEADERSTRINGHEADERRECOVERRECOVERFPRECVRUNESTRSELRECV2MINMAXREALIMAGCOMPLEXUNSAFEADDUNSAFESLICEUNSAFESLICEDATAUNSAFESTRINGUNSAFESTRINGDATAMETHEXPRMETHVALUEBLOCKBREAKCASECONTINUEDEFERFALLFORGOTOIFLABELGORANGERETURNSELECTSWITCHTYPESWINLCALLMAKEFACEITABIDATASPTRCFUNCCHECKNILRESULTINLMARKLINKSYMOFFSETJUMPTABLEINTERFACESWITCHDYNAMICDOTTYPEDYNAMICDOTTYPE2DYNAMICTYPETAILCALLGETGGETCALLERSPEND"
 
-var _Op_index = [...]uint16{0, 3, 7, 13, 17, 24, 27, 30, 33, 35, 38, 44, 48, 54, 60, 69, 81, 90, 99, 111, 120, 129, 141, 143, 146, 156, 163, 170, 177, 181, 185, 193, 201, 210, 213, 218, 223, 230, 237, 243, 252, 260, 268, 274, 278, 287, 294, 298, 301, 308, 314, 317, 323, 330, 338, 342, 349, 357, 359, 361, 363, 365, 367, 369, 374, 379, 387, 390, 399, 402, 406, 414, 421, 430, 443, 446, 449, 452, 455, 458, 461, 467, 470, 473, 479, 483, 486, 490, 495, 500, 507, 512, 516, 521, 529, 537, 543, 552, 563, 575, 582, 591, 595, 602, 610, 613, 616, 620, 624, 631, 640, 651, 666, 678, 694, 702, 711, 716, 721, 725, 733, 738, 742, 745, 749, 751, 756, 758, 763, 769, 775, 781, 787, 794, 802, 806, 811, 815, 820, 828, 834, 841, 854, 863, 878, 892, 907, 918, 926, 930, 941, 944}
+var _Op_index = [...]uint16{0, 3, 7, 13, 17, 24, 27, 30, 33, 35, 38, 44, 48, 54, 60, 69, 81, 90, 99, 111, 120, 129, 141, 143, 146, 156, 163, 170, 177, 181, 185, 193, 201, 210, 213, 218, 223, 230, 237, 241, 247, 256, 264, 272, 278, 282, 291, 298, 302, 305, 312, 318, 321, 327, 334, 342, 346, 353, 361, 363, 365, 367, 369, 371, 373, 378, 383, 391, 399, 402, 411, 414, 418, 426, 433, 442, 455, 458, 461, 464, 467, 470, 473, 479, 482, 485, 491, 495, 498, 502, 507, 512, 519, 524, 528, 533, 541, 549, 555, 564, 575, 587, 594, 603, 607, 614, 622, 625, 628, 632, 636, 643, 652, 663, 678, 690, 706, 714, 723, 728, 733, 737, 745, 750, 754, 757, 761, 763, 768, 770, 775, 781, 787, 793, 799, 806, 814, 818, 823, 827, 832, 840, 846, 853, 866, 875, 890, 904, 919, 930, 938, 942, 953, 956}
 
 func (i Op) String() string {
 	if i >= Op(len(_Op_index)-1) {
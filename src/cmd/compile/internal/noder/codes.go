@@ -63,6 +63,18 @@ const (
 	exprRecv
 	exprReshape
 	exprRuntimeBuiltin // a reference to a runtime function from transformed syntax. Followed by string name, e.g., "panicrangeexit"
+	exprCond           // Wo conditional expression
+	exprSetIndex       // Wo set membership test
+	exprSetAdd         // Wo set add method call
+	exprSetDelete      // Wo set delete method call
+	exprOptional       // Wo conversion to an optional type
+	exprUnwrap         // Wo postfix ? operator
+	exprIsPresent      // Wo optional IsPresent method call
+	exprOrElse         // Wo optional OrElse method call
+	exprPropagate      // Wo postfix ! operator
+	exprVariant        // Wo enum variant
+	exprEnumField      // Wo enum field selection
+	exprEnumUnmarshal  // Wo enum UnmarshalText method body
 )
 
 type codeAssign int
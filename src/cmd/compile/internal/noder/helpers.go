@@ -5,6 +5,7 @@
 package noder
 
 import (
+	"fmt"
 	"go/constant"
 
 	"cmd/compile/internal/ir"
@@ -38,6 +39,64 @@ func typed(typ *types.Type, n ir.Node) ir.Node {
 	return n
 }
 
+// Types
+
+// optionalType returns the representation of the Wo optional type
+// elem?, which is laid out like:
+//
+//	struct {
+//		v  elem
+//		ok bool
+//	}
+//
+// The zero value represents None. The field names are declared in
+// the builtin package so they cannot collide with user-declared
+// struct types.
+func optionalType(elem *types.Type) *types.Type {
+	return types.NewStruct([]*types.Field{
+		types.NewField(src.NoXPos, types.BuiltinPkg.Lookup("v"), elem),
+		types.NewField(src.NoXPos, types.BuiltinPkg.Lookup("ok"), types.Types[types.TBOOL]),
+	})
+}
+
+// enumType returns the representation of a Wo enum type whose
+// variants have the given fields. If no variant has fields, a value
+// of the enum is represented by the index of its variant, an unsigned
+// integer of the smallest sufficient size. Otherwise, the enum is a
+// sum type laid out like:
+//
+//	struct {
+//		tag uintN
+//		v0  struct{ fields of variant 0 }
+//		v1  struct{ fields of variant 1 }
+//		...
+//	}
+//
+// As for optional types, the field names are declared in the builtin
+// package.
+func enumType(variants [][]*types.Field) *types.Type {
+	var tag *types.Type
+	switch n := len(variants); {
+	case n <= 1<<8:
+		tag = types.Types[types.TUINT8]
+	case n <= 1<<16:
+		tag = types.Types[types.TUINT16]
+	default:
+		tag = types.Types[types.TUINT32]
+	}
+
+	fields := []*types.Field{types.NewField(src.NoXPos, types.BuiltinPkg.Lookup("tag"), tag)}
+	sum := false
+	for i, vfields := range variants {
+		sum = sum || len(vfields) > 0
+		fields = append(fields, types.NewField(src.NoXPos, types.BuiltinPkg.Lookup(fmt.Sprintf("v%d", i)), types.NewStruct(vfields)))
+	}
+	if !sum {
+		return tag
+	}
+	return types.NewStruct(fields)
+}
+
 // Values
 
 // FixValue returns val after converting and truncating it as
@@ -73,6 +132,40 @@ func Deref(pos src.XPos, typ *types.Type, x ir.Node) *ir.StarExpr {
 	return n
 }
 
+// optionalLit returns a value of the Wo optional type typ with the
+// given element value and presence flag.
+func optionalLit(pos src.XPos, typ *types.Type, value, ok ir.Node) ir.Node {
+	return typecheck.Expr(ir.NewCompLitExpr(pos, ir.OCOMPLIT, typ, []ir.Node{
+		ir.NewStructKeyExpr(pos, typ.Field(0), value),
+		ir.NewStructKeyExpr(pos, typ.Field(1), ok),
+	}))
+}
+
+// enumLit returns the value of the Wo enum type typ for the variant
+// with the given index and field values.
+func enumLit(pos src.XPos, typ *types.Type, index int, values []ir.Node) ir.Node {
+	if !typ.IsStruct() {
+		return ir.NewBasicLit(pos, typ, constant.MakeInt64(int64(index)))
+	}
+	vtyp := typ.Field(index + 1).Type
+	elems := make([]ir.Node, len(values))
+	for i, value := range values {
+		elems[i] = ir.NewStructKeyExpr(pos, vtyp.Field(i), value)
+	}
+	return typecheck.Expr(ir.NewCompLitExpr(pos, ir.OCOMPLIT, typ, []ir.Node{
+		ir.NewStructKeyExpr(pos, typ.Field(0), ir.NewBasicLit(pos, typ.Field(0).Type, constant.MakeInt64(int64(index)))),
+		ir.NewStructKeyExpr(pos, typ.Field(index+1), ir.NewCompLitExpr(pos, ir.OCOMPLIT, vtyp, elems)),
+	}))
+}
+
+// enumTag returns the index of the variant of the Wo enum value x.
+func enumTag(pos src.XPos, x ir.Node) ir.Node {
+	if x.Type().IsStruct() {
+		return typecheck.DotField(pos, x, 0)
+	}
+	return x
+}
+
 // Statements
 
 func idealType(tv syntax.TypeAndValue) types2.Type {
@@ -19,6 +19,7 @@ import (
 )
 
 var versionErrorRx = regexp.MustCompile(`requires go[0-9]+\.[0-9]+ or later`)
+var woErrorRx = regexp.MustCompile(`requires Wo feature [a-z]+, which is disabled`)
 
 // checkFiles configures and runs the types2 checker on the given
 // parsed source files and then returns the result.
@@ -53,6 +54,7 @@ func checkFiles(m posMap, noders []*noder) (*types2.Package, *types2.Info, map[*
 	conf := types2.Config{
 		Context:            ctxt,
 		GoVersion:          base.Flag.Lang,
+		DisabledWoFeatures: syntax.AllFeatures &^ base.Flag.Cfg.WoFeatures,
 		IgnoreBranchErrors: true, // parser already checked via syntax.CheckBranches mode
 		Importer:           &importer,
 		Sizes:              types2.SizesFor("gc", buildcfg.GOARCH),
@@ -70,6 +72,7 @@ func checkFiles(m posMap, noders []*noder) (*types2.Package, *types2.Info, map[*
 		Scopes:             make(map[syntax.Node]*types2.Scope),
 		Instances:          make(map[*syntax.Name]types2.Instance),
 		FileVersions:       make(map[*syntax.PosBase]string),
+		DefaultArgs:        make(map[*syntax.CallExpr][]syntax.Expr),
 		// expand as needed
 	}
 	conf.Error = func(err error) {
@@ -88,11 +91,19 @@ func checkFiles(m posMap, noders []*noder) (*types2.Package, *types2.Info, map[*
 				// Otherwise, hint at the -lang setting.
 				msg = fmt.Sprintf("%s (-lang was set to %s; check go.mod)", msg, base.Flag.Lang)
 			}
+		} else if woErrorRx.MatchString(msg) {
+			msg = fmt.Sprintf("%s (-wo was set to %s)", msg, base.Flag.Wo)
+		}
+		if terr.Severity == types2.SeverityWarning {
+			base.WarnfCodeAt(m.makeXPos(terr.Pos), terr.Code, "%s", msg)
+			return
 		}
 		base.ErrorfAt(m.makeXPos(terr.Pos), terr.Code, "%s", msg)
 	}
 
-	pkg, err := conf.Check(base.Ctxt.Pkgpath, files, info)
+	pkg := types2.NewPackage(base.Ctxt.Pkgpath, "")
+	pkg.SetModule(base.Flag.Module)
+	err := types2.NewChecker(&conf, pkg, info).Files(files)
 	base.ExitIfErrors()
 	if err != nil {
 		base.FatalfAt(src.NoXPos, "conf.Check error: %v", err)
@@ -172,6 +183,13 @@ func checkFiles(m posMap, noders []*noder) (*types2.Package, *types2.Info, map[*
 	}
 	base.ExitIfErrors()
 
+	// With -entry, the named function replaces main as the program
+	// entry point, so it must be declared like main.
+	if base.Flag.Entry != "" && pkg.Name() == "main" {
+		checkEntry(m, pkg, base.Flag.Entry)
+		base.ExitIfErrors()
+	}
+
 	// Rewrite range over function to explicit function calls
 	// with the loop bodies converted into new implicit closures.
 	// We do this now, before serialization to unified IR, so that if the
@@ -218,6 +236,10 @@ func (f *cycleFinder) visit(typ0 types2.Type) bool {
 			typ0 = typ.Elem()
 		case *types2.Chan:
 			typ0 = typ.Elem()
+		case *types2.Set:
+			typ0 = typ.Elem()
+		case *types2.Optional:
+			typ0 = typ.Elem()
 		case *types2.Map:
 			if f.visit(typ.Key()) {
 				return true
@@ -234,6 +256,17 @@ func (f *cycleFinder) visit(typ0 types2.Type) bool {
 			}
 			return false
 
+		case *types2.Enum:
+			for i := 0; i < typ.NumVariants(); i++ {
+				v := typ.Variant(i)
+				for j := 0; j < v.NumFields(); j++ {
+					if f.visit(v.Field(j).Type()) {
+						return true
+					}
+				}
+			}
+			return false
+
 		case *types2.Interface:
 			// The empty interface (e.g., "any") cannot be part of a cycle.
 			if typ.NumExplicitMethods() == 0 && typ.NumEmbeddeds() == 0 {
@@ -271,3 +304,21 @@ func (f *cycleFinder) visit(typ0 types2.Type) bool {
 		}
 	}
 }
+
+// checkEntry reports an error unless the main package pkg declares
+// a function name suitable as the program entry point.
+func checkEntry(m posMap, pkg *types2.Package, name string) {
+	fn, ok := pkg.Scope().Lookup(name).(*types2.Func)
+	if !ok {
+		base.Errorf("function %s is undeclared in the main package", name)
+		return
+	}
+	sig := fn.Type().(*types2.Signature)
+	if sig.TypeParams().Len() != 0 {
+		base.ErrorfAt(m.makeXPos(fn.Pos()), 0, "func %s must have no type parameters", name)
+		return
+	}
+	if sig.Params().Len() != 0 || sig.Results().Len() != 0 {
+		base.ErrorfAt(m.makeXPos(fn.Pos()), 0, "func %s must have no arguments and no return values", name)
+	}
+}
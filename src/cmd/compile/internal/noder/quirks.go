@@ -28,7 +28,12 @@ func typeExprEndPos(expr0 syntax.Expr) syntax.Pos {
 			expr0 = expr.X
 
 		case *syntax.Operation:
-			assert(expr.Op == syntax.Mul)
+			if expr.Op == syntax.Or && expr.Y != nil {
+				// Wo union type
+				expr0 = expr.Y
+				break
+			}
+			assert(expr.Op == syntax.Mul || expr.Op == syntax.Question)
 			assert(expr.Y == nil)
 			expr0 = expr.X
 
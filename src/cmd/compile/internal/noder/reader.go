@@ -364,6 +364,7 @@ func (r *reader) doPkg() *types.Pkg {
 	}
 
 	name := r.String()
+	_ = r.String() // module path, only needed by types2
 
 	pkg := types.NewPkg(path, "")
 
@@ -514,6 +515,13 @@ func (r *reader) doTyp() *types.Type {
 		return types.NewChan(r.typ(), dir)
 	case pkgbits.TypeMap:
 		return types.NewMap(r.typ(), r.typ())
+	case pkgbits.TypeSet:
+		// Wo sets are maps with zero-size elements.
+		return types.NewMap(r.typ(), types.NewStruct(nil))
+	case pkgbits.TypeOptional:
+		return optionalType(r.typ())
+	case pkgbits.TypeEnum:
+		return r.enumType()
 	case pkgbits.TypePointer:
 		return types.NewPtr(r.typ())
 	case pkgbits.TypeSignature:
@@ -598,6 +606,31 @@ func (r *reader) structType() *types.Type {
 	return types.NewStruct(fields)
 }
 
+func (r *reader) enumType() *types.Type {
+	nfields := r.Len()
+	for i := 0; i < nfields; i++ {
+		r.pos()
+		r.selector()
+		r.typ()
+	}
+
+	// The values of shared fields are only needed by types2.
+	variants := make([][]*types.Field, r.Len())
+	for i := range variants {
+		r.pos()
+		r.selector()
+		for j := 0; j < nfields; j++ {
+			r.Value()
+		}
+		fields := make([]*types.Field, r.Len())
+		for j := range fields {
+			fields[j] = types.NewField(r.pos(), r.selector(), r.typ())
+		}
+		variants[i] = fields
+	}
+	return enumType(variants)
+}
+
 func (r *reader) signature(recv *types.Field) *types.Type {
 	r.Sync(pkgbits.SyncSignature)
 
@@ -606,10 +639,44 @@ func (r *reader) signature(recv *types.Field) *types.Type {
 	if r.Bool() { // variadic
 		params[len(params)-1].SetIsDDD(true)
 	}
+	r.defaults(len(params))
+	r.skips(len(results))
 
 	return types.NewSignature(recv, params, results)
 }
 
+// defaults skips the default values of n Wo parameters. Calls that
+// omit arguments already have them filled in by the writer.
+func (r *reader) defaults(n int) {
+	if !r.Bool() {
+		return
+	}
+	for range n {
+		if !r.Bool() {
+			continue
+		}
+		switch pkgbits.CodeDefault(r.Code(pkgbits.SyncCodeDefault)) {
+		case pkgbits.DefaultConst:
+			_ = r.Value()
+		case pkgbits.DefaultNil:
+			_ = r.String()
+		case pkgbits.DefaultObj:
+			_ = r.obj()
+		}
+	}
+}
+
+// skips skips the skip modifiers of n Wo results. Assignments that
+// omit skippable results already assign them to blank identifiers.
+func (r *reader) skips(n int) {
+	if !r.Bool() {
+		return
+	}
+	for range n {
+		_ = r.Bool()
+	}
+}
+
 func (r *reader) params() []*types.Field {
 	r.Sync(pkgbits.SyncParams)
 	params := make([]*types.Field, r.Len())
@@ -688,6 +755,9 @@ func (pr *pkgReader) objIdxMayFail(idx index, implicits, explicits []*types.Type
 	rname := pr.newReader(pkgbits.RelocName, idx, pkgbits.SyncObject1)
 	_, sym := rname.qualifiedIdent()
 	tag := pkgbits.CodeObj(rname.Code(pkgbits.SyncCodeObj))
+	if pkgbits.CodeVis(rname.Code(pkgbits.SyncCodeVis)) == pkgbits.VisExport {
+		sym.SetExport(true)
+	}
 
 	if tag == pkgbits.ObjStub {
 		assert(!sym.IsBlank())
@@ -780,15 +850,30 @@ func (pr *pkgReader) objIdxMayFail(idx index, implicits, explicits []*types.Type
 		setValue(name, val)
 		return name, nil
 
-	case pkgbits.ObjFunc:
+	case pkgbits.ObjFunc, pkgbits.ObjMethod:
 		if sym.Name == "init" {
 			sym = Renameinit()
 		}
 
-		npos := r.pos()
-		setBasePos(npos)
-		r.typeParamNames()
-		typ := r.signature(nil)
+		var npos src.XPos
+		var typ *types.Type
+		if tag == pkgbits.ObjMethod {
+			// A Wo method with type parameters is a generic function
+			// whose first parameter is the receiver.
+			r.Sync(pkgbits.SyncMethod)
+			npos = r.pos()
+			setBasePos(npos)
+			r.selector()
+			r.typeParamNames()
+			recv := r.param()
+			typ = r.signature(nil)
+			typ = types.NewSignature(nil, append([]*types.Field{recv}, typ.Params()...), typ.Results())
+		} else {
+			npos = r.pos()
+			setBasePos(npos)
+			r.typeParamNames()
+			typ = r.signature(nil)
+		}
 		fpos := r.pos()
 
 		fn := ir.NewFunc(fpos, npos, sym, typ)
@@ -846,6 +931,11 @@ func (pr *pkgReader) objIdxMayFail(idx index, implicits, explicits []*types.Type
 			typ.SetMethods(methods)
 		}
 
+		// Wo methods with type parameters are read when instantiated.
+		for i := r.Len(); i > 0; i-- {
+			r.Reloc(pkgbits.RelocObj)
+		}
+
 		if !r.dict.shaped {
 			r.needWrapper(typ)
 		}
@@ -1858,6 +1948,22 @@ func (r *reader) forStmt(label *types.Sym) ir.Node {
 		return rang
 	}
 
+	if r.Bool() {
+		pos := r.pos()
+		init, cond, bind := r.varClause()
+		body := r.blockStmt()
+		r.Bool() // the bound variables are per iteration anyway
+		r.closeAnotherScope()
+
+		// Evaluate the value at the start of each iteration, and
+		// leave the loop if it does not provide the variables.
+		exit := typecheck.Expr(ir.NewUnaryExpr(pos, ir.ONOT, cond))
+		init = append(init, typecheck.Stmt(ir.NewIfStmt(pos, exit, []ir.Node{ir.NewBranchStmt(pos, ir.OBREAK, nil)}, nil)), bind)
+		stmt := ir.NewForStmt(pos, nil, nil, nil, append(init, body...), false)
+		stmt.Label = label
+		return stmt
+	}
+
 	pos := r.pos()
 	init := r.stmt()
 	cond := r.optExpr()
@@ -1879,12 +1985,21 @@ func (r *reader) ifStmt() ir.Node {
 	r.Sync(pkgbits.SyncIfStmt)
 	r.openScope()
 	pos := r.pos()
-	init := r.stmts()
-	cond := r.expr()
+	var init ir.Nodes
+	var cond, bind ir.Node
+	if r.Bool() {
+		init, cond, bind = r.varClause()
+	} else {
+		init = r.stmts()
+		cond = r.expr()
+	}
 	staticCond := r.Int()
 	var then, els []ir.Node
 	if staticCond >= 0 {
 		then = r.blockStmt()
+		if bind != nil {
+			then = append([]ir.Node{bind}, then...)
+		}
 	} else {
 		r.lastCloseScopePos = r.pos()
 	}
@@ -1911,6 +2026,45 @@ func (r *reader) ifStmt() ir.Node {
 	return n
 }
 
+// varClause reads a Wo conditional binding clause. It returns the
+// statements that evaluate the value, the condition under which the
+// value provides the bound variables, and the assignment that
+// declares them.
+func (r *reader) varClause() (init ir.Nodes, cond, bind ir.Node) {
+	pos := r.pos()
+	names, lhs := r.assignList()
+
+	var values []ir.Node
+	if r.Bool() {
+		// Values followed by a bool or an error.
+		results := r.multiValue()
+		init = ir.TakeInit(results[0])
+		last := results[len(results)-1]
+		values = results[:len(results)-1]
+		cond = last
+		if !last.Type().IsBoolean() {
+			cond = typecheck.Expr(ir.NewBinaryExpr(pos, ir.OEQ, last, ir.NewNilExpr(pos, last.Type())))
+		}
+	} else {
+		// Optional value.
+		tmp := r.tempCopy(pos, r.expr(), &init)
+		cond = typecheck.DotField(pos, tmp, 1)
+		var value ir.Node = typecheck.DotField(pos, tmp, 0)
+		if r.Bool() {
+			n := ir.NewConvExpr(pos, ir.OCONV, r.typ(), value)
+			n.TypeWord, n.SrcRType = r.convRTTI(pos)
+			n.SetImplicit(true)
+			value = typecheck.Expr(n)
+		}
+		values = []ir.Node{value}
+	}
+
+	as := ir.NewAssignListStmt(pos, ir.OAS2, lhs, values)
+	as.Def = r.initDefn(as, names)
+	bind = typecheck.Stmt(as)
+	return
+}
+
 func (r *reader) selectStmt(label *types.Sym) ir.Node {
 	r.Sync(pkgbits.SyncSelectStmt)
 
@@ -1984,6 +2138,8 @@ func (r *reader) switchStmt(label *types.Sym) ir.Node {
 	var tag ir.Node
 	var ident *ir.Ident
 	var iface *types.Type
+	var sum *ir.Name // copy of the Wo enum value switched on, if of a sum type
+	var sumInit ir.Nodes
 	if r.Bool() {
 		pos := r.pos()
 		if r.Bool() {
@@ -1994,6 +2150,10 @@ func (r *reader) switchStmt(label *types.Sym) ir.Node {
 		tag = ir.NewTypeSwitchGuard(pos, ident, x)
 	} else {
 		tag = r.optExpr()
+		if tag != nil && r.Bool() {
+			sum = r.tempCopy(pos, tag, &sumInit)
+			tag = enumTag(pos, sum)
+		}
 	}
 
 	clauses := make([]*ir.CaseClause, r.Len())
@@ -2005,7 +2165,29 @@ func (r *reader) switchStmt(label *types.Sym) ir.Node {
 
 		pos := r.pos()
 		var cases, rtypes []ir.Node
-		if iface != nil {
+		var bindings []ir.Node
+		if sum != nil {
+			cases = make([]ir.Node, r.Len())
+			for i := range cases {
+				pos := r.pos()
+				index := r.Len()
+				cases[i] = ir.NewBasicLit(pos, tag.Type(), constant.MakeInt64(int64(index)))
+
+				// Bind the values of the variant's fields.
+				for j, n := 0, r.Len(); j < n; j++ {
+					if !r.Bool() {
+						continue
+					}
+					name := r.curfn.NewLocal(r.pos(), r.localIdent(), r.typ())
+					r.addLocal(name)
+					value := typecheck.DotField(pos, typecheck.DotField(pos, sum, index+1), j)
+					as := ir.NewAssignStmt(name.Pos(), name, value)
+					as.Def = true
+					name.Defn = as
+					bindings = append(bindings, typecheck.Stmt(ir.NewDecl(name.Pos(), ir.ODCL, name)), typecheck.Stmt(as))
+				}
+			}
+		} else if iface != nil {
 			cases = make([]ir.Node, r.Len())
 			if len(cases) == 0 {
 				cases = nil // TODO(mdempsky): Unclear if this matters.
@@ -2050,6 +2232,9 @@ func (r *reader) switchStmt(label *types.Sym) ir.Node {
 		}
 
 		clause.Body = r.stmts()
+		if bindings != nil {
+			clause.Body = append(bindings, clause.Body...)
+		}
 		clauses[i] = clause
 	}
 	if len(clauses) > 0 {
@@ -2062,6 +2247,7 @@ func (r *reader) switchStmt(label *types.Sym) ir.Node {
 	if init != nil {
 		n.SetInit([]ir.Node{init})
 	}
+	n.PtrInit().Append(sumInit...)
 	return n
 }
 
@@ -2138,7 +2324,11 @@ func (r *reader) expr() (res ir.Node) {
 	case exprZero:
 		pos := r.pos()
 		typ := r.typ()
-		return ir.NewZero(pos, typ)
+		zero := ir.NewZero(pos, typ)
+		if typ.IsStruct() { // None of a Wo optional type
+			zero = typecheck.Expr(zero)
+		}
+		return zero
 
 	case exprCompLit:
 		return r.compLit()
@@ -2268,6 +2458,157 @@ func (r *reader) expr() (res ir.Node) {
 		}
 		return n
 
+	case exprSetIndex:
+		x := r.expr()
+		pos := r.pos()
+		index := r.expr()
+		n := ir.NewIndexExpr(pos, x, index)
+		n.SetOp(ir.OINDEXSET)
+		n.RType = r.rtype(pos)
+		return typed(types.Types[types.TBOOL], n)
+
+	case exprSetAdd:
+		pos := r.pos()
+		set := r.expr()
+		elem := r.expr()
+		index := typecheck.AssignExpr(ir.NewIndexExpr(pos, set, elem)).(*ir.IndexExpr)
+		index.RType = r.rtype(pos)
+		zero := typecheck.Expr(ir.NewCompLitExpr(pos, ir.OCOMPLIT, index.Type(), nil))
+		return typecheck.Stmt(ir.NewAssignStmt(pos, index, zero))
+
+	case exprSetDelete:
+		pos := r.pos()
+		set := r.expr()
+		elem := r.expr()
+		n := typecheck.Stmt(ir.NewCallExpr(pos, ir.ODELETE, nil, []ir.Node{set, elem})).(*ir.CallExpr)
+		n.RType = r.rtype(pos)
+		return n
+
+	case exprOptional:
+		pos := r.pos()
+		typ := r.typ()
+		if r.Bool() { // comma-ok expression
+			var init ir.Nodes
+			value, ok := r.twoValues(pos, types.Types[types.TBOOL], &init)
+			if r.Bool() {
+				n := ir.NewConvExpr(pos, ir.OCONV, typ.Field(0).Type, value)
+				n.TypeWord, n.SrcRType = r.convRTTI(pos)
+				n.SetImplicit(true)
+				value = typecheck.Expr(n)
+			}
+			return ir.InitExpr(init, optionalLit(pos, typ, value, ok))
+		}
+		value := r.expr()
+		return optionalLit(pos, typ, value, ir.NewBool(pos, true))
+
+	case exprUnwrap:
+		pos := r.pos()
+		var init ir.Nodes
+		var value, ok ir.Node
+		if r.Bool() { // comma-ok expression
+			value, ok = r.twoValues(pos, types.Types[types.TBOOL], &init)
+		} else {
+			tmp := r.tempCopy(pos, r.expr(), &init)
+			value, ok = typecheck.DotField(pos, tmp, 0), typecheck.DotField(pos, tmp, 1)
+		}
+
+		// If the value is absent, return None (that is, zero values)
+		// from the enclosing function.
+		cond := typecheck.Expr(ir.NewUnaryExpr(pos, ir.ONOT, ok))
+		init.Append(typecheck.Stmt(ir.NewIfStmt(pos, cond, []ir.Node{r.earlyReturn(pos, nil)}, nil)))
+		return ir.InitExpr(init, value)
+
+	case exprPropagate:
+		pos := r.pos()
+		var init ir.Nodes
+		var value, err ir.Node
+		if r.Bool() { // value and error
+			value, err = r.twoValues(pos, types.ErrorType, &init)
+		} else {
+			err = r.tempCopy(pos, r.expr(), &init)
+		}
+
+		// If there is an error, return it (with zero values for the
+		// other results) from the enclosing function.
+		cond := typecheck.Expr(ir.NewBinaryExpr(pos, ir.ONE, err, ir.NewNilExpr(pos, err.Type())))
+		init.Append(typecheck.Stmt(ir.NewIfStmt(pos, cond, []ir.Node{r.earlyReturn(pos, err)}, nil)))
+		if value == nil {
+			return typecheck.Stmt(ir.NewBlockStmt(pos, init))
+		}
+		return ir.InitExpr(init, value)
+
+	case exprIsPresent:
+		pos := r.pos()
+		x := r.expr()
+		return typecheck.DotField(pos, x, 1)
+
+	case exprVariant:
+		pos := r.pos()
+		typ := r.typ()
+		index := r.Len()
+		var values []ir.Node
+		if r.Bool() {
+			values = r.multiExpr()
+		}
+		return enumLit(pos, typ, index, values)
+
+	case exprEnumField:
+		x := r.expr()
+		pos := r.pos()
+		if x.Type().IsPtr() {
+			x = typecheck.Expr(ir.NewStarExpr(pos, x))
+		}
+		tag := enumTag(pos, x)
+		if r.Bool() { // pos
+			return typecheck.Expr(ir.NewConvExpr(pos, ir.OCONV, types.Types[types.TINT], tag))
+		}
+
+		// Index a slice of the field's values by the variant index.
+		typ := r.typ()
+		values := make([]ir.Node, r.Len())
+		for i := range values {
+			values[i] = ir.NewBasicLit(pos, typ, FixValue(typ, r.Value()))
+		}
+		lit := ir.NewCompLitExpr(pos, ir.OCOMPLIT, types.NewSlice(typ), values)
+		return typecheck.Expr(ir.NewIndexExpr(pos, lit, tag))
+
+	case exprEnumUnmarshal:
+		// The body of the UnmarshalText method of a Wo enum type E:
+		//
+		//	s := string(text)
+		//	if s == "V0" { *x = V0; return nil }
+		//	if s == "V1" { *x = V1; return nil }
+		//	...
+		//	return runtime.enumtexterror("p.E", s)
+		pos := r.pos()
+		x := r.expr()
+		text := r.expr()
+		tname := r.String()
+		typ := x.Type().Elem()
+		str := types.Types[types.TSTRING]
+
+		var init ir.Nodes
+		s := r.tempCopy(pos, typecheck.Expr(ir.NewConvExpr(pos, ir.OCONV, str, text)), &init)
+		for i, n := 0, r.Len(); i < n; i++ {
+			cond := typecheck.Expr(ir.NewBinaryExpr(pos, ir.OEQ, s, ir.NewBasicLit(pos, str, constant.MakeString(r.String()))))
+			body := []ir.Node{
+				ir.NewAssignStmt(pos, ir.NewStarExpr(pos, x), enumLit(pos, typ, i, nil)),
+				ir.NewReturnStmt(pos, []ir.Node{ir.NewNilExpr(pos, types.ErrorType)}),
+			}
+			init.Append(typecheck.Stmt(ir.NewIfStmt(pos, cond, body, nil)))
+		}
+		fn := typecheck.LookupRuntime("enumtexterror")
+		return ir.InitExpr(init, typecheck.Call(pos, fn, []ir.Node{ir.NewBasicLit(pos, str, constant.MakeString(tname)), s}, false))
+
+	case exprOrElse:
+		pos := r.pos()
+		var init ir.Nodes
+		x := r.tempCopy(pos, r.expr(), &init)
+		y := r.tempCopy(pos, r.expr(), &init)
+		value := typecheck.DotField(pos, x, 0)
+		n := typed(value.Type(), ir.NewCondExpr(pos, typecheck.DotField(pos, x, 1), value, y))
+		return ir.InitExpr(init, n)
+
 	case exprSlice:
 		x := r.expr()
 		pos := r.pos()
@@ -2328,6 +2669,13 @@ func (r *reader) expr() (res ir.Node) {
 		}
 		return typecheck.Expr(ir.NewBinaryExpr(pos, op, x, y))
 
+	case exprCond:
+		pos := r.pos()
+		cond := typecheck.DefaultLit(r.expr(), nil)
+		x := r.expr()
+		y := r.expr()
+		return typed(x.Type(), ir.NewCondExpr(pos, cond, x, y))
+
 	case exprRecv:
 		x := r.expr()
 		pos := r.pos()
@@ -2380,6 +2728,9 @@ func (r *reader) expr() (res ir.Node) {
 			_, shapedFn, dictPtr := r.funcInst(pos)
 			fun = shapedFn
 			args.Append(dictPtr)
+			if r.Bool() { // Wo method with type parameters
+				args.Append(r.expr())
+			}
 		} else {
 			fun = r.expr()
 		}
@@ -2933,30 +3284,7 @@ func (r *reader) multiExpr() []ir.Node {
 	r.Sync(pkgbits.SyncMultiExpr)
 
 	if r.Bool() { // N:1
-		pos := r.pos()
-		expr := r.expr()
-
-		results := make([]ir.Node, r.Len())
-		as := ir.NewAssignListStmt(pos, ir.OAS2, nil, []ir.Node{expr})
-		as.Def = true
-		for i := range results {
-			tmp := r.temp(pos, r.typ())
-			as.PtrInit().Append(ir.NewDecl(pos, ir.ODCL, tmp))
-			as.Lhs.Append(tmp)
-
-			res := ir.Node(tmp)
-			if r.Bool() {
-				n := ir.NewConvExpr(pos, ir.OCONV, r.typ(), res)
-				n.TypeWord, n.SrcRType = r.convRTTI(pos)
-				n.SetImplicit(true)
-				res = typecheck.Expr(n)
-			}
-			results[i] = res
-		}
-
-		// TODO(mdempsky): Could use ir.InlinedCallExpr instead?
-		results[0] = ir.InitExpr([]ir.Node{typecheck.Stmt(as)}, results[0])
-		return results
+		return r.multiValue()
 	}
 
 	// N:N
@@ -2970,6 +3298,79 @@ func (r *reader) multiExpr() []ir.Node {
 	return exprs
 }
 
+// multiValue reads a multi-valued expression and returns its
+// (implicitly converted) values.
+func (r *reader) multiValue() []ir.Node {
+	pos := r.pos()
+	expr := r.expr()
+
+	results := make([]ir.Node, r.Len())
+	as := ir.NewAssignListStmt(pos, ir.OAS2, nil, []ir.Node{expr})
+	as.Def = true
+	for i := range results {
+		tmp := r.temp(pos, r.typ())
+		as.PtrInit().Append(ir.NewDecl(pos, ir.ODCL, tmp))
+		as.Lhs.Append(tmp)
+
+		res := ir.Node(tmp)
+		if r.Bool() {
+			typ := r.typ()
+			if r.Bool() { // Wo optional value
+				if r.Bool() {
+					n := ir.NewConvExpr(pos, ir.OCONV, r.typ(), res)
+					n.TypeWord, n.SrcRType = r.convRTTI(pos)
+					n.SetImplicit(true)
+					res = typecheck.Expr(n)
+				}
+				res = optionalLit(pos, typ, res, ir.NewBool(pos, true))
+			} else {
+				n := ir.NewConvExpr(pos, ir.OCONV, typ, res)
+				n.TypeWord, n.SrcRType = r.convRTTI(pos)
+				n.SetImplicit(true)
+				res = typecheck.Expr(n)
+			}
+		}
+		results[i] = res
+	}
+
+	// TODO(mdempsky): Could use ir.InlinedCallExpr instead?
+	results[0] = ir.InitExpr([]ir.Node{typecheck.Stmt(as)}, results[0])
+	return results
+}
+
+// twoValues reads the type of the first value and an expression
+// yielding two values, where the second is of type second (e.g., a
+// comma-ok expression). It assigns the values to new temporaries,
+// appending the assignment to init, and returns the temporaries.
+func (r *reader) twoValues(pos src.XPos, second *types.Type, init *ir.Nodes) (x, y ir.Node) {
+	typ := r.typ()
+	expr := r.expr()
+
+	v := r.temp(pos, typ)
+	w := r.temp(pos, second)
+	as := ir.NewAssignListStmt(pos, ir.OAS2, []ir.Node{v, w}, []ir.Node{expr})
+	as.Def = true
+	as.PtrInit().Append(ir.NewDecl(pos, ir.ODCL, v), ir.NewDecl(pos, ir.ODCL, w))
+	init.Append(typecheck.Stmt(as))
+	return v, w
+}
+
+// earlyReturn returns a statement that returns from the enclosing
+// function with zero values for all results, except that the last
+// result is last if it is non-nil. It is used by the Wo postfix ?
+// and ! operators.
+func (r *reader) earlyReturn(pos src.XPos, last ir.Node) ir.Node {
+	results := r.curfn.Type().Results()
+	values := make([]ir.Node, len(results))
+	for i, result := range results {
+		values[i] = ir.NewZero(pos, result.Type)
+	}
+	if last != nil {
+		values[len(values)-1] = last
+	}
+	return ir.NewReturnStmt(pos, values)
+}
+
 // temp returns a new autotemp of the specified type.
 func (r *reader) temp(pos src.XPos, typ *types.Type) *ir.Name {
 	return typecheck.TempAt(pos, r.curfn, typ)
@@ -3024,6 +3425,17 @@ func (r *reader) compLit() ir.Node {
 		*elemp = r.expr()
 	}
 
+	if typ.IsMap() {
+		// The elements of a Wo set literal are the keys of the
+		// underlying map; its elements are zero-size.
+		for i, elem := range elems {
+			if elem.Op() != ir.OKEY {
+				pos := elem.Pos()
+				elems[i] = ir.NewKeyExpr(pos, elem, ir.NewCompLitExpr(pos, ir.OCOMPLIT, typ.Elem(), nil))
+			}
+		}
+	}
+
 	lit := typecheck.Expr(ir.NewCompLitExpr(pos, ir.OCOMPLIT, typ, elems))
 	if rtype != nil {
 		lit := lit.(*ir.CompLitExpr)
@@ -3294,6 +3706,15 @@ func (r *reader) pkgInitOrder(target *ir.Package) {
 		for j := range lhs {
 			lhs[j] = r.obj()
 		}
+		if r.Bool() { // Wo call with omitted results
+			rhs := r.multiValue()
+			as := typecheck.Stmt(ir.NewAssignListStmt(lhs[0].Pos(), ir.OAS2, lhs, rhs))
+			for _, v := range lhs {
+				v.(*ir.Name).Defn = as
+			}
+			initOrder[i] = as
+			continue
+		}
 		rhs := r.expr()
 		pos := lhs[0].Pos()
 
@@ -507,7 +507,7 @@ func writeUnifiedExport(out io.Writer) {
 			assert(xpath == pr.PkgPath())
 			assert(xtag != pkgbits.ObjStub)
 
-			if types.IsExported(xname) {
+			if types.IsExported(xname) || pr.PeekVis(idx) != pkgbits.VisDefault {
 				l.relocIdx(pr, pkgbits.RelocObj, idx)
 			}
 		}
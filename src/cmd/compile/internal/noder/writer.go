@@ -12,6 +12,7 @@ import (
 	"internal/buildcfg"
 	"internal/pkgbits"
 	"os"
+	"slices"
 	"strings"
 
 	"cmd/compile/internal/base"
@@ -213,6 +214,11 @@ type writerDict struct {
 	// derived slice, if present.
 	derivedIdx map[types2.Type]index
 
+	// method is the signature of the declaration if it is a Wo method
+	// with type parameters, whose type parameters follow those of its
+	// receiver.
+	method *types2.Signature
+
 	// These slices correspond to entries in the runtime dictionary.
 	typeParamMethodExprs []writerMethodExprInfo
 	subdicts             []objInfo
@@ -236,6 +242,12 @@ func (dict *writerDict) typeParamIndex(typ *types2.TypeParam) int {
 		}
 	}
 
+	if sig := dict.method; sig != nil {
+		if tparams := sig.TypeParams(); typ.Index() < tparams.Len() && tparams.At(typ.Index()) == typ {
+			return len(dict.implicits) + sig.RecvTypeParams().Len() + typ.Index()
+		}
+	}
+
 	return len(dict.implicits) + typ.Index()
 }
 
@@ -458,6 +470,7 @@ func (pw *pkgWriter) pkgIdx(pkg *types2.Package) index {
 		base.Assertf(path != "builtin" && path != "unsafe", "unexpected path for user-defined package: %q", path)
 		w.String(path)
 		w.String(pkg.Name())
+		w.String(pkg.Module())
 
 		w.Len(len(pkg.Imports()))
 		for _, imp := range pkg.Imports() {
@@ -573,6 +586,18 @@ func (pw *pkgWriter) typIdx(typ types2.Type, dict *writerDict) typeInfo {
 		w.typ(typ.Key())
 		w.typ(typ.Elem())
 
+	case *types2.Set:
+		w.Code(pkgbits.TypeSet)
+		w.typ(typ.Elem())
+
+	case *types2.Optional:
+		w.Code(pkgbits.TypeOptional)
+		w.typ(typ.Elem())
+
+	case *types2.Enum:
+		w.Code(pkgbits.TypeEnum)
+		w.enumType(typ)
+
 	case *types2.Pointer:
 		w.Code(pkgbits.TypePointer)
 		w.typ(typ.Elem())
@@ -643,6 +668,33 @@ func (w *writer) structType(typ *types2.Struct) {
 	}
 }
 
+func (w *writer) enumType(typ *types2.Enum) {
+	w.Len(typ.NumFields())
+	for i := 0; i < typ.NumFields(); i++ {
+		f := typ.Field(i)
+		w.pos(f)
+		w.selector(f)
+		w.typ(f.Type())
+	}
+
+	w.Len(typ.NumVariants())
+	for i := 0; i < typ.NumVariants(); i++ {
+		v := typ.Variant(i)
+		w.pos(v)
+		w.selector(v)
+		for j := 0; j < typ.NumFields(); j++ {
+			w.Value(v.Value(j))
+		}
+		w.Len(v.NumFields())
+		for j := 0; j < v.NumFields(); j++ {
+			f := v.Field(j)
+			w.pos(f)
+			w.selector(f)
+			w.typ(f.Type())
+		}
+	}
+}
+
 func (w *writer) unionType(typ *types2.Union) {
 	w.Len(typ.Len())
 	for i := 0; i < typ.Len(); i++ {
@@ -702,6 +754,54 @@ func (w *writer) signature(sig *types2.Signature) {
 	w.params(sig.Params())
 	w.params(sig.Results())
 	w.Bool(sig.Variadic())
+	w.defaults(sig)
+	w.skips(sig)
+}
+
+// defaults writes the default values of the parameters of the Wo
+// signature sig, if any.
+func (w *writer) defaults(sig *types2.Signature) {
+	n := sig.Params().Len()
+	has := false
+	for i := 0; i < n && !has; i++ {
+		has = sig.Default(i) != nil
+	}
+	if !w.Bool(has) {
+		return
+	}
+	for i := 0; i < n; i++ {
+		obj := sig.Default(i)
+		if !w.Bool(obj != nil) {
+			continue
+		}
+		switch obj := obj.(type) {
+		case *types2.Const:
+			w.Code(pkgbits.DefaultConst)
+			w.Value(obj.Val())
+		case *types2.Nil:
+			w.Code(pkgbits.DefaultNil)
+			w.String(obj.Name())
+		default:
+			w.Code(pkgbits.DefaultObj)
+			w.obj(obj, nil)
+		}
+	}
+}
+
+// skips writes the skip modifiers of the results of the Wo signature
+// sig, if any.
+func (w *writer) skips(sig *types2.Signature) {
+	n := sig.Results().Len()
+	has := false
+	for i := 0; i < n && !has; i++ {
+		has = sig.Skippable(i)
+	}
+	if !w.Bool(has) {
+		return
+	}
+	for i := 0; i < n; i++ {
+		w.Bool(sig.Skippable(i))
+	}
 }
 
 func (w *writer) params(typ *types2.Tuple) {
@@ -756,6 +856,24 @@ func (pw *pkgWriter) objInstIdx(obj types2.Object, explicits *types2.TypeList, d
 	return objInfo{idx: pw.objIdx(obj), explicits: explicitInfos}
 }
 
+// methodInstIdx returns the indices for the Wo method with type
+// parameters selected by sel, instantiated with the type arguments of
+// its receiver followed by targs, adding them to the export data as
+// needed.
+func (pw *pkgWriter) methodInstIdx(sel *types2.Selection, targs *types2.TypeList, dict *writerDict) objInfo {
+	fn := sel.Obj().(*types2.Func)
+	var explicitInfos []typeInfo
+	if recv, ok := types2.Unalias(deref2(fn.Type().(*types2.Signature).Recv().Type())).(*types2.Named); ok {
+		for i := 0; i < recv.TypeArgs().Len(); i++ {
+			explicitInfos = append(explicitInfos, pw.typIdx(recv.TypeArgs().At(i), dict))
+		}
+	}
+	for i := 0; i < targs.Len(); i++ {
+		explicitInfos = append(explicitInfos, pw.typIdx(targs.At(i), dict))
+	}
+	return objInfo{idx: pw.objIdx(fn.Origin()), explicits: explicitInfos}
+}
+
 // objIdx returns the index for the given Object, adding it to the
 // export data as needed.
 func (pw *pkgWriter) objIdx(obj types2.Object) index {
@@ -775,6 +893,9 @@ func (pw *pkgWriter) objIdx(obj types2.Object) index {
 		assert(ok)
 		dict.implicits = decl.implicits
 	}
+	if fn, ok := obj.(*types2.Func); ok && isGenericMethod(fn) {
+		dict.method = fn.Type().(*types2.Signature)
+	}
 
 	// We encode objects into 4 elements across different sections, all
 	// sharing the same index:
@@ -818,6 +939,7 @@ func (pw *pkgWriter) objIdx(obj types2.Object) index {
 
 	wname.qualifiedIdent(obj)
 	wname.Code(code)
+	wname.Code(objVis(obj))
 	wname.Flush()
 
 	wdict.objDict(obj, w.dict)
@@ -826,6 +948,18 @@ func (pw *pkgWriter) objIdx(obj types2.Object) index {
 	return w.Idx
 }
 
+// objVis returns the encoding of the visibility of obj, which may be
+// set by a Wo visibility modifier.
+func objVis(obj types2.Object) pkgbits.CodeVis {
+	switch obj.Visibility() {
+	case types2.ExportVis:
+		return pkgbits.VisExport
+	case types2.PkgVis:
+		return pkgbits.VisPkg
+	}
+	return pkgbits.VisDefault
+}
+
 // doObj writes the RelocObj definition for obj to w, and the
 // RelocObjExt definition to wext.
 func (w *writer) doObj(wext *writer, obj types2.Object) pkgbits.CodeObj {
@@ -845,6 +979,11 @@ func (w *writer) doObj(wext *writer, obj types2.Object) pkgbits.CodeObj {
 		return pkgbits.ObjConst
 
 	case *types2.Func:
+		if isGenericMethod(obj) {
+			w.method(wext, obj)
+			return pkgbits.ObjMethod
+		}
+
 		decl, ok := w.p.funDecls[obj]
 		assert(ok)
 		sig := obj.Type().(*types2.Signature)
@@ -882,9 +1021,23 @@ func (w *writer) doObj(wext *writer, obj types2.Object) pkgbits.CodeObj {
 		wext.typeExt(obj)
 		w.typ(named.Underlying())
 
-		w.Len(named.NumMethods())
+		// Wo methods with type parameters are not part of the method
+		// set; they are separate objects, instantiated like functions.
+		var methods, generic []*types2.Func
 		for i := 0; i < named.NumMethods(); i++ {
-			w.method(wext, named.Method(i))
+			if meth := named.Method(i); isGenericMethod(meth) {
+				generic = append(generic, meth)
+			} else {
+				methods = append(methods, meth)
+			}
+		}
+		w.Len(len(methods))
+		for _, meth := range methods {
+			w.method(wext, meth)
+		}
+		w.Len(len(generic))
+		for _, meth := range generic {
+			w.Reloc(pkgbits.RelocObj, w.p.objIdx(meth))
 		}
 
 		return pkgbits.ObjType
@@ -894,6 +1047,20 @@ func (w *writer) doObj(wext *writer, obj types2.Object) pkgbits.CodeObj {
 		w.typ(obj.Type())
 		wext.varExt(obj)
 		return pkgbits.ObjVar
+
+	case *types2.Variant:
+		w.pos(obj)
+		w.typ(obj.Type())
+		w.Len(obj.Index())
+		return pkgbits.ObjVariant
+
+	case *types2.Overload:
+		w.pos(obj)
+		w.Len(obj.NumFuncs())
+		for i := 0; i < obj.NumFuncs(); i++ {
+			w.obj(obj.Func(i), nil)
+		}
+		return pkgbits.ObjOverload
 	}
 }
 
@@ -908,10 +1075,10 @@ func (w *writer) objDict(obj types2.Object, dict *writerDict) {
 	w.Len(len(dict.implicits))
 
 	tparams := objTypeParams(obj)
-	ntparams := tparams.Len()
+	ntparams := len(tparams)
 	w.Len(ntparams)
-	for i := 0; i < ntparams; i++ {
-		w.typ(tparams.At(i).Constraint())
+	for _, tparam := range tparams {
+		w.typ(tparam.Constraint())
 	}
 
 	nderived := len(dict.derived)
@@ -941,8 +1108,7 @@ func (w *writer) objDict(obj types2.Object, dict *writerDict) {
 	for _, implicit := range dict.implicits {
 		w.Bool(implicit.Underlying().(*types2.Interface).IsMethodSet())
 	}
-	for i := 0; i < ntparams; i++ {
-		tparam := tparams.At(i)
+	for _, tparam := range tparams {
 		w.Bool(tparam.Underlying().(*types2.Interface).IsMethodSet())
 	}
 
@@ -971,30 +1137,36 @@ func (w *writer) objDict(obj types2.Object, dict *writerDict) {
 	assert(len(dict.derived) == nderived)
 }
 
-func (w *writer) typeParamNames(tparams *types2.TypeParamList) {
+func (w *writer) typeParamNames(lists ...*types2.TypeParamList) {
 	w.Sync(pkgbits.SyncTypeParamNames)
 
-	ntparams := tparams.Len()
-	for i := 0; i < ntparams; i++ {
-		tparam := tparams.At(i).Obj()
-		w.pos(tparam)
-		w.localIdent(tparam)
+	for _, tparams := range lists {
+		ntparams := tparams.Len()
+		for i := 0; i < ntparams; i++ {
+			tparam := tparams.At(i).Obj()
+			w.pos(tparam)
+			w.localIdent(tparam)
+		}
 	}
 }
 
 func (w *writer) method(wext *writer, meth *types2.Func) {
 	decl, ok := w.p.funDecls[meth]
-	assert(ok)
+	assert(ok || w.p.isEnumMethod(meth))
 	sig := meth.Type().(*types2.Signature)
 
 	w.Sync(pkgbits.SyncMethod)
 	w.pos(meth)
 	w.selector(meth)
-	w.typeParamNames(sig.RecvTypeParams())
+	w.typeParamNames(sig.RecvTypeParams(), sig.TypeParams())
 	w.param(sig.Recv())
 	w.signature(sig)
 
-	w.pos(decl) // XXX: Hack to workaround linker limitations.
+	if ok {
+		w.pos(decl) // XXX: Hack to workaround linker limitations.
+	} else {
+		w.pos(meth)
+	}
 	wext.funcExt(meth)
 }
 
@@ -1017,6 +1189,13 @@ func (w *writer) qualifiedIdent(obj types2.Object) {
 		}
 	}
 
+	if fn, ok := obj.(*types2.Func); ok && isGenericMethod(fn) {
+		// Wo methods with type parameters are named after their
+		// receiver base type.
+		recv, _ := deref2(fn.Type().(*types2.Signature).Recv().Type()).(*types2.Named)
+		name = recv.Obj().Name() + "." + name
+	}
+
 	w.pkg(obj.Pkg())
 	w.String(name)
 }
@@ -1058,7 +1237,10 @@ func (pw *pkgWriter) selectorIdx(obj types2.Object) selectorInfo {
 
 func (w *writer) funcExt(obj *types2.Func) {
 	decl, ok := w.p.funDecls[obj]
-	assert(ok)
+	if !ok {
+		w.enumMethodExt(obj)
+		return
+	}
 
 	// TODO(mdempsky): Extend these pragma validation flags to account
 	// for generics. E.g., linkname probably doesn't make sense at
@@ -1136,6 +1318,27 @@ func (w *writer) funcExt(obj *types2.Func) {
 	w.Sync(pkgbits.SyncEOF)
 }
 
+// enumMethodExt is like funcExt, but for a method that a Wo enum
+// type has implicitly (see isEnumMethod).
+func (w *writer) enumMethodExt(obj *types2.Func) {
+	assert(w.p.isEnumMethod(obj))
+	body := w.p.enumMethodBodyIdx(obj, w.dict)
+
+	w.Sync(pkgbits.SyncFuncExt)
+	w.pragmaFlag(0)
+	w.linkname(obj)
+
+	if buildcfg.GOARCH == "wasm" {
+		w.String("")
+		w.String("")
+		w.String("")
+	}
+
+	w.Bool(false) // stub extension
+	w.Reloc(pkgbits.RelocBody, body)
+	w.Sync(pkgbits.SyncEOF)
+}
+
 func (w *writer) typeExt(obj *types2.TypeName) {
 	decl, ok := w.p.typDecls[obj]
 	assert(ok)
@@ -1183,6 +1386,106 @@ func (pw *pkgWriter) bodyIdx(sig *types2.Signature, block *syntax.BlockStmt, dic
 	return w.Flush(), w.closureVars
 }
 
+// isEnumMethod reports whether fn is one of the methods String,
+// MarshalText, and UnmarshalText that a Wo enum type of the current
+// package has without a declaration.
+func (pw *pkgWriter) isEnumMethod(fn *types2.Func) bool {
+	if _, ok := pw.funDecls[fn]; ok || fn.Pkg() != pw.curpkg {
+		return false
+	}
+	recv := fn.Type().(*types2.Signature).Recv()
+	if recv == nil {
+		return false
+	}
+	typ := recv.Type()
+	if ptr, ok := typ.(*types2.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	_, ok := typ.Underlying().(*types2.Enum)
+	return ok
+}
+
+// enumMethodBodyIdx returns the index for the body of the implicit
+// method fn of a Wo enum type (see isEnumMethod), adding it to the
+// export data. The bodies of String and MarshalText return the name of
+// the variant of the receiver x:
+//
+//	func (x E) String() string { return x.name }
+//	func (x E) MarshalText() ([]byte, error) { return []byte(x.name), nil }
+//
+// The body of UnmarshalText sets *x to the variant named text, or
+// returns an error if there is no such variant (see exprEnumUnmarshal).
+func (pw *pkgWriter) enumMethodBodyIdx(fn *types2.Func, dict *writerDict) index {
+	sig := fn.Type().(*types2.Signature)
+	recv := sig.Recv()
+	named := recv.Type()
+	if ptr, ok := named.(*types2.Pointer); ok {
+		named = ptr.Elem()
+	}
+	enum := named.Underlying().(*types2.Enum)
+
+	w := pw.newWriter(pkgbits.RelocBody, pkgbits.SyncFuncBody)
+	w.sig = sig
+	w.dict = dict
+
+	w.declareParams(sig)
+	w.Bool(true) // has body
+	w.Sync(pkgbits.SyncStmts)
+
+	w.Code(stmtReturn)
+	w.pos(fn)
+	w.Sync(pkgbits.SyncMultiExpr)
+	w.Bool(false) // N:N assignment
+	w.Len(sig.Results().Len())
+
+	name := func() {
+		w.Code(exprEnumField)
+		w.Code(exprLocal)
+		w.useLocal(fn.Pos(), recv)
+		w.pos(fn)
+		w.enumField(enum, enum.NumFields())
+	}
+	switch fn.Name() {
+	case "String":
+		name()
+	case "MarshalText":
+		str, bytes := types2.Typ[types2.String], sig.Results().At(0).Type()
+		w.Code(exprConvert)
+		w.Bool(false) // explicit
+		w.typ(bytes)
+		w.pos(fn)
+		w.convRTTI(str, bytes)
+		w.Bool(false) // not a type parameter
+		w.Bool(false) // not identical
+		name()
+
+		w.Code(exprZero)
+		w.pos(fn)
+		w.typ(sig.Results().At(1).Type())
+	case "UnmarshalText":
+		w.Code(exprEnumUnmarshal)
+		w.pos(fn)
+		w.Code(exprLocal)
+		w.useLocal(fn.Pos(), recv)
+		w.Code(exprLocal)
+		w.useLocal(fn.Pos(), sig.Params().At(0))
+		obj := named.(*types2.Named).Obj()
+		w.String(obj.Pkg().Name() + "." + obj.Name())
+		w.Len(enum.NumVariants())
+		for i := 0; i < enum.NumVariants(); i++ {
+			w.String(enum.Variant(i).Name())
+		}
+	default:
+		pw.fatalf(fn, "unexpected enum method %v", fn)
+	}
+
+	w.Code(stmtEnd)
+	w.Sync(pkgbits.SyncStmtsEnd)
+	w.pos(fn)
+
+	return w.Flush()
+}
+
 func (w *writer) declareParams(sig *types2.Signature) {
 	addLocals := func(params *types2.Tuple) {
 		for i := 0; i < params.Len(); i++ {
@@ -1361,6 +1664,14 @@ func (w *writer) stmt1(stmt syntax.Stmt) {
 		}
 
 	case *syntax.ExprStmt:
+		// The value of a Wo x! used as a statement is discarded by
+		// assigning it to the blank identifier.
+		if op, ok := syntax.Unparen(stmt.X).(*syntax.Operation); ok && op.Op == syntax.Errable {
+			if _, ok := w.p.typeOf(op.X).(*types2.Tuple); ok {
+				w.assignStmt(stmt, syntax.NewName(stmt.Pos(), "_"), stmt.X)
+				break
+			}
+		}
 		w.Code(stmtExpr)
 		w.expr(stmt.X)
 
@@ -1460,6 +1771,16 @@ func (w *writer) assignStmt(pos poser, lhs0, rhs0 syntax.Expr) {
 	lhs := syntax.UnpackListExpr(lhs0)
 	rhs := syntax.UnpackListExpr(rhs0)
 
+	// A Wo call whose skippable results are omitted still produces
+	// all of them; assign the omitted ones to blank identifiers.
+	var tuple *types2.Tuple
+	if len(rhs) == 1 {
+		if skipped := w.p.skippedResults(rhs[0], len(lhs)); skipped != nil {
+			tuple = w.p.typeOf(rhs[0]).(*types2.Tuple)
+			lhs = unskipLHS(lhs, skipped)
+		}
+	}
+
 	w.Code(stmtAssign)
 	w.pos(pos)
 
@@ -1490,9 +1811,59 @@ func (w *writer) assignStmt(pos poser, lhs0, rhs0 syntax.Expr) {
 		return w.p.typeOf(dst)
 	}
 
+	if tuple != nil {
+		w.Sync(pkgbits.SyncMultiExpr)
+		w.Bool(true) // N:1 assignment
+		w.multiValue(pos, dstType, rhs[0], tuple)
+		return
+	}
 	w.multiExpr(pos, dstType, rhs)
 }
 
+// skippedResults returns which results of the multi-valued Wo call
+// expr are omitted by an assignment to n operands, or nil if none
+// are. Only skippable results can be omitted (see types2.Checker).
+func (pw *pkgWriter) skippedResults(expr syntax.Expr, n int) []bool {
+	tuple, ok := pw.typeOf(expr).(*types2.Tuple)
+	if !ok || tuple.Len() <= n {
+		return nil
+	}
+	call, ok := syntax.Unparen(expr).(*syntax.CallExpr)
+	if !ok {
+		return nil
+	}
+	sig, ok := types2.CoreType(pw.typeOf(call.Fun)).(*types2.Signature)
+	if !ok {
+		return nil
+	}
+	skipped := make([]bool, tuple.Len())
+	for i := range skipped {
+		if skipped[i] = sig.Skippable(i); !skipped[i] {
+			n--
+		}
+	}
+	if n != 0 {
+		return nil // e.g., a comma-ok assignment to an optional variable
+	}
+	return skipped
+}
+
+// unskipLHS returns lhs with a blank identifier inserted for each
+// skipped result.
+func unskipLHS(lhs []syntax.Expr, skipped []bool) []syntax.Expr {
+	pos := syntax.StartPos(lhs[0])
+	list := make([]syntax.Expr, 0, len(skipped))
+	for _, skip := range skipped {
+		if skip {
+			list = append(list, syntax.NewName(pos, "_"))
+		} else {
+			list = append(list, lhs[0])
+			lhs = lhs[1:]
+		}
+	}
+	return list
+}
+
 func (w *writer) blockStmt(stmt *syntax.BlockStmt) {
 	w.Sync(pkgbits.SyncBlockStmt)
 	w.openScope(stmt.Pos())
@@ -1505,16 +1876,28 @@ func (w *writer) forStmt(stmt *syntax.ForStmt) {
 	w.openScope(stmt.Pos())
 
 	if rang, ok := stmt.Init.(*syntax.RangeClause); w.Bool(ok) {
+		xtyp := w.p.typeOf(rang.X)
+		keyType, valueType := types2.RangeKeyVal(xtyp)
+
+		lhs := syntax.UnpackListExpr(rang.Lhs)
+		if rang.Colon && len(lhs) == 1 && valueType != nil {
+			// In "for v : X", v is the value, not the key.
+			lhs = []syntax.Expr{syntax.NewName(rang.Pos(), "_"), lhs[0]}
+		}
+
 		w.pos(rang)
-		w.assignList(rang.Lhs)
+		// As if w.assignList(rang.Lhs).
+		w.Len(len(lhs))
+		for _, expr := range lhs {
+			w.assign(expr)
+		}
 		w.expr(rang.X)
 
-		xtyp := w.p.typeOf(rang.X)
-		if _, isMap := types2.CoreType(xtyp).(*types2.Map); isMap {
+		switch types2.CoreType(xtyp).(type) {
+		case *types2.Map, *types2.Set:
 			w.rtype(xtyp)
 		}
 		{
-			lhs := syntax.UnpackListExpr(rang.Lhs)
 			assign := func(i int, src types2.Type) {
 				if i >= len(lhs) {
 					return
@@ -1536,11 +1919,14 @@ func (w *writer) forStmt(stmt *syntax.ForStmt) {
 				w.convRTTI(src, dstType)
 			}
 
-			keyType, valueType := types2.RangeKeyVal(w.p.typeOf(rang.X))
 			assign(0, keyType)
 			assign(1, valueType)
 		}
 
+	} else if clause, ok := stmt.Init.(*syntax.VarClause); w.Bool(ok) {
+		w.pos(stmt)
+		w.varClause(clause)
+
 	} else {
 		if stmt.Cond != nil && w.p.staticBool(&stmt.Cond) < 0 { // always false
 			stmt.Post = nil
@@ -1578,13 +1964,21 @@ func (w *writer) distinctVars(stmt *syntax.ForStmt) bool {
 }
 
 func (w *writer) ifStmt(stmt *syntax.IfStmt) {
-	cond := w.p.staticBool(&stmt.Cond)
+	clause, _ := stmt.Init.(*syntax.VarClause)
+	cond := 0
+	if clause == nil {
+		cond = w.p.staticBool(&stmt.Cond)
+	}
 
 	w.Sync(pkgbits.SyncIfStmt)
 	w.openScope(stmt.Pos())
 	w.pos(stmt)
-	w.stmt(stmt.Init)
-	w.expr(stmt.Cond)
+	if w.Bool(clause != nil) {
+		w.varClause(clause)
+	} else {
+		w.stmt(stmt.Init)
+		w.expr(stmt.Cond)
+	}
 	w.Int(cond)
 	if cond >= 0 {
 		w.blockStmt(stmt.Then)
@@ -1597,6 +1991,38 @@ func (w *writer) ifStmt(stmt *syntax.IfStmt) {
 	w.closeAnotherScope()
 }
 
+// varClause writes the Wo conditional binding clause: the variables
+// it binds and the value that provides them. The reader evaluates the
+// value and derives the condition from its kind (see types2.Checker).
+func (w *writer) varClause(clause *syntax.VarClause) {
+	w.pos(clause)
+	w.Len(len(clause.NameList))
+	for _, name := range clause.NameList {
+		w.assign(name)
+	}
+
+	dstType := func(i int) types2.Type {
+		if i < len(clause.NameList) {
+			if obj, ok := w.p.info.Defs[clause.NameList[i]].(*types2.Var); ok {
+				return obj.Type()
+			}
+		}
+		return nil // the bool or error
+	}
+	if tuple, ok := w.p.typeOf(clause.Value).(*types2.Tuple); w.Bool(ok) {
+		w.multiValue(clause, dstType, clause.Value, tuple)
+		return
+	}
+
+	// optional value
+	w.expr(clause.Value)
+	elem := types2.CoreType(w.p.typeOf(clause.Value)).(*types2.Optional).Elem()
+	if dst := dstType(0); w.Bool(dst != nil && !types2.Identical(elem, dst)) {
+		w.typ(dst)
+		w.convRTTI(elem, dst)
+	}
+}
+
 func (w *writer) selectStmt(stmt *syntax.SelectStmt) {
 	w.Sync(pkgbits.SyncSelectStmt)
 
@@ -1626,6 +2052,7 @@ func (w *writer) switchStmt(stmt *syntax.SwitchStmt) {
 
 	var iface, tagType types2.Type
 	var tagTypeIsChan bool
+	var sumEnum bool // switch on a value of a Wo enum sum type
 	if guard, ok := stmt.Tag.(*syntax.TypeSwitchGuard); w.Bool(ok) {
 		iface = w.p.typeOf(guard.X)
 
@@ -1718,6 +2145,9 @@ func (w *writer) switchStmt(stmt *syntax.SwitchStmt) {
 
 		if w.Bool(tag != nil) {
 			w.implicitConvExpr(tagType, tag)
+
+			enum, ok := tagType.Underlying().(*types2.Enum)
+			sumEnum = w.Bool(ok && enum.IsSum())
 		}
 	}
 
@@ -1732,12 +2162,62 @@ func (w *writer) switchStmt(stmt *syntax.SwitchStmt) {
 
 		cases := syntax.UnpackListExpr(clause.Cases)
 		if iface != nil {
-			w.Len(len(cases))
+			// A case with a Wo union type is written as a case
+			// for each of the types in its type set.
+			var exprs []syntax.Expr
+			var typs []types2.Type
 			for _, cas := range cases {
-				if w.Bool(isNil(w.p, cas)) {
+				if isNil(w.p, cas) {
+					exprs, typs = append(exprs, cas), append(typs, nil)
+					continue
+				}
+				typ := w.p.typeAndValue(cas).Type
+				if terms := unionTerms(typ); terms != nil {
+					for _, term := range terms {
+						exprs, typs = append(exprs, cas), append(typs, term)
+					}
 					continue
 				}
-				w.exprType(iface, cas)
+				exprs, typs = append(exprs, cas), append(typs, typ)
+			}
+
+			w.Len(len(exprs))
+			for i, cas := range exprs {
+				if w.Bool(typs[i] == nil) {
+					continue
+				}
+				w.caseType(iface, cas, typs[i])
+			}
+		} else if sumEnum {
+			// The cases of a switch on a sum type are variant
+			// indices, possibly binding the values of the
+			// variant's fields to new variables.
+			w.Len(len(cases))
+			for _, cas := range cases {
+				call, _ := syntax.Unparen(cas).(*syntax.CallExpr)
+				fun := cas
+				if call != nil {
+					fun = call.Fun
+				}
+				v, ok := variantOf(w.p, fun)
+				assert(ok)
+				w.pos(cas)
+				w.Len(v.Index())
+
+				var names []syntax.Expr
+				if call != nil {
+					names = call.ArgList
+				}
+				w.Len(len(names))
+				for _, name := range names {
+					obj, _ := w.p.info.Defs[name.(*syntax.Name)].(*types2.Var)
+					if w.Bool(obj != nil && obj.Name() != "_") {
+						w.pos(obj)
+						w.localIdent(obj)
+						w.typ(obj.Type())
+						w.addLocal(obj)
+					}
+				}
 			}
 		} else {
 			// As if w.exprList(clause.Cases),
@@ -1849,6 +2329,14 @@ func (w *writer) expr(expr syntax.Expr) {
 	}
 
 	if obj != nil {
+		if obj, ok := obj.(*types2.Variant); ok {
+			w.Code(exprVariant)
+			w.pos(expr)
+			w.variant(obj)
+			w.Bool(false) // no field values
+			return
+		}
+
 		if targs.Len() != 0 {
 			obj := obj.(*types2.Func)
 
@@ -1882,7 +2370,14 @@ func (w *writer) expr(expr syntax.Expr) {
 
 	case *syntax.FuncLit:
 		w.Code(exprFuncLit)
-		w.funcLit(expr)
+		w.funcLit(expr, expr.Body)
+
+	case *syntax.LambdaExpr:
+		// Wo function literals are ordinary function literals
+		// with an implicit body.
+		sig := w.p.typeOf(expr).(*types2.Signature)
+		w.Code(exprFuncLit)
+		w.funcLit(expr, syntax.LambdaBody(expr, sig.Results().Len() > 0))
 
 	case *syntax.SelectorExpr:
 		sel, ok := w.p.info.Selections[expr]
@@ -1893,6 +2388,14 @@ func (w *writer) expr(expr syntax.Expr) {
 			w.p.fatalf(expr, "unexpected selection kind: %v", sel.Kind())
 
 		case types2.FieldVal:
+			if enum, ok := deref2(sel.Recv()).Underlying().(*types2.Enum); ok {
+				w.Code(exprEnumField)
+				w.expr(expr.X)
+				w.pos(expr)
+				w.enumField(enum, sel.Index()[0])
+				break
+			}
+
 			w.Code(exprFieldVal)
 			w.expr(expr.X)
 			w.pos(expr)
@@ -1938,6 +2441,15 @@ func (w *writer) expr(expr syntax.Expr) {
 
 		xtyp := w.p.typeOf(expr.X)
 
+		if setType, ok := types2.CoreType(xtyp).(*types2.Set); ok {
+			w.Code(exprSetIndex)
+			w.expr(expr.X)
+			w.pos(expr)
+			w.implicitConvExpr(setType.Elem(), expr.Index)
+			w.rtype(xtyp)
+			break
+		}
+
 		var keyType types2.Type
 		if mapType, ok := types2.CoreType(xtyp).(*types2.Map); ok {
 			keyType = mapType.Key()
@@ -1969,6 +2481,26 @@ func (w *writer) expr(expr syntax.Expr) {
 		w.rtype(iface)
 
 	case *syntax.Operation:
+		if expr.Op == syntax.Question {
+			w.Code(exprUnwrap)
+			w.pos(expr)
+			if tuple, ok := w.p.typeOf(expr.X).(*types2.Tuple); w.Bool(ok) {
+				w.typ(tuple.At(0).Type())
+			}
+			w.expr(expr.X)
+			break
+		}
+
+		if expr.Op == syntax.Errable {
+			w.Code(exprPropagate)
+			w.pos(expr)
+			if tuple, ok := w.p.typeOf(expr.X).(*types2.Tuple); w.Bool(ok) {
+				w.typ(tuple.At(0).Type())
+			}
+			w.expr(expr.X)
+			break
+		}
+
 		if expr.Y == nil {
 			w.Code(exprUnaryOp)
 			w.op(unOps[expr.Op])
@@ -1994,12 +2526,39 @@ func (w *writer) expr(expr syntax.Expr) {
 			}
 		}
 
+		// Optional values can only be compared against None, which
+		// tests whether the value is present.
+		if isOptional(commonType) {
+			x := expr.X
+			if w.p.typeAndValue(x).IsNil() {
+				x = expr.Y
+			}
+			if expr.Op == syntax.Eql {
+				w.Code(exprUnaryOp)
+				w.op(ir.ONOT)
+				w.pos(expr)
+			}
+			w.Code(exprIsPresent)
+			w.pos(expr)
+			w.expr(x)
+			break
+		}
+
 		w.Code(exprBinaryOp)
 		w.op(binOps[expr.Op])
 		w.implicitConvExpr(commonType, expr.X)
 		w.pos(expr)
 		w.implicitConvExpr(commonType, expr.Y)
 
+	case *syntax.CondExpr:
+		typ := w.p.typeOf(expr)
+
+		w.Code(exprCond)
+		w.pos(expr)
+		w.expr(expr.Cond)
+		w.implicitConvExpr(typ, expr.Then)
+		w.implicitConvExpr(typ, expr.Else)
+
 	case *syntax.CallExpr:
 		tv := w.p.typeAndValue(expr.Fun)
 		if tv.IsType() {
@@ -2009,8 +2568,56 @@ func (w *writer) expr(expr syntax.Expr) {
 			break
 		}
 
+		if v, ok := variantOf(w.p, expr.Fun); ok {
+			assert(!expr.HasDots)
+			w.Code(exprVariant)
+			w.pos(expr)
+			w.variant(v)
+			w.Bool(true)
+			w.multiExpr(expr, func(i int) types2.Type { return v.Field(i).Type() }, expr.ArgList)
+			break
+		}
+
 		var rtype types2.Type
 		if tv.IsBuiltin() {
+			// s.add(x) and s.delete(x) for a Wo set s.
+			if sel, ok := syntax.Unparen(expr.Fun).(*syntax.SelectorExpr); ok {
+				if tv, ok := w.p.maybeTypeAndValue(sel.X); ok && !tv.IsType() {
+					if opt, ok := tv.Type.Underlying().(*types2.Optional); ok {
+						assert(!expr.HasDots)
+
+						if sel.Sel.Value == "IsPresent" {
+							assert(len(expr.ArgList) == 0)
+							w.Code(exprIsPresent)
+							w.pos(expr)
+							w.expr(sel.X)
+						} else {
+							assert(len(expr.ArgList) == 1)
+							w.Code(exprOrElse)
+							w.pos(expr)
+							w.expr(sel.X)
+							w.implicitConvExpr(opt.Elem(), expr.ArgList[0])
+						}
+						return
+					}
+					if set, ok := tv.Type.Underlying().(*types2.Set); ok {
+						assert(len(expr.ArgList) == 1)
+						assert(!expr.HasDots)
+
+						if sel.Sel.Value == "add" {
+							w.Code(exprSetAdd)
+						} else {
+							w.Code(exprSetDelete)
+						}
+						w.pos(expr)
+						w.expr(sel.X)
+						w.implicitConvExpr(set.Elem(), expr.ArgList[0])
+						w.rtype(tv.Type)
+						return
+					}
+				}
+			}
+
 			switch obj, _ := lookupObj(w.p, syntax.Unparen(expr.Fun)); obj.Name() {
 			case "make":
 				assert(len(expr.ArgList) >= 1)
@@ -2027,7 +2634,7 @@ func (w *writer) expr(expr syntax.Expr) {
 					w.p.fatalf(expr, "unexpected core type: %v", coreType)
 				case *types2.Chan:
 					w.rtype(typ)
-				case *types2.Map:
+				case *types2.Map, *types2.Set:
 					w.rtype(typ)
 				case *types2.Slice:
 					w.rtype(sliceElem(typ))
@@ -2099,6 +2706,18 @@ func (w *writer) expr(expr syntax.Expr) {
 		writeFunExpr := func() {
 			fun := syntax.Unparen(expr.Fun)
 
+			if selector, sel, ok := genericMethodOf(w.p.info, fun); ok {
+				// x.m(args) for the Wo method m with type parameters is
+				// the call m(x, args) of a generic function.
+				w.Bool(false) // not a method call
+				w.Bool(true)  // call to instanced function
+				w.pos(fun)
+				w.funcInstInfo(w.p.methodInstIdx(sel, w.p.info.Instances[selector.Sel].TypeArgs, w.dict))
+				w.Bool(true) // receiver
+				w.recvExpr(selector, sel)
+				return
+			}
+
 			if selector, ok := fun.(*syntax.SelectorExpr); ok {
 				if sel, ok := w.p.info.Selections[selector]; ok && sel.Kind() == types2.MethodVal {
 					w.Bool(true) // method call
@@ -2115,6 +2734,7 @@ func (w *writer) expr(expr syntax.Expr) {
 
 				w.pos(fun)
 				w.funcInst(obj, inst.TypeArgs)
+				w.Bool(false) // no receiver
 				return
 			}
 
@@ -2135,7 +2755,17 @@ func (w *writer) expr(expr syntax.Expr) {
 			return paramTypes.At(i).Type()
 		}
 
-		w.multiExpr(expr, paramType, expr.ArgList)
+		args := expr.ArgList
+		if dflts := w.p.info.DefaultArgs[expr]; dflts != nil {
+			// Omitted arguments of a Wo function take their default values.
+			args = append(slices.Clip(args), dflts...)
+		}
+		if sel, ok := syntax.Unparen(expr.Fun).(*syntax.SelectorExpr); ok && isBuiltinMethod(w.p.info, sel) {
+			// x.f(args) for the Wo builtin method f is pkg.f(x, args).
+			args = append([]syntax.Expr{sel.X}, args...)
+		}
+
+		w.multiExpr(expr, paramType, args)
 		w.Bool(expr.HasDots)
 		if rtype != nil {
 			w.rtype(rtype)
@@ -2147,6 +2777,35 @@ func sliceElem(typ types2.Type) types2.Type {
 	return types2.CoreType(typ).(*types2.Slice).Elem()
 }
 
+// variant writes the enum type and index of the Wo enum variant v.
+func (w *writer) variant(v *types2.Variant) {
+	w.typ(v.Type())
+	w.Len(v.Index())
+}
+
+// enumField writes the values of the field with index i of the Wo
+// enum type typ for all variants of typ. The predeclared fields name
+// and pos follow the shared fields; the values of pos are implied.
+func (w *writer) enumField(typ *types2.Enum, i int) {
+	if w.Bool(i == typ.NumFields()+1) { // pos
+		return
+	}
+	if i == typ.NumFields() { // name
+		w.typ(types2.Typ[types2.String])
+	} else {
+		w.typ(typ.Field(i).Type())
+	}
+	w.Len(typ.NumVariants())
+	for j := 0; j < typ.NumVariants(); j++ {
+		v := typ.Variant(j)
+		if i == typ.NumFields() { // name
+			w.Value(constant.MakeString(v.Name()))
+		} else {
+			w.Value(v.Value(i))
+		}
+	}
+}
+
 func (w *writer) optExpr(expr syntax.Expr) {
 	if w.Bool(expr != nil) {
 		w.expr(expr)
@@ -2183,8 +2842,12 @@ func (w *writer) recvExpr(expr *syntax.SelectorExpr, sel *types2.Selection) type
 
 // funcInst writes a reference to an instantiated function.
 func (w *writer) funcInst(obj *types2.Func, targs *types2.TypeList) {
-	info := w.p.objInstIdx(obj, targs, w.dict)
+	w.funcInstInfo(w.p.objInstIdx(obj, targs, w.dict))
+}
 
+// funcInstInfo writes a reference to the instantiated function
+// represented by info.
+func (w *writer) funcInstInfo(info objInfo) {
 	// Type arguments list contains derived types; we can emit a static
 	// call to the shaped function, but need to dynamically compute the
 	// runtime dictionary pointer.
@@ -2268,29 +2931,9 @@ func (w *writer) multiExpr(pos poser, dstType func(int) types2.Type, exprs []syn
 
 	if len(exprs) == 1 {
 		expr := exprs[0]
-		if tuple, ok := w.p.typeOf(expr).(*types2.Tuple); ok {
-			assert(tuple.Len() > 1)
+		if tuple, ok := w.p.typeOf(expr).(*types2.Tuple); ok && !isOptional(dstType(0)) {
 			w.Bool(true) // N:1 assignment
-			w.pos(pos)
-			w.expr(expr)
-
-			w.Len(tuple.Len())
-			for i := 0; i < tuple.Len(); i++ {
-				src := tuple.At(i).Type()
-				// TODO(mdempsky): Investigate not writing src here. I think
-				// the reader should be able to infer it from expr anyway.
-				w.typ(src)
-				if dst := dstType(i); w.Bool(dst != nil && !types2.Identical(src, dst)) {
-					if src == nil || dst == nil {
-						w.p.fatalf(pos, "src is %v, dst is %v", src, dst)
-					}
-					if !types2.AssignableTo(src, dst) {
-						w.p.fatalf(pos, "%v is not assignable to %v", src, dst)
-					}
-					w.typ(dst)
-					w.convRTTI(src, dst)
-				}
-			}
+			w.multiValue(pos, dstType, expr, tuple)
 			return
 		}
 	}
@@ -2302,6 +2945,42 @@ func (w *writer) multiExpr(pos poser, dstType func(int) types2.Type, exprs []syn
 	}
 }
 
+// multiValue writes the multi-valued expression expr of type tuple,
+// where the i'th value is implicitly converted to dstType(i).
+func (w *writer) multiValue(pos poser, dstType func(int) types2.Type, expr syntax.Expr, tuple *types2.Tuple) {
+	assert(tuple.Len() > 1)
+	w.pos(pos)
+	w.expr(expr)
+
+	w.Len(tuple.Len())
+	for i := 0; i < tuple.Len(); i++ {
+		src := tuple.At(i).Type()
+		// TODO(mdempsky): Investigate not writing src here. I think
+		// the reader should be able to infer it from expr anyway.
+		w.typ(src)
+		if dst := dstType(i); w.Bool(dst != nil && !types2.Identical(src, dst)) {
+			if src == nil || dst == nil {
+				w.p.fatalf(pos, "src is %v, dst is %v", src, dst)
+			}
+			if !types2.AssignableTo(src, dst) {
+				w.p.fatalf(pos, "%v is not assignable to %v", src, dst)
+			}
+			w.typ(dst)
+			if w.Bool(isOptional(dst) && !isOptional(src)) {
+				// The value is wrapped in the Wo optional type dst,
+				// after conversion to its element type if needed.
+				elem := types2.CoreType(dst).(*types2.Optional).Elem()
+				if w.Bool(!types2.Identical(src, elem)) {
+					w.typ(elem)
+					w.convRTTI(src, elem)
+				}
+				continue
+			}
+			w.convRTTI(src, dst)
+		}
+	}
+}
+
 // implicitConvExpr is like expr, but if dst is non-nil and different
 // from expr's type, then an implicit conversion operation is inserted
 // at expr's position.
@@ -2312,6 +2991,11 @@ func (w *writer) implicitConvExpr(dst types2.Type, expr syntax.Expr) {
 func (w *writer) convertExpr(dst types2.Type, expr syntax.Expr, implicit bool) {
 	src := w.p.typeOf(expr)
 
+	if isOptional(dst) && !isOptional(src) {
+		w.optionalExpr(dst, expr)
+		return
+	}
+
 	// Omit implicit no-op conversions.
 	identical := dst == nil || types2.Identical(src, dst)
 	if implicit && identical {
@@ -2333,6 +3017,37 @@ func (w *writer) convertExpr(dst types2.Type, expr syntax.Expr, implicit bool) {
 	w.expr(expr)
 }
 
+// optionalExpr writes expr converted to the Wo optional type dst.
+// Expr is either a value assignable to dst's element type or a
+// comma-ok expression.
+func (w *writer) optionalExpr(dst types2.Type, expr syntax.Expr) {
+	elem := types2.CoreType(dst).(*types2.Optional).Elem()
+
+	w.Code(exprOptional)
+	w.pos(expr)
+	w.typ(dst)
+	if tuple, ok := w.p.typeOf(expr).(*types2.Tuple); w.Bool(ok) {
+		assert(tuple.Len() == 2)
+		src := tuple.At(0).Type()
+		w.typ(src)
+		w.expr(expr)
+		if w.Bool(!types2.Identical(src, elem)) {
+			w.convRTTI(src, elem)
+		}
+		return
+	}
+	w.implicitConvExpr(elem, expr)
+}
+
+// isOptional reports whether typ is a Wo optional type.
+func isOptional(typ types2.Type) bool {
+	if typ == nil {
+		return false
+	}
+	_, ok := types2.CoreType(typ).(*types2.Optional)
+	return ok
+}
+
 func (w *writer) compLit(lit *syntax.CompositeLit) {
 	typ := w.p.typeOf(lit)
 
@@ -2353,6 +3068,9 @@ func (w *writer) compLit(lit *syntax.CompositeLit) {
 	case *types2.Map:
 		w.rtype(typ0)
 		keyType, elemType = typ.Key(), typ.Elem()
+	case *types2.Set:
+		w.rtype(typ0)
+		elemType = typ.Elem()
 	case *types2.Slice:
 		elemType = typ.Elem()
 	case *types2.Struct:
@@ -2385,15 +3103,19 @@ func (w *writer) compLit(lit *syntax.CompositeLit) {
 	}
 }
 
-func (w *writer) funcLit(expr *syntax.FuncLit) {
+// funcLit writes the function literal expr with the given body;
+// expr is a *syntax.FuncLit or a Wo *syntax.LambdaExpr.
+func (w *writer) funcLit(expr syntax.Expr, block *syntax.BlockStmt) {
 	sig := w.p.typeOf(expr).(*types2.Signature)
 
-	body, closureVars := w.p.bodyIdx(sig, expr.Body, w.dict)
+	body, closureVars := w.p.bodyIdx(sig, block, w.dict)
+
+	lit, _ := expr.(*syntax.FuncLit)
 
 	w.Sync(pkgbits.SyncFuncLit)
 	w.pos(expr)
 	w.signature(sig)
-	w.Bool(w.p.rangeFuncBodyClosures[expr])
+	w.Bool(w.p.rangeFuncBodyClosures[lit])
 
 	w.Len(len(closureVars))
 	for _, cv := range closureVars {
@@ -2495,19 +3217,67 @@ func (w *writer) exprType(iface types2.Type, typ syntax.Expr) {
 	tv := w.p.typeAndValue(typ)
 	assert(tv.IsType())
 
+	w.caseType(iface, typ, tv.Type)
+}
+
+// caseType is like exprType, but writes the given type instead of
+// the type denoted by the type expression at pos.
+func (w *writer) caseType(iface types2.Type, pos poser, typ types2.Type) {
 	w.Sync(pkgbits.SyncExprType)
-	w.pos(typ)
+	w.pos(pos)
 
-	if w.Bool(iface != nil && !iface.Underlying().(*types2.Interface).Empty()) {
-		w.itab(tv.Type, iface)
+	// Wo union types without methods are represented as empty
+	// interfaces.
+	if w.Bool(iface != nil && iface.Underlying().(*types2.Interface).NumMethods() != 0) {
+		w.itab(typ, iface)
 	} else {
-		w.rtype(tv.Type)
+		w.rtype(typ)
 
-		info := w.p.typIdx(tv.Type, w.dict)
+		info := w.p.typIdx(typ, w.dict)
 		w.Bool(info.derived)
 	}
 }
 
+// unionTerms returns the types in the type set of the Wo union type
+// typ, or nil if typ is not a union type. The type checker ensures
+// that the type sets of union types in type switch cases contain no
+// ~ terms.
+func unionTerms(typ types2.Type) []types2.Type {
+	iface, _ := typ.Underlying().(*types2.Interface)
+	if iface == nil || iface.IsMethodSet() {
+		return nil
+	}
+
+	// Collect the terms of all embedded unions, and keep those
+	// that are in the type set of the intersection.
+	var terms []types2.Type
+	var collect func(t *types2.Interface)
+	collect = func(t *types2.Interface) {
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			switch e := t.EmbeddedType(i).Underlying().(type) {
+			case *types2.Interface:
+				collect(e)
+			case *types2.Union:
+				for j := 0; j < e.Len(); j++ {
+					term := e.Term(j)
+					if sub, ok := term.Type().Underlying().(*types2.Interface); ok {
+						collect(sub)
+						continue
+					}
+					if term.Tilde() || !types2.Implements(term.Type(), iface) || slices.ContainsFunc(terms, func(t types2.Type) bool {
+						return types2.Identical(t, term.Type())
+					}) {
+						continue
+					}
+					terms = append(terms, term.Type())
+				}
+			}
+		}
+	}
+	collect(iface)
+	return terms
+}
+
 // isInterface reports whether typ is known to be an interface type.
 // If typ is a type parameter, then isInterface reports an internal
 // compiler error instead.
@@ -2566,16 +3336,13 @@ type declCollector struct {
 
 func (c *declCollector) withTParams(obj types2.Object) *declCollector {
 	tparams := objTypeParams(obj)
-	n := tparams.Len()
-	if n == 0 {
+	if len(tparams) == 0 {
 		return c
 	}
 
 	copy := *c
 	copy.implicits = copy.implicits[:len(copy.implicits):len(copy.implicits)]
-	for i := 0; i < n; i++ {
-		copy.implicits = append(copy.implicits, tparams.At(i))
-	}
+	copy.implicits = append(copy.implicits, tparams...)
 	return &copy
 }
 
@@ -2733,11 +3500,35 @@ func (w *writer) pkgInitOrder() {
 	// TODO(mdempsky): Write as a function body instead?
 	w.Len(len(w.p.info.InitOrder))
 	for _, init := range w.p.info.InitOrder {
-		w.Len(len(init.Lhs))
-		for _, v := range init.Lhs {
+		lhs := init.Lhs
+		skipped := w.p.skippedResults(init.Rhs, len(lhs))
+		if skipped != nil {
+			// Assign omitted Wo results to blank variables.
+			tuple := w.p.typeOf(init.Rhs).(*types2.Tuple)
+			vars, pos := lhs, lhs[0].Pos()
+			lhs = make([]*types2.Var, len(skipped))
+			for i, skip := range skipped {
+				if skip {
+					lhs[i] = types2.NewVar(pos, w.p.curpkg, "_", tuple.At(i).Type())
+				} else {
+					lhs[i] = vars[0]
+					vars = vars[1:]
+				}
+			}
+		}
+		w.Len(len(lhs))
+		for _, v := range lhs {
 			w.obj(v, nil)
 		}
-		w.expr(init.Rhs)
+		if w.Bool(skipped != nil) {
+			// The remaining results may need implicit conversions.
+			tuple := w.p.typeOf(init.Rhs).(*types2.Tuple)
+			w.multiValue(init.Rhs, func(i int) types2.Type { return lhs[i].Type() }, init.Rhs, tuple)
+		} else if len(init.Lhs) == 1 && isOptional(init.Lhs[0].Type()) {
+			w.implicitConvExpr(init.Lhs[0].Type(), init.Rhs)
+		} else {
+			w.expr(init.Rhs)
+		}
 	}
 }
 
@@ -2793,6 +3584,18 @@ func (w *writer) pkgDecl(decl syntax.Decl) {
 		w.Code(declOther)
 		w.pkgObjs(decl.Name)
 
+		// The implicit methods of a Wo enum type have no declarations
+		// of their own.
+		if named, ok := name.Type().(*types2.Named); ok && !decl.Alias {
+			for i := 0; i < named.NumMethods(); i++ {
+				if m := named.Method(i); w.p.isEnumMethod(m) {
+					w.Code(declMethod)
+					w.typ(named)
+					w.selector(m)
+				}
+			}
+		}
+
 	case *syntax.VarDecl:
 		w.Code(declVar)
 		w.pkgObjs(decl.NameList...)
@@ -2924,9 +3727,9 @@ func lookupObj(p *pkgWriter, expr syntax.Expr) (obj types2.Object, inst types2.I
 		expr = index.X
 	}
 
-	// Strip package qualifier, if present.
+	// Strip package qualifier or Wo builtin method receiver, if present.
 	if sel, ok := expr.(*syntax.SelectorExpr); ok {
-		if !isPkgQual(p.info, sel) {
+		if !isPkgQual(p.info, sel) && !isBuiltinMethod(p.info, sel) {
 			return // normal selector expression
 		}
 		expr = sel.Sel
@@ -2939,6 +3742,31 @@ func lookupObj(p *pkgWriter, expr syntax.Expr) (obj types2.Object, inst types2.I
 	return
 }
 
+// genericMethodOf reports whether fun, possibly instantiated, selects
+// a Wo method with type parameters, and returns its selector
+// expression and selection if so.
+func genericMethodOf(info *types2.Info, fun syntax.Expr) (*syntax.SelectorExpr, *types2.Selection, bool) {
+	if index, ok := fun.(*syntax.IndexExpr); ok {
+		fun = index.X
+	}
+	selector, ok := fun.(*syntax.SelectorExpr)
+	if !ok {
+		return nil, nil, false
+	}
+	sel, ok := info.Selections[selector]
+	if !ok || sel.Kind() != types2.MethodVal || !isGenericMethod(sel.Obj().(*types2.Func)) {
+		return nil, nil, false
+	}
+	return selector, sel, true
+}
+
+// variantOf returns the Wo enum variant that expr refers to, if any.
+func variantOf(p *pkgWriter, expr syntax.Expr) (*types2.Variant, bool) {
+	obj, _ := lookupObj(p, syntax.Unparen(expr))
+	v, ok := obj.(*types2.Variant)
+	return v, ok
+}
+
 // isPkgQual reports whether the given selector expression is a
 // package-qualified identifier.
 func isPkgQual(info *types2.Info, sel *syntax.SelectorExpr) bool {
@@ -2949,6 +3777,17 @@ func isPkgQual(info *types2.Info, sel *syntax.SelectorExpr) bool {
 	return false
 }
 
+// isBuiltinMethod reports whether sel selects a Wo builtin method of
+// sel.X, that is, a package-level function taking sel.X as its first
+// argument.
+func isBuiltinMethod(info *types2.Info, sel *syntax.SelectorExpr) bool {
+	if _, ok := info.Selections[sel]; ok || isPkgQual(info, sel) {
+		return false
+	}
+	fn, ok := info.Uses[sel.Sel].(*types2.Func)
+	return ok && fn.Signature().Recv() == nil
+}
+
 // isNil reports whether expr is a (possibly parenthesized) reference
 // to the predeclared nil value.
 func isNil(p *pkgWriter, expr syntax.Expr) bool {
@@ -3001,23 +3840,38 @@ func fieldIndex(info *types2.Info, str *types2.Struct, key *syntax.Name) int {
 }
 
 // objTypeParams returns the type parameters on the given object.
-func objTypeParams(obj types2.Object) *types2.TypeParamList {
+// The type parameters of a Wo method with type parameters follow
+// those of its receiver.
+func objTypeParams(obj types2.Object) []*types2.TypeParam {
+	var lists []*types2.TypeParamList
 	switch obj := obj.(type) {
 	case *types2.Func:
 		sig := obj.Type().(*types2.Signature)
-		if sig.Recv() != nil {
-			return sig.RecvTypeParams()
-		}
-		return sig.TypeParams()
+		lists = append(lists, sig.RecvTypeParams(), sig.TypeParams())
 	case *types2.TypeName:
 		switch t := obj.Type().(type) {
 		case *types2.Named:
-			return t.TypeParams()
+			lists = append(lists, t.TypeParams())
 		case *types2.Alias:
-			return t.TypeParams()
+			lists = append(lists, t.TypeParams())
 		}
 	}
-	return nil
+
+	var tparams []*types2.TypeParam
+	for _, list := range lists {
+		for i := 0; i < list.Len(); i++ {
+			tparams = append(tparams, list.At(i))
+		}
+	}
+	return tparams
+}
+
+// isGenericMethod reports whether fn is a Wo method with type
+// parameters. Such a method is encoded as an object of its own, a
+// generic function whose first parameter is the receiver.
+func isGenericMethod(fn *types2.Func) bool {
+	sig := fn.Type().(*types2.Signature)
+	return sig.Recv() != nil && sig.TypeParams().Len() != 0
 }
 
 // splitNamed decomposes a use of a defined type into its original
@@ -3121,7 +3975,10 @@ func (pw *pkgWriter) terminates(stmt syntax.Stmt) bool {
 		//	}
 		//	unreachable
 	case *syntax.IfStmt:
-		cond := pw.staticBool(&stmt.Cond)
+		cond := 0 // unknown for Wo conditional bindings
+		if stmt.Cond != nil {
+			cond = pw.staticBool(&stmt.Cond)
+		}
 		return (cond < 0 || pw.terminates(stmt.Then)) && (cond > 0 || pw.terminates(stmt.Else))
 	case *syntax.BlockStmt:
 		return pw.terminates(lastNonEmptyStmt(stmt.List))
@@ -1036,7 +1036,12 @@ func (r *rewriter) endLoop(loop *forLoop) {
 
 	// Give the closure generated for the body a name, to help the debugger connect it to its frame, if active.
 	r.bodyClosureCount++
-	clo := r.bodyFunc(nfor.Body.List, syntax.UnpackListExpr(rclause.Lhs), rclause.Def, ftyp, start, end)
+	lhs := syntax.UnpackListExpr(rclause.Lhs)
+	if rclause.Colon && len(lhs) == 1 && ftyp.Params().Len() == 2 {
+		// In "for v : X", v is the second iteration value.
+		lhs = []syntax.Expr{nil, lhs[0]}
+	}
+	clo := r.bodyFunc(nfor.Body.List, lhs, rclause.Def, ftyp, start, end)
 	cloDecl, cloVar := r.declSingleVar(fmt.Sprintf("#yield%d", r.bodyClosureCount), clo.GetTypeInfo().Type, clo)
 	setPos(cloDecl, start)
 
@@ -1130,7 +1135,8 @@ func (r *rewriter) setStateAt(index int, stateVal abi.RF_State) *syntax.AssignSt
 // bodyFunc converts the loop body (control flow has already been updated)
 // to a func literal that can be passed to the range function.
 //
-// vars is the range variables from the range statement.
+// vars is the range variables from the range statement;
+// a nil entry stands for an omitted variable.
 // def indicates whether this is a := range statement.
 // ftyp is the type of the function we are creating
 // start and end are the syntax positions to use for new nodes
@@ -1153,14 +1159,14 @@ func (r *rewriter) bodyFunc(body []syntax.Stmt, lhs []syntax.Expr, def bool, fty
 	for i := 0; i < ftyp.Params().Len(); i++ {
 		typ := ftyp.Params().At(i).Type()
 		var paramVar *types2.Var
-		if i < len(lhs) && def {
+		if i < len(lhs) && lhs[i] != nil && def {
 			// Reuse range variable as parameter.
 			x := lhs[i]
 			paramVar = r.info.Defs[x.(*syntax.Name)].(*types2.Var)
 		} else {
 			// Declare new parameter and assign it to range expression.
 			paramVar = types2.NewVar(start, r.pkg, fmt.Sprintf("#p%d", 1+i), typ)
-			if i < len(lhs) {
+			if i < len(lhs) && lhs[i] != nil {
 				x := lhs[i]
 				as := &syntax.AssignStmt{Lhs: x, Rhs: r.useObj(paramVar)}
 				as.SetPos(x.Pos())
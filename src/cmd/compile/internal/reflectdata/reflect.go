@@ -484,11 +484,11 @@ func dcommontype(c rttype.Cursor, t *types.Type) {
 		p = "*" + p
 		tflag |= abi.TFlagExtraStar
 		if t.Sym() != nil {
-			exported = types.IsExported(t.Sym().Name)
+			exported = types.IsExported(t.Sym().Name) || t.Sym().Export()
 		}
 	} else {
 		if t.Elem() != nil && t.Elem().Sym() != nil {
-			exported = types.IsExported(t.Elem().Sym().Name)
+			exported = types.IsExported(t.Elem().Sym().Name) || t.Elem().Sym().Export()
 		}
 	}
 
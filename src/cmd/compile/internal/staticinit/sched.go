@@ -145,6 +145,13 @@ func (s *Schedule) tryStaticInit(n ir.Node) bool {
 		return false
 	}
 
+	// A Wo optional value built from a comma-ok expression
+	// carries its own init statements and needs dynamic
+	// execution too.
+	if rhs.Op() == ir.OSTRUCTLIT && len(rhs.Init()) != 0 {
+		return false
+	}
+
 	lno := ir.SetPos(n)
 	defer func() { base.Pos = lno }()
 
@@ -883,6 +890,7 @@ func isSideEffect(n ir.Node) bool {
 		ir.OPLUS,
 		ir.ONEG,
 		ir.OOROR,
+		ir.OCOND,
 		ir.OPAREN,
 		ir.ORUNESTR,
 		ir.OREAL,
@@ -92,7 +92,7 @@ func declaredErrors(t *testing.T, filename string) map[position]string {
 			// we may have more comments before the next token - collect them
 			pattern = strings.TrimSpace(msg[9 : len(msg)-2])
 		}
-	}, comments)
+	}, comments|woTokens) // recognize all tokens so error positions are correct in Wo files
 
 	// consume file
 	for {
@@ -36,11 +36,12 @@ func (*node) aNode()           {}
 
 // package PkgName; DeclList[0], DeclList[1], ...
 type File struct {
-	Pragma    Pragma
-	PkgName   *Name
-	DeclList  []Decl
-	EOF       Pos
-	GoVersion string
+	Pragma     Pragma
+	PkgName    *Name
+	DeclList   []Decl
+	EOF        Pos
+	GoVersion  string
+	WoFeatures Features // Wo features the file may use; 0 for Go files
 	node
 }
 
@@ -69,6 +70,7 @@ type (
 	ConstDecl struct {
 		Group    *Group // nil means not part of a group
 		Pragma   Pragma
+		Vis      Visibility
 		NameList []*Name
 		Type     Expr // nil means no type
 		Values   Expr // nil means no values
@@ -79,6 +81,7 @@ type (
 	TypeDecl struct {
 		Group      *Group // nil means not part of a group
 		Pragma     Pragma
+		Vis        Visibility
 		Name       *Name
 		TParamList []*Field // nil means no type parameters
 		Alias      bool
@@ -92,6 +95,7 @@ type (
 	VarDecl struct {
 		Group    *Group // nil means not part of a group
 		Pragma   Pragma
+		Vis      Visibility
 		NameList []*Name
 		Type     Expr // nil means no type
 		Values   Expr // nil means no values
@@ -104,6 +108,7 @@ type (
 	// func Receiver Name Type
 	FuncDecl struct {
 		Pragma     Pragma
+		Vis        Visibility
 		Recv       *Field // nil means regular function
 		Name       *Name
 		TParamList []*Field // nil means no type parameters
@@ -181,6 +186,14 @@ type (
 		expr
 	}
 
+	// ParamList[0] -> Body
+	// (ParamList[0], ParamList[1], ...) -> Body
+	LambdaExpr struct {
+		ParamList []*Name // parameter types are inferred from the context
+		Body      Expr
+		expr
+	}
+
 	// (X)
 	ParenExpr struct {
 		X Expr
@@ -229,12 +242,19 @@ type (
 		expr
 	}
 
+	// Op X, X Op Y, X? (Wo; Op == Question), or X! (Wo; Op == Errable)
 	Operation struct {
 		Op   Operator
 		X, Y Expr // Y == nil means unary expression
 		expr
 	}
 
+	// if Cond then Then else Else
+	CondExpr struct {
+		Cond, Then, Else Expr
+		expr
+	}
+
 	// Fun(ArgList[0], ArgList[1], ...)
 	CallExpr struct {
 		Fun     Expr
@@ -276,23 +296,51 @@ type (
 		expr
 	}
 
+	// enum { VariantList[0], VariantList[1], ...; FieldList[0]; FieldList[1]; ... } (Wo)
+	EnumType struct {
+		VariantList []*Variant
+		FieldList   []*Field // fields shared by all variants
+		expr
+	}
+
+	// Name
+	// Name(ValueList[0], ValueList[1], ...)
+	// Name(FieldList[0], FieldList[1], ...)
+	Variant struct {
+		Name      *Name
+		ValueList []Expr   // values of the shared fields of the enum type
+		FieldList []*Field // fields specific to the variant
+		node
+	}
+
 	// Name Type
 	//      Type
+	// Name Type = Default (Wo)
+	// Name = Default      (Wo)
+	// skip Name Type      (Wo; Skip is set)
+	// skip Type           (Wo; Skip is set)
 	Field struct {
-		Name *Name // nil means anonymous field/parameter (structs/parameters), or embedded element (interfaces)
-		Type Expr  // field names declared in a list share the same Type (identical pointers)
+		Name    *Name // nil means anonymous field/parameter (structs/parameters), or embedded element (interfaces)
+		Type    Expr  // field names declared in a list share the same Type (identical pointers); nil if inferred from Default
+		Default Expr  // default value of a Wo function parameter, or nil
+		Skip    bool  // Wo result parameter that callers may omit
 		node
 	}
 
 	// interface { MethodList[0]; MethodList[1]; ... }
+	// <MethodList[0], MethodList[1], ...> (Wo; Compact is set)
 	InterfaceType struct {
 		MethodList []*Field
+		Compact    bool
 		expr
 	}
 
+	// func(ParamList) ResultList
+	// ParamList -> ResultList (Wo; Arrow is set)
 	FuncType struct {
 		ParamList  []*Field
 		ResultList []*Field
+		Arrow      bool
 		expr
 	}
 
@@ -372,9 +420,12 @@ type (
 		stmt
 	}
 
+	// Lhs Op= Rhs
+	// Lhs Type = Rhs (Wo; Type is set and Op == Def)
 	AssignStmt struct {
 		Op       Operator // 0 means no operation
 		Lhs, Rhs Expr     // Rhs == nil means Lhs++ (Op == Add) or Lhs-- (Op == Sub)
+		Type     Expr     // type of a Wo typed declaration, or nil
 		simpleStmt
 	}
 
@@ -403,7 +454,7 @@ type (
 	}
 
 	IfStmt struct {
-		Init SimpleStmt
+		Init SimpleStmt // incl. *VarClause (Cond is nil)
 		Cond Expr
 		Then *BlockStmt
 		Else Stmt // either nil, *IfStmt, or *BlockStmt
@@ -411,7 +462,7 @@ type (
 	}
 
 	ForStmt struct {
-		Init SimpleStmt // incl. *RangeClause
+		Init SimpleStmt // incl. *RangeClause, *VarClause
 		Cond Expr
 		Post SimpleStmt
 		Body *BlockStmt
@@ -435,9 +486,19 @@ type (
 
 type (
 	RangeClause struct {
-		Lhs Expr // nil means no Lhs = or Lhs :=
-		Def bool // means :=
-		X   Expr // range X
+		Lhs   Expr // nil means no Lhs = or Lhs :=
+		Def   bool // means :=
+		Colon bool // Wo spelling Lhs : X; implies Def
+		X     Expr // range X
+		simpleStmt
+	}
+
+	// var NameList [Type] = Value
+	// (Wo conditional binding in an if or for header)
+	VarClause struct {
+		NameList []*Name
+		Type     Expr // nil means no type
+		Value    Expr
 		simpleStmt
 	}
 
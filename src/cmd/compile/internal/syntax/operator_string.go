@@ -12,30 +12,32 @@ func _() {
 	_ = x[Not-2]
 	_ = x[Recv-3]
 	_ = x[Tilde-4]
-	_ = x[OrOr-5]
-	_ = x[AndAnd-6]
-	_ = x[Eql-7]
-	_ = x[Neq-8]
-	_ = x[Lss-9]
-	_ = x[Leq-10]
-	_ = x[Gtr-11]
-	_ = x[Geq-12]
-	_ = x[Add-13]
-	_ = x[Sub-14]
-	_ = x[Or-15]
-	_ = x[Xor-16]
-	_ = x[Mul-17]
-	_ = x[Div-18]
-	_ = x[Rem-19]
-	_ = x[And-20]
-	_ = x[AndNot-21]
-	_ = x[Shl-22]
-	_ = x[Shr-23]
+	_ = x[Question-5]
+	_ = x[Errable-6]
+	_ = x[OrOr-7]
+	_ = x[AndAnd-8]
+	_ = x[Eql-9]
+	_ = x[Neq-10]
+	_ = x[Lss-11]
+	_ = x[Leq-12]
+	_ = x[Gtr-13]
+	_ = x[Geq-14]
+	_ = x[Add-15]
+	_ = x[Sub-16]
+	_ = x[Or-17]
+	_ = x[Xor-18]
+	_ = x[Mul-19]
+	_ = x[Div-20]
+	_ = x[Rem-21]
+	_ = x[And-22]
+	_ = x[AndNot-23]
+	_ = x[Shl-24]
+	_ = x[Shr-25]
 }
 
-const _Operator_name = ":!<-~||&&==!=<<=>>=+-|^*/%&&^<<>>"
+const _Operator_name = ":!<-~?!||&&==!=<<=>>=+-|^*/%&&^<<>>"
 
-var _Operator_index = [...]uint8{0, 1, 2, 4, 5, 7, 9, 11, 13, 14, 16, 17, 19, 20, 21, 22, 23, 24, 25, 26, 27, 29, 31, 33}
+var _Operator_index = [...]uint8{0, 1, 2, 4, 5, 6, 7, 9, 11, 13, 15, 16, 18, 19, 21, 22, 23, 24, 25, 26, 27, 28, 29, 31, 33, 35}
 
 func (i Operator) String() string {
 	i -= 1
@@ -27,6 +27,7 @@ type parser struct {
 	errcnt    int      // number of errors encountered
 	pragma    Pragma   // pragmas
 	goVersion string   // Go version from //go:build line
+	wo        Features // Wo features enabled for this file
 
 	top    bool   // in top of file (before package clause)
 	fnest  int    // function nesting level (for error handling)
@@ -40,6 +41,13 @@ func (p *parser) init(file *PosBase, r io.Reader, errh ErrorHandler, pragh Pragm
 	p.errh = errh
 	p.mode = mode
 	p.pragh = pragh
+	if strings.HasSuffix(file.Filename(), ".wo") {
+		p.wo = AllFeatures
+	}
+	scanMode := directives
+	if p.wo != 0 {
+		scanMode |= woTokens
+	}
 	p.scanner.init(
 		r,
 		// Error and directive handler for scanner.
@@ -53,7 +61,7 @@ func (p *parser) init(file *PosBase, r io.Reader, errh ErrorHandler, pragh Pragm
 				return
 			}
 
-			// otherwise it must be a comment containing a line or go: directive.
+			// otherwise it must be a comment containing a line, go:, or wo: directive.
 			// //line directives must be at the start of the line (column colbase).
 			// /*line*/ directives can be anywhere in the line.
 			text := commentText(msg)
@@ -73,6 +81,11 @@ func (p *parser) init(file *PosBase, r io.Reader, errh ErrorHandler, pragh Pragm
 				return
 			}
 
+			if strings.HasPrefix(text, "wo:") {
+				p.woDirective(p.posAt(line, col+2), text) // +2 to skip over //
+				return
+			}
+
 			// go: directive (but be conservative and test)
 			if strings.HasPrefix(text, "go:") {
 				if p.top && strings.HasPrefix(msg, "//go:build") {
@@ -85,7 +98,7 @@ func (p *parser) init(file *PosBase, r io.Reader, errh ErrorHandler, pragh Pragm
 				}
 			}
 		},
-		directives,
+		scanMode,
 	)
 
 	p.base = file
@@ -405,6 +418,7 @@ func (p *parser) fileOrNil() *File {
 
 	// PackageClause
 	f.GoVersion = p.goVersion
+	f.WoFeatures = p.wo
 	p.top = false
 	if !p.got(_Package) {
 		p.syntaxError("package statement must be first")
@@ -423,6 +437,20 @@ func (p *parser) fileOrNil() *File {
 	// { ( ImportDecl | TopLevelDecl ) ";" }
 	prev := _Import
 	for p.tok != _EOF {
+		// In Wo files, a declaration may start with a visibility modifier.
+		var vis Visibility
+		if p.tok == _Name {
+			if vis = p.visModifier(p.lit); vis != DefaultVis {
+				p.next()
+				if p.tok != _Const && p.tok != _Type && p.tok != _Var && p.tok != _Func {
+					p.syntaxError("expected const, type, var, or func after " + vis.String())
+					p.advance(_Import, _Const, _Type, _Var, _Func)
+					continue
+				}
+			}
+		}
+		ndecls := len(f.DeclList)
+
 		if p.tok == _Import && prev != _Import {
 			p.syntaxError("imports must appear before other declarations")
 		}
@@ -462,6 +490,10 @@ func (p *parser) fileOrNil() *File {
 			continue
 		}
 
+		if vis != DefaultVis {
+			p.setVis(f.DeclList[ndecls:], vis)
+		}
+
 		// Reset p.pragma BEFORE advancing to the next token (consuming ';')
 		// since comments before may set pragmas for the next function decl.
 		p.clearPragma()
@@ -650,25 +682,25 @@ func (p *parser) typeDecl(group *Group) Decl {
 				// d.Name "[" pname ...
 				// d.Name "[" pname ptype ...
 				// d.Name "[" pname ptype "," ...
-				d.TParamList = p.paramList(pname, ptype, _Rbrack, true) // ptype may be nil
+				d.TParamList = p.paramList(pname, ptype, _Rbrack, true, false) // ptype may be nil
 				d.Alias = p.gotAssign()
-				d.Type = p.typeOrNil()
+				d.Type = p.unionType(p.typeOrNil())
 			} else {
 				// d.Name "[" pname "]" ...
 				// d.Name "[" x ...
-				d.Type = p.arrayType(pos, x)
+				d.Type = p.unionType(p.arrayType(pos, x))
 			}
 		case _Rbrack:
 			// d.Name "[" "]" ...
 			p.next()
-			d.Type = p.sliceType(pos)
+			d.Type = p.unionType(p.sliceType(pos))
 		default:
 			// d.Name "[" ...
-			d.Type = p.arrayType(pos, nil)
+			d.Type = p.unionType(p.arrayType(pos, nil))
 		}
 	} else {
 		d.Alias = p.gotAssign()
-		d.Type = p.typeOrNil()
+		d.Type = p.unionType(p.typeOrNil())
 	}
 
 	if d.Type == nil {
@@ -774,7 +806,7 @@ func (p *parser) varDecl(group *Group) Decl {
 	if p.gotAssign() {
 		d.Values = p.exprList()
 	} else {
-		d.Type = p.type_()
+		d.Type = p.unionType(p.type_())
 		if p.gotAssign() {
 			d.Values = p.exprList()
 		}
@@ -799,8 +831,11 @@ func (p *parser) funcDeclOrNil() *FuncDecl {
 
 	var context string
 	if p.got(_Lparen) {
-		context = "method"
-		rcvr := p.paramList(nil, nil, _Rparen, false)
+		// In Wo files, methods may have type parameters.
+		if p.wo&WoMethodTParams == 0 {
+			context = "method"
+		}
+		rcvr := p.paramList(nil, nil, _Rparen, false, false)
 		switch len(rcvr) {
 		case 0:
 			p.error("method has no receiver")
@@ -814,13 +849,22 @@ func (p *parser) funcDeclOrNil() *FuncDecl {
 
 	if p.tok == _Name {
 		f.Name = p.name()
+		if vis := p.visModifier(f.Name.Value); vis != DefaultVis && p.tok == _Name {
+			// In Wo files, the function name may be preceded
+			// by a visibility modifier.
+			if f.Recv != nil {
+				p.errorAt(f.Name.Pos(), "methods cannot have visibility modifiers")
+			}
+			f.Vis = vis
+			f.Name = p.name()
+		}
 		f.TParamList, f.Type = p.funcType(context)
 	} else {
 		f.Name = NewName(p.pos(), "_")
 		f.Type = new(FuncType)
 		f.Type.pos = p.pos()
 		msg := "expected name or ("
-		if context != "" {
+		if f.Recv != nil {
 			msg = "expected name"
 		}
 		p.syntaxError(msg)
@@ -997,6 +1041,8 @@ func (p *parser) callStmt() *CallStmt {
 
 // Operand     = Literal | OperandName | MethodExpr | "(" Expression ")" .
 // Literal     = BasicLit | CompositeLit | FunctionLit .
+//
+// In Wo files, a function literal may also be a LambdaLit.
 // BasicLit    = int_lit | float_lit | imaginary_lit | rune_lit | string_lit .
 // OperandName = identifier | QualifiedIdent.
 func (p *parser) operand(keep_parens bool) Expr {
@@ -1006,7 +1052,11 @@ func (p *parser) operand(keep_parens bool) Expr {
 
 	switch p.tok {
 	case _Name:
-		return p.name()
+		x := p.name()
+		if p.tok == _RArrow && p.wo&WoArrow != 0 {
+			return p.lambdaExpr(x.pos, []*Name{x})
+		}
+		return x
 
 	case _Literal:
 		return p.oliteral()
@@ -1014,10 +1064,31 @@ func (p *parser) operand(keep_parens bool) Expr {
 	case _Lparen:
 		pos := p.pos()
 		p.next()
-		p.xnest++
-		x := p.expr()
-		p.xnest--
-		p.want(_Rparen)
+		var x Expr
+		if p.wo&WoArrow != 0 {
+			// In Wo files, a parenthesized list may be the
+			// parameter list of a function literal.
+			var list []Expr
+			p.xnest++
+			p.list("parenthesized expression", _Comma, _Rparen, func() bool {
+				list = append(list, p.expr())
+				return false
+			})
+			p.xnest--
+			if p.tok == _RArrow {
+				return p.lambdaExpr(pos, p.lambdaParams(list))
+			}
+			if len(list) != 1 {
+				p.syntaxError("expected ->")
+				return p.badExpr()
+			}
+			x = list[0]
+		} else {
+			p.xnest++
+			x = p.expr()
+			p.xnest--
+			p.want(_Rparen)
+		}
 
 		// Optimization: Record presence of ()'s only where needed
 		// for error reporting. Don't bother in other cases; it is
@@ -1062,7 +1133,16 @@ func (p *parser) operand(keep_parens bool) Expr {
 	case _Lbrack, _Chan, _Map, _Struct, _Interface:
 		return p.type_() // othertype
 
+	case _If:
+		if p.wo&WoTernary != 0 {
+			return p.condExpr()
+		}
+		fallthrough
+
 	default:
+		if p.tok == _Operator && p.op == Lss && p.wo&WoInterface != 0 {
+			return p.compactInterfaceType() // othertype
+		}
 		x := p.badExpr()
 		p.syntaxError("expected expression")
 		p.advance(_Rparen, _Rbrack, _Rbrace)
@@ -1075,6 +1155,67 @@ func (p *parser) operand(keep_parens bool) Expr {
 	// as well (operand is only called from pexpr).
 }
 
+// lambdaParams returns the parameter names of a Wo function literal
+// parsed as the expressions in list.
+func (p *parser) lambdaParams(list []Expr) []*Name {
+	names := make([]*Name, 0, len(list))
+	for _, x := range list {
+		if name, ok := x.(*Name); ok {
+			names = append(names, name)
+			continue
+		}
+		p.errorAt(StartPos(x), fmt.Sprintf("syntax error: invalid parameter %s in function literal", String(x)))
+	}
+	return names
+}
+
+// lambdaExpr parses a Wo function literal whose parameters have been
+// parsed already; pos is the position of the literal.
+//
+//	LambdaLit    = LambdaParams "->" Expression .
+//	LambdaParams = identifier | "(" [ IdentifierList [ "," ] ] ")" .
+func (p *parser) lambdaExpr(pos Pos, params []*Name) *LambdaExpr {
+	if trace {
+		defer p.trace("lambdaExpr")()
+	}
+
+	x := new(LambdaExpr)
+	x.pos = pos
+	x.ParamList = params
+	p.want(_RArrow)
+	x.Body = p.expr()
+	return x
+}
+
+// condExpr parses a Wo conditional expression.
+//
+//	CondExpr = "if" Expression "then" Expression "else" Expression .
+func (p *parser) condExpr() *CondExpr {
+	if trace {
+		defer p.trace("condExpr")()
+	}
+
+	x := new(CondExpr)
+	x.pos = p.pos()
+	p.want(_If)
+	p.xnest++
+	x.Cond = p.expr()
+	if p.tok == _Name && p.lit == "then" {
+		p.next()
+	} else {
+		p.syntaxError("expected then")
+	}
+	x.Then = p.expr()
+	p.xnest--
+	if !p.got(_Else) {
+		p.syntaxError("expected else")
+		x.Else = p.badExpr()
+		return x
+	}
+	x.Else = p.expr()
+	return x
+}
+
 // pexpr parses a PrimaryExpr.
 //
 //	PrimaryExpr =
@@ -1239,6 +1380,22 @@ loop:
 			n.Type = x
 			x = n
 
+		case _Question:
+			if p.wo&WoOptional == 0 {
+				break loop
+			}
+			// pexpr '?'
+			x = newOptional(pos, x)
+			p.next()
+
+		case _Operator:
+			if p.op != Not || p.wo&WoResult == 0 {
+				break loop
+			}
+			// pexpr '!'
+			x = newErrable(pos, x)
+			p.next()
+
 		default:
 			break loop
 		}
@@ -1334,6 +1491,26 @@ func newIndirect(pos Pos, typ Expr) Expr {
 	return o
 }
 
+// newOptional returns the Wo optional type x? (or the unwrap
+// operation x? if x is a value); pos is the position of the ?.
+func newOptional(pos Pos, x Expr) Expr {
+	o := new(Operation)
+	o.pos = pos
+	o.Op = Question
+	o.X = x
+	return o
+}
+
+// newErrable returns the Wo result type x! (or the error propagation
+// x! if x is a value); pos is the position of the !.
+func newErrable(pos Pos, x Expr) Expr {
+	o := new(Operation)
+	o.pos = pos
+	o.Op = Errable
+	o.X = x
+	return o
+}
+
 // typeOrNil is like type_ but it returns nil if there was no type
 // instead of reporting an error.
 //
@@ -1341,11 +1518,118 @@ func newIndirect(pos Pos, typ Expr) Expr {
 //	TypeName = identifier | QualifiedIdent .
 //	TypeLit  = ArrayType | StructType | PointerType | FunctionType | InterfaceType |
 //		      SliceType | MapType | Channel_Type .
+//
+// In Wo files, a type may be followed by ? to denote an optional type,
+// and function types may be written with an arrow:
+//
+//	OptionalType = Type "?" .
+//	ArrowType    = ArrowParams "->" ArrowResult .
+//	ArrowParams  = Type | "(" [ TypeList [ "," ] ] ")" .
+//	ArrowResult  = Type | "_" | "(" [ TypeList [ "," ] ] ")" .
+//
+// Arrow types are right-associative, and "_" stands for no result.
 func (p *parser) typeOrNil() Expr {
 	if trace {
 		defer p.trace("typeOrNil")()
 	}
 
+	t := p.nonOptionalTypeOrNil()
+	if t != nil {
+		t = p.arrowOrType(p.optionalType(t))
+	}
+	return t
+}
+
+// optionalType returns typ followed by any number of ? as an optional type.
+func (p *parser) optionalType(typ Expr) Expr {
+	if p.wo&WoOptional != 0 {
+		for p.tok == _Question {
+			typ = newOptional(p.pos(), typ)
+			p.next()
+		}
+	}
+	return typ
+}
+
+// arrowOrType returns the arrow function type typ -> Result if typ
+// is followed by ->; otherwise it returns typ.
+func (p *parser) arrowOrType(typ Expr) Expr {
+	if p.tok == _RArrow && p.wo&WoArrow != 0 {
+		return p.arrowType(StartPos(typ), []Expr{typ})
+	}
+	return typ
+}
+
+// arrowType parses the result of an arrow function type with the given
+// parameter types; pos is the position of the type.
+func (p *parser) arrowType(pos Pos, params []Expr) *FuncType {
+	if trace {
+		defer p.trace("arrowType")()
+	}
+
+	typ := new(FuncType)
+	typ.pos = pos
+	typ.Arrow = true
+	typ.ParamList = newTypeFields(params)
+	p.want(_RArrow)
+
+	switch {
+	case p.tok == _Name && p.lit == "_":
+		// no result
+		p.next()
+
+	case p.tok == _Lparen:
+		pos := p.pos()
+		p.next()
+		list := p.parenTypeList()
+		switch {
+		case p.tok == _RArrow:
+			typ.ResultList = newTypeFields([]Expr{p.arrowType(pos, list)})
+		case len(list) == 1:
+			typ.ResultList = newTypeFields([]Expr{p.arrowOrType(p.optionalType(list[0]))})
+		default:
+			typ.ResultList = newTypeFields(list)
+		}
+
+	default:
+		typ.ResultList = newTypeFields([]Expr{p.type_()})
+	}
+
+	return typ
+}
+
+// parenTypeList parses a possibly empty list of types up to and
+// including the closing ")"; the "(" has been consumed already.
+// The last type may be a variadic ...T type.
+func (p *parser) parenTypeList() (list []Expr) {
+	p.list("type list", _Comma, _Rparen, func() bool {
+		if p.tok == _DotDotDot {
+			t := new(DotsType)
+			t.pos = p.pos()
+			p.next()
+			t.Elem = p.unionType(p.type_())
+			list = append(list, t)
+			return false
+		}
+		list = append(list, p.unionType(p.type_()))
+		return false
+	})
+	return
+}
+
+// newTypeFields returns a list of unnamed fields with the given types.
+func newTypeFields(types []Expr) []*Field {
+	var list []*Field
+	for _, t := range types {
+		f := new(Field)
+		f.pos = StartPos(t)
+		f.Type = t
+		list = append(list, f)
+	}
+	return list
+}
+
+func (p *parser) nonOptionalTypeOrNil() Expr {
 	pos := p.pos()
 	switch p.tok {
 	case _Star:
@@ -1407,11 +1691,37 @@ func (p *parser) typeOrNil() Expr {
 	case _Interface:
 		return p.interfaceType()
 
+	case _Operator:
+		if p.op == Lss && p.wo&WoInterface != 0 {
+			return p.compactInterfaceType()
+		}
+
 	case _Name:
+		if p.lit == "enum" && p.wo&WoEnum != 0 {
+			// In Wo files, enum is a keyword if followed by "{".
+			name := p.name()
+			if p.tok == _Lbrace {
+				return p.enumType(pos)
+			}
+			return p.qualifiedName(name)
+		}
 		return p.qualifiedName(nil)
 
 	case _Lparen:
 		p.next()
+		if p.wo&WoArrow != 0 {
+			// In Wo files, a parenthesized list of types may be
+			// the parameter list of an arrow type.
+			list := p.parenTypeList()
+			if p.tok == _RArrow {
+				return p.arrowType(pos, list)
+			}
+			if len(list) != 1 {
+				p.syntaxError("expected ->")
+				return p.badExpr()
+			}
+			return list[0]
+		}
 		t := p.type_()
 		p.want(_Rparen)
 		// The parser doesn't keep unnecessary parentheses.
@@ -1469,12 +1779,12 @@ func (p *parser) funcType(context string) ([]*Field, *FuncType) {
 			p.syntaxError("empty type parameter list")
 			p.next()
 		} else {
-			tparamList = p.paramList(nil, nil, _Rbrack, true)
+			tparamList = p.paramList(nil, nil, _Rbrack, true, false)
 		}
 	}
 
 	p.want(_Lparen)
-	typ.ParamList = p.paramList(nil, nil, _Rparen, false)
+	typ.ParamList = p.paramList(nil, nil, _Rparen, false, false)
 	typ.ResultList = p.funcResult()
 
 	return tparamList, typ
@@ -1549,6 +1859,134 @@ func (p *parser) structType() *StructType {
 	return typ
 }
 
+// EnumType    = "enum" "{" { EnumElem ";" } "}" .
+// EnumElem    = Variant { "," Variant } [ "," ] | IdentifierList Type .
+// Variant     = identifier [ "(" [ VariantArgs [ "," ] ] ")" ] .
+// VariantArgs = ExpressionList | ParameterList .
+//
+// The "enum" keyword has already been consumed.
+func (p *parser) enumType(pos Pos) *EnumType {
+	if trace {
+		defer p.trace("enumType")()
+	}
+
+	typ := new(EnumType)
+	typ.pos = pos
+
+	p.want(_Lbrace)
+	p.list("enum type", _Semi, _Rbrace, func() bool {
+		p.enumElem(typ)
+		return false
+	})
+
+	return typ
+}
+
+// enumElem parses a list of variants or a field declaration and
+// appends it to typ.
+func (p *parser) enumElem(typ *EnumType) {
+	if trace {
+		defer p.trace("enumElem")()
+	}
+
+	pos := p.pos()
+	var list []*Variant
+	for {
+		v := new(Variant)
+		v.pos = p.pos()
+		v.Name = p.name()
+		if p.tok == _Lparen {
+			v.ValueList, v.FieldList = p.variantArgs()
+		}
+		list = append(list, v)
+		if !p.got(_Comma) || p.tok == _Semi || p.tok == _Rbrace {
+			break
+		}
+	}
+
+	if p.tok != _Semi && p.tok != _Rbrace {
+		// IdentifierList Type
+		t := p.type_()
+		for _, v := range list {
+			if v.ValueList != nil || v.FieldList != nil {
+				p.errorAt(v.Pos(), "syntax error: variant in enum field declaration")
+				return
+			}
+			f := new(Field)
+			f.pos = v.Pos()
+			f.Name = v.Name
+			f.Type = t
+			typ.FieldList = append(typ.FieldList, f)
+		}
+		return
+	}
+
+	if len(typ.FieldList) > 0 {
+		p.errorAt(pos, "syntax error: enum variants must precede fields")
+		return
+	}
+	typ.VariantList = append(typ.VariantList, list...)
+}
+
+// variantArgs parses the parenthesized values or fields of a variant.
+// A name followed by the start of a type begins a field declaration;
+// as in a parameter list, names preceding a field declaration share
+// its type. All other arguments are values.
+func (p *parser) variantArgs() (values []Expr, fields []*Field) {
+	if trace {
+		defer p.trace("variantArgs")()
+	}
+
+	pos := p.pos()
+	typed := false // set if there is a field declaration
+	p.want(_Lparen)
+	p.list("variant", _Comma, _Rparen, func() bool {
+		var x Expr
+		if p.tok == _Name {
+			name := p.name()
+			switch p.tok {
+			case _Name, _Star, _Lbrack, _Arrow, _Func, _Map, _Chan, _Struct, _Interface:
+				// name Type
+				f := new(Field)
+				f.pos = name.Pos()
+				f.Name = name
+				f.Type = p.type_()
+				for i := len(fields) - 1; i >= 0 && fields[i] != nil && fields[i].Type == nil; i-- {
+					fields[i].Type = f.Type
+				}
+				fields = append(fields, f)
+				typed = true
+				return false
+			}
+			x = p.binaryExpr(p.pexpr(name, false), 0)
+		} else {
+			x = p.expr()
+		}
+		values = append(values, x)
+
+		// A name may be the name of a field declared later.
+		var f *Field
+		if name, _ := x.(*Name); name != nil {
+			f = new(Field)
+			f.pos = name.Pos()
+			f.Name = name
+		}
+		fields = append(fields, f)
+		return false
+	})
+
+	if !typed {
+		return values, nil
+	}
+	for _, f := range fields {
+		if f == nil || f.Type == nil {
+			p.errorAt(pos, "syntax error: mixed values and fields in variant")
+			return nil, nil
+		}
+	}
+	return nil, fields
+}
+
 // InterfaceType = "interface" "{" { ( MethodDecl | EmbeddedElem ) ";" } "}" .
 func (p *parser) interfaceType() *InterfaceType {
 	if trace {
@@ -1575,18 +2013,119 @@ func (p *parser) interfaceType() *InterfaceType {
 	return typ
 }
 
+// In Wo files, interface types may also be written in angle brackets,
+// with the elements separated by commas:
+//
+//	CompactInterfaceType = "<" [ InterfaceElem { "," InterfaceElem } [ "," ] ] ">" .
+func (p *parser) compactInterfaceType() *InterfaceType {
+	if trace {
+		defer p.trace("compactInterfaceType")()
+	}
+
+	typ := new(InterfaceType)
+	typ.pos = p.pos()
+	typ.Compact = true
+
+	p.next() // consume "<"
+	for p.tok != _EOF && !p.gotGtr() {
+		var f *Field
+		if p.tok == _Name {
+			f = p.methodDecl()
+		}
+		if f == nil || f.Name == nil {
+			f = p.embeddedElem(f)
+		}
+		typ.MethodList = append(typ.MethodList, f)
+		if !p.got(_Comma) && !(p.tok == _Operator && (p.op == Gtr || p.op == Shr)) {
+			p.syntaxError("in interface type; possibly missing comma or >")
+			p.advance(_Semi, _Rparen, _Rbrack, _Rbrace)
+			break
+		}
+	}
+
+	return typ
+}
+
+// gotGtr is like got for the operator ">", but it also accepts ">>",
+// of which it consumes only the first ">": the closing ">" of nested
+// compact interface types are scanned as ">>".
+func (p *parser) gotGtr() bool {
+	if p.tok == _Operator {
+		switch p.op {
+		case Gtr:
+			// Like the closing "}" of an interface type, the closing
+			// ">" may end a line.
+			p.nlsemi = true
+			p.next()
+			return true
+		case Shr:
+			p.op, p.prec = Gtr, precCmp
+			p.col++
+			return true
+		}
+	}
+	return false
+}
+
+// unionType returns the Wo union type x | ... if x is followed by "|"
+// in a Wo file; otherwise it returns x. Unlike the terms of a type
+// constraint, the terms of a union type cannot be ~ terms.
+//
+//	UnionType = Type "|" Type { "|" Type } .
+func (p *parser) unionType(x Expr) Expr {
+	if p.wo&WoInterface == 0 || x == nil {
+		return x
+	}
+	for p.tok == _Operator && p.op == Or {
+		t := new(Operation)
+		t.pos = p.pos()
+		t.Op = Or
+		p.next()
+		t.X = x
+		t.Y = p.type_()
+		x = t
+	}
+	return x
+}
+
 // Result = Parameters | Type .
+//
+// In Wo files, a result type may be followed by ! to denote the
+// results (Type, error):
+//
+//	ResultType = Type "!" .
+//
+// Parameters followed by -> are the parameters of an arrow result type.
 func (p *parser) funcResult() []*Field {
 	if trace {
 		defer p.trace("funcResult")()
 	}
 
+	pos := p.pos()
 	if p.got(_Lparen) {
-		return p.paramList(nil, nil, _Rparen, false)
+		list := p.paramList(nil, nil, _Rparen, false, true)
+		if p.tok == _RArrow && p.wo&WoArrow != 0 {
+			// list is the parameter list of an arrow result type
+			var types []Expr
+			for _, f := range list {
+				if f.Name != nil {
+					p.errorAt(f.Pos(), "syntax error: arrow function type must have no parameter names")
+				}
+				if f.Skip {
+					p.errorAt(f.Pos(), "syntax error: unexpected skip in parameter list")
+				}
+				types = append(types, f.Type)
+			}
+			return newTypeFields([]Expr{p.arrowType(pos, types)})
+		}
+		return list
 	}
 
-	pos := p.pos()
-	if typ := p.typeOrNil(); typ != nil {
+	if typ := p.unionType(p.typeOrNil()); typ != nil {
+		if p.tok == _Operator && p.op == Not && p.wo&WoResult != 0 {
+			typ = newErrable(p.pos(), typ)
+			p.next()
+		}
 		f := new(Field)
 		f.pos = pos
 		f.Type = typ
@@ -1652,7 +2191,7 @@ func (p *parser) fieldDecl(styp *StructType) {
 			}
 		} else {
 			// T P
-			typ = p.type_()
+			typ = p.unionType(p.type_())
 		}
 
 		tag := p.oliteral()
@@ -1793,7 +2332,7 @@ func (p *parser) methodDecl() *Field {
 
 		// A type argument list looks like a parameter list with only
 		// types. Parse a parameter list and decide afterwards.
-		list := p.paramList(nil, nil, _Rbrack, false)
+		list := p.paramList(nil, nil, _Rbrack, false, false)
 		if len(list) == 0 {
 			// The type parameter list is not [] but we got nothing
 			// due to other errors (reported by paramList). Treat
@@ -1924,6 +2463,7 @@ func (p *parser) paramDeclOrNil(name *Name, follow token) *Field {
 			if typ, ok := f.Type.(*IndexExpr); ok {
 				// name "[" ... "]"
 				typ.X = name
+				f.Type = p.arrowOrType(p.optionalType(typ))
 			} else {
 				// name "[" n "]" E
 				f.Name = name
@@ -1932,24 +2472,39 @@ func (p *parser) paramDeclOrNil(name *Name, follow token) *Field {
 				// name "[" ... "]" "|" ...
 				// name "[" n "]" E "|" ...
 				f = p.embeddedElem(f)
+			} else {
+				f.Type = p.unionType(f.Type)
 			}
 			return f
 		}
 
 		if p.tok == _Dot {
 			// name "." ...
-			f.Type = p.qualifiedName(name)
+			f.Type = p.arrowOrType(p.optionalType(p.qualifiedName(name)))
 			if typeSetsOk && p.tok == _Operator && p.op == Or {
 				// name "." name "|" ...
 				f = p.embeddedElem(f)
+			} else {
+				f.Type = p.unionType(f.Type)
 			}
 			return f
 		}
 
-		if typeSetsOk && p.tok == _Operator && p.op == Or {
+		if p.tok == _Operator && p.op == Or && (typeSetsOk || p.wo&WoInterface != 0) {
 			// name "|" ...
-			f.Type = name
-			return p.embeddedElem(f)
+			if typeSetsOk {
+				f.Type = name
+				return p.embeddedElem(f)
+			}
+			f.Type = p.unionType(name)
+			return f
+		}
+
+		if p.tok == _Question && p.wo&WoOptional != 0 || p.tok == _RArrow && p.wo&WoArrow != 0 {
+			// name "?" ...
+			// name "->" ...
+			f.Type = p.arrowOrType(p.optionalType(name))
+			return f
 		}
 
 		f.Name = name
@@ -1960,7 +2515,7 @@ func (p *parser) paramDeclOrNil(name *Name, follow token) *Field {
 		t := new(DotsType)
 		t.pos = p.pos()
 		p.next()
-		t.Elem = p.typeOrNil()
+		t.Elem = p.unionType(p.typeOrNil())
 		if t.Elem == nil {
 			t.Elem = p.badExpr()
 			p.syntaxError("... is missing type")
@@ -1979,6 +2534,8 @@ func (p *parser) paramDeclOrNil(name *Name, follow token) *Field {
 	if typeSetsOk && p.tok == _Operator && p.op == Or && f.Type != nil {
 		// [name] type "|"
 		f = p.embeddedElem(f)
+	} else {
+		f.Type = p.unionType(f.Type)
 	}
 	if f.Name != nil || f.Type != nil {
 		return f
@@ -1991,11 +2548,23 @@ func (p *parser) paramDeclOrNil(name *Name, follow token) *Field {
 
 // Parameters    = "(" [ ParameterList [ "," ] ] ")" .
 // ParameterList = ParameterDecl { "," ParameterDecl } .
+//
+// In Wo files, a parameter may have a default value,
+//
+//	ParameterDecl = [ IdentifierList ] [ "..." ] Type [ "=" Expression ] |
+//	                identifier "=" Expression .
+//
+// and a result may be preceded by a skip modifier:
+//
+//	Result = "(" [ ResultDecl { "," ResultDecl } [ "," ] ] ")" .
+//	ResultDecl = [ "skip" ] ParameterDecl .
+//
 // "(" or "[" has already been consumed.
 // If name != nil, it is the first name after "(" or "[".
 // If typ != nil, name must be != nil, and (name, typ) is the first field in the list.
+// If results is set, the list is a result parameter list.
 // In the result list, either all fields have a name, or no field has a name.
-func (p *parser) paramList(name *Name, typ Expr, close token, requireNames bool) (list []*Field) {
+func (p *parser) paramList(name *Name, typ Expr, close token, requireNames, results bool) (list []*Field) {
 	if trace {
 		defer p.trace("paramList")()
 	}
@@ -2024,7 +2593,24 @@ func (p *parser) paramList(name *Name, typ Expr, close token, requireNames bool)
 			par.Name = name
 			par.Type = typ
 		} else {
+			var skip *Name // Wo skip modifier, if any
+			if results && name == nil && p.tok == _Name && p.lit == "skip" && p.wo&WoSkip != 0 {
+				skip = p.name()
+				if p.tok == _Comma || p.tok == close || p.tok == _Dot {
+					// skip is the parameter name or type
+					name, skip = skip, nil
+				}
+			}
 			par = p.paramDeclOrNil(name, close)
+			if par != nil && skip != nil {
+				par.pos = skip.pos
+				par.Skip = true
+			}
+			if par != nil && close == _Rparen && p.tok == _Assign && p.wo&WoDefault != 0 {
+				// [name] [type] "=" Expression
+				p.next()
+				par.Default = p.expr()
+			}
 		}
 		name = nil // 1st name was consumed if present
 		typ = nil  // 1st type was consumed if present
@@ -2032,10 +2618,10 @@ func (p *parser) paramList(name *Name, typ Expr, close token, requireNames bool)
 			if debug && par.Name == nil && par.Type == nil {
 				panic("parameter without name or type")
 			}
-			if par.Name != nil && par.Type != nil {
+			if par.Name != nil && (par.Type != nil || par.Default != nil) {
 				named++
 			}
-			if par.Type != nil {
+			if par.Type != nil || par.Default != nil {
 				typed++
 			}
 			list = append(list, par)
@@ -2068,6 +2654,9 @@ func (p *parser) paramList(name *Name, typ Expr, close token, requireNames bool)
 					errPos = StartPos(typ)
 					par.Name = NewName(errPos, "_")
 				}
+			} else if par.Default != nil {
+				// The type is inferred from the default value (Wo).
+				typ = nil
 			} else if typ != nil {
 				par.Type = typ
 			} else {
@@ -2139,6 +2728,30 @@ func (p *parser) simpleStmt(lhs Expr, keyword token) SimpleStmt {
 		lhs = p.exprList()
 	}
 
+	if keyword == _For && p.tok == _Colon && p.wo&WoRange != 0 {
+		// expr_list : expr
+		r := p.newRangeClause(lhs, true)
+		r.Colon = true
+		return r
+	}
+
+	if name, ok := lhs.(*Name); ok && p.wo&WoAssign != 0 && p.startsTypedDecl() {
+		// name Type = expr (Wo)
+		s := new(AssignStmt)
+		s.Op = Def
+		s.Lhs = name
+		s.Type = p.type_()
+		s.pos = p.pos()
+		if !p.gotAssign() {
+			p.syntaxError("expected =")
+			p.advance(_Semi, _Rbrace)
+			s.Rhs = p.badExpr()
+			return s
+		}
+		s.Rhs = p.expr()
+		return s
+	}
+
 	if _, ok := lhs.(*ListExpr); !ok && p.tok != _Assign && p.tok != _Define {
 		// expr
 		pos := p.pos()
@@ -2218,10 +2831,23 @@ func (p *parser) simpleStmt(lhs Expr, keyword token) SimpleStmt {
 	}
 }
 
+// startsTypedDecl reports whether the current token, which follows
+// the name on the left of a simple statement, starts the type of a Wo
+// typed declaration name Type = expr. Types that start with a token
+// that could continue the expression, such as [ or *, must be declared
+// with var instead.
+func (p *parser) startsTypedDecl() bool {
+	switch p.tok {
+	case _Name, _Map, _Chan, _Func, _Struct, _Interface:
+		return true
+	}
+	return false
+}
+
 func (p *parser) newRangeClause(lhs Expr, def bool) *RangeClause {
 	r := new(RangeClause)
 	r.pos = p.pos()
-	p.next() // consume _Range
+	p.next() // consume _Range (or _Colon)
 	r.Lhs = lhs
 	r.Def = def
 	r.X = p.expr()
@@ -2339,6 +2965,12 @@ func (p *parser) header(keyword token) (init SimpleStmt, cond Expr, post SimpleS
 	p.xnest = -1
 
 	if p.tok != _Semi {
+		if p.tok == _Var && keyword != _Switch && p.wo&WoIfVar != 0 {
+			// The binding is the entire header.
+			init = p.varClause()
+			p.xnest = outer
+			return
+		}
 		// accept potential varDecl but complain
 		if p.got(_Var) {
 			p.syntaxError(fmt.Sprintf("var declaration not allowed in %s initializer", keyword.String()))
@@ -2437,6 +3069,26 @@ func emphasize(x Expr) string {
 	return s
 }
 
+// VarClause = "var" IdentifierList [ Type ] "=" Expression .
+func (p *parser) varClause() *VarClause {
+	if trace {
+		defer p.trace("varClause")()
+	}
+
+	c := new(VarClause)
+	c.pos = p.pos()
+
+	p.want(_Var)
+	c.NameList = p.nameList(p.name())
+	if !p.gotAssign() {
+		c.Type = p.type_()
+		p.want(_Assign)
+	}
+	c.Value = p.expr()
+
+	return c
+}
+
 func (p *parser) ifStmt() *IfStmt {
 	if trace {
 		defer p.trace("ifStmt")()
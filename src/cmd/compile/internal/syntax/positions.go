@@ -39,6 +39,7 @@ func StartPos(n Node) Pos {
 		case *KeyValueExpr:
 			m = n.Key
 		// case *FuncLit:
+		// case *LambdaExpr:
 		// case *ParenExpr:
 		case *SelectorExpr:
 			m = n.X
@@ -54,7 +55,7 @@ func StartPos(n Node) Pos {
 			}
 			m = n.X
 		case *Operation:
-			if n.Y != nil {
+			if n.Y != nil || n.Op == Question || n.Op == Errable {
 				m = n.X
 				continue
 			}
@@ -72,6 +73,8 @@ func StartPos(n Node) Pos {
 		// case *SliceType:
 		// case *DotsType:
 		// case *StructType:
+		// case *EnumType:
+		// case *Variant:
 		// case *Field:
 		// case *InterfaceType:
 		// case *FuncType:
@@ -103,6 +106,7 @@ func StartPos(n Node) Pos {
 				continue
 			}
 			m = n.X
+		// case *VarClause:
 		// case *CaseClause:
 		// case *CommClause:
 
@@ -186,6 +190,8 @@ func EndPos(n Node) Pos {
 			m = n.Value
 		case *FuncLit:
 			m = n.Body
+		case *LambdaExpr:
+			m = n.Body
 		case *ParenExpr:
 			m = n.X
 		case *SelectorExpr:
@@ -209,7 +215,13 @@ func EndPos(n Node) Pos {
 				m = n.Y
 				continue
 			}
+			if n.Op == Question || n.Op == Errable {
+				p := n.Pos()
+				return MakePos(p.Base(), p.Line(), p.Col()+1)
+			}
 			m = n.X
+		case *CondExpr:
+			m = n.Else
 		case *CallExpr:
 			if l := lastExpr(n.ArgList); l != nil {
 				m = l
@@ -237,7 +249,31 @@ func EndPos(n Node) Pos {
 			}
 			return n.Pos()
 			// TODO(gri) need to take TagList into account
+		case *EnumType:
+			if l := lastField(n.FieldList); l != nil {
+				m = l
+				continue
+			}
+			if l := len(n.VariantList); l > 0 {
+				m = n.VariantList[l-1]
+				continue
+			}
+			return n.Pos()
+		case *Variant:
+			if l := lastField(n.FieldList); l != nil {
+				m = l
+				continue
+			}
+			if l := lastExpr(n.ValueList); l != nil {
+				m = l
+				continue
+			}
+			m = n.Name
 		case *Field:
+			if n.Default != nil {
+				m = n.Default
+				continue
+			}
 			if n.Type != nil {
 				m = n.Type
 				continue
@@ -317,6 +353,8 @@ func EndPos(n Node) Pos {
 		// helper nodes
 		case *RangeClause:
 			m = n.X
+		case *VarClause:
+			m = n.Value
 		case *CaseClause:
 			if l := lastStmt(n.Body); l != nil {
 				m = l
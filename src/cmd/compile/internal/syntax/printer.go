@@ -397,6 +397,16 @@ func (p *printer) printRawNode(n Node) {
 		}
 		p.print(_Rbrace)
 
+	case *LambdaExpr:
+		if len(n.ParamList) == 1 {
+			p.print(n.ParamList[0])
+		} else {
+			p.print(_Lparen)
+			p.printNameList(n.ParamList)
+			p.print(_Rparen)
+		}
+		p.print(blank, _RArrow, blank, n.Body)
+
 	case *ParenExpr:
 		p.print(_Lparen, n.X, _Rparen)
 
@@ -438,7 +448,10 @@ func (p *printer) printRawNode(n Node) {
 		p.print(_Rparen)
 
 	case *Operation:
-		if n.Y == nil {
+		if n.Op == Question || n.Op == Errable {
+			// Wo optional or result type, unwrap, or error propagation
+			p.print(n.X, n.Op)
+		} else if n.Y == nil {
 			// unary expr
 			p.print(n.Op)
 			// if n.Op == lexical.Range {
@@ -452,6 +465,9 @@ func (p *printer) printRawNode(n Node) {
 			p.print(n.X, blank, n.Op, blank, n.Y)
 		}
 
+	case *CondExpr:
+		p.print(_If, blank, n.Cond, blank, _Name, "then", blank, n.Then, blank, _Else, blank, n.Else)
+
 	case *KeyValueExpr:
 		p.print(n.Key, _Colon, blank, n.Value)
 
@@ -488,11 +504,74 @@ func (p *printer) printRawNode(n Node) {
 		}
 		p.print(_Rbrace)
 
+	case *EnumType:
+		p.print(_Name, "enum")
+		if p.linebreaks {
+			p.print(blank)
+		}
+		p.print(_Lbrace)
+		if p.linebreaks {
+			p.print(newline, indent)
+			for i, v := range n.VariantList {
+				if i > 0 {
+					p.print(_Semi, newline)
+				}
+				p.printNode(v)
+			}
+			if len(n.FieldList) > 0 {
+				p.print(_Semi, newline)
+				p.printFieldList(n.FieldList, nil, _Semi)
+			}
+			p.print(outdent, newline)
+		} else {
+			for i, v := range n.VariantList {
+				if i > 0 {
+					p.print(_Comma, blank)
+				}
+				p.printNode(v)
+			}
+			if len(n.FieldList) > 0 {
+				p.print(_Semi, blank)
+				p.printFieldList(n.FieldList, nil, _Semi)
+			}
+		}
+		p.print(_Rbrace)
+
+	case *Variant:
+		p.printNode(n.Name)
+		if len(n.ValueList) > 0 {
+			p.print(_Lparen)
+			p.printExprList(n.ValueList)
+			p.print(_Rparen)
+		} else if len(n.FieldList) > 0 {
+			p.printParameterList(n.FieldList, 0)
+		}
+
 	case *FuncType:
+		if n.Arrow {
+			p.printArrowSignature(n)
+			break
+		}
 		p.print(_Func)
 		p.printSignature(n)
 
 	case *InterfaceType:
+		if n.Compact {
+			p.print(Lss)
+			for i, m := range n.MethodList {
+				if i > 0 {
+					p.print(_Comma, blank)
+				}
+				if m.Name != nil {
+					p.printNode(m.Name)
+					p.printSignature(m.Type.(*FuncType))
+				} else {
+					p.printNode(m.Type)
+				}
+			}
+			p.print(Gtr)
+			break
+		}
 		p.print(_Interface)
 		if p.linebreaks && len(n.MethodList) > 1 {
 			p.print(blank)
@@ -549,6 +628,9 @@ func (p *printer) printRawNode(n Node) {
 			// TODO(gri) This is going to break the mayCombine
 			//           check once we enable that again.
 			p.print(n.Op, n.Op) // ++ or --
+		} else if n.Type != nil {
+			p.print(blank, n.Type, blank, _Assign, blank)
+			p.print(n.Rhs)
 		} else {
 			p.print(blank, n.Op, _Assign, blank)
 			p.print(n.Rhs)
@@ -580,10 +662,14 @@ func (p *printer) printRawNode(n Node) {
 
 	case *IfStmt:
 		p.print(_If, blank)
-		if n.Init != nil {
-			p.print(n.Init, _Semi, blank)
+		if _, ok := n.Init.(*VarClause); ok {
+			p.print(n.Init, blank, n.Then)
+		} else {
+			if n.Init != nil {
+				p.print(n.Init, _Semi, blank)
+			}
+			p.print(n.Cond, blank, n.Then)
 		}
-		p.print(n.Cond, blank, n.Then)
 		if n.Else != nil {
 			p.print(blank, _Else, blank, n.Else)
 		}
@@ -603,6 +689,10 @@ func (p *printer) printRawNode(n Node) {
 		p.printSelectBody(n.Body)
 
 	case *RangeClause:
+		if n.Colon {
+			p.print(n.Lhs, blank, _Colon, blank, n.X)
+			break
+		}
 		if n.Lhs != nil {
 			tok := _Assign
 			if n.Def {
@@ -612,6 +702,14 @@ func (p *printer) printRawNode(n Node) {
 		}
 		p.print(_Range, blank, n.X)
 
+	case *VarClause:
+		p.print(_Var, blank)
+		p.printNameList(n.NameList)
+		if n.Type != nil {
+			p.print(blank, n.Type)
+		}
+		p.print(blank, _Assign, blank, n.Value)
+
 	case *ForStmt:
 		p.print(_For, blank)
 		if n.Init == nil && n.Post == nil {
@@ -622,7 +720,7 @@ func (p *printer) printRawNode(n Node) {
 			if n.Init != nil {
 				p.print(n.Init)
 				// TODO(gri) clean this up
-				if _, ok := n.Init.(*RangeClause); ok {
+				if isForClause(n.Init) {
 					p.print(blank, n.Body)
 					break
 				}
@@ -649,6 +747,7 @@ func (p *printer) printRawNode(n Node) {
 
 	case *ConstDecl:
 		if n.Group == nil {
+			p.printVis(n.Vis)
 			p.print(_Const, blank)
 		}
 		p.printNameList(n.NameList)
@@ -661,6 +760,7 @@ func (p *printer) printRawNode(n Node) {
 
 	case *TypeDecl:
 		if n.Group == nil {
+			p.printVis(n.Vis)
 			p.print(_Type, blank)
 		}
 		p.print(n.Name)
@@ -675,6 +775,7 @@ func (p *printer) printRawNode(n Node) {
 
 	case *VarDecl:
 		if n.Group == nil {
+			p.printVis(n.Vis)
 			p.print(_Var, blank)
 		}
 		p.printNameList(n.NameList)
@@ -686,6 +787,7 @@ func (p *printer) printRawNode(n Node) {
 		}
 
 	case *FuncDecl:
+		p.printVis(n.Vis)
 		p.print(_Func, blank)
 		if r := n.Recv; r != nil {
 			p.print(_Lparen)
@@ -705,6 +807,7 @@ func (p *printer) printRawNode(n Node) {
 		}
 
 	case *printGroup:
+		p.printVis(n.Vis)
 		p.print(n.Tok, blank, _Lparen)
 		if len(n.Decls) > 0 {
 			p.print(newline, indent)
@@ -827,9 +930,42 @@ func groupFor(d Decl) (token, *Group) {
 type printGroup struct {
 	node
 	Tok   token
+	Vis   Visibility
 	Decls []Decl
 }
 
+// declVis returns the visibility modifier of d.
+func declVis(d Decl) Visibility {
+	switch d := d.(type) {
+	case *ConstDecl:
+		return d.Vis
+	case *TypeDecl:
+		return d.Vis
+	case *VarDecl:
+		return d.Vis
+	case *FuncDecl:
+		return d.Vis
+	}
+	return DefaultVis
+}
+
+// isForClause reports whether s is a range or Wo var clause,
+// which is the entire header of a for statement.
+func isForClause(s SimpleStmt) bool {
+	switch s.(type) {
+	case *RangeClause, *VarClause:
+		return true
+	}
+	return false
+}
+
+// printVis prints the Wo visibility modifier vis, if any.
+func (p *printer) printVis(vis Visibility) {
+	if vis != DefaultVis {
+		p.print(_Name, vis.String(), blank)
+	}
+}
+
 func (p *printer) printDecl(list []Decl) {
 	tok, group := groupFor(list[0])
 
@@ -855,6 +991,7 @@ func (p *printer) printDecl(list []Decl) {
 	var pg printGroup
 	// *pg.Comments() = *group.Comments()
 	pg.Tok = tok
+	pg.Vis = declVis(list[0])
 	pg.Decls = list
 	p.printNode(&pg)
 }
@@ -885,7 +1022,7 @@ func (p *printer) printSignature(sig *FuncType) {
 	p.printParameterList(sig.ParamList, 0)
 	if list := sig.ResultList; list != nil {
 		p.print(blank)
-		if len(list) == 1 && list[0].Name == nil {
+		if len(list) == 1 && list[0].Name == nil && !isUnion(list[0].Type) {
 			p.printNode(list[0].Type)
 		} else {
 			p.printParameterList(list, 0)
@@ -893,6 +1030,35 @@ func (p *printer) printSignature(sig *FuncType) {
 	}
 }
 
+// isUnion reports whether x is a Wo union type.
+func isUnion(x Expr) bool {
+	op, _ := x.(*Operation)
+	return op != nil && op.Op == Or && op.Y != nil
+}
+
+// printArrowSignature prints the Wo arrow function type sig.
+func (p *printer) printArrowSignature(sig *FuncType) {
+	if list := sig.ParamList; len(list) == 1 && list[0].Name == nil && !isArrowType(list[0].Type) {
+		p.printNode(list[0].Type)
+	} else {
+		p.printParameterList(list, 0)
+	}
+	p.print(blank, _RArrow, blank)
+	switch list := sig.ResultList; len(list) {
+	case 0:
+		p.print(_Name, "_")
+	case 1:
+		p.printNode(list[0].Type)
+	default:
+		p.printParameterList(list, 0)
+	}
+}
+
+func isArrowType(x Expr) bool {
+	t, _ := x.(*FuncType)
+	return t != nil && t.Arrow
+}
+
 // If tok != 0 print a type parameter list: tok == _Type means
 // a type parameter list for a type, tok == _Func means a type
 // parameter list for a func.
@@ -906,17 +1072,28 @@ func (p *printer) printParameterList(list []*Field, tok token) {
 		if i > 0 {
 			p.print(_Comma, blank)
 		}
+		if f.Skip {
+			p.print(_Name, "skip", blank)
+		}
 		if f.Name != nil {
 			p.printNode(f.Name)
-			if i+1 < len(list) {
+			if i+1 < len(list) && f.Default == nil {
 				f1 := list[i+1]
 				if f1.Name != nil && f1.Type == f.Type {
 					continue // no need to print type
 				}
 			}
-			p.print(blank)
+			if f.Type != nil {
+				p.print(blank)
+			}
+		}
+		if f.Type != nil {
+			p.printNode(f.Type)
+		}
+		if f.Default != nil {
+			p.print(blank, _Assign, blank)
+			p.printNode(f.Default)
 		}
-		p.printNode(f.Type)
 	}
 	// A type parameter list [P T] where the name P and the type expression T syntactically
 	// combine to another valid (value) expression requires a trailing comma, as in [P *T,]
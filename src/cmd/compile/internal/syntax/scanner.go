@@ -25,6 +25,7 @@ import (
 const (
 	comments   uint = 1 << iota // call handler for all comments
 	directives                  // call handler for directives only
+	woTokens                    // recognize Wo-only tokens such as ? and ->
 )
 
 type scanner struct {
@@ -83,7 +84,7 @@ func (s *scanner) setLit(kind LitKind, ok bool) {
 // which can be used to distinguish these handler calls from errors.
 //
 // If the scanner mode includes the directives (but not the comments)
-// flag, only comments containing a //line, /*line, or //go: directive
+// flag, only comments containing a //line, /*line, //go:, or //wo: directive
 // are reported, in the same way as regular comments.
 func (s *scanner) next() {
 	nlsemi := s.nlsemi
@@ -208,6 +209,11 @@ redo:
 
 	case '-':
 		s.nextch()
+		if s.ch == '>' && s.mode&woTokens != 0 {
+			s.nextch()
+			s.tok = _RArrow
+			break
+		}
 		s.op, s.prec = Sub, precAdd
 		if s.ch != '-' {
 			goto assignop
@@ -342,12 +348,25 @@ redo:
 		}
 		s.op, s.prec = Not, 0
 		s.tok = _Operator
+		// In Wo files, ! may also be a postfix operator.
+		s.nlsemi = s.mode&woTokens != 0
 
 	case '~':
 		s.nextch()
 		s.op, s.prec = Tilde, 0
 		s.tok = _Operator
 
+	case '?':
+		if s.mode&woTokens == 0 {
+			// not a Go token
+			s.errorf("invalid character %#U", s.ch)
+			s.nextch()
+			goto redo
+		}
+		s.nextch()
+		s.nlsemi = true
+		s.tok = _Question
+
 	default:
 		s.errorf("invalid character %#U", s.ch)
 		s.nextch()
@@ -747,16 +766,19 @@ func (s *scanner) lineComment() {
 	}
 
 	// are we saving directives? or is this definitely not a directive?
-	if s.mode&directives == 0 || (s.ch != 'g' && s.ch != 'l') {
+	if s.mode&directives == 0 || (s.ch != 'g' && s.ch != 'l' && s.ch != 'w') {
 		s.stop()
 		s.skipLine()
 		return
 	}
 
-	// recognize go: or line directives
+	// recognize go:, wo: or line directives
 	prefix := "go:"
-	if s.ch == 'l' {
+	switch s.ch {
+	case 'l':
 		prefix = "line "
+	case 'w':
+		prefix = "wo:"
 	}
 	for _, m := range prefix {
 		if s.ch != m {
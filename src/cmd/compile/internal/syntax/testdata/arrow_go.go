@@ -0,0 +1,9 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Arrow function types and function literals are only recognized in Wo files.
+
+package p
+
+type _ int /* ERROR unexpected - after top level declaration */ -> int
@@ -0,0 +1,11 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Typed declarations without var are only recognized in Wo files.
+
+package p
+
+func _() {
+	i /* ERROR unexpected name int at end of statement */ int = 2
+}
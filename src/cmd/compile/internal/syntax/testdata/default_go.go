@@ -0,0 +1,9 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Default parameter values are only recognized in Wo files.
+
+package p
+
+func f(x int /* ERROR unexpected = in parameter list */ = 1)
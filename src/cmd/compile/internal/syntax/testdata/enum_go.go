@@ -0,0 +1,11 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Enum types are only recognized in Wo files.
+
+package p
+
+type enum int
+
+type _ enum /* ERROR unexpected { after top level declaration */ {}
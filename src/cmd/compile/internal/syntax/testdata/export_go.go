@@ -0,0 +1,9 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Visibility modifiers are only recognized in Wo files.
+
+package p
+
+/* ERROR non-declaration statement outside function body */ export func f()
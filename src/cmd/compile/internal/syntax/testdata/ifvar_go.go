@@ -0,0 +1,11 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Conditional bindings are only recognized in Wo files.
+
+package p
+
+func _() {
+	if var /* ERROR var declaration not allowed in if initializer */ x = f(); x {}
+}
@@ -0,0 +1,9 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Method type parameters are only permitted in Wo files.
+
+package p
+
+func (T) m /* ERROR method must have no type parameters */ [P any]() {}
@@ -0,0 +1,10 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Skip modifiers are only recognized in Wo files.
+
+package p
+
+func f() (skip int, err error)
+func g() (skip int, string /* ERROR missing parameter type */ )
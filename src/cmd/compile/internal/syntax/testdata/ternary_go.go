@@ -0,0 +1,9 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Conditional expressions are only recognized in Wo files.
+
+package p
+
+var _ = /* ERROR expected expression */ if a then b else c
@@ -55,7 +55,7 @@ func CommentMap(src io.Reader, rx *regexp.Regexp) (res map[uint][]Error) {
 			}
 			res[prev.line] = append(res[prev.line], err)
 		}
-	}, comments)
+	}, comments|woTokens) // recognize all tokens so positions are correct in Wo files
 
 	for s.tok != _EOF {
 		s.next()
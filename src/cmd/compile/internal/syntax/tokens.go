@@ -27,6 +27,8 @@ const (
 	_Define   // :=
 	_Arrow    // <-
 	_Star     // *
+	_Question // ?
+	_RArrow   // ->
 
 	// delimiters
 	_Lparen    // (
@@ -118,6 +120,12 @@ const (
 	Recv  // <-
 	Tilde // ~
 
+	// Question is the postfix ? of Wo optional types and unwrapping
+	Question // ?
+
+	// Errable is the postfix ! of Wo result types and error propagation
+	Errable // !
+
 	// precOrOr
 	OrOr // ||
 
@@ -128,6 +128,10 @@ func (w walker) node(n Node) {
 		w.node(n.Type)
 		w.node(n.Body)
 
+	case *LambdaExpr:
+		w.nameList(n.ParamList)
+		w.node(n.Body)
+
 	case *ParenExpr:
 		w.node(n.X)
 
@@ -163,6 +167,11 @@ func (w walker) node(n Node) {
 			w.node(n.Y)
 		}
 
+	case *CondExpr:
+		w.node(n.Cond)
+		w.node(n.Then)
+		w.node(n.Else)
+
 	case *CallExpr:
 		w.node(n.Fun)
 		w.exprList(n.ArgList)
@@ -191,11 +200,27 @@ func (w walker) node(n Node) {
 			}
 		}
 
+	case *EnumType:
+		for _, v := range n.VariantList {
+			w.node(v)
+		}
+		w.fieldList(n.FieldList)
+
+	case *Variant:
+		w.node(n.Name)
+		w.exprList(n.ValueList)
+		w.fieldList(n.FieldList)
+
 	case *Field:
 		if n.Name != nil {
 			w.node(n.Name)
 		}
-		w.node(n.Type)
+		if n.Type != nil {
+			w.node(n.Type)
+		}
+		if n.Default != nil {
+			w.node(n.Default)
+		}
 
 	case *InterfaceType:
 		w.fieldList(n.MethodList)
@@ -233,6 +258,9 @@ func (w walker) node(n Node) {
 
 	case *AssignStmt:
 		w.node(n.Lhs)
+		if n.Type != nil {
+			w.node(n.Type)
+		}
 		if n.Rhs != nil {
 			w.node(n.Rhs)
 		}
@@ -255,7 +283,9 @@ func (w walker) node(n Node) {
 		if n.Init != nil {
 			w.node(n.Init)
 		}
-		w.node(n.Cond)
+		if n.Cond != nil {
+			w.node(n.Cond)
+		}
 		w.node(n.Then)
 		if n.Else != nil {
 			w.node(n.Else)
@@ -296,6 +326,13 @@ func (w walker) node(n Node) {
 		}
 		w.node(n.X)
 
+	case *VarClause:
+		w.nameList(n.NameList)
+		if n.Type != nil {
+			w.node(n.Type)
+		}
+		w.node(n.Value)
+
 	case *CaseClause:
 		if n.Cases != nil {
 			w.node(n.Cases)
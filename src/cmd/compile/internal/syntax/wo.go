@@ -0,0 +1,248 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syntax
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Features describes a set of Wo language features.
+//
+// A file is written in the Wo dialect if its name ends in ".wo" or if
+// it starts with a //wo:dialect directive before the package clause.
+// Wo files may use all Wo features unless the directive restricts them;
+// Go files may use none. The directive has the form
+//
+//	//wo:dialect [list]
+//
+// where the optional list has the syntax accepted by ParseFeatures.
+type Features uint64
+
+// Wo language features.
+const (
+	WoTernary        Features = 1 << iota // if x then y else z expressions
+	WoRange                               // for k, v : x range clauses
+	WoSet                                 // predeclared set[T] type
+	WoOptional                            // T? optional types and postfix ?
+	WoResult                              // T! result types and postfix !
+	WoArrow                               // A -> B function types and x -> e function literals
+	WoEnum                                // enum types
+	WoInterface                           // <M()> interface literals and union value types
+	WoUnused                              // unused variables are warnings, not errors
+	WoUninit                              // variables must be assigned before they are read
+	WoExport                              // export and pkg visibility modifiers
+	WoOverload                            // function overloading
+	WoDefault                             // default parameter values
+	WoSkip                                // skip result slots
+	WoIfVar                               // if var x = f() {} conditional bindings
+	WoBuiltinMethods                      // methods on strings, slices and maps
+	WoMethodTParams                       // type parameters on methods
+	WoShadow                              // no shadowing of predeclared identifiers and imports
+	WoAssign                              // x T = v declarations, := only for shadowing, no parallel assignment
+
+	AllFeatures Features = 1<<iota - 1
+)
+
+var featureNames = [...]string{
+	"ternary",
+	"range",
+	"set",
+	"optional",
+	"result",
+	"arrow",
+	"enum",
+	"interface",
+	"unused",
+	"uninit",
+	"export",
+	"overload",
+	"default",
+	"skip",
+	"ifvar",
+	"builtinmethods",
+	"methodtparams",
+	"shadow",
+	"assign",
+}
+
+// String returns the comma-separated names of the features in f,
+// "all" if f contains all features, or "none" if f is empty.
+func (f Features) String() string {
+	if f == 0 {
+		return "none"
+	}
+	if f == AllFeatures {
+		return "all"
+	}
+	var names []string
+	for i, name := range featureNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	if f&^AllFeatures != 0 {
+		names = append(names, fmt.Sprintf("%#x", uint64(f&^AllFeatures)))
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseFeatures parses a comma-separated list of Wo feature names.
+// The names "all" and "none" stand for all and no features; a name
+// prefixed with "-" removes that feature from the set. The list is
+// applied from left to right, starting with all features if the list
+// is empty or begins with a removal, and with no features otherwise.
+func ParseFeatures(list string) (Features, error) {
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return AllFeatures, nil
+	}
+	var f Features
+	if strings.HasPrefix(list, "-") {
+		f = AllFeatures
+	}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		remove := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+		var x Features
+		switch name {
+		case "all":
+			x = AllFeatures
+		case "none":
+			f = 0
+			continue
+		default:
+			for i, n := range featureNames {
+				if n == name {
+					x = 1 << i
+					break
+				}
+			}
+			if x == 0 {
+				return 0, fmt.Errorf("unknown Wo feature %q", name)
+			}
+		}
+		if remove {
+			f &^= x
+		} else {
+			f |= x
+		}
+	}
+	return f, nil
+}
+
+// woDirective processes a //wo: directive with the given text
+// (without the leading "//") found at pos. Directives other than
+// //wo:dialect are reserved in Wo files and ignored in Go files.
+func (p *parser) woDirective(pos Pos, text string) {
+	verb, list, _ := strings.Cut(text, " ")
+	if verb != "wo:dialect" {
+		if p.wo != 0 {
+			p.errorAt(pos, fmt.Sprintf("unknown directive //%s", verb))
+		}
+		return
+	}
+	if !p.top {
+		p.errorAt(pos, "misplaced //wo:dialect directive: must appear before package clause")
+		return
+	}
+	f, err := ParseFeatures(list)
+	if err != nil {
+		p.errorAt(pos, fmt.Sprintf("invalid //wo:dialect directive: %v", err))
+		return
+	}
+	p.wo = f
+	if f != 0 {
+		p.scanner.mode |= woTokens
+	} else {
+		p.scanner.mode &^= woTokens
+	}
+}
+
+// A Visibility is the visibility modifier of a Wo package-level
+// declaration, which overrides the capitalization rule for exported
+// identifiers:
+//
+//	export func InKilos() float64
+//	func export แมว()
+//	func pkg Get() int
+type Visibility uint8
+
+const (
+	DefaultVis Visibility = iota // no modifier
+	ExportVis                    // export: visible to all importing packages
+	PkgVis                       // pkg: visible to the packages of the same module
+)
+
+func (v Visibility) String() string {
+	switch v {
+	case ExportVis:
+		return "export"
+	case PkgVis:
+		return "pkg"
+	}
+	return ""
+}
+
+// visModifier returns the visibility denoted by the modifier name,
+// or DefaultVis if name is not a modifier in the current file.
+func (p *parser) visModifier(name string) Visibility {
+	if p.wo&WoExport != 0 {
+		switch name {
+		case "export":
+			return ExportVis
+		case "pkg":
+			return PkgVis
+		}
+	}
+	return DefaultVis
+}
+
+// setVis sets the visibility of the declarations in list, which
+// were preceded by the modifier vis.
+func (p *parser) setVis(list []Decl, vis Visibility) {
+	for _, d := range list {
+		switch d := d.(type) {
+		case *ConstDecl:
+			d.Vis = vis
+		case *TypeDecl:
+			d.Vis = vis
+		case *VarDecl:
+			d.Vis = vis
+		case *FuncDecl:
+			switch {
+			case d.Recv != nil:
+				p.errorAt(d.Pos(), "methods cannot have visibility modifiers")
+			case d.Vis != DefaultVis:
+				p.errorAt(d.Name.Pos(), "multiple visibility modifiers")
+			}
+			d.Vis = vis
+		}
+	}
+}
+
+// LambdaBody returns the function body of the Wo function literal x:
+// { return x.Body } if the function type of x has results, and
+// { x.Body } otherwise. Each call returns a new body.
+func LambdaBody(x *LambdaExpr, results bool) *BlockStmt {
+	var s Stmt
+	if results {
+		r := new(ReturnStmt)
+		r.pos = x.Body.Pos()
+		r.Results = x.Body
+		s = r
+	} else {
+		e := new(ExprStmt)
+		e.pos = x.Body.Pos()
+		e.X = x.Body
+		s = e
+	}
+	b := new(BlockStmt)
+	b.pos = StartPos(x.Body)
+	b.List = []Stmt{s}
+	b.Rbrace = EndPos(x.Body)
+	return b
+}
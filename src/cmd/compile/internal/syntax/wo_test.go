@@ -0,0 +1,351 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package syntax
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseFeatures(t *testing.T) {
+	for _, test := range []struct {
+		list string
+		want Features
+		err  string
+	}{
+		{"", AllFeatures, ""},
+		{"all", AllFeatures, ""},
+		{"none", 0, ""},
+		{" all , none ", 0, ""},
+		{"ternary", WoTernary, ""},
+		{"ternary, range", WoTernary | WoRange, ""},
+		{"-ternary", AllFeatures &^ WoTernary, ""},
+		{"-ternary,-range", AllFeatures &^ (WoTernary | WoRange), ""},
+		{"none,ternary", WoTernary, ""},
+		{"all,-ternary,ternary", AllFeatures, ""},
+		{"ternary,-ternary", 0, ""},
+		{"wombat", 0, `unknown Wo feature "wombat"`},
+		{"-wombat", 0, `unknown Wo feature "wombat"`},
+		{"-ternary,wombat", 0, `unknown Wo feature "wombat"`},
+	} {
+		got, err := ParseFeatures(test.list)
+		if err != nil {
+			if test.err == "" || err.Error() != test.err {
+				t.Errorf("ParseFeatures(%q): unexpected error %v", test.list, err)
+			}
+			continue
+		}
+		if test.err != "" {
+			t.Errorf("ParseFeatures(%q): got %s, want error %s", test.list, got, test.err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseFeatures(%q) = %s, want %s", test.list, got, test.want)
+		}
+	}
+}
+
+func TestFeaturesString(t *testing.T) {
+	for _, f := range []Features{0, AllFeatures, WoTernary, WoRange, AllFeatures &^ WoRange} {
+		got, err := ParseFeatures(f.String())
+		if err != nil || got != f {
+			t.Errorf("ParseFeatures(%q) = %s, %v; want %s", f.String(), got, err, f)
+		}
+	}
+	if got, want := WoRange.String(), "range"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestWoDialect(t *testing.T) {
+	for _, test := range []struct {
+		filename, src string
+		want          Features
+	}{
+		{"x.go", "package p", 0},
+		{"x.wo", "package p", AllFeatures},
+		{"x.go", "//wo:dialect\npackage p", AllFeatures},
+		{"x.go", "//go:build linux\n\n//wo:dialect ternary,range\n\npackage p", WoTernary | WoRange},
+		{"x.wo", "//wo:dialect -range\npackage p", AllFeatures &^ WoRange},
+		{"x.wo", "//wo:dialect none\npackage p", 0},
+		{"x.go", "//wo:note\npackage p\n\n//wo:note\nvar x int", 0},
+		{"x.go", "//wo:note\n//wo:dialect range\npackage p", WoRange},
+	} {
+		f, err := Parse(NewFileBase(test.filename), strings.NewReader(test.src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %q: %v", test.filename, test.src, err)
+			continue
+		}
+		if f.WoFeatures != test.want {
+			t.Errorf("%s: %q: got features %s, want %s", test.filename, test.src, f.WoFeatures, test.want)
+		}
+	}
+}
+
+func TestWoDirectiveErrors(t *testing.T) {
+	for _, test := range []struct {
+		src, err string
+	}{
+		{"package p\n//wo:dialect\n", "2:3: misplaced //wo:dialect directive"},
+		{"//wo:dialect wombat\npackage p", `1:3: invalid //wo:dialect directive: unknown Wo feature "wombat"`},
+		{"//wo:dialect\n//wo:wombat\npackage p", "2:3: unknown directive //wo:wombat"},
+	} {
+		_, err := Parse(NewFileBase("x.go"), strings.NewReader(test.src), nil, nil, 0)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%q: got error %v, want %s", test.src, err, test.err)
+		}
+	}
+}
+
+func TestPrintCondExpr(t *testing.T) {
+	for _, test := range [][2]string{
+		dup("if a then b else c"),
+		dup("if a then b else if c then d else e"),
+		dup("x + if a then b else c"),
+		dup("(if a then b else c) + x"),
+		dup("(if a then b else c).f"),
+	} {
+		src := "package p; var _ = " + test[0]
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader(src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", test[0], err)
+			continue
+		}
+		x := f.DeclList[0].(*VarDecl).Values
+		if got := String(x); got != test[1] {
+			t.Errorf("%s: got %s, want %s", test[0], got, test[1])
+		}
+	}
+}
+
+func TestPrintRangeColon(t *testing.T) {
+	for _, src := range []string{
+		"for v : xs {}",
+		"for i, v : xs {}",
+		"for i := range xs {}",
+		"for v : f(x) {}",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; func _() { "+src+" }"), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		s := f.DeclList[0].(*FuncDecl).Body.List[0]
+		if got := String(s); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintOptional(t *testing.T) {
+	for _, src := range []string{
+		"var _ int?",
+		"var _ []*T?",
+		"var _ func(int?) (string?, error)",
+		"var _ = m[k]?",
+		"var _ = f()?.g?",
+		"var _ = int?(x)",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintResult(t *testing.T) {
+	for _, src := range []string{
+		"var _ func() int!",
+		"var _ func() (func() *T!, error)",
+		"var _ = f()!",
+		"var _ = !f()!.g!",
+		"var _ = a.b()! + c(d!)!",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintArrow(t *testing.T) {
+	for _, src := range []string{
+		"type _ int -> (() -> _, int) -> int",
+		"type _ (int, string) -> (int, error)",
+		"type _ (int -> int) -> int?",
+		"type _ func(int -> int) int",
+		"var _ = v -> v == 0",
+		"var _ = (a, b) -> a < b",
+		"var _ = () -> f()",
+		"var _ = x -> y -> x + y",
+		"var _ = f(x -> x, 1)",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintDefault(t *testing.T) {
+	for _, src := range []string{
+		"func f(s string, w io.Writer = os.Stdout)",
+		"func f(a, b int = 1, c = \"c\")",
+		"func f(x = -1, xs ...int)",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintSkip(t *testing.T) {
+	for _, src := range []string{
+		"func f() (skip int, string, skip error)",
+		"func f() (skip n int, s string)",
+		"func f() (skip a, b int, skip err error)",
+		"func f() (skip, string)",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestSkip(t *testing.T) {
+	for _, test := range []struct {
+		filename, src string
+		want          string // skip modifiers of the results, as a string of 0s and 1s
+	}{
+		{"x.wo", "func f() (skip int, string, skip error)", "101"},
+		{"x.wo", "func f() (skip n int, s string)", "10"},
+		{"x.wo", "func f() (skip a, b int)", "10"},
+		{"x.wo", "func f() (skip, string)", "00"},
+		{"x.wo", "func f(skip int)", ""},
+		{"x.go", "func f() (skip int, err error)", "00"},
+	} {
+		f, err := Parse(NewFileBase(test.filename), strings.NewReader("package p; "+test.src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", test.src, err)
+			continue
+		}
+		var got []byte
+		for _, r := range f.DeclList[0].(*FuncDecl).Type.ResultList {
+			if r.Skip {
+				got = append(got, '1')
+			} else {
+				got = append(got, '0')
+			}
+		}
+		if string(got) != test.want {
+			t.Errorf("%s: got skip modifiers %q, want %q", test.src, got, test.want)
+		}
+	}
+}
+
+func TestPrintIfVar(t *testing.T) {
+	for _, src := range []string{
+		"if var x = f() {}",
+		"if var v, w = g(x) {} else {}",
+		"if var n int64 = m[k] {}",
+		"for var line = next() {}",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; func _() { "+src+" }"), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		s := f.DeclList[0].(*FuncDecl).Body.List[0]
+		if got := String(s); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintEnum(t *testing.T) {
+	for _, src := range []string{
+		"type _ enum{}",
+		"type _ enum{A, B, C}",
+		"type _ enum{A(true), B(false); b bool}",
+		"type _ enum{A(1, \"one\"), B(2, \"two\"); n int; s, t string}",
+		"type _ enum{Closed, Open(contents string)}",
+		"type _ enum{Moved(from, to string, next *T)}",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintInterface(t *testing.T) {
+	for _, src := range []string{
+		"type _ <>",
+		"type _ <Length(<>) int>",
+		"type _ <M(<N() <>>) <>>",
+		"type _ <io.Reader, Close() error>",
+		"type _ <int | string, M()>",
+		"type _ int8 | int16",
+		"var _ []int | string",
+		"func _(x int | string) (int | error)",
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+src), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", src, err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != src {
+			t.Errorf("%s: got %s", src, got)
+		}
+	}
+}
+
+func TestPrintVisibility(t *testing.T) {
+	for _, test := range [][2]string{
+		dup("export func InKilos() float64"),
+		dup("pkg func Get() int"),
+		dup("export const c = 1"),
+		dup("pkg var v int"),
+		dup("export type T int"),
+		{"func export แมว()", "export func แมว()"},
+		{"func pkg get()", "pkg func get()"},
+		dup("func pkg()"),
+	} {
+		f, err := Parse(NewFileBase("x.wo"), strings.NewReader("package p; "+test[0]), nil, nil, 0)
+		if err != nil {
+			t.Errorf("%s: %s", test[0], err)
+			continue
+		}
+		if got := String(f.DeclList[0]); got != test[1] {
+			t.Errorf("%s: got %s, want %s", test[0], got, test[1])
+		}
+	}
+}
@@ -129,6 +129,9 @@ func panicrangestate(state int)
 // defer in range over func
 func deferrangefunc() interface{}
 
+// error of the UnmarshalText method of Wo enum types
+func enumtexterror(typ, text string) error
+
 func rand() uint64
 func rand32() uint32
 
@@ -109,129 +109,130 @@ var runtimeDecls = [...]struct {
 	{"efaceeq", funcTag, 77},
 	{"panicrangestate", funcTag, 78},
 	{"deferrangefunc", funcTag, 79},
-	{"rand", funcTag, 80},
-	{"rand32", funcTag, 81},
-	{"makemap64", funcTag, 83},
-	{"makemap", funcTag, 84},
-	{"makemap_small", funcTag, 85},
-	{"mapaccess1", funcTag, 86},
-	{"mapaccess1_fast32", funcTag, 87},
-	{"mapaccess1_fast64", funcTag, 88},
-	{"mapaccess1_faststr", funcTag, 89},
-	{"mapaccess1_fat", funcTag, 90},
-	{"mapaccess2", funcTag, 91},
-	{"mapaccess2_fast32", funcTag, 92},
-	{"mapaccess2_fast64", funcTag, 93},
-	{"mapaccess2_faststr", funcTag, 94},
-	{"mapaccess2_fat", funcTag, 95},
-	{"mapassign", funcTag, 86},
-	{"mapassign_fast32", funcTag, 87},
-	{"mapassign_fast32ptr", funcTag, 96},
-	{"mapassign_fast64", funcTag, 88},
-	{"mapassign_fast64ptr", funcTag, 96},
-	{"mapassign_faststr", funcTag, 89},
-	{"mapiterinit", funcTag, 97},
-	{"mapdelete", funcTag, 97},
-	{"mapdelete_fast32", funcTag, 98},
-	{"mapdelete_fast64", funcTag, 99},
-	{"mapdelete_faststr", funcTag, 100},
-	{"mapiternext", funcTag, 101},
-	{"mapclear", funcTag, 102},
-	{"makechan64", funcTag, 104},
-	{"makechan", funcTag, 105},
-	{"chanrecv1", funcTag, 107},
-	{"chanrecv2", funcTag, 108},
-	{"chansend1", funcTag, 110},
-	{"closechan", funcTag, 111},
-	{"chanlen", funcTag, 112},
-	{"chancap", funcTag, 112},
-	{"writeBarrier", varTag, 114},
-	{"typedmemmove", funcTag, 115},
-	{"typedmemclr", funcTag, 116},
-	{"typedslicecopy", funcTag, 117},
-	{"selectnbsend", funcTag, 118},
-	{"selectnbrecv", funcTag, 119},
-	{"selectsetpc", funcTag, 120},
-	{"selectgo", funcTag, 121},
+	{"enumtexterror", funcTag, 81},
+	{"rand", funcTag, 82},
+	{"rand32", funcTag, 83},
+	{"makemap64", funcTag, 85},
+	{"makemap", funcTag, 86},
+	{"makemap_small", funcTag, 87},
+	{"mapaccess1", funcTag, 88},
+	{"mapaccess1_fast32", funcTag, 89},
+	{"mapaccess1_fast64", funcTag, 90},
+	{"mapaccess1_faststr", funcTag, 91},
+	{"mapaccess1_fat", funcTag, 92},
+	{"mapaccess2", funcTag, 93},
+	{"mapaccess2_fast32", funcTag, 94},
+	{"mapaccess2_fast64", funcTag, 95},
+	{"mapaccess2_faststr", funcTag, 96},
+	{"mapaccess2_fat", funcTag, 97},
+	{"mapassign", funcTag, 88},
+	{"mapassign_fast32", funcTag, 89},
+	{"mapassign_fast32ptr", funcTag, 98},
+	{"mapassign_fast64", funcTag, 90},
+	{"mapassign_fast64ptr", funcTag, 98},
+	{"mapassign_faststr", funcTag, 91},
+	{"mapiterinit", funcTag, 99},
+	{"mapdelete", funcTag, 99},
+	{"mapdelete_fast32", funcTag, 100},
+	{"mapdelete_fast64", funcTag, 101},
+	{"mapdelete_faststr", funcTag, 102},
+	{"mapiternext", funcTag, 103},
+	{"mapclear", funcTag, 104},
+	{"makechan64", funcTag, 106},
+	{"makechan", funcTag, 107},
+	{"chanrecv1", funcTag, 109},
+	{"chanrecv2", funcTag, 110},
+	{"chansend1", funcTag, 112},
+	{"closechan", funcTag, 113},
+	{"chanlen", funcTag, 114},
+	{"chancap", funcTag, 114},
+	{"writeBarrier", varTag, 116},
+	{"typedmemmove", funcTag, 117},
+	{"typedmemclr", funcTag, 118},
+	{"typedslicecopy", funcTag, 119},
+	{"selectnbsend", funcTag, 120},
+	{"selectnbrecv", funcTag, 121},
+	{"selectsetpc", funcTag, 122},
+	{"selectgo", funcTag, 123},
 	{"block", funcTag, 9},
-	{"makeslice", funcTag, 122},
-	{"makeslice64", funcTag, 123},
-	{"makeslicecopy", funcTag, 124},
-	{"growslice", funcTag, 126},
-	{"unsafeslicecheckptr", funcTag, 127},
+	{"makeslice", funcTag, 124},
+	{"makeslice64", funcTag, 125},
+	{"makeslicecopy", funcTag, 126},
+	{"growslice", funcTag, 128},
+	{"unsafeslicecheckptr", funcTag, 129},
 	{"panicunsafeslicelen", funcTag, 9},
 	{"panicunsafeslicenilptr", funcTag, 9},
-	{"unsafestringcheckptr", funcTag, 128},
+	{"unsafestringcheckptr", funcTag, 130},
 	{"panicunsafestringlen", funcTag, 9},
 	{"panicunsafestringnilptr", funcTag, 9},
-	{"memmove", funcTag, 129},
-	{"memclrNoHeapPointers", funcTag, 130},
-	{"memclrHasPointers", funcTag, 130},
-	{"memequal", funcTag, 131},
-	{"memequal0", funcTag, 132},
-	{"memequal8", funcTag, 132},
-	{"memequal16", funcTag, 132},
-	{"memequal32", funcTag, 132},
-	{"memequal64", funcTag, 132},
-	{"memequal128", funcTag, 132},
-	{"f32equal", funcTag, 133},
-	{"f64equal", funcTag, 133},
-	{"c64equal", funcTag, 133},
-	{"c128equal", funcTag, 133},
-	{"strequal", funcTag, 133},
-	{"interequal", funcTag, 133},
-	{"nilinterequal", funcTag, 133},
-	{"memhash", funcTag, 134},
-	{"memhash0", funcTag, 135},
-	{"memhash8", funcTag, 135},
-	{"memhash16", funcTag, 135},
-	{"memhash32", funcTag, 135},
-	{"memhash64", funcTag, 135},
-	{"memhash128", funcTag, 135},
-	{"f32hash", funcTag, 136},
-	{"f64hash", funcTag, 136},
-	{"c64hash", funcTag, 136},
-	{"c128hash", funcTag, 136},
-	{"strhash", funcTag, 136},
-	{"interhash", funcTag, 136},
-	{"nilinterhash", funcTag, 136},
-	{"int64div", funcTag, 137},
-	{"uint64div", funcTag, 138},
-	{"int64mod", funcTag, 137},
-	{"uint64mod", funcTag, 138},
-	{"float64toint64", funcTag, 139},
-	{"float64touint64", funcTag, 140},
-	{"float64touint32", funcTag, 141},
-	{"int64tofloat64", funcTag, 142},
-	{"int64tofloat32", funcTag, 144},
-	{"uint64tofloat64", funcTag, 145},
-	{"uint64tofloat32", funcTag, 146},
-	{"uint32tofloat64", funcTag, 147},
-	{"complex128div", funcTag, 148},
+	{"memmove", funcTag, 131},
+	{"memclrNoHeapPointers", funcTag, 132},
+	{"memclrHasPointers", funcTag, 132},
+	{"memequal", funcTag, 133},
+	{"memequal0", funcTag, 134},
+	{"memequal8", funcTag, 134},
+	{"memequal16", funcTag, 134},
+	{"memequal32", funcTag, 134},
+	{"memequal64", funcTag, 134},
+	{"memequal128", funcTag, 134},
+	{"f32equal", funcTag, 135},
+	{"f64equal", funcTag, 135},
+	{"c64equal", funcTag, 135},
+	{"c128equal", funcTag, 135},
+	{"strequal", funcTag, 135},
+	{"interequal", funcTag, 135},
+	{"nilinterequal", funcTag, 135},
+	{"memhash", funcTag, 136},
+	{"memhash0", funcTag, 137},
+	{"memhash8", funcTag, 137},
+	{"memhash16", funcTag, 137},
+	{"memhash32", funcTag, 137},
+	{"memhash64", funcTag, 137},
+	{"memhash128", funcTag, 137},
+	{"f32hash", funcTag, 138},
+	{"f64hash", funcTag, 138},
+	{"c64hash", funcTag, 138},
+	{"c128hash", funcTag, 138},
+	{"strhash", funcTag, 138},
+	{"interhash", funcTag, 138},
+	{"nilinterhash", funcTag, 138},
+	{"int64div", funcTag, 139},
+	{"uint64div", funcTag, 140},
+	{"int64mod", funcTag, 139},
+	{"uint64mod", funcTag, 140},
+	{"float64toint64", funcTag, 141},
+	{"float64touint64", funcTag, 142},
+	{"float64touint32", funcTag, 143},
+	{"int64tofloat64", funcTag, 144},
+	{"int64tofloat32", funcTag, 146},
+	{"uint64tofloat64", funcTag, 147},
+	{"uint64tofloat32", funcTag, 148},
+	{"uint32tofloat64", funcTag, 149},
+	{"complex128div", funcTag, 150},
 	{"racefuncenter", funcTag, 31},
 	{"racefuncexit", funcTag, 9},
 	{"raceread", funcTag, 31},
 	{"racewrite", funcTag, 31},
-	{"racereadrange", funcTag, 149},
-	{"racewriterange", funcTag, 149},
-	{"msanread", funcTag, 149},
-	{"msanwrite", funcTag, 149},
-	{"msanmove", funcTag, 150},
-	{"asanread", funcTag, 149},
-	{"asanwrite", funcTag, 149},
-	{"checkptrAlignment", funcTag, 151},
-	{"checkptrArithmetic", funcTag, 153},
-	{"libfuzzerTraceCmp1", funcTag, 154},
-	{"libfuzzerTraceCmp2", funcTag, 155},
-	{"libfuzzerTraceCmp4", funcTag, 156},
-	{"libfuzzerTraceCmp8", funcTag, 157},
-	{"libfuzzerTraceConstCmp1", funcTag, 154},
-	{"libfuzzerTraceConstCmp2", funcTag, 155},
-	{"libfuzzerTraceConstCmp4", funcTag, 156},
-	{"libfuzzerTraceConstCmp8", funcTag, 157},
-	{"libfuzzerHookStrCmp", funcTag, 158},
-	{"libfuzzerHookEqualFold", funcTag, 158},
-	{"addCovMeta", funcTag, 160},
+	{"racereadrange", funcTag, 151},
+	{"racewriterange", funcTag, 151},
+	{"msanread", funcTag, 151},
+	{"msanwrite", funcTag, 151},
+	{"msanmove", funcTag, 152},
+	{"asanread", funcTag, 151},
+	{"asanwrite", funcTag, 151},
+	{"checkptrAlignment", funcTag, 153},
+	{"checkptrArithmetic", funcTag, 155},
+	{"libfuzzerTraceCmp1", funcTag, 156},
+	{"libfuzzerTraceCmp2", funcTag, 157},
+	{"libfuzzerTraceCmp4", funcTag, 158},
+	{"libfuzzerTraceCmp8", funcTag, 159},
+	{"libfuzzerTraceConstCmp1", funcTag, 156},
+	{"libfuzzerTraceConstCmp2", funcTag, 157},
+	{"libfuzzerTraceConstCmp4", funcTag, 158},
+	{"libfuzzerTraceConstCmp8", funcTag, 159},
+	{"libfuzzerHookStrCmp", funcTag, 160},
+	{"libfuzzerHookEqualFold", funcTag, 160},
+	{"addCovMeta", funcTag, 162},
 	{"x86HasPOPCNT", varTag, 6},
 	{"x86HasSSE41", varTag, 6},
 	{"x86HasFMA", varTag, 6},
@@ -240,11 +241,11 @@ var runtimeDecls = [...]struct {
 	{"loong64HasLAMCAS", varTag, 6},
 	{"loong64HasLAM_BH", varTag, 6},
 	{"loong64HasLSX", varTag, 6},
-	{"asanregisterglobals", funcTag, 130},
+	{"asanregisterglobals", funcTag, 132},
 }
 
 func runtimeTypes() []*types.Type {
-	var typs [161]*types.Type
+	var typs [163]*types.Type
 	typs[0] = types.ByteType
 	typs[1] = types.NewPtr(typs[0])
 	typs[2] = types.Types[types.TANY]
@@ -325,87 +326,89 @@ func runtimeTypes() []*types.Type {
 	typs[77] = newSig(params(typs[76], typs[7], typs[7]), params(typs[6]))
 	typs[78] = newSig(params(typs[15]), nil)
 	typs[79] = newSig(nil, params(typs[10]))
-	typs[80] = newSig(nil, params(typs[24]))
-	typs[81] = newSig(nil, params(typs[65]))
-	typs[82] = types.NewMap(typs[2], typs[2])
-	typs[83] = newSig(params(typs[1], typs[22], typs[3]), params(typs[82]))
-	typs[84] = newSig(params(typs[1], typs[15], typs[3]), params(typs[82]))
-	typs[85] = newSig(nil, params(typs[82]))
-	typs[86] = newSig(params(typs[1], typs[82], typs[3]), params(typs[3]))
-	typs[87] = newSig(params(typs[1], typs[82], typs[65]), params(typs[3]))
-	typs[88] = newSig(params(typs[1], typs[82], typs[24]), params(typs[3]))
-	typs[89] = newSig(params(typs[1], typs[82], typs[28]), params(typs[3]))
-	typs[90] = newSig(params(typs[1], typs[82], typs[3], typs[1]), params(typs[3]))
-	typs[91] = newSig(params(typs[1], typs[82], typs[3]), params(typs[3], typs[6]))
-	typs[92] = newSig(params(typs[1], typs[82], typs[65]), params(typs[3], typs[6]))
-	typs[93] = newSig(params(typs[1], typs[82], typs[24]), params(typs[3], typs[6]))
-	typs[94] = newSig(params(typs[1], typs[82], typs[28]), params(typs[3], typs[6]))
-	typs[95] = newSig(params(typs[1], typs[82], typs[3], typs[1]), params(typs[3], typs[6]))
-	typs[96] = newSig(params(typs[1], typs[82], typs[7]), params(typs[3]))
-	typs[97] = newSig(params(typs[1], typs[82], typs[3]), nil)
-	typs[98] = newSig(params(typs[1], typs[82], typs[65]), nil)
-	typs[99] = newSig(params(typs[1], typs[82], typs[24]), nil)
-	typs[100] = newSig(params(typs[1], typs[82], typs[28]), nil)
-	typs[101] = newSig(params(typs[3]), nil)
-	typs[102] = newSig(params(typs[1], typs[82]), nil)
-	typs[103] = types.NewChan(typs[2], types.Cboth)
-	typs[104] = newSig(params(typs[1], typs[22]), params(typs[103]))
-	typs[105] = newSig(params(typs[1], typs[15]), params(typs[103]))
-	typs[106] = types.NewChan(typs[2], types.Crecv)
-	typs[107] = newSig(params(typs[106], typs[3]), nil)
-	typs[108] = newSig(params(typs[106], typs[3]), params(typs[6]))
-	typs[109] = types.NewChan(typs[2], types.Csend)
-	typs[110] = newSig(params(typs[109], typs[3]), nil)
-	typs[111] = newSig(params(typs[109]), nil)
-	typs[112] = newSig(params(typs[2]), params(typs[15]))
-	typs[113] = types.NewArray(typs[0], 3)
-	typs[114] = types.NewStruct([]*types.Field{types.NewField(src.NoXPos, Lookup("enabled"), typs[6]), types.NewField(src.NoXPos, Lookup("pad"), typs[113]), types.NewField(src.NoXPos, Lookup("cgo"), typs[6]), types.NewField(src.NoXPos, Lookup("alignme"), typs[24])})
-	typs[115] = newSig(params(typs[1], typs[3], typs[3]), nil)
-	typs[116] = newSig(params(typs[1], typs[3]), nil)
-	typs[117] = newSig(params(typs[1], typs[3], typs[15], typs[3], typs[15]), params(typs[15]))
-	typs[118] = newSig(params(typs[109], typs[3]), params(typs[6]))
-	typs[119] = newSig(params(typs[3], typs[106]), params(typs[6], typs[6]))
-	typs[120] = newSig(params(typs[76]), nil)
-	typs[121] = newSig(params(typs[1], typs[1], typs[76], typs[15], typs[15], typs[6]), params(typs[15], typs[6]))
-	typs[122] = newSig(params(typs[1], typs[15], typs[15]), params(typs[7]))
-	typs[123] = newSig(params(typs[1], typs[22], typs[22]), params(typs[7]))
-	typs[124] = newSig(params(typs[1], typs[15], typs[15], typs[7]), params(typs[7]))
-	typs[125] = types.NewSlice(typs[2])
-	typs[126] = newSig(params(typs[3], typs[15], typs[15], typs[15], typs[1]), params(typs[125]))
-	typs[127] = newSig(params(typs[1], typs[7], typs[22]), nil)
-	typs[128] = newSig(params(typs[7], typs[22]), nil)
-	typs[129] = newSig(params(typs[3], typs[3], typs[5]), nil)
-	typs[130] = newSig(params(typs[7], typs[5]), nil)
-	typs[131] = newSig(params(typs[3], typs[3], typs[5]), params(typs[6]))
-	typs[132] = newSig(params(typs[3], typs[3]), params(typs[6]))
-	typs[133] = newSig(params(typs[7], typs[7]), params(typs[6]))
-	typs[134] = newSig(params(typs[3], typs[5], typs[5]), params(typs[5]))
-	typs[135] = newSig(params(typs[7], typs[5]), params(typs[5]))
-	typs[136] = newSig(params(typs[3], typs[5]), params(typs[5]))
-	typs[137] = newSig(params(typs[22], typs[22]), params(typs[22]))
-	typs[138] = newSig(params(typs[24], typs[24]), params(typs[24]))
-	typs[139] = newSig(params(typs[20]), params(typs[22]))
-	typs[140] = newSig(params(typs[20]), params(typs[24]))
-	typs[141] = newSig(params(typs[20]), params(typs[65]))
-	typs[142] = newSig(params(typs[22]), params(typs[20]))
-	typs[143] = types.Types[types.TFLOAT32]
-	typs[144] = newSig(params(typs[22]), params(typs[143]))
-	typs[145] = newSig(params(typs[24]), params(typs[20]))
-	typs[146] = newSig(params(typs[24]), params(typs[143]))
-	typs[147] = newSig(params(typs[65]), params(typs[20]))
-	typs[148] = newSig(params(typs[26], typs[26]), params(typs[26]))
-	typs[149] = newSig(params(typs[5], typs[5]), nil)
-	typs[150] = newSig(params(typs[5], typs[5], typs[5]), nil)
-	typs[151] = newSig(params(typs[7], typs[1], typs[5]), nil)
-	typs[152] = types.NewSlice(typs[7])
-	typs[153] = newSig(params(typs[7], typs[152]), nil)
-	typs[154] = newSig(params(typs[69], typs[69], typs[17]), nil)
-	typs[155] = newSig(params(typs[63], typs[63], typs[17]), nil)
-	typs[156] = newSig(params(typs[65], typs[65], typs[17]), nil)
-	typs[157] = newSig(params(typs[24], typs[24], typs[17]), nil)
-	typs[158] = newSig(params(typs[28], typs[28], typs[17]), nil)
-	typs[159] = types.NewArray(typs[0], 16)
-	typs[160] = newSig(params(typs[7], typs[65], typs[159], typs[28], typs[15], typs[69], typs[69]), params(typs[65]))
+	typs[80] = types.ErrorType
+	typs[81] = newSig(params(typs[28], typs[28]), params(typs[80]))
+	typs[82] = newSig(nil, params(typs[24]))
+	typs[83] = newSig(nil, params(typs[65]))
+	typs[84] = types.NewMap(typs[2], typs[2])
+	typs[85] = newSig(params(typs[1], typs[22], typs[3]), params(typs[84]))
+	typs[86] = newSig(params(typs[1], typs[15], typs[3]), params(typs[84]))
+	typs[87] = newSig(nil, params(typs[84]))
+	typs[88] = newSig(params(typs[1], typs[84], typs[3]), params(typs[3]))
+	typs[89] = newSig(params(typs[1], typs[84], typs[65]), params(typs[3]))
+	typs[90] = newSig(params(typs[1], typs[84], typs[24]), params(typs[3]))
+	typs[91] = newSig(params(typs[1], typs[84], typs[28]), params(typs[3]))
+	typs[92] = newSig(params(typs[1], typs[84], typs[3], typs[1]), params(typs[3]))
+	typs[93] = newSig(params(typs[1], typs[84], typs[3]), params(typs[3], typs[6]))
+	typs[94] = newSig(params(typs[1], typs[84], typs[65]), params(typs[3], typs[6]))
+	typs[95] = newSig(params(typs[1], typs[84], typs[24]), params(typs[3], typs[6]))
+	typs[96] = newSig(params(typs[1], typs[84], typs[28]), params(typs[3], typs[6]))
+	typs[97] = newSig(params(typs[1], typs[84], typs[3], typs[1]), params(typs[3], typs[6]))
+	typs[98] = newSig(params(typs[1], typs[84], typs[7]), params(typs[3]))
+	typs[99] = newSig(params(typs[1], typs[84], typs[3]), nil)
+	typs[100] = newSig(params(typs[1], typs[84], typs[65]), nil)
+	typs[101] = newSig(params(typs[1], typs[84], typs[24]), nil)
+	typs[102] = newSig(params(typs[1], typs[84], typs[28]), nil)
+	typs[103] = newSig(params(typs[3]), nil)
+	typs[104] = newSig(params(typs[1], typs[84]), nil)
+	typs[105] = types.NewChan(typs[2], types.Cboth)
+	typs[106] = newSig(params(typs[1], typs[22]), params(typs[105]))
+	typs[107] = newSig(params(typs[1], typs[15]), params(typs[105]))
+	typs[108] = types.NewChan(typs[2], types.Crecv)
+	typs[109] = newSig(params(typs[108], typs[3]), nil)
+	typs[110] = newSig(params(typs[108], typs[3]), params(typs[6]))
+	typs[111] = types.NewChan(typs[2], types.Csend)
+	typs[112] = newSig(params(typs[111], typs[3]), nil)
+	typs[113] = newSig(params(typs[111]), nil)
+	typs[114] = newSig(params(typs[2]), params(typs[15]))
+	typs[115] = types.NewArray(typs[0], 3)
+	typs[116] = types.NewStruct([]*types.Field{types.NewField(src.NoXPos, Lookup("enabled"), typs[6]), types.NewField(src.NoXPos, Lookup("pad"), typs[115]), types.NewField(src.NoXPos, Lookup("cgo"), typs[6]), types.NewField(src.NoXPos, Lookup("alignme"), typs[24])})
+	typs[117] = newSig(params(typs[1], typs[3], typs[3]), nil)
+	typs[118] = newSig(params(typs[1], typs[3]), nil)
+	typs[119] = newSig(params(typs[1], typs[3], typs[15], typs[3], typs[15]), params(typs[15]))
+	typs[120] = newSig(params(typs[111], typs[3]), params(typs[6]))
+	typs[121] = newSig(params(typs[3], typs[108]), params(typs[6], typs[6]))
+	typs[122] = newSig(params(typs[76]), nil)
+	typs[123] = newSig(params(typs[1], typs[1], typs[76], typs[15], typs[15], typs[6]), params(typs[15], typs[6]))
+	typs[124] = newSig(params(typs[1], typs[15], typs[15]), params(typs[7]))
+	typs[125] = newSig(params(typs[1], typs[22], typs[22]), params(typs[7]))
+	typs[126] = newSig(params(typs[1], typs[15], typs[15], typs[7]), params(typs[7]))
+	typs[127] = types.NewSlice(typs[2])
+	typs[128] = newSig(params(typs[3], typs[15], typs[15], typs[15], typs[1]), params(typs[127]))
+	typs[129] = newSig(params(typs[1], typs[7], typs[22]), nil)
+	typs[130] = newSig(params(typs[7], typs[22]), nil)
+	typs[131] = newSig(params(typs[3], typs[3], typs[5]), nil)
+	typs[132] = newSig(params(typs[7], typs[5]), nil)
+	typs[133] = newSig(params(typs[3], typs[3], typs[5]), params(typs[6]))
+	typs[134] = newSig(params(typs[3], typs[3]), params(typs[6]))
+	typs[135] = newSig(params(typs[7], typs[7]), params(typs[6]))
+	typs[136] = newSig(params(typs[3], typs[5], typs[5]), params(typs[5]))
+	typs[137] = newSig(params(typs[7], typs[5]), params(typs[5]))
+	typs[138] = newSig(params(typs[3], typs[5]), params(typs[5]))
+	typs[139] = newSig(params(typs[22], typs[22]), params(typs[22]))
+	typs[140] = newSig(params(typs[24], typs[24]), params(typs[24]))
+	typs[141] = newSig(params(typs[20]), params(typs[22]))
+	typs[142] = newSig(params(typs[20]), params(typs[24]))
+	typs[143] = newSig(params(typs[20]), params(typs[65]))
+	typs[144] = newSig(params(typs[22]), params(typs[20]))
+	typs[145] = types.Types[types.TFLOAT32]
+	typs[146] = newSig(params(typs[22]), params(typs[145]))
+	typs[147] = newSig(params(typs[24]), params(typs[20]))
+	typs[148] = newSig(params(typs[24]), params(typs[145]))
+	typs[149] = newSig(params(typs[65]), params(typs[20]))
+	typs[150] = newSig(params(typs[26], typs[26]), params(typs[26]))
+	typs[151] = newSig(params(typs[5], typs[5]), nil)
+	typs[152] = newSig(params(typs[5], typs[5], typs[5]), nil)
+	typs[153] = newSig(params(typs[7], typs[1], typs[5]), nil)
+	typs[154] = types.NewSlice(typs[7])
+	typs[155] = newSig(params(typs[7], typs[154]), nil)
+	typs[156] = newSig(params(typs[69], typs[69], typs[17]), nil)
+	typs[157] = newSig(params(typs[63], typs[63], typs[17]), nil)
+	typs[158] = newSig(params(typs[65], typs[65], typs[17]), nil)
+	typs[159] = newSig(params(typs[24], typs[24], typs[17]), nil)
+	typs[160] = newSig(params(typs[28], typs[28], typs[17]), nil)
+	typs[161] = types.NewArray(typs[0], 16)
+	typs[162] = newSig(params(typs[7], typs[65], typs[161], typs[28], typs[15], typs[69], typs[69]), params(typs[65]))
 	return typs[:]
 }
 
@@ -682,9 +682,12 @@ func tcSwitchType(n *ir.SwitchStmt) {
 		}
 
 		if ncase.Var != nil {
-			// Assign the clause variable's type.
+			// Assign the clause variable's type, unless it was
+			// already set, as for cases with Wo union types.
 			vt := t
-			if len(ls) == 1 {
+			if ncase.Var.Type() != nil {
+				vt = ncase.Var.Type()
+			} else if len(ls) == 1 {
 				if ls[0].Op() == ir.OTYPE || ls[0].Op() == ir.ODYNAMICTYPE {
 					vt = ls[0].Type()
 				} else if !ir.IsNil(ls[0]) {
@@ -49,6 +49,7 @@ const (
 	symSiggen // type symbol has been generated
 	symAsm    // on asmlist, for writing to -asmhdr
 	symFunc   // function symbol
+	symExport // package-level object declared with a Wo export modifier
 )
 
 func (sym *Sym) OnExportList() bool { return sym.flags&symOnExportList != 0 }
@@ -56,12 +57,14 @@ func (sym *Sym) Uniq() bool         { return sym.flags&symUniq != 0 }
 func (sym *Sym) Siggen() bool       { return sym.flags&symSiggen != 0 }
 func (sym *Sym) Asm() bool          { return sym.flags&symAsm != 0 }
 func (sym *Sym) Func() bool         { return sym.flags&symFunc != 0 }
+func (sym *Sym) Export() bool       { return sym.flags&symExport != 0 }
 
 func (sym *Sym) SetOnExportList(b bool) { sym.flags.set(symOnExportList, b) }
 func (sym *Sym) SetUniq(b bool)         { sym.flags.set(symUniq, b) }
 func (sym *Sym) SetSiggen(b bool)       { sym.flags.set(symSiggen, b) }
 func (sym *Sym) SetAsm(b bool)          { sym.flags.set(symAsm, b) }
 func (sym *Sym) SetFunc(b bool)         { sym.flags.set(symFunc, b) }
+func (sym *Sym) SetExport(b bool)       { sym.flags.set(symExport, b) }
 
 func (sym *Sym) IsBlank() bool {
 	return sym != nil && sym.Name == "_"
@@ -34,13 +34,15 @@ import (
 // An Error describes a type-checking error; it implements the error interface.
 // A "soft" error is an error that still permits a valid interpretation of a
 // package (such as "unused variable"); "hard" errors may lead to unpredictable
-// behavior if ignored.
+// behavior if ignored. A warning is a soft error that doesn't make
+// type-checking fail; warnings are only reported for Wo files.
 type Error struct {
-	Pos  syntax.Pos // error position
-	Msg  string     // default error message, user-friendly
-	Full string     // full error message, for debugging (may contain internal details)
-	Soft bool       // if set, error is "soft"
-	Code Code       // error code
+	Pos      syntax.Pos // error position
+	Msg      string     // default error message, user-friendly
+	Full     string     // full error message, for debugging (may contain internal details)
+	Soft     bool       // if set, error is "soft"
+	Code     Code       // error code
+	Severity Severity   // SeverityError, or SeverityWarning for warnings (which are also soft)
 }
 
 // Error returns an error string formatted as follows:
@@ -56,6 +58,31 @@ func (err Error) FullError() string {
 	return fmt.Sprintf("%s: %s", err.Pos, err.Full)
 }
 
+// A Severity describes how a diagnostic reported during type-checking
+// affects the outcome of type-checking.
+type Severity int
+
+const (
+	// SeverityError diagnostics are errors: the package is invalid.
+	SeverityError Severity = iota
+
+	// SeverityWarning diagnostics are warnings: they point out likely
+	// mistakes, but the package is still valid. The type checker only
+	// reports warnings in Wo files, for diagnostics that are errors in
+	// Go files, such as unused variables.
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
 // An ArgumentError holds an error associated with an argument index.
 type ArgumentError struct {
 	Index int
@@ -117,6 +144,11 @@ type Config struct {
 	// result in an error.
 	GoVersion string
 
+	// DisabledWoFeatures is the set of Wo language features that may not
+	// be used, even by files written in the Wo dialect. Which features a
+	// file may use is recorded in its syntax.File.WoFeatures field.
+	DisabledWoFeatures syntax.Features
+
 	// If IgnoreFuncBodies is set, function bodies are not
 	// type-checked.
 	IgnoreFuncBodies bool
@@ -314,6 +346,14 @@ type Info struct {
 	// Version strings begin with “go”, like “go1.21”, and
 	// are suitable for use with the [go/version] package.
 	FileVersions map[*syntax.PosBase]string
+
+	// DefaultArgs maps calls that omit trailing arguments of a Wo
+	// function with default parameter values to the identifiers
+	// denoting the default values of the omitted arguments, in
+	// parameter order. The identifiers are not part of the syntax
+	// tree, but their types and the objects they denote are recorded
+	// like those of the call's other arguments.
+	DefaultArgs map[*syntax.CallExpr][]syntax.Expr
 }
 
 func (info *Info) recordTypes() bool {
@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements typechecking of Wo conditional bindings.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	. "internal/types/errors"
+)
+
+// A conditional binding
+//
+//	if var x1, ..., xn [T] = v { ... }
+//	for var x1, ..., xn [T] = v { ... }
+//
+// binds the variables x1, ..., xn to the values of v and executes
+// the block only if v provides them. The value v must be one of:
+//
+//   - an optional value, which provides its element if present (n == 1);
+//   - a comma-ok expression, which provides its value if ok (n == 1);
+//   - a call with n+1 results whose last result is a bool, which
+//     provides the other results if the bool is true;
+//   - a call with n+1 results whose last result is an error, which
+//     provides the other results if the error is nil.
+//
+// The variables are only in scope in the block. A for statement
+// evaluates v before each iteration and terminates when v does not
+// provide the values.
+
+// binding typechecks the value of the conditional binding c and
+// returns the variables it binds, which are not declared yet.
+// If there is an error, the variables have invalid type.
+func (check *Checker) binding(c *syntax.VarClause) []*Var {
+	vars := make([]*Var, len(c.NameList))
+	for i, name := range c.NameList {
+		vars[i] = NewVar(name.Pos(), check.pkg, name.Value, nil)
+	}
+	defer func() {
+		for _, v := range vars {
+			if v.typ == nil {
+				v.typ = Typ[Invalid]
+			}
+		}
+	}()
+
+	var T Type
+	if c.Type != nil {
+		T = check.varType(c.Type)
+	}
+
+	e := c.Value
+	var x operand
+	check.rawExpr(nil, &x, e, nil, false)
+	check.exclude(&x, 1<<novalue|1<<builtin|1<<typexpr)
+	if x.mode == invalid || !check.verifyWof(c, syntax.WoIfVar, "conditional binding") {
+		return vars
+	}
+
+	var types []Type // types of the bound values
+	if t, _ := x.typ.(*Tuple); t != nil {
+		last := t.vars[t.Len()-1].typ
+		if isBoolean(last) || Identical(last, universeError) {
+			for _, v := range t.vars[:t.Len()-1] {
+				types = append(types, v.typ)
+			}
+		}
+	} else if x.mode == mapindex || x.mode == commaok {
+		types = []Type{x.typ}
+		check.recordCommaOkTypes(e, []*operand{&x, {mode: value, expr: e, typ: Typ[Bool]}})
+	} else if u, _ := under(x.typ).(*Optional); u != nil {
+		types = []Type{u.elem}
+	}
+	if types == nil {
+		check.errorf(&x, InvalidBinding, "cannot bind %s: not an optional value, comma-ok expression, or values followed by a bool or error", &x)
+		return vars
+	}
+	if len(types) != len(vars) {
+		check.errorf(c.NameList[0], WrongAssignCount, "assignment mismatch: %s but %s binds %s", measure(len(vars), "variable"), e, measure(len(types), "value"))
+		return vars
+	}
+
+	for i, v := range vars {
+		if T == nil {
+			v.typ = types[i]
+			continue
+		}
+		y := operand{mode: value, expr: e, typ: types[i]}
+		check.assignment(&y, T, "conditional binding")
+		if y.mode != invalid {
+			v.typ = T
+		}
+	}
+	return vars
+}
+
+// declareBinding declares the variables vars bound by the conditional
+// binding c in the current scope, starting at pos.
+func (check *Checker) declareBinding(c *syntax.VarClause, vars []*Var, pos syntax.Pos) {
+	for i, name := range c.NameList {
+		check.declare(check.scope, name, vars[i], pos)
+	}
+}
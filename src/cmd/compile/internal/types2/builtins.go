@@ -30,6 +30,17 @@ func (check *Checker) builtin(x *operand, call *syntax.CallExpr, id builtinId) (
 		return
 	}
 
+	// For s.add(x) and s.delete(x), the set s is only available via the selector.
+	// The same applies to o.IsPresent() and o.OrElse(x) for the optional o.
+	var set *Set
+	if id == _SetAdd || id == _SetDelete {
+		set = under(x.typ).(*Set)
+	}
+	var opt *Optional
+	if id == _OptionalIsPresent || id == _OptionalOrElse {
+		opt = under(x.typ).(*Optional)
+	}
+
 	// For len(x) and cap(x) we need to know if x contains any function calls or
 	// receive operations. Save/restore current setting and set hasCallOrRecv to
 	// false for the evaluation of x so that we can check it afterwards.
@@ -175,7 +186,7 @@ func (check *Checker) builtin(x *operand, call *syntax.CallExpr, id builtinId) (
 		case *Slice, *Chan:
 			mode = value
 
-		case *Map:
+		case *Map, *Set:
 			if id == _Len {
 				mode = value
 			}
@@ -230,7 +241,7 @@ func (check *Checker) builtin(x *operand, call *syntax.CallExpr, id builtinId) (
 
 		if !underIs(x.typ, func(u Type) bool {
 			switch u.(type) {
-			case *Map, *Slice:
+			case *Map, *Set, *Slice:
 				return true
 			}
 			check.errorf(x, InvalidClear, invalidArg+"cannot clear %s: argument must be (or constrained by) map or slice", x)
@@ -415,6 +426,40 @@ func (check *Checker) builtin(x *operand, call *syntax.CallExpr, id builtinId) (
 			check.recordBuiltinType(call.Fun, makeSig(nil, map_, key))
 		}
 
+	case _SetAdd, _SetDelete:
+		// s.add(x)
+		// s.delete(x)
+		check.assignment(x, set.elem, "argument to "+bin.name)
+		if x.mode == invalid {
+			return
+		}
+
+		x.mode = novalue
+		if check.recordTypes() {
+			check.recordBuiltinType(call.Fun, makeSig(nil, set.elem))
+		}
+
+	case _OptionalIsPresent:
+		// o.IsPresent()
+		x.mode = value
+		x.typ = Typ[Bool]
+		if check.recordTypes() {
+			check.recordBuiltinType(call.Fun, makeSig(x.typ))
+		}
+
+	case _OptionalOrElse:
+		// o.OrElse(x)
+		check.assignment(x, opt.elem, "argument to "+bin.name)
+		if x.mode == invalid {
+			return
+		}
+
+		x.mode = value
+		x.typ = opt.elem
+		if check.recordTypes() {
+			check.recordBuiltinType(call.Fun, makeSig(x.typ, x.typ))
+		}
+
 	case _Imag, _Real:
 		// imag(complexT) floatT
 		// real(complexT) floatT
@@ -497,7 +542,7 @@ func (check *Checker) builtin(x *operand, call *syntax.CallExpr, id builtinId) (
 		switch coreType(T).(type) {
 		case *Slice:
 			min = 2
-		case *Map, *Chan:
+		case *Map, *Set, *Chan:
 			min = 1
 		case nil:
 			check.errorf(arg0, InvalidMake, invalidArg+"cannot make %s: no core type", arg0)
@@ -612,9 +657,9 @@ func (check *Checker) builtin(x *operand, call *syntax.CallExpr, id builtinId) (
 	case _Panic:
 		// panic(x)
 		// record panic call if inside a function with result parameters
-		// (for use in Checker.isTerminating)
-		if check.sig != nil && check.sig.results.Len() > 0 {
-			// function has result parameters
+		// (for use in Checker.isTerminating) or in a Wo file (for use in
+		// Checker.uninit)
+		if check.sig != nil && (check.sig.results.Len() > 0 || check.allowUninit(call)) {
 			p := check.isPanic
 			if p == nil {
 				// allocate lazily
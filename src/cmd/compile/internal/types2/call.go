@@ -9,6 +9,7 @@ package types2
 import (
 	"cmd/compile/internal/syntax"
 	. "internal/types/errors"
+	"slices"
 	"strings"
 )
 
@@ -167,6 +168,13 @@ func (check *Checker) instantiateSignature(pos syntax.Pos, expr syntax.Expr, typ
 }
 
 func (check *Checker) callExpr(x *operand, call *syntax.CallExpr) exprKind {
+	// Wo generic methods can only be called; see Checker.selector.
+	defer func(callee syntax.Expr) { check.callee = callee }(check.callee)
+	check.callee = call.Fun
+	if iexpr, _ := call.Fun.(*syntax.IndexExpr); iexpr != nil {
+		check.callee = iexpr.X
+	}
+
 	var inst *syntax.IndexExpr // function instantiation, if any
 	if iexpr, _ := call.Fun.(*syntax.IndexExpr); iexpr != nil {
 		if check.indexExpr(x, iexpr) {
@@ -178,11 +186,34 @@ func (check *Checker) callExpr(x *operand, call *syntax.CallExpr) exprKind {
 		}
 		x.expr = iexpr
 		check.record(x)
+	} else if v := check.variant(call.Fun); v != nil {
+		// construction of a Wo enum value
+		return check.variantCall(x, call, v)
+	} else if ov := check.overload(call.Fun); ov != nil {
+		// call of an overloaded Wo function
+		return check.overloadCall(x, call, ov)
 	} else {
 		check.exprOrType(x, call.Fun, true)
 	}
 	// x.typ may be generic
 
+	// The Wo builtin method call x.f(args) is the call pkg.f(x, args).
+	var recv *operand
+	if x.mode == builtin && x.id == _BuiltinMethod {
+		sel := syntax.Unparen(call.Fun).(*syntax.SelectorExpr)
+		recv = &operand{mode: value, expr: sel.X, typ: x.typ}
+		if x.val != nil {
+			recv.mode = constant_
+			recv.val = x.val
+		}
+		fn := check.lookupBuiltinMethod(x.typ, sel.Sel.Value)
+		x.mode = value
+		x.typ = fn.typ
+		x.val = nil
+		x.expr = call.Fun
+		check.record(x)
+	}
+
 	switch x.mode {
 	case invalid:
 		check.use(call.ArgList...)
@@ -204,7 +235,7 @@ func (check *Checker) callExpr(x *operand, call *syntax.CallExpr) exprKind {
 			check.expr(nil, x, call.ArgList[0])
 			if x.mode != invalid {
 				if t, _ := under(T).(*Interface); t != nil && !isTypeParam(T) {
-					if !t.IsMethodSet() {
+					if !t.IsMethodSet() && !check.valueIface(call, t) {
 						check.errorf(call, MisplacedConstraintIface, "cannot use interface %s in conversion (contains specific type constraints or is comparable)", T)
 						break
 					}
@@ -293,6 +324,19 @@ func (check *Checker) callExpr(x *operand, call *syntax.CallExpr) exprKind {
 
 	// evaluate arguments
 	args, atargs, atxlist := check.genericExprList(call.ArgList)
+	if recv != nil {
+		if len(call.ArgList) == 1 && len(args) > 1 {
+			check.errorf(call.ArgList[0], TooManyValues, "multiple-value %s in single-value context", call.ArgList[0])
+			x.mode = invalid
+			x.expr = call
+			return statement
+		}
+		args = append([]*operand{recv}, args...)
+		if atargs != nil {
+			atargs = append([][]Type{nil}, atargs...)
+			atxlist = append([][]syntax.Expr{nil}, atxlist...)
+		}
+	}
 	sig = check.arguments(call, sig, targs, xlist, args, atargs, atxlist)
 
 	if wasGeneric && sig.TypeParams().Len() == 0 {
@@ -331,7 +375,7 @@ func (check *Checker) callExpr(x *operand, call *syntax.CallExpr) exprKind {
 // A single-element expression list may evaluate to multiple operands.
 func (check *Checker) exprList(elist []syntax.Expr) (xlist []*operand) {
 	if n := len(elist); n == 1 {
-		xlist, _ = check.multiExpr(elist[0], false)
+		xlist, _ = check.multiExpr(elist[0], false, 0)
 	} else if n > 1 {
 		// multiple (possibly invalid) values
 		xlist = make([]*operand, n)
@@ -380,7 +424,12 @@ func (check *Checker) genericExprList(elist []syntax.Expr) (resList []*operand,
 		// single value (possibly a partially instantiated function), or a multi-valued expression
 		e := elist[0]
 		var x operand
-		if inst, _ := e.(*syntax.IndexExpr); inst != nil && check.indexExpr(&x, inst) {
+		if lam, _ := e.(*syntax.LambdaExpr); lam != nil {
+			// x is a Wo function literal; its type depends on the
+			// corresponding parameter and is determined by arguments.
+			x = operand{mode: value, expr: lam}
+			resList = []*operand{&x}
+		} else if inst, _ := e.(*syntax.IndexExpr); inst != nil && check.indexExpr(&x, inst) {
 			// x is a generic function.
 			targs, xlist := check.funcInst(nil, x.Pos(), &x, inst, infer)
 			if targs != nil {
@@ -417,7 +466,10 @@ func (check *Checker) genericExprList(elist []syntax.Expr) (resList []*operand,
 		xlistList = make([][]syntax.Expr, n)
 		for i, e := range elist {
 			var x operand
-			if inst, _ := e.(*syntax.IndexExpr); inst != nil && check.indexExpr(&x, inst) {
+			if lam, _ := e.(*syntax.LambdaExpr); lam != nil {
+				// x is a Wo function literal (see above).
+				x = operand{mode: value, expr: lam}
+			} else if inst, _ := e.(*syntax.IndexExpr); inst != nil && check.indexExpr(&x, inst) {
 				// x is a generic function.
 				targs, xlist := check.funcInst(nil, x.Pos(), &x, inst, infer)
 				if targs != nil {
@@ -466,6 +518,7 @@ func (check *Checker) arguments(call *syntax.CallExpr, sig *Signature, targs []T
 	// variadic func | nargs >= npars-1 | nargs == npars |
 	// --------------+------------------+----------------+
 
+	args = check.defaultArgs(call, sig, args)
 	nargs := len(args)
 	npars := sig.params.Len()
 	ddd := hasDots(call)
@@ -605,8 +658,22 @@ func (check *Checker) arguments(call *syntax.CallExpr, sig *Signature, targs []T
 
 	// infer missing type arguments of callee and function arguments
 	if len(tparams) > 0 {
+		// Wo function literals take their types from the inferred
+		// parameter types and don't participate in inference.
+		inferParams, inferArgs := sigParams, args
+		if slices.ContainsFunc(args, isLambda) {
+			var vars []*Var
+			inferArgs = nil
+			for i, a := range args {
+				if !isLambda(a) {
+					vars = append(vars, sigParams.vars[i])
+					inferArgs = append(inferArgs, a)
+				}
+			}
+			inferParams = NewTuple(vars...)
+		}
 		err := check.newError(CannotInferTypeArgs)
-		targs = check.infer(call.Pos(), tparams, targs, sigParams, args, false, err)
+		targs = check.infer(call.Pos(), tparams, targs, inferParams, inferArgs, false, err)
 		if targs == nil {
 			// TODO(gri) If infer inferred the first targs[:n], consider instantiating
 			//           the call signature for better error messages/gopls behavior.
@@ -648,6 +715,12 @@ func (check *Checker) arguments(call *syntax.CallExpr, sig *Signature, targs []T
 	if len(args) > 0 {
 		context := check.sprintf("argument to %s", call.Fun)
 		for i, a := range args {
+			if isLambda(a) {
+				lam := a.expr.(*syntax.LambdaExpr)
+				T, _ := coreType(sigParams.vars[i].typ).(*Signature)
+				check.lambda(a, lam, T)
+				check.record(a)
+			}
 			check.assignment(a, sigParams.vars[i].typ, context)
 		}
 	}
@@ -666,6 +739,60 @@ var cgoPrefixes = [...]string{
 	"_Cmacro_", // function to evaluate the expanded expression
 }
 
+// lookupBuiltinMethod returns the Wo builtin method with the given name
+// of values of type T, or nil if there is none. It imports the package
+// providing the builtin methods of T if it has a builtin method of that
+// name.
+func (check *Checker) lookupBuiltinMethod(T Type, name string) *Func {
+	path := BuiltinMethodPath(T)
+	if path == "" || !isExported(name) {
+		return nil
+	}
+	// The package is looked up without reporting errors: the build system
+	// only provides it if it has a function named like a selector, so it
+	// may be unavailable for selectors that are not builtin methods. The
+	// caller then reports the missing field or method.
+	key := importKey{path, ""}
+	pkg := check.impMap[key]
+	if pkg == nil {
+		pkg = check.lookupPackage(path)
+	}
+	if pkg == nil || pkg.fake {
+		return nil
+	}
+	fn, _ := pkg.scope.Lookup(name).(*Func)
+	if fn == nil || !IsBuiltinMethod(T, fn) {
+		return nil
+	}
+	if check.impMap[key] == nil {
+		check.impMap[key] = pkg
+		if check.pkgPathMap != nil {
+			check.markImports(pkg)
+		}
+	}
+	return fn
+}
+
+// lookupPackage imports the package with the given path like
+// importPackage, but it does not record the package or report errors.
+// It returns nil if the package cannot be imported.
+func (check *Checker) lookupPackage(path string) *Package {
+	var imp *Package
+	var err error
+	switch importer := check.conf.Importer.(type) {
+	case nil:
+		return nil
+	case ImporterFrom:
+		imp, err = importer.ImportFrom(path, "", 0)
+	default:
+		imp, err = importer.Import(path)
+	}
+	if err != nil || imp == nil || !imp.complete || imp.name == "_" || imp.name == "" {
+		return nil
+	}
+	return imp
+}
+
 func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName, wantType bool) {
 	// these must be declared before the "goto Error" statements
 	var (
@@ -721,8 +848,8 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 					}
 					goto Error
 				}
-				if !exp.Exported() {
-					check.errorf(e.Sel, UnexportedName, "name %s not exported by package %s", sel, pkg.name)
+				if !check.visible(exp) {
+					check.unexportedNameError(e.Sel, exp)
 					// ok to continue
 				}
 			}
@@ -756,6 +883,16 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 				x.mode = builtin
 				x.typ = exp.typ
 				x.id = exp.id
+			case *Variant:
+				if len(exp.fields) > 0 {
+					check.errorf(e, InvalidEnum, "cannot use variant %s without values for its fields", syntax.Expr(e))
+					goto Error
+				}
+				x.mode = value
+				x.typ = exp.typ
+			case *Overload:
+				check.overloadUseError(e.Sel, exp)
+				goto Error
 			default:
 				check.dump("%v: unexpected object %v", atPos(e.Sel), exp)
 				panic("unreachable")
@@ -801,6 +938,47 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 
 	obj, index, indirect = lookupFieldOrMethod(x.typ, x.mode == variable, check.pkg, sel, false)
 	if obj == nil {
+		// Wo sets have built-in add and delete methods.
+		if _, ok := under(x.typ).(*Set); ok && x.mode != typexpr && (sel == "add" || sel == "delete") {
+			x.mode = builtin
+			x.id = _SetAdd
+			if sel == "delete" {
+				x.id = _SetDelete
+			}
+			x.expr = e
+			return
+		}
+
+		// Wo optionals have built-in IsPresent and OrElse methods.
+		if _, ok := under(x.typ).(*Optional); ok && x.mode != typexpr && (sel == "IsPresent" || sel == "OrElse") {
+			x.mode = builtin
+			x.id = _OptionalIsPresent
+			if sel == "OrElse" {
+				x.id = _OptionalOrElse
+			}
+			x.expr = e
+			return
+		}
+
+		// Wo strings, slices and maps have builtin methods provided by
+		// the functions of the strings, slices and maps packages.
+		if x.mode != typexpr && check.woFeatures[e.Pos().FileBase()] != 0 {
+			if fn := check.lookupBuiltinMethod(x.typ, sel); fn != nil {
+				if !check.verifyWof(e.Sel, syntax.WoBuiltinMethods, "builtin method %s", sel) {
+					goto Error
+				}
+				check.recordUse(e.Sel, fn)
+				if isUntyped(x.typ) {
+					// an untyped string receiver is a string
+					check.assignment(x, nil, "receiver of builtin method "+sel)
+				}
+				x.mode = builtin
+				x.id = _BuiltinMethod
+				x.expr = e
+				return
+			}
+		}
+
 		// Don't report another error if the underlying type was invalid (go.dev/issue/49541).
 		if !isValid(under(x.typ)) {
 			goto Error
@@ -852,6 +1030,10 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 			check.error(e, InvalidDeclCycle, "illegal cycle in method declaration")
 			goto Error
 		}
+		if sig.tparams != nil {
+			check.errorf(e.Sel, InvalidGenericMethod, "invalid method expression %s.%s (method %s has type parameters)", x.typ, sel, sel)
+			goto Error
+		}
 
 		// The receiver type becomes the type of the first function
 		// argument of the method expression's function type.
@@ -874,12 +1056,17 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 			}
 		}
 		params = append([]*Var{NewVar(sig.recv.pos, sig.recv.pkg, name, x.typ)}, params...)
+		var defaults []Object
+		if sig.defaults != nil {
+			defaults = append([]Object{nil}, sig.defaults...)
+		}
 		x.mode = value
 		x.typ = &Signature{
 			tparams:  sig.tparams,
 			params:   NewTuple(params...),
 			results:  sig.results,
 			variadic: sig.variadic,
+			defaults: defaults,
 		}
 
 		check.addDeclDep(m)
@@ -894,6 +1081,10 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 			} else {
 				x.mode = value
 			}
+			// The fields of Wo enum values cannot be assigned.
+			if base, _ := deref(x.typ); isEnum(base) {
+				x.mode = value
+			}
 			x.typ = obj.typ
 
 		case *Func:
@@ -903,6 +1094,13 @@ func (check *Checker) selector(x *operand, e *syntax.SelectorExpr, def *TypeName
 
 			x.mode = value
 
+			// A Wo method with type parameters has no method value;
+			// it can only be called.
+			if obj.typ.(*Signature).tparams != nil && e != check.callee {
+				check.errorf(e.Sel, InvalidGenericMethod, "cannot use generic method %s.%s without calling it", x.expr, sel)
+				goto Error
+			}
+
 			// remove receiver
 			sig := *obj.typ.(*Signature)
 			sig.recv = nil
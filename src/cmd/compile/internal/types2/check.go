@@ -64,6 +64,8 @@ type environment struct {
 	isPanic       map[*syntax.CallExpr]bool // set of panic call expressions (used for termination check)
 	hasLabel      bool                      // set if a function makes use of labels (only ~1% of functions); unused outside functions
 	hasCallOrRecv bool                      // set if an expression contains a function call or channel receive operation
+	inRangeFunc   bool                      // set if inside the body of a range-over-func loop
+	callee        syntax.Expr               // function expression of the call being checked, if any
 }
 
 // lookupScope looks up name in the current environment and if an object
@@ -164,6 +166,11 @@ type Checker struct {
 	unionTypeSets map[*Union]*_TypeSet       // computed type sets for union types
 	mono          monoGraph                  // graph for detecting non-monomorphizable instantiation loops
 
+	woFeatures   map[*syntax.PosBase]syntax.Features // maps Wo files to the Wo features they may use (Go files have no entry)
+	uninitVars   map[*Var]bool                       // set of local variables declared without initial value in Wo files
+	uninitNames  map[*syntax.Name]*Var               // maps identifiers declaring or denoting variables in uninitVars to them
+	enumSwitches map[*syntax.SwitchStmt]bool         // set of Wo enum switches with a case for every variant
+
 	firstErr error                    // first error encountered
 	methods  map[*TypeName][]*Func    // maps package scope type names to associated non-blank (non-interface) methods
 	untyped  map[syntax.Expr]exprInfo // map of expressions without final type
@@ -301,6 +308,10 @@ func (check *Checker) initFiles(files []*syntax.File) {
 	check.files = nil
 	check.imports = nil
 	check.dotImportMap = nil
+	check.woFeatures = nil
+	check.uninitVars = nil
+	check.uninitNames = nil
+	check.enumSwitches = nil
 
 	check.firstErr = nil
 	check.methods = nil
@@ -373,6 +384,13 @@ func (check *Checker) initFiles(files []*syntax.File) {
 			}
 		}
 		versions[file.Pos().FileBase()] = v // file.Pos().FileBase() may be nil for tests
+
+		if file.WoFeatures != 0 {
+			if check.woFeatures == nil {
+				check.woFeatures = make(map[*syntax.PosBase]syntax.Features)
+			}
+			check.woFeatures[file.Pos().FileBase()] = file.WoFeatures
+		}
 	}
 }
 
@@ -482,6 +500,9 @@ func (check *Checker) checkFiles(files []*syntax.File) {
 	check.seenPkgMap = nil
 	check.brokenAliases = nil
 	check.unionTypeSets = nil
+	check.uninitVars = nil
+	check.uninitNames = nil
+	check.enumSwitches = nil
 	check.ctxt = nil
 
 	// TODO(gri) There's more memory we should release at this point.
@@ -17,7 +17,8 @@
 // error reported at that source position, and that each ERRORx pattern
 // is a regular expression matching the respective error.
 // Consecutive comments may be used to indicate multiple errors reported
-// at the same position.
+// at the same position. Expected warnings are indicated like errors, but
+// with a WARNING indicator instead.
 //
 // For instance, the following test source indicates that an "undeclared"
 // error should be reported for the undeclared variable x:
@@ -69,14 +70,14 @@ func parseFiles(t *testing.T, filenames []string, srcs [][]byte, mode syntax.Mod
 	return files, errlist
 }
 
-func unpackError(err error) (syntax.Pos, string) {
+func unpackError(err error) (syntax.Pos, string, Severity) {
 	switch err := err.(type) {
 	case syntax.Error:
-		return err.Pos, err.Msg
+		return err.Pos, err.Msg, SeverityError
 	case Error:
-		return err.Pos, err.Msg
+		return err.Pos, err.Msg, err.Severity
 	default:
-		return nopos, err.Error()
+		return nopos, err.Error(), SeverityError
 	}
 }
 
@@ -212,7 +213,7 @@ func testFilesImpl(t *testing.T, filenames []string, srcs [][]byte, colDelta uin
 	// collect expected errors
 	errmap := make(map[string]map[uint][]syntax.Error)
 	for i, filename := range filenames {
-		if m := syntax.CommentMap(bytes.NewReader(srcs[i]), regexp.MustCompile("^ ERRORx? ")); len(m) > 0 {
+		if m := syntax.CommentMap(bytes.NewReader(srcs[i]), regexp.MustCompile("^ (ERRORx?|WARNING) ")); len(m) > 0 {
 			errmap[filename] = m
 		}
 	}
@@ -220,7 +221,7 @@ func testFilesImpl(t *testing.T, filenames []string, srcs [][]byte, colDelta uin
 	// match against found errors
 	var indices []int // list indices of matching errors, reused for each error
 	for _, err := range errlist {
-		gotPos, gotMsg := unpackError(err)
+		gotPos, gotMsg, gotSeverity := unpackError(err)
 
 		// find list of errors for the respective error line
 		filename := gotPos.Base().Filename()
@@ -235,13 +236,21 @@ func testFilesImpl(t *testing.T, filenames []string, srcs [][]byte, colDelta uin
 		indices = indices[:0]
 		for i, want := range errList {
 			pattern, substr := strings.CutPrefix(want.Msg, " ERROR ")
+			severity := SeverityError
 			if !substr {
 				var found bool
 				pattern, found = strings.CutPrefix(want.Msg, " ERRORx ")
 				if !found {
-					panic("unreachable")
+					pattern, substr = strings.CutPrefix(want.Msg, " WARNING ")
+					if !substr {
+						panic("unreachable")
+					}
+					severity = SeverityWarning
 				}
 			}
+			if severity != gotSeverity {
+				continue
+			}
 			unquoted, err := strconv.Unquote(strings.TrimSpace(pattern))
 			if err != nil {
 				t.Errorf("%s:%d:%d: invalid ERROR pattern (cannot unquote %s)", filename, line, want.Pos.Col(), pattern)
@@ -415,6 +424,7 @@ func TestExamples(t *testing.T) {
 func TestFixedbugs(t *testing.T) {
 	testDirFiles(t, "../../../../internal/types/testdata/fixedbugs", 100, false)
 }                            // TODO(gri) narrow column tolerance
+func TestWo(t *testing.T)    { testDirFiles(t, "../../../../internal/types/testdata/wo", 100, false) } // TODO(gri) narrow column tolerance
 func TestLocal(t *testing.T) { testDirFiles(t, "testdata/local", 0, false) }
 
 func testDirFiles(t *testing.T, dir string, colDelta uint, manual bool) {
@@ -27,6 +27,7 @@ func (check *Checker) declare(scope *Scope, id *syntax.Name, obj Object, pos syn
 			return
 		}
 		obj.setScopePos(pos)
+		check.checkShadow(scope, obj)
 	}
 	if id != nil {
 		check.recordDef(id, obj)
@@ -143,6 +144,11 @@ func (check *Checker) objDecl(obj Object, def *TypeName) {
 				obj.typ = Typ[Invalid]
 			}
 
+		case *Variant:
+			if obj.typ == nil {
+				obj.typ = Typ[Invalid]
+			}
+
 		case *Func:
 			if !check.validCycle(obj) {
 				// Don't set obj.typ to Typ[Invalid] here
@@ -191,6 +197,8 @@ func (check *Checker) objDecl(obj Object, def *TypeName) {
 	case *Func:
 		// functions may be recursive - no need to track dependencies
 		check.funcDecl(obj, d)
+	case *Variant:
+		check.variantDecl(obj, d)
 	default:
 		panic("unreachable")
 	}
@@ -427,6 +435,12 @@ func (check *Checker) varDecl(obj *Var, lhs []*Var, typ, init syntax.Expr) {
 
 	if lhs == nil || len(lhs) == 1 {
 		assert(lhs == nil || lhs[0] == obj)
+		if obj.typ != nil && isOptional(obj.typ) || check.maySkip(init) {
+			// The initialization expression may be a comma-ok expression,
+			// or a call with skippable results.
+			check.initVars([]*Var{obj}, []syntax.Expr{init}, nil)
+			return
+		}
 		var x operand
 		check.expr(newTarget(obj.typ, obj.name), &x, init)
 		check.initVar(obj, &x, "variable declaration")
@@ -709,6 +723,22 @@ func (check *Checker) collectMethods(obj *TypeName) {
 }
 
 func (check *Checker) checkFieldUniqueness(base *Named) {
+	if t, _ := base.under().(*Enum); t != nil {
+		// The fields of a Wo enum type must be distinct from its method names.
+		for i := 0; i < base.NumMethods(); i++ {
+			m := base.Method(i)
+			if _, fld := t.lookupField(m.pkg, m.name, false); fld != nil {
+				err := check.newError(DuplicateFieldAndMethod)
+				err.addf(m, "field and method with the same name %s", m.name)
+				if fld.pos.IsKnown() {
+					err.addAltDecl(fld)
+				}
+				err.report()
+			}
+		}
+		return
+	}
+
 	if t, _ := base.under().(*Struct); t != nil {
 		var mset objset
 		for i := 0; i < base.NumMethods(); i++ {
@@ -897,6 +927,21 @@ func (check *Checker) declStmt(list []syntax.Decl) {
 				check.declare(check.scope, name, lhs0[i], scopePos)
 			}
 
+			// In Wo files, variables without initial value must be
+			// assigned before they are read (see Checker.uninit).
+			if values == nil && check.allowUninit(s) {
+				if check.uninitVars == nil {
+					check.uninitVars = make(map[*Var]bool)
+					check.uninitNames = make(map[*syntax.Name]*Var)
+				}
+				for i, name := range s.NameList {
+					if name.Value != "_" {
+						check.uninitVars[lhs0[i]] = true
+						check.uninitNames[name] = lhs0[i]
+					}
+				}
+			}
+
 		case *syntax.TypeDecl:
 			obj := NewTypeName(s.Name.Pos(), pkg, s.Name.Value, nil)
 			// spec: "The scope of a type identifier declared inside a function
@@ -0,0 +1,164 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements typechecking of the default values of Wo
+// function parameters, and the filling in of omitted arguments.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	. "internal/types/errors"
+)
+
+// paramDefaults type-checks the default values of the parameters of
+// ftyp, whose parameter variables are params, and returns them as
+// described for Signature.Default. Parameters without a type take the
+// default type of their default value. The result is nil if no
+// parameter has a default value.
+//
+// Default values are evaluated at each call that omits them. To make
+// them meaningful in any package, they are restricted to constants,
+// nil, and package-level variables and functions.
+func (check *Checker) paramDefaults(ftyp *syntax.FuncType, params []*Var, variadic bool, tparams []*TypeParam) []Object {
+	dflts := paramDefaultExprs(ftyp)
+	var defaults []Object
+	first := -1 // index of first parameter with a default value
+	for i, dflt := range dflts {
+		par := params[i]
+		if dflt == nil {
+			if first >= 0 && !(variadic && i == len(dflts)-1) {
+				check.errorf(par, InvalidDefault, "missing default value for parameter %s following parameter with default value", par.name)
+			}
+			continue
+		}
+		if first < 0 {
+			first = i
+			if !check.verifyWof(dflt, syntax.WoDefault, "default parameter value") {
+				return nil
+			}
+			defaults = make([]Object, len(params))
+		}
+		switch {
+		case variadic && i == len(dflts)-1:
+			check.errorf(dflt, InvalidDefault, "variadic parameter %s cannot have a default value", par.name)
+		case par.typ != nil && isParameterized(tparams, par.typ):
+			check.errorf(dflt, InvalidDefault, "parameter %s of generic type %s cannot have a default value", par.name, par.typ)
+		default:
+			defaults[i] = check.paramDefault(par, dflt)
+		}
+		if par.typ == nil {
+			par.typ = Typ[Invalid]
+		}
+	}
+	return defaults
+}
+
+// paramDefault type-checks the default value e of the parameter par
+// and returns it as described for Signature.Default, or nil if there
+// is an error. If par has no type, it takes the default type of e.
+func (check *Checker) paramDefault(par *Var, e syntax.Expr) Object {
+	var x operand
+	check.expr(nil, &x, e)
+	if x.mode == invalid {
+		return nil
+	}
+	if par.typ == nil {
+		if x.isNil() {
+			check.errorf(&x, UntypedNilUse, "use of untyped %s in default value of parameter %s", x.expr, par.name)
+			return nil
+		}
+		par.typ = Default(x.typ)
+	}
+	check.assignment(&x, par.typ, "default value of parameter "+par.name)
+	switch {
+	case x.mode == invalid:
+		return nil
+	case x.mode == constant_:
+		// x has the parameter type, or the type of the value that
+		// is implicitly converted to it, such as T for T? or any.
+		return NewConst(x.Pos(), check.pkg, "", x.typ, x.val)
+	}
+	switch obj := check.defaultObj(e).(type) {
+	case *Nil:
+		return obj
+	case *Var:
+		if obj.parent == obj.pkg.scope {
+			return obj
+		}
+	case *Func:
+		if sig := obj.Signature(); sig.recv == nil && sig.tparams == nil {
+			return obj
+		}
+	}
+	check.errorf(e, InvalidDefault, "default value %s of parameter %s must be a constant, nil, or a package-level variable or function", e, par.name)
+	return nil
+}
+
+// defaultObj returns the object denoted by the (possibly qualified)
+// identifier e, or nil if e is not such an identifier.
+func (check *Checker) defaultObj(e syntax.Expr) Object {
+	switch e := syntax.Unparen(e).(type) {
+	case *syntax.Name:
+		return check.lookup(e.Value)
+	case *syntax.SelectorExpr:
+		if x, _ := e.X.(*syntax.Name); x != nil {
+			if pname, _ := check.lookup(x.Value).(*PkgName); pname != nil {
+				return pname.imported.scope.Lookup(e.Sel.Value)
+			}
+		}
+	}
+	return nil
+}
+
+// defaultArgs fills in the arguments omitted from the call of a
+// function with signature sig, using the default values of the
+// corresponding parameters, and returns the complete list of
+// arguments (see Checker.defaultArg for the omitted arguments).
+// If the call is not in a Wo file, or it omits a parameter without
+// default value, defaultArgs returns args unchanged.
+func (check *Checker) defaultArgs(call *syntax.CallExpr, sig *Signature, args []*operand) []*operand {
+	n := sig.params.Len()
+	if sig.variadic {
+		n-- // the variadic parameter has no default value
+	}
+	// f(g()) where g returns multiple values must provide all arguments.
+	if sig.defaults == nil || len(args) >= n || len(call.ArgList) != len(args) || hasDots(call) {
+		return args
+	}
+	for i := len(args); i < n; i++ {
+		if sig.defaults[i] == nil {
+			return args
+		}
+	}
+	if !check.allowWo(call, syntax.WoDefault) {
+		return args
+	}
+
+	for i := len(args); i < n; i++ {
+		obj := sig.defaults[i]
+		x := new(operand)
+		x.mode = value
+		x.typ = sig.params.vars[i].typ
+		switch obj := obj.(type) {
+		case *Const:
+			x.mode = constant_
+			x.typ = obj.typ
+			x.val = obj.val
+		case *Nil:
+			if isTypes2 {
+				x.mode = nilvalue
+			}
+		case *Var, *Func:
+			if _, ok := obj.(*Var); ok {
+				x.mode = variable
+			}
+			x.typ = obj.Type()
+			check.addDeclDep(obj)
+		}
+		check.defaultArg(call, x, obj)
+		args = append(args, x)
+	}
+	return args
+}
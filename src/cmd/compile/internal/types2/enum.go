@@ -0,0 +1,149 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types2
+
+// An Enum represents a Wo enum type.
+//
+// An enum type is always the underlying type of a defined type E.
+// Its variants are package-level objects of type E. All variants
+// share the enum's fields, whose values are constants given by each
+// variant. A variant may instead have fields of its own whose values
+// are provided when the variant is constructed; such variants make
+// the enum a sum type.
+//
+// Every enum type also has the predeclared fields name and pos,
+// which hold the name of a variant and its index in the enum.
+type Enum struct {
+	variants []*Variant
+	fields   []*Var // fields shared by all variants
+	name     *Var   // predeclared name field
+	pos      *Var   // predeclared pos field
+}
+
+// NewEnum returns a new enum type with the given variants and shared
+// fields. The variants must have been created with NewVariant, in
+// order and with a nil type; their type is set to the defined type
+// when the enum becomes its underlying type.
+func NewEnum(variants []*Variant, fields []*Var) *Enum {
+	var fset objset
+	for _, f := range fields {
+		if f.name != "_" && fset.insert(f) != nil {
+			panic("multiple fields with the same name")
+		}
+	}
+	for i, v := range variants {
+		if v.index != i || len(v.values) != len(fields) {
+			panic("invalid variant")
+		}
+	}
+	return newEnum(variants, fields)
+}
+
+func newEnum(variants []*Variant, fields []*Var) *Enum {
+	var pkg *Package
+	if len(variants) > 0 {
+		pkg = variants[0].pkg
+	}
+	return &Enum{
+		variants: variants,
+		fields:   fields,
+		name:     NewField(nopos, pkg, "name", Typ[String], false),
+		pos:      NewField(nopos, pkg, "pos", Typ[Int], false),
+	}
+}
+
+// NumVariants returns the number of variants of the enum.
+func (t *Enum) NumVariants() int { return len(t.variants) }
+
+// Variant returns the i'th variant of the enum for 0 <= i < NumVariants().
+func (t *Enum) Variant(i int) *Variant { return t.variants[i] }
+
+// NumFields returns the number of fields shared by all variants
+// of the enum, not counting the predeclared fields name and pos.
+func (t *Enum) NumFields() int { return len(t.fields) }
+
+// Field returns the i'th shared field of the enum for 0 <= i < NumFields().
+func (t *Enum) Field(i int) *Var { return t.fields[i] }
+
+// IsSum reports whether some variant of the enum has fields of its own.
+func (t *Enum) IsSum() bool {
+	for _, v := range t.variants {
+		if len(v.fields) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// TagType returns the type of the integer that represents the index
+// of a variant: the smallest unsigned integer type that can hold the
+// index of every variant.
+func (t *Enum) TagType() *Basic {
+	switch n := len(t.variants); {
+	case n <= 1<<8:
+		return Typ[Uint8]
+	case n <= 1<<16:
+		return Typ[Uint16]
+	}
+	return Typ[Uint32]
+}
+
+// layout returns the struct type that describes the memory layout of
+// a value of the sum type t:
+//
+//	struct {
+//		tag TagType
+//		v0  struct{ fields of variant 0 }
+//		v1  struct{ fields of variant 1 }
+//		...
+//	}
+//
+// The result is nil if t is not a sum type; a value of t is then
+// represented by its tag alone.
+func (t *Enum) layout() *Struct {
+	if !t.IsSum() {
+		return nil
+	}
+	fields := []*Var{NewField(nopos, nil, "_", t.TagType(), false)}
+	for _, v := range t.variants {
+		vfields := make([]*Var, len(v.fields))
+		for i, f := range v.fields {
+			vfields[i] = NewField(nopos, nil, "_", f.typ, false)
+		}
+		fields = append(fields, NewField(nopos, nil, "_", NewStruct(vfields, nil), false))
+	}
+	return NewStruct(fields, nil)
+}
+
+// lookupField returns the index and field with the given name,
+// or -1 and nil. The predeclared fields name and pos follow the
+// shared fields in the numbering; they are found independently of pkg.
+func (t *Enum) lookupField(pkg *Package, name string, foldCase bool) (int, *Var) {
+	switch name {
+	case "name":
+		return len(t.fields), t.name
+	case "pos":
+		return len(t.fields) + 1, t.pos
+	}
+	for i, f := range t.fields {
+		if f.sameId(pkg, name, foldCase) {
+			return i, f
+		}
+	}
+	return -1, nil
+}
+
+// setType sets the type of the enum's variants without a type to
+// the defined type typ whose underlying type is t.
+func (t *Enum) setType(typ *Named) {
+	for _, v := range t.variants {
+		if v.typ == nil {
+			v.typ = typ
+		}
+	}
+}
+
+func (t *Enum) Underlying() Type { return t }
+func (t *Enum) String() string   { return TypeString(t, nil) }
@@ -0,0 +1,369 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements typechecking of Wo enum types, their
+// variants, and switch statements on enum values.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	"go/constant"
+	. "internal/types/errors"
+	"slices"
+	"strings"
+)
+
+// enumType type-checks the Wo enum type e, which is the type of the
+// type declaration for def, and sets up typ accordingly. The variants
+// of the enum were declared in the package scope by the resolver.
+func (check *Checker) enumType(typ *Enum, e *syntax.EnumType, def *TypeName) {
+	named := def.typ.(*Named)
+	tdecl := check.objMap[def].tdecl
+
+	// shared fields
+	var fset objset
+	var prev syntax.Expr
+	var ftyp Type
+	names, ftypes := fieldNames(e.FieldList)
+	for i, name := range names {
+		ftype := ftypes[i]
+		// Fields declared together share their type expression.
+		if ftype != prev {
+			prev = ftype
+			ftyp = check.varType(ftype)
+			if isValid(ftyp) && !isConstType(ftyp) {
+				check.errorf(ftype, InvalidEnum, "invalid enum field type %s (values of shared fields must be constants)", ftyp)
+			}
+		}
+		fld := NewField(name.Pos(), check.pkg, name.Value, ftyp, false)
+		if n := name.Value; n == "name" || n == "pos" {
+			check.errorf(name, InvalidEnum, "cannot declare field %s: enum types have a predeclared field %s", n, n)
+		} else {
+			check.declareInSet(&fset, name.Pos(), fld)
+		}
+		check.recordDef(name, fld)
+		typ.fields = append(typ.fields, fld)
+	}
+	typ.name = NewField(nopos, check.pkg, "name", Typ[String], false)
+	typ.pos = NewField(nopos, check.pkg, "pos", Typ[Int], false)
+
+	// variants
+	for i, v := range e.VariantList {
+		obj, _ := check.pkg.scope.Lookup(v.Name.Value).(*Variant)
+		if obj == nil || check.objMap[obj].tdecl != tdecl {
+			// The variant was not declared (because it is blank or
+			// was redeclared). Use a new object so that the remaining
+			// variants keep their index.
+			obj = NewVariant(v.Name.Pos(), check.pkg, v.Name.Value, nil, i, nil, nil)
+			check.recordDef(v.Name, obj)
+		}
+		obj.index = i
+
+		// values of shared fields
+		obj.values = make([]constant.Value, len(typ.fields))
+		for j, arg := range v.ValueList {
+			var x operand
+			check.expr(nil, &x, arg)
+			if j >= len(typ.fields) || !isConstType(typ.fields[j].typ) {
+				continue
+			}
+			check.assignment(&x, typ.fields[j].typ, "enum value")
+			switch x.mode {
+			case invalid:
+			case constant_:
+				obj.values[j] = x.val
+			default:
+				check.errorf(&x, InvalidEnum, "value %s of field %s in variant %s is not constant", &x, typ.fields[j].name, obj.name)
+			}
+		}
+		for j := range obj.values {
+			if obj.values[j] == nil {
+				obj.values[j] = constant.MakeUnknown()
+			}
+		}
+		switch n := len(typ.fields); {
+		case numFields(v.FieldList) > 0 && n > 0:
+			check.errorf(v, InvalidEnum, "variant %s cannot have fields of its own in enum with shared fields", obj.name)
+		case len(v.ValueList) > n:
+			check.errorf(v.ValueList[n], InvalidEnum, "too many values in variant %s (enum has %d shared fields)", obj.name, n)
+		case len(v.ValueList) < n:
+			check.errorf(v, InvalidEnum, "missing values in variant %s (enum has %d shared fields)", obj.name, n)
+		default:
+			// fields of the variant
+			var vset objset
+			var prev syntax.Expr
+			var ftyp Type
+			names, ftypes := fieldNames(v.FieldList)
+			for i, name := range names {
+				ftype := ftypes[i]
+				if ftype != prev {
+					prev = ftype
+					ftyp = check.varType(ftype)
+				}
+				fld := NewField(name.Pos(), check.pkg, name.Value, ftyp, false)
+				check.declareInSet(&vset, name.Pos(), fld)
+				check.recordDef(name, fld)
+				obj.fields = append(obj.fields, fld)
+			}
+		}
+
+		typ.variants = append(typ.variants, obj)
+	}
+
+	typ.setType(named)
+	if isEnumDecl(tdecl) {
+		check.enumMethods(def, named)
+	}
+}
+
+// enumMethods associates the methods that every Wo enum type E has
+// with the type name def of E, unless they are declared explicitly:
+//
+//	func (x E) String() string
+//	func (x E) MarshalText() ([]byte, error)
+//	func (x *E) UnmarshalText(text []byte) error
+//
+// String and MarshalText return the name of the variant of x, and
+// UnmarshalText sets *x to the variant named text (with zero fields);
+// they make the fmt and encoding packages use the names of the
+// variants of E. The methods have no declarations; the compiler
+// provides their bodies.
+func (check *Checker) enumMethods(def *TypeName, named *Named) {
+	pos := def.pos
+	param := func(name string, typ Type) *Var { return NewParam(pos, check.pkg, name, typ) }
+	methods := []struct {
+		name            string
+		ptr             bool
+		params, results *Tuple
+	}{
+		{"String", false, nil, NewTuple(param("", Typ[String]))},
+		{"MarshalText", false, nil, NewTuple(param("", NewSlice(universeByte)), param("", universeError))},
+		{"UnmarshalText", true, NewTuple(param("text", NewSlice(universeByte))), NewTuple(param("", universeError))},
+	}
+	if check.methods == nil {
+		check.methods = make(map[*TypeName][]*Func)
+	}
+	declared := check.methods[def]
+	for _, m := range methods {
+		if slices.ContainsFunc(declared, func(f *Func) bool { return f.name == m.name }) {
+			continue
+		}
+		var rtyp Type = named
+		if m.ptr {
+			rtyp = NewPointer(named)
+		}
+		sig := NewSignatureType(param("x", rtyp), nil, nil, m.params, m.results, false)
+		check.methods[def] = append(check.methods[def], NewFunc(pos, check.pkg, m.name, sig))
+	}
+}
+
+// variantDecl type-checks the enum type declaring the variant obj.
+func (check *Checker) variantDecl(obj *Variant, d *declInfo) {
+	if tname, _ := check.pkg.scope.Lookup(d.tdecl.Name.Value).(*TypeName); tname != nil && check.objMap[tname].tdecl == d.tdecl {
+		check.objDecl(tname, nil)
+	}
+	if obj.typ == nil {
+		obj.typ = Typ[Invalid] // the enum type is invalid
+	}
+}
+
+// variant returns the Wo enum variant denoted by the identifier or
+// qualified identifier e, or nil. If e denotes a variant, the uses
+// of the identifiers are recorded and the type of the variant is
+// determined.
+func (check *Checker) variant(e syntax.Expr) *Variant {
+	switch e := e.(type) {
+	case *syntax.Name:
+		scope, obj := check.lookupScope(e.Value)
+		v, _ := obj.(*Variant)
+		if v == nil {
+			return nil
+		}
+		check.recordUse(e, v)
+		if pkgName := check.dotImportMap[dotImportKey{scope, v.name}]; pkgName != nil {
+			pkgName.used = true
+		}
+		if v.typ == nil {
+			check.objDecl(v, nil)
+		}
+		return v
+
+	case *syntax.SelectorExpr:
+		ident, _ := e.X.(*syntax.Name)
+		if ident == nil {
+			return nil
+		}
+		pname, _ := check.lookup(ident.Value).(*PkgName)
+		if pname == nil {
+			return nil
+		}
+		v, _ := pname.imported.scope.Lookup(e.Sel.Value).(*Variant)
+		if v == nil {
+			return nil
+		}
+		check.recordUse(ident, pname)
+		pname.used = true
+		if !check.visible(v) {
+			check.unexportedNameError(e.Sel, v)
+		}
+		check.recordUse(e.Sel, v)
+		return v
+	}
+	return nil
+}
+
+// variantCall type-checks the call of the Wo enum variant v, which
+// constructs a value of the enum type from the values of the
+// variant's fields.
+func (check *Checker) variantCall(x *operand, call *syntax.CallExpr, v *Variant) exprKind {
+	x.mode = invalid
+	x.expr = call
+
+	switch {
+	case !isValid(v.typ):
+		check.use(call.ArgList...)
+		return expression
+	case len(v.fields) == 0:
+		check.use(call.ArgList...)
+		check.errorf(call, InvalidCall, invalidOp+"cannot call variant %s without fields", v.name)
+		return expression
+	}
+
+	params := make([]*Var, len(v.fields))
+	for i, f := range v.fields {
+		params[i] = NewParam(f.pos, f.pkg, f.name, f.typ)
+	}
+	sig := NewSignatureType(nil, nil, nil, NewTuple(params...), NewTuple(NewParam(nopos, nil, "", v.typ)), false)
+	check.recordTypeAndValue(call.Fun, value, sig, nil)
+
+	args, atargs, atxlist := check.genericExprList(call.ArgList)
+	check.arguments(call, sig, nil, nil, args, atargs, atxlist)
+
+	x.mode = value
+	x.typ = v.typ
+	return expression
+}
+
+// enumSwitchStmt type-checks the clauses of the switch statement s
+// whose tag x is a value of Wo enum type. A case that denotes a
+// variant matches the values of that variant. If the enum is a sum
+// type, every case must denote a variant, and the case for a variant
+// with fields may bind the field values to new variables:
+//
+//	switch f {
+//	case Closed:
+//	case Open(contents):
+//	}
+//
+// Unless s has a default clause, there must be a case for every
+// variant.
+func (check *Checker) enumSwitchStmt(inner stmtContext, s *syntax.SwitchStmt, x *operand) {
+	t := under(x.typ).(*Enum)
+	sum := t.IsSum()
+
+	hasDefault := false
+	seen := make(map[*Variant]syntax.Expr) // map of variants to the cases denoting them
+	values := make(valueMap)               // for cases that don't denote variants
+	clauses := check.caseClauses(s)
+	for i, clause := range clauses {
+		if clause == nil {
+			continue // error reported by caseClauses
+		}
+		inner := inner
+		if i+1 < len(clauses) {
+			inner |= fallthroughOk
+		} else {
+			inner |= finalSwitchCase
+		}
+		cases := caseExprs(clause)
+		if cases == nil {
+			hasDefault = true
+		}
+
+		var names []*syntax.Name // identifiers bound to the fields of a variant
+		var bindings []*Var      // corresponding variables
+		for _, e := range cases {
+			fun := e
+			call, _ := e.(*syntax.CallExpr)
+			if call != nil {
+				fun = call.Fun
+			}
+			v := check.variant(fun)
+			if v == nil {
+				if sum {
+					check.use(e)
+					check.errorf(e, InvalidEnum, "case %s is not a variant of %s", e, x.typ)
+				} else {
+					check.caseValues(x, []syntax.Expr{e}, values)
+				}
+				continue
+			}
+			if !isValid(v.typ) {
+				continue
+			}
+			if !Identical(v.typ, x.typ) {
+				check.errorf(e, MismatchedTypes, invalidOp+"invalid case %s in switch on %s (mismatched types %s and %s)", e, x.expr, v.typ, x.typ)
+				continue
+			}
+			if prev := seen[v]; prev != nil {
+				err := check.newError(DuplicateCase)
+				err.addf(e, "duplicate case %s in expression switch", v.name)
+				err.addf(prev, "previous case")
+				err.report()
+				continue
+			}
+			seen[v] = e
+
+			check.recordTypeAndValue(e, value, v.typ, nil)
+			if call == nil {
+				continue
+			}
+			switch {
+			case len(v.fields) == 0:
+				check.errorf(call, InvalidEnum, "cannot bind fields of variant %s without fields", v.name)
+			case len(cases) > 1:
+				check.errorf(call, InvalidEnum, "cannot bind fields of variant %s in case with multiple values", v.name)
+			case len(call.ArgList) != len(v.fields) || hasDots(call):
+				check.errorf(call, WrongArgCount, "wrong number of fields of variant %s in case %s (have %d, want %d)", v.name, e, len(call.ArgList), len(v.fields))
+			default:
+				for j, arg := range call.ArgList {
+					name, _ := arg.(*syntax.Name)
+					if name == nil {
+						check.errorf(arg, InvalidEnum, "field %s of variant %s must be bound to an identifier", v.fields[j].name, v.name)
+						names, bindings = nil, nil
+						break
+					}
+					names = append(names, name)
+					bindings = append(bindings, NewVar(name.Pos(), check.pkg, name.Value, v.fields[j].typ))
+				}
+			}
+		}
+
+		check.openScope(clause, "case")
+		for i, obj := range bindings {
+			check.declare(check.scope, names[i], obj, clause.Colon)
+		}
+		check.stmtList(inner, clause.Body)
+		check.closeScope()
+	}
+
+	if hasDefault {
+		return
+	}
+	var missing []string
+	for _, v := range t.variants {
+		if seen[v] == nil {
+			missing = append(missing, v.name)
+		}
+	}
+	if len(missing) > 0 {
+		check.errorf(s, NonExhaustiveSwitch, "missing cases in switch on %s: %s", x.expr, strings.Join(missing, ", "))
+		return
+	}
+	if check.enumSwitches == nil {
+		check.enumSwitches = make(map[*syntax.SwitchStmt]bool)
+	}
+	check.enumSwitches[s] = true
+}
@@ -36,10 +36,11 @@ type errorDesc struct {
 // A new error_ is created with Checker.newError.
 // To report an error_, call error_.report.
 type error_ struct {
-	check *Checker
-	desc  []errorDesc
-	code  Code
-	soft  bool // TODO(gri) eventually determine this from an error code
+	check    *Checker
+	desc     []errorDesc
+	code     Code
+	soft     bool // TODO(gri) eventually determine this from an error code
+	severity Severity
 }
 
 // newError returns a new error_ with the given error code.
@@ -141,10 +142,10 @@ func (err *error_) report() {
 	if multiError {
 		for i := range err.desc {
 			p := &err.desc[i]
-			check.handleError(i, p.pos, err.code, p.msg, err.soft)
+			check.handleError(i, p.pos, err.code, p.msg, err.soft, err.severity)
 		}
 	} else {
-		check.handleError(0, err.pos(), err.code, err.msg(), err.soft)
+		check.handleError(0, err.pos(), err.code, err.msg(), err.soft, err.severity)
 	}
 
 	// make sure the error is not reported twice
@@ -152,7 +153,7 @@ func (err *error_) report() {
 }
 
 // handleError should only be called by error_.report.
-func (check *Checker) handleError(index int, pos syntax.Pos, code Code, msg string, soft bool) {
+func (check *Checker) handleError(index int, pos syntax.Pos, code Code, msg string, soft bool, severity Severity) {
 	assert(code != 0)
 
 	if index == 0 {
@@ -188,11 +189,20 @@ func (check *Checker) handleError(index int, pos syntax.Pos, code Code, msg stri
 	}
 
 	e := Error{
-		Pos:  pos,
-		Msg:  stripAnnotations(msg),
-		Full: msg,
-		Soft: soft,
-		Code: code,
+		Pos:      pos,
+		Msg:      stripAnnotations(msg),
+		Full:     msg,
+		Soft:     soft,
+		Code:     code,
+		Severity: severity,
+	}
+
+	// Warnings don't make type-checking fail.
+	if severity == SeverityWarning {
+		if f := check.conf.Error; f != nil {
+			f(e)
+		}
+		return
 	}
 
 	if check.firstErr == nil {
@@ -235,6 +245,16 @@ func (check *Checker) softErrorf(at poser, code Code, format string, args ...any
 	err.report()
 }
 
+// warnf reports a warning. Warnings are only reported in Wo files,
+// for diagnostics that are (soft) errors in Go files.
+func (check *Checker) warnf(at poser, code Code, format string, args ...any) {
+	err := check.newError(code)
+	err.addf(at, format, args...)
+	err.soft = true
+	err.severity = SeverityWarning
+	err.report()
+}
+
 func (check *Checker) versionErrorf(at poser, v goVersion, format string, args ...any) {
 	msg := check.sprintf(format, args...)
 	err := check.newError(UnsupportedFeature)
@@ -242,6 +262,13 @@ func (check *Checker) versionErrorf(at poser, v goVersion, format string, args .
 	err.report()
 }
 
+func (check *Checker) woErrorf(at poser, f syntax.Features, format string, args ...any) {
+	msg := check.sprintf(format, args...)
+	err := check.newError(UnsupportedFeature)
+	err.addf(at, "%s requires Wo feature %s, which is disabled", msg, f)
+	err.report()
+}
+
 // atPos reports the left (= start) position of at.
 func atPos(at poser) syntax.Pos {
 	switch x := at.(type) {
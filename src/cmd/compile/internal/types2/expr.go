@@ -127,6 +127,142 @@ var op2str2 = [...]string{
 	syntax.Shl: "shift",
 }
 
+// unwrap typechecks the Wo postfix expression e.X?. If e.X denotes a type,
+// the result is the optional type e.X?. Otherwise e.X must be a value of
+// optional type, a comma-ok expression, or a call returning a value and a
+// boolean, and the result is the present value: if the value is absent,
+// the enclosing function returns None (and zero values for any other
+// results).
+func (check *Checker) unwrap(x *operand, e *syntax.Operation) {
+	check.rawExpr(nil, x, e.X, nil, false)
+	check.exclude(x, 1<<novalue|1<<builtin)
+	if x.mode == invalid {
+		return
+	}
+	if x.mode == typexpr {
+		check.validVarType(e.X, x.typ)
+		if !check.verifyWof(e, syntax.WoOptional, "optional type") {
+			x.mode = invalid
+			return
+		}
+		x.typ = &Optional{elem: x.typ}
+		return
+	}
+	if !check.verifyWof(e.Pos(), syntax.WoOptional, "? operator") {
+		x.mode = invalid
+		return
+	}
+
+	var elem Type
+	if t, _ := x.typ.(*Tuple); t != nil {
+		if t.Len() == 2 && isBoolean(t.vars[1].typ) {
+			elem = t.vars[0].typ
+		}
+	} else if x.mode == mapindex || x.mode == commaok {
+		elem = x.typ
+		check.recordCommaOkTypes(e.X, []*operand{x, {mode: value, expr: e.X, typ: Typ[Bool]}})
+	} else if u, _ := under(x.typ).(*Optional); u != nil {
+		elem = u.elem
+	}
+	if elem == nil {
+		check.errorf(x, InvalidUnwrap, invalidOp+"cannot unwrap %s: not an optional value or comma-ok expression", x)
+		x.mode = invalid
+		return
+	}
+
+	// The enclosing function must be able to return None.
+	switch {
+	case check.sig == nil:
+		check.error(e.Pos(), InvalidUnwrap, "? operator outside function")
+		x.mode = invalid
+		return
+	case check.inRangeFunc:
+		check.error(e.Pos(), InvalidUnwrap, "cannot use ? operator in range-over-func loop body")
+		x.mode = invalid
+		return
+	case !hasOptionalResult(check.sig):
+		check.error(e.Pos(), InvalidUnwrap, "cannot use ? operator in function without optional (last) result")
+		x.mode = invalid
+		return
+	}
+
+	x.mode = value
+	x.typ = elem
+}
+
+// propagate typechecks the Wo error propagation x!, where x is an
+// error or a call returning at most one value followed by an error.
+// If the error is not nil, x! returns it (with zero values for the
+// other results) from the enclosing function.
+func (check *Checker) propagate(x *operand, e *syntax.Operation) {
+	check.rawExpr(nil, x, e.X, nil, false)
+	check.exclude(x, 1<<novalue|1<<builtin|1<<typexpr)
+	if x.mode == invalid {
+		return
+	}
+	if !check.verifyWof(e.Pos(), syntax.WoResult, "! operator") {
+		x.mode = invalid
+		return
+	}
+
+	vals := []Type{x.typ}
+	if t, _ := x.typ.(*Tuple); t != nil {
+		vals = vals[:0]
+		for _, v := range t.vars {
+			vals = append(vals, v.typ)
+		}
+	}
+	if !Identical(vals[len(vals)-1], universeError) {
+		check.errorf(x, InvalidPropagate, invalidOp+"cannot use ! operator on %s: last value is not an error", x)
+		x.mode = invalid
+		return
+	}
+	if len(vals) > 2 {
+		check.errorf(x, InvalidPropagate, invalidOp+"cannot use ! operator on %s: more than one value besides the error", x)
+		x.mode = invalid
+		return
+	}
+
+	// The enclosing function must be able to return the error.
+	switch {
+	case check.sig == nil:
+		check.error(e.Pos(), InvalidPropagate, "! operator outside function")
+		x.mode = invalid
+		return
+	case check.inRangeFunc:
+		check.error(e.Pos(), InvalidPropagate, "cannot use ! operator in range-over-func loop body")
+		x.mode = invalid
+		return
+	case !hasErrorResult(check.sig):
+		check.error(e.Pos(), InvalidPropagate, "cannot use ! operator in function without error (last) result")
+		x.mode = invalid
+		return
+	}
+
+	if len(vals) == 1 {
+		x.mode = novalue
+		x.typ = nil
+	} else {
+		x.mode = value
+		x.typ = vals[0]
+	}
+	return
+}
+
+// isNone reports whether x is the predeclared Wo value None.
+func (check *Checker) isNone(x *operand) bool {
+	if name, _ := syntax.Unparen(x.expr).(*syntax.Name); name != nil {
+		return check.lookup(name.Value) == universeNone
+	}
+	return false
+}
+
+// allowOptional reports whether the Wo optional feature is enabled
+// for the file containing at.
+func (check *Checker) allowOptional(at poser) bool {
+	return check.allowWo(at, syntax.WoOptional)
+}
+
 func (check *Checker) unary(x *operand, e *syntax.Operation) {
 	check.expr(nil, x, e.X)
 	if x.mode == invalid {
@@ -323,6 +459,11 @@ func (check *Checker) updateExprType(x syntax.Expr, typ Type, final bool) {
 			check.updateExprType(x.Y, typ, final)
 		}
 
+	case *syntax.CondExpr:
+		// The branch types match the result type.
+		check.updateExprType(x.Then, typ, final)
+		check.updateExprType(x.Else, typ, final)
+
 	default:
 		panic("unreachable")
 	}
@@ -394,13 +535,17 @@ func (check *Checker) implicitTypeAndValue(x *operand, target Type) (Type, const
 
 	if x.isNil() {
 		assert(isUntyped(x.typ))
-		if hasNil(target) {
+		if hasNil(target) && (!check.isNone(x) || isOptional(target)) {
 			return target, nil, 0
 		}
 		return nil, nil, InvalidUntypedConversion
 	}
 
 	switch u := under(target).(type) {
+	case *Optional:
+		// An untyped value is converted to the element type;
+		// the resulting value is present.
+		return check.implicitTypeAndValue(x, u.elem)
 	case *Basic:
 		if x.mode == constant_ {
 			v, code := check.representation(x, u)
@@ -447,8 +592,9 @@ func (check *Checker) implicitTypeAndValue(x *operand, target Type) (Type, const
 		}
 		// Update operand types to the default type rather than the target
 		// (interface) type: values must have concrete dynamic types.
-		// Untyped nil was handled upfront.
-		if !u.Empty() {
+		// Untyped nil was handled upfront. In Wo files, union types
+		// accept untyped values whose default type is in their type set.
+		if !u.Empty() && !(u.NumMethods() == 0 && check.allowWo(x, syntax.WoInterface)) {
 			return nil, nil, InvalidUntypedConversion // cannot assign untyped values to non-empty interfaces
 		}
 		return Default(x.typ), nil, 0 // default type for nil is nil
@@ -586,7 +732,7 @@ Error:
 // If there is no more specific cause, the result is "".
 func (check *Checker) incomparableCause(typ Type) string {
 	switch under(typ).(type) {
-	case *Slice, *Signature, *Map:
+	case *Slice, *Signature, *Map, *Set, *Optional:
 		return compositeKind(typ) + " can only be compared to nil"
 	}
 	// see if we can extract a more specific error
@@ -911,6 +1057,56 @@ func (check *Checker) matchTypes(x, y *operand) {
 	}
 }
 
+// condExpr type-checks the Wo conditional expression e and sets x to the result.
+// Both branches must be assignable to a common type, which becomes the type of
+// the result. As for binary operations, an untyped branch is converted to the
+// type of the other branch, so untyped constants are defaulted consistently.
+func (check *Checker) condExpr(T *target, x *operand, e *syntax.CondExpr) {
+	check.verifyWof(e, syntax.WoTernary, "conditional expression")
+
+	var c operand
+	check.expr(nil, &c, e.Cond)
+	if c.mode != invalid && !allBoolean(c.typ) {
+		check.error(e.Cond, InvalidCond, "non-boolean condition in conditional expression")
+	}
+
+	var y operand
+	check.expr(T, x, e.Then)
+	check.expr(T, &y, e.Else)
+	if x.mode == invalid {
+		return
+	}
+	if y.mode == invalid {
+		x.mode = invalid
+		x.expr = y.expr
+		return
+	}
+
+	check.matchTypes(x, &y)
+	if x.mode == invalid {
+		return
+	}
+
+	if !Identical(x.typ, y.typ) {
+		if ok, _ := y.assignableTo(check, x.typ, nil); ok {
+			// x.typ is the common type
+		} else if ok, _ := x.assignableTo(check, y.typ, nil); ok {
+			x.typ = y.typ
+		} else {
+			if isValid(x.typ) && isValid(y.typ) {
+				check.errorf(e, MismatchedTypes, "mismatched types %s and %s in conditional expression", x.typ, y.typ)
+			}
+			x.mode = invalid
+			return
+		}
+	}
+
+	if !x.isNil() || !y.isNil() {
+		x.mode = value
+	}
+	x.val = nil
+}
+
 // exprKind describes the kind of an expression; the kind
 // determines if an expression is valid in 'statement context'.
 type exprKind int
@@ -976,7 +1172,7 @@ func (check *Checker) nonGeneric(T *target, x *operand) {
 	}
 	var what string
 	switch t := x.typ.(type) {
-	case *Alias, *Named:
+	case *Alias, *Named, *Set:
 		if isGeneric(t) {
 			what = "type"
 		}
@@ -1036,6 +1232,18 @@ func (check *Checker) exprInternal(T *target, x *operand, e syntax.Expr, hint Ty
 			goto Error
 		}
 
+	case *syntax.LambdaExpr:
+		var sig *Signature
+		if T != nil {
+			sig = T.sig
+		} else if hint != nil {
+			sig, _ = under(hint).(*Signature)
+		}
+		check.lambda(x, e, sig)
+		if x.mode == invalid {
+			goto Error
+		}
+
 	case *syntax.CompositeLit:
 		check.compositeLit(x, e, hint)
 		if x.mode == invalid {
@@ -1163,6 +1371,25 @@ func (check *Checker) exprInternal(T *target, x *operand, e syntax.Expr, hint Ty
 				break
 			}
 
+			if e.Op == syntax.Question {
+				// Wo optional type or unwrap
+				check.unwrap(x, e)
+				if x.mode == invalid {
+					goto Error
+				}
+				break
+			}
+
+			if e.Op == syntax.Errable {
+				// Wo error propagation
+				check.propagate(x, e)
+				if x.mode == invalid {
+					goto Error
+				}
+				x.expr = e
+				return statement // error propagation may appear in statement context
+			}
+
 			check.unary(x, e)
 			if x.mode == invalid {
 				goto Error
@@ -1180,6 +1407,12 @@ func (check *Checker) exprInternal(T *target, x *operand, e syntax.Expr, hint Ty
 			goto Error
 		}
 
+	case *syntax.CondExpr:
+		check.condExpr(T, x, e)
+		if x.mode == invalid {
+			goto Error
+		}
+
 	case *syntax.KeyValueExpr:
 		// key:value expressions are handled in composite literals
 		check.error(e, InvalidSyntaxTree, "no key:value expected")
@@ -1253,6 +1486,22 @@ func keyVal(x constant.Value) interface{} {
 
 // typeAssertion checks x.(T). The type of x must be an interface.
 func (check *Checker) typeAssertion(e syntax.Expr, x *operand, T Type, typeSwitch bool) {
+	// Values of Wo union types are represented like other interface
+	// values, so only the types of their terms can be matched at run
+	// time, in type switches.
+	if t, _ := under(T).(*Interface); t != nil && t.typeSet().hasTerms() && check.allowWo(e, syntax.WoInterface) {
+		if !typeSwitch {
+			check.errorf(e, InvalidAssert, "cannot assert to union type %s (use a type switch)", T)
+			return
+		}
+		for _, term := range t.typeSet().terms {
+			if term.tilde {
+				check.errorf(e, InvalidAssert, "cannot match union type %s with ~ terms in type switch", T)
+				return
+			}
+		}
+	}
+
 	var cause string
 	if check.assertableTo(x.typ, T, &cause) {
 		return // success
@@ -1288,16 +1537,23 @@ func (check *Checker) genericExpr(x *operand, e syntax.Expr) {
 // If allowCommaOk is set and e is a map index, comma-ok, or comma-err
 // expression, the result is a two-element list containing the value
 // of e, and an untyped bool value or an error value, respectively.
+// If want > 0 and e is a call of a Wo function with skippable results,
+// these results are omitted from list if exactly want results remain
+// (see Checker.unskipped).
 // If an error occurred, list[0] is not valid.
-func (check *Checker) multiExpr(e syntax.Expr, allowCommaOk bool) (list []*operand, commaOk bool) {
+func (check *Checker) multiExpr(e syntax.Expr, allowCommaOk bool, want int) (list []*operand, commaOk bool) {
 	var x operand
 	check.rawExpr(nil, &x, e, nil, false)
 	check.exclude(&x, 1<<novalue|1<<builtin|1<<typexpr)
 
 	if t, ok := x.typ.(*Tuple); ok && x.mode != invalid {
 		// multiple values
-		list = make([]*operand, t.Len())
-		for i, v := range t.vars {
+		vars := t.vars
+		if want > 0 && want < len(vars) {
+			vars = check.unskipped(e, vars, want)
+		}
+		list = make([]*operand, len(vars))
+		for i, v := range vars {
 			list[i] = &operand{mode: value, expr: e, typ: v.typ}
 		}
 		return
@@ -100,6 +100,21 @@ func (check *Checker) indexExpr(x *operand, e *syntax.IndexExpr) (isFuncInst boo
 		x.expr = e
 		return false
 
+	case *Set:
+		// s[x] reports whether x is an element of s
+		index := check.singleIndex(e)
+		if index == nil {
+			x.mode = invalid
+			return false
+		}
+		var elem operand
+		check.expr(nil, &elem, index)
+		check.assignment(&elem, typ.elem, "set index")
+		x.mode = value
+		x.typ = Typ[Bool]
+		x.expr = e
+		return false
+
 	case *Interface:
 		if !isTypeParam(x.typ) {
 			break
@@ -0,0 +1,106 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements typechecking of Wo function literals
+// of the form x -> e.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	. "internal/types/errors"
+)
+
+// lambda type-checks the Wo function literal e and sets x to the result.
+// The literal takes its parameter and result types from the function
+// type T required by the context, much like an untyped constant takes
+// its type from the context; T is nil if there is no such type.
+func (check *Checker) lambda(x *operand, e *syntax.LambdaExpr, T *Signature) {
+	x.mode = invalid
+	if !check.verifyWof(e, syntax.WoArrow, "function literal %s", syntax.Expr(e)) {
+		return
+	}
+
+	if T == nil {
+		check.errorf(e, InvalidLambda, "cannot infer parameter types of function literal %s", syntax.Expr(e))
+		check.invalidLambda(e)
+		return
+	}
+	if n := T.params.Len(); len(e.ParamList) != n {
+		check.errorf(e, InvalidLambda, "cannot use function literal %s as %s value: have %d parameters, want %d", syntax.Expr(e), T, len(e.ParamList), n)
+		check.invalidLambda(e)
+		return
+	}
+
+	// The parameters are in scope in the body of the literal.
+	scope := NewScope(check.scope, e.Pos(), endPos(e), "function")
+	scopePos := startPos(e.Body)
+	var params, results []*Var
+	for i, name := range e.ParamList {
+		par := NewParam(name.Pos(), check.pkg, name.Value, T.params.At(i).typ)
+		check.declare(scope, name, par, scopePos)
+		params = append(params, par)
+	}
+	for i := range T.results.Len() {
+		results = append(results, NewParam(scopePos, check.pkg, "", T.results.At(i).typ))
+	}
+	sig := &Signature{
+		scope:    scope,
+		params:   NewTuple(params...),
+		results:  NewTuple(results...),
+		variadic: T.variadic,
+	}
+
+	if !check.conf.IgnoreFuncBodies {
+		// Like function literals, lambdas are checked later
+		// (see Checker.funcLit).
+		body := lambdaBody(e, len(results) > 0)
+		decl := check.decl
+		iota := check.iota
+		check.later(func() {
+			check.funcBody(decl, "<function literal>", sig, body, iota)
+		}).describef(e, "function literal")
+	}
+
+	x.mode = value
+	x.typ = sig
+}
+
+// invalidLambda type-checks the body of the Wo function literal e
+// whose type cannot be determined. The parameters have invalid type,
+// so that only errors independent of them are reported; but the body
+// is checked, so that it doesn't cause follow-up errors such as unused
+// imports.
+func (check *Checker) invalidLambda(e *syntax.LambdaExpr) {
+	scope := NewScope(check.scope, e.Pos(), endPos(e), "function")
+	scopePos := startPos(e.Body)
+	for _, name := range e.ParamList {
+		check.declare(scope, name, NewParam(name.Pos(), check.pkg, name.Value, Typ[Invalid]), scopePos)
+	}
+
+	if !check.conf.IgnoreFuncBodies {
+		// see Checker.funcBody
+		env := environment{
+			decl:    check.decl,
+			scope:   scope,
+			version: check.version,
+			iota:    check.iota,
+			sig:     &Signature{scope: scope},
+		}
+		check.later(func() {
+			defer func(env environment) {
+				check.environment = env
+			}(check.environment)
+			check.environment = env
+			check.use(e.Body)
+		}).describef(e, "function literal")
+	}
+}
+
+// isLambda reports whether x is a Wo function literal whose
+// type has not been determined yet (see Checker.genericExprList).
+func isLambda(x *operand) bool {
+	_, ok := x.expr.(*syntax.LambdaExpr)
+	return ok && x.mode == value && x.typ == nil
+}
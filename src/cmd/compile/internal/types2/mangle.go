@@ -0,0 +1,169 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the mangled names of overloaded Wo functions.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	"fmt"
+	"strings"
+)
+
+// overloadName returns the mangled name of the overloaded function name
+// with the function type ftyp. The mangled name consists of name, an
+// underscore, and the spellings of the parameter types (see
+// writeTypeSpelling):
+//
+//	func write(s string)                      // write_6string
+//	func write(f Formatter, s string)         // write_9Formatter6string
+//	func write(w *os.File, args ...any)       // write_ptrpkg2os4Filedots3any
+//	func write()                              // write_
+//
+// Spellings are self-delimiting, so overloads with different parameter
+// type expressions have different mangled names. Mangled names only
+// depend on the declaration of the function, so they don't change if
+// other overloads are added or removed.
+func overloadName(name string, ftyp *syntax.FuncType) string {
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteByte('_')
+	for _, f := range ftyp.ParamList {
+		writeTypeSpelling(&b, f.Type)
+	}
+	return b.String()
+}
+
+// writeTypeSpelling writes the spelling of the type expression e for
+// mangled names of overloaded functions. An identifier is spelled as
+// its length followed by the identifier (see writeIdentSpelling), and
+// other types as a keyword followed by the spellings of their parts:
+//
+//	T, p.T              1T, pkg1p1T
+//	*T, []T, ...T       ptr1T, slice1T, dots1T
+//	[N]T                array1N1T (see writeStringSpelling)
+//	map[K]V             map1K1V
+//	chan T              chan1T (sendchan1T, recvchan1T for chan<- T, <-chan T)
+//	T?, T!              opt1T, result1T
+//	A | B, ~T           or1A1B, tilde1T
+//	G[A, B]             inst2_1G1A1B
+//	interface{}         any
+//
+// Function, struct, and interface types are spelled func, struct, and
+// interface followed by their parameters and results, fields, or
+// elements (see writeFieldSpellings). Expressions that are not types
+// are spelled type.
+func writeTypeSpelling(b *strings.Builder, e syntax.Expr) {
+	switch e := e.(type) {
+	case *syntax.Name:
+		writeIdentSpelling(b, e.Value)
+	case *syntax.SelectorExpr:
+		x, _ := e.X.(*syntax.Name)
+		if x == nil {
+			b.WriteString("type")
+			return
+		}
+		b.WriteString("pkg")
+		writeIdentSpelling(b, x.Value)
+		writeIdentSpelling(b, e.Sel.Value)
+	case *syntax.ParenExpr:
+		writeTypeSpelling(b, e.X)
+	case *syntax.IndexExpr:
+		args := syntax.UnpackListExpr(e.Index)
+		fmt.Fprintf(b, "inst%d_", len(args))
+		writeTypeSpelling(b, e.X)
+		for _, a := range args {
+			writeTypeSpelling(b, a)
+		}
+	case *syntax.Operation:
+		switch {
+		case e.Op == syntax.Mul && e.Y == nil:
+			b.WriteString("ptr")
+		case e.Op == syntax.Tilde && e.Y == nil:
+			b.WriteString("tilde")
+		case e.Op == syntax.Question:
+			b.WriteString("opt")
+		case e.Op == syntax.Errable:
+			b.WriteString("result")
+		case e.Op == syntax.Or && e.Y != nil:
+			b.WriteString("or")
+			writeTypeSpelling(b, e.X)
+			writeTypeSpelling(b, e.Y)
+			return
+		default:
+			b.WriteString("type")
+			return
+		}
+		writeTypeSpelling(b, e.X)
+	case *syntax.SliceType:
+		b.WriteString("slice")
+		writeTypeSpelling(b, e.Elem)
+	case *syntax.ArrayType:
+		b.WriteString("array")
+		if e.Len == nil {
+			writeStringSpelling(b, "...")
+		} else {
+			writeStringSpelling(b, syntax.String(e.Len))
+		}
+		writeTypeSpelling(b, e.Elem)
+	case *syntax.DotsType:
+		b.WriteString("dots")
+		writeTypeSpelling(b, e.Elem)
+	case *syntax.MapType:
+		b.WriteString("map")
+		writeTypeSpelling(b, e.Key)
+		writeTypeSpelling(b, e.Value)
+	case *syntax.ChanType:
+		switch e.Dir {
+		case syntax.SendOnly:
+			b.WriteString("sendchan")
+		case syntax.RecvOnly:
+			b.WriteString("recvchan")
+		default:
+			b.WriteString("chan")
+		}
+		writeTypeSpelling(b, e.Elem)
+	case *syntax.FuncType:
+		b.WriteString("func")
+		writeFieldSpellings(b, e.ParamList, false)
+		writeFieldSpellings(b, e.ResultList, false)
+	case *syntax.StructType:
+		b.WriteString("struct")
+		writeFieldSpellings(b, e.FieldList, true)
+		for i := range e.FieldList {
+			var tag string
+			if i < len(e.TagList) && e.TagList[i] != nil {
+				tag = e.TagList[i].Value
+			}
+			writeStringSpelling(b, tag)
+		}
+	case *syntax.InterfaceType:
+		if len(e.MethodList) == 0 {
+			b.WriteString("any")
+			return
+		}
+		b.WriteString("interface")
+		writeFieldSpellings(b, e.MethodList, true)
+	default:
+		b.WriteString("type")
+	}
+}
+
+// writeFieldSpellings writes the number of fields in list, an
+// underscore, and for each field the spelling of its name (if names is
+// set; embedded fields have an empty name) and of its type.
+func writeFieldSpellings(b *strings.Builder, list []*syntax.Field, names bool) {
+	fmt.Fprintf(b, "%d_", len(list))
+	for _, f := range list {
+		if names {
+			var name string
+			if f.Name != nil {
+				name = f.Name.Value
+			}
+			writeIdentSpelling(b, name)
+		}
+		writeTypeSpelling(b, f.Type)
+	}
+}
@@ -234,6 +234,17 @@ func (w *monoGraph) assign(pkg *Package, pos syntax.Pos, tpar *TypeParam, targ T
 		case *Map:
 			do(typ.Key())
 			do(typ.Elem())
+		case *Set:
+			do(typ.Elem())
+		case *Optional:
+			do(typ.Elem())
+		case *Enum:
+			for i := 0; i < typ.NumVariants(); i++ {
+				v := typ.Variant(i)
+				for j := 0; j < v.NumFields(); j++ {
+					do(v.Field(j).Type())
+				}
+			}
 		case *Pointer:
 			do(typ.Elem())
 		case *Slice:
@@ -213,6 +213,9 @@ func (n *Named) resolve() *Named {
 		assert(n.TypeArgs().Len() == 0) // instances are created by instantiation, in which case n.loader is nil
 
 		tparams, underlying, methods := n.loader(n)
+		if t, _ := underlying.(*Enum); t != nil {
+			t.setType(n)
+		}
 
 		n.tparams = bindTParams(tparams)
 		n.underlying = underlying
@@ -424,7 +427,11 @@ func (t *Named) expandMethod(i int) *Func {
 		if check != nil {
 			ctxt = check.context()
 		}
-		sig = check.subst(origm.pos, origSig, smap, t, ctxt).(*Signature)
+		if origSig.TypeParams().Len() > 0 {
+			sig = check.substMethodTParams(origm.pos, origSig, smap, t, ctxt)
+		} else {
+			sig = check.subst(origm.pos, origSig, smap, t, ctxt).(*Signature)
+		}
 	}
 
 	if sig == origSig {
@@ -456,6 +463,9 @@ func (t *Named) SetUnderlying(underlying Type) {
 		panic("underlying type must not be *Named")
 	}
 	t.resolve().underlying = underlying
+	if e, _ := underlying.(*Enum); e != nil {
+		e.setType(t)
+	}
 	if t.fromRHS == nil {
 		t.fromRHS = underlying // for cycle detection
 	}
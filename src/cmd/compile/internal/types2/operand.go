@@ -246,6 +246,12 @@ func compositeKind(typ Type) string {
 		return "interface"
 	case *Map:
 		return "map"
+	case *Set:
+		return "set"
+	case *Optional:
+		return "optional"
+	case *Enum:
+		return "enum"
 	case *Chan:
 		return "chan"
 	case *Tuple:
@@ -390,6 +396,14 @@ func (x *operand) assignableTo(check *Checker, T Type, cause *string) (bool, Cod
 		}
 	}
 
+	// T is an optional type (but not a type parameter) and
+	// x is assignable to the element type of T.
+	if To, _ := Tu.(*Optional); To != nil && Tp == nil {
+		if ok, _ := x.assignableTo(check, To.elem, nil); ok {
+			return true, 0
+		}
+	}
+
 	// optimization: if we don't have type parameters, we're done
 	if Vp == nil && Tp == nil {
 		return false, IncompatibleAssign
@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types2
+
+// An Optional represents a Wo optional type T?.
+//
+// A value of optional type either holds a value of the element
+// type T or is absent (None). The zero value of an optional type
+// is None.
+type Optional struct {
+	elem Type
+}
+
+// NewOptional returns a new optional type for the given element type.
+func NewOptional(elem Type) *Optional {
+	return &Optional{elem: elem}
+}
+
+// Elem returns the element type of optional o.
+func (o *Optional) Elem() Type { return o.elem }
+
+func (t *Optional) Underlying() Type { return t }
+func (t *Optional) String() string   { return TypeString(t, nil) }
@@ -0,0 +1,339 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements typechecking of overloaded Wo functions
+// and the resolution of calls to them.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	"fmt"
+	. "internal/types/errors"
+	"strings"
+)
+
+// overloadedFuncs returns the sets of overloaded functions declared
+// in the package, indexed by their name. The sets are still empty;
+// the resolver adds their functions as it declares them.
+//
+// A package-level function is overloaded if it is declared more than
+// once, and all its declarations are in Wo files that may use the
+// overload feature. Functions named init, main in package main, or _
+// cannot be overloaded.
+func (check *Checker) overloadedFuncs() map[string]*Overload {
+	decls := make(map[string][]*syntax.FuncDecl)
+	var names []string // in order of first declaration
+	for _, file := range check.files {
+		for _, decl := range file.DeclList {
+			s, _ := decl.(*syntax.FuncDecl)
+			if s == nil || s.Recv != nil {
+				continue
+			}
+			name := s.Name.Value
+			if name == "_" || name == "init" || name == "main" && check.pkg.name == "main" {
+				continue
+			}
+			if decls[name] == nil {
+				names = append(names, name)
+			}
+			decls[name] = append(decls[name], s)
+		}
+	}
+
+	var overloads map[string]*Overload
+	for _, name := range names {
+		list := decls[name]
+		if len(list) < 2 {
+			continue
+		}
+		ok := true
+		for _, s := range list {
+			if !check.isWo(s) {
+				// Go files cannot overload functions; the
+				// redeclarations are reported as usual.
+				ok = false
+				break
+			}
+		}
+		for _, s := range list {
+			if ok && !check.verifyWof(s.Name, syntax.WoOverload, "function overloading") {
+				ok = false
+			}
+		}
+		if !ok {
+			continue
+		}
+		for _, s := range list {
+			if tparam := firstTypeParam(s); tparam != nil {
+				check.errorf(tparam, InvalidOverload, "overloaded function %s cannot have type parameters", name)
+			}
+		}
+		if overloads == nil {
+			overloads = make(map[string]*Overload)
+		}
+		overloads[name] = NewOverload(list[0].Name.Pos(), check.pkg, name, nil)
+	}
+	return overloads
+}
+
+// writeIdentSpelling writes the spelling of the identifier name: its
+// length in bytes followed by name.
+func writeIdentSpelling(b *strings.Builder, name string) {
+	fmt.Fprintf(b, "%d%s", len(name), name)
+}
+
+// writeStringSpelling writes the spelling of the string s, which need
+// not be an identifier: the spelling of the identifier that is s with
+// each byte other than an ASCII letter or digit replaced by an
+// underscore followed by two hexadecimal digits.
+func writeStringSpelling(b *strings.Builder, s string) {
+	var ident strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' {
+			ident.WriteByte(c)
+		} else {
+			fmt.Fprintf(&ident, "_%02x", c)
+		}
+	}
+	writeIdentSpelling(b, ident.String())
+}
+
+// declareOverload declares the function obj of the set of overloaded
+// functions ov in the package scope, under its mangled name.
+func (check *Checker) declareOverload(ov *Overload, ident *syntax.Name, obj *Func) {
+	for _, f := range ov.funcs {
+		if f.name == obj.name {
+			err := check.newError(DuplicateDecl)
+			err.addf(obj, "%s redeclared in this block (overloads must differ in their parameter types)", ov.name)
+			err.addAltDecl(f)
+			err.report()
+			check.recordDef(ident, obj)
+			return
+		}
+	}
+	if len(ov.funcs) == 0 {
+		ov.vis = obj.vis
+	} else if obj.vis != ov.vis {
+		check.errorf(ident, InvalidOverload, "overloads of %s must have the same visibility modifier", ov.name)
+	}
+	ov.funcs = append(ov.funcs, obj)
+	check.declare(check.pkg.scope, ident, obj, nopos)
+}
+
+// overload returns the set of overloaded Wo functions denoted by the
+// identifier or qualified identifier e, or nil. If e is a qualified
+// identifier, the use of the package name is recorded.
+func (check *Checker) overload(e syntax.Expr) *Overload {
+	switch e := e.(type) {
+	case *syntax.Name:
+		scope, obj := check.lookupScope(e.Value)
+		ov, _ := obj.(*Overload)
+		if ov == nil {
+			return nil
+		}
+		if pkgName := check.dotImportMap[dotImportKey{scope, ov.name}]; pkgName != nil {
+			pkgName.used = true
+		}
+		return ov
+
+	case *syntax.SelectorExpr:
+		ident, _ := e.X.(*syntax.Name)
+		if ident == nil {
+			return nil
+		}
+		pname, _ := check.lookup(ident.Value).(*PkgName)
+		if pname == nil {
+			return nil
+		}
+		ov, _ := pname.imported.scope.Lookup(e.Sel.Value).(*Overload)
+		if ov == nil {
+			return nil
+		}
+		check.recordUse(ident, pname)
+		pname.used = true
+		if !check.visible(ov) {
+			check.unexportedNameError(e.Sel, ov)
+		}
+		return ov
+	}
+	return nil
+}
+
+// overloadCall type-checks the call of one of the overloaded Wo
+// functions ov. The function is chosen by the number and types of
+// the arguments among the functions to whose parameters the arguments
+// can be passed: functions whose parameter types are identical to the
+// (default) types of the arguments are preferred, and among those,
+// functions that are not variadic. The call is ambiguous if there is
+// more than one such function. The use of the function name is
+// recorded as a use of the chosen function.
+func (check *Checker) overloadCall(x *operand, call *syntax.CallExpr, ov *Overload) exprKind {
+	x.mode = invalid
+	x.expr = call
+
+	name, _ := call.Fun.(*syntax.Name)
+	if sel, _ := call.Fun.(*syntax.SelectorExpr); sel != nil {
+		name = sel.Sel
+	}
+	if !check.allowWo(call, syntax.WoOverload) {
+		check.use(call.ArgList...)
+		check.overloadUseError(name, ov)
+		return statement
+	}
+
+	args, atargs, atxlist := check.genericExprList(call.ArgList)
+	for _, a := range args {
+		if a.mode == invalid {
+			return statement
+		}
+	}
+
+	ddd := hasDots(call)
+	var matches []*Func // functions with the best match
+	best := noMatch
+	for _, f := range ov.funcs {
+		if f.typ == nil && f.pkg == check.pkg {
+			check.objDecl(f, nil)
+		}
+		sig, _ := f.typ.(*Signature)
+		if sig == nil || sig.TypeParams().Len() > 0 {
+			continue
+		}
+		switch m := check.overloadMatch(sig, args, ddd); {
+		case m > best:
+			matches = []*Func{f}
+			best = m
+		case m == best && m != noMatch:
+			matches = append(matches, f)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		err := check.newError(NoMatchingOverload)
+		err.addf(call, "cannot call overloaded function %s with arguments %s", ov.name, check.typesSummary(operandTypes(args), false, ddd))
+		for _, f := range ov.funcs {
+			err.addf(f, "candidate %s", f)
+		}
+		err.report()
+		return statement
+	case 1:
+		// ok
+	default:
+		err := check.newError(AmbiguousOverload)
+		err.addf(call, "ambiguous call of overloaded function %s with arguments %s", ov.name, check.typesSummary(operandTypes(args), false, ddd))
+		for _, f := range matches {
+			err.addf(f, "candidate %s", f)
+		}
+		err.report()
+		return statement
+	}
+
+	f := matches[0]
+	check.recordUse(name, f)
+	check.addDeclDep(f)
+	sig := f.typ.(*Signature)
+	check.recordTypeAndValue(call.Fun, value, sig, nil)
+	sig = check.arguments(call, sig, nil, nil, args, atargs, atxlist)
+
+	switch sig.results.Len() {
+	case 0:
+		x.mode = novalue
+	case 1:
+		x.mode = value
+		x.typ = sig.results.vars[0].typ // unpack tuple
+	default:
+		x.mode = value
+		x.typ = sig.results
+	}
+	check.hasCallOrRecv = true
+	return statement
+}
+
+// An overloadMatch describes how well the arguments of a call match
+// the parameters of an overloaded function. Better matches are greater.
+type overloadMatch int
+
+const (
+	noMatch        overloadMatch = iota // arguments cannot be passed
+	assignMatch                         // arguments are assignable to the parameters
+	variadicMatch                       // arguments have the parameter types, some are passed to ... parameter or omitted
+	identicalMatch                      // arguments have the parameter types
+)
+
+// overloadMatch reports how well the arguments args match the
+// parameters of a function with signature sig. If ddd is set,
+// the last argument is followed by ...
+func (check *Checker) overloadMatch(sig *Signature, args []*operand, ddd bool) overloadMatch {
+	nargs, npars := len(args), sig.params.Len()
+	// Trailing parameters with default values may be omitted.
+	nreq := npars
+	if sig.variadic {
+		nreq--
+	}
+	for nreq > 0 && sig.Default(nreq-1) != nil && !ddd {
+		nreq--
+	}
+	switch {
+	case ddd:
+		if !sig.variadic || nargs != npars {
+			return noMatch
+		}
+	case sig.variadic:
+		if nargs < nreq {
+			return noMatch
+		}
+	default:
+		if nargs < nreq || nargs > npars {
+			return noMatch
+		}
+	}
+
+	m := identicalMatch
+	if sig.variadic && !ddd || nargs < npars {
+		// A call that relies on variadic or default parameters
+		// ranks below one that matches the parameters exactly.
+		m = variadicMatch
+	}
+	for i, a := range args {
+		var T Type
+		if sig.variadic && !ddd && i >= npars-1 {
+			T = sig.params.vars[npars-1].typ.(*Slice).elem
+		} else {
+			T = sig.params.vars[i].typ
+		}
+		if a.typ == nil || isGeneric(a.typ) {
+			// a is a Wo function literal or a generic function,
+			// whose type depends on the parameter.
+			if _, ok := under(T).(*Signature); !ok {
+				return noMatch
+			}
+			m = assignMatch
+			continue
+		}
+		if ok, _ := a.assignableTo(check, T, nil); !ok {
+			return noMatch
+		}
+		if !Identical(Default(a.typ), T) {
+			m = assignMatch
+		}
+	}
+	return m
+}
+
+// overloadUseError reports that the set of overloaded functions ov,
+// denoted by the identifier e, is used as a value, or called from a Go
+// file. Such uses must refer to the functions by their mangled names.
+func (check *Checker) overloadUseError(e *syntax.Name, ov *Overload) {
+	names := make([]string, len(ov.funcs))
+	for i, f := range ov.funcs {
+		names[i] = f.name
+	}
+	if check.allowWo(e, syntax.WoOverload) {
+		check.errorf(e, InvalidOverload, "cannot use overloaded function %s as value (use one of %s)", ov.name, strings.Join(names, ", "))
+		return
+	}
+	check.errorf(e, InvalidOverload, "cannot use overloaded function %s in Go file (use one of %s)", ov.name, strings.Join(names, ", "))
+}
@@ -18,6 +18,7 @@ type Package struct {
 	fake      bool   // scope lookup errors are silently dropped if package is fake (internal use only)
 	cgo       bool   // uses of this package will be rewritten into uses of declarations from _cgo_gotypes.go
 	goVersion string // minimum Go version required for package (by Config.GoVersion, typically from go.mod)
+	module    string // path of the module containing the package, if known
 }
 
 // NewPackage returns a new Package for the given package path and name.
@@ -42,6 +43,15 @@ func (pkg *Package) SetName(name string) { pkg.name = name }
 // as reported in the [go/ast.File.GoVersion] field.
 func (pkg *Package) GoVersion() string { return pkg.goVersion }
 
+// Module returns the path of the module containing the package.
+// Objects declared with the Wo pkg modifier are visible to the
+// packages of the same module. If the module is unknown, Module
+// returns the empty string.
+func (pkg *Package) Module() string { return pkg.module }
+
+// SetModule sets the path of the module containing the package.
+func (pkg *Package) SetModule(path string) { pkg.module = path }
+
 // Scope returns the (complete or incomplete) package scope
 // holding the objects declared at package level (TypeNames,
 // Consts, Vars, and Funcs).
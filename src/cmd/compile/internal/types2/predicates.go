@@ -145,6 +145,9 @@ func isGeneric(t Type) bool {
 	if alias, _ := t.(*Alias); alias != nil && alias.tparams != nil && alias.targs == nil {
 		return true
 	}
+	if set, _ := t.(*Set); set != nil && set.elem == nil {
+		return true // predeclared (uninstantiated) Wo set type
+	}
 	named := asNamed(t)
 	return named != nil && named.obj != nil && named.inst == nil && named.TypeParams().Len() > 0
 }
@@ -182,6 +185,18 @@ func comparableType(T Type, dynamic bool, seen map[Type]bool, reportf func(strin
 			}
 		}
 		return true
+	case *Enum:
+		for _, v := range t.variants {
+			for _, f := range v.fields {
+				if !comparableType(f.typ, dynamic, seen, nil) {
+					if reportf != nil {
+						reportf("enum containing %s cannot be compared", f.typ)
+					}
+					return false
+				}
+			}
+		}
+		return true
 	case *Array:
 		if !comparableType(t.elem, dynamic, seen, nil) {
 			if reportf != nil {
@@ -206,17 +221,55 @@ func comparableType(T Type, dynamic bool, seen map[Type]bool, reportf func(strin
 	return false
 }
 
+// isOptional reports whether t is a Wo optional type.
+func isOptional(t Type) bool {
+	_, ok := under(t).(*Optional)
+	return ok
+}
+
+// isEnum reports whether t is a Wo enum type.
+func isEnum(t Type) bool {
+	_, ok := under(t).(*Enum)
+	return ok
+}
+
+// hasOptionalResult reports whether the last result of sig
+// is of Wo optional type.
+func hasOptionalResult(sig *Signature) bool {
+	n := sig.results.Len()
+	return n > 0 && isOptional(sig.results.vars[n-1].typ)
+}
+
+// hasErrorResult reports whether the last result of sig
+// is of type error.
+func hasErrorResult(sig *Signature) bool {
+	n := sig.results.Len()
+	return n > 0 && Identical(sig.results.vars[n-1].typ, universeError)
+}
+
 // hasNil reports whether type t includes the nil value.
 func hasNil(t Type) bool {
 	switch u := under(t).(type) {
 	case *Basic:
 		return u.kind == UnsafePointer
-	case *Slice, *Pointer, *Signature, *Map, *Chan:
+	case *Slice, *Pointer, *Signature, *Map, *Set, *Optional, *Chan:
 		return true
 	case *Interface:
-		return !isTypeParam(t) || underIs(t, func(u Type) bool {
-			return u != nil && hasNil(u)
-		})
+		if isTypeParam(t) {
+			return underIs(t, func(u Type) bool {
+				return u != nil && hasNil(u)
+			})
+		}
+		// A Wo union type includes nil only if one of its terms does.
+		tset := u.typeSet()
+		if !tset.hasTerms() {
+			return true
+		}
+		for _, term := range tset.terms {
+			if hasNil(term.typ) {
+				return true
+			}
+		}
 	}
 	return false
 }
@@ -458,6 +511,23 @@ func (c *comparer) identical(x, y Type, p *ifacePair) bool {
 			return c.identical(x.key, y.key, p) && c.identical(x.elem, y.elem, p)
 		}
 
+	case *Set:
+		// Two set types are identical if they have identical element types.
+		if y, ok := y.(*Set); ok {
+			return c.identical(x.elem, y.elem, p)
+		}
+
+	case *Optional:
+		// Two optional types are identical if they have identical element types.
+		if y, ok := y.(*Optional); ok {
+			return c.identical(x.elem, y.elem, p)
+		}
+
+	case *Enum:
+		// Enum types are only declared by defined types,
+		// so two enum types are identical only if they are the same.
+		return x == y
+
 	case *Chan:
 		// Two channel types are identical if they have identical value types
 		// and the same direction.
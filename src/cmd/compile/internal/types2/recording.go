@@ -148,6 +148,7 @@ func (check *Checker) recordUse(id *syntax.Name, obj Object) {
 	if m := check.Uses; m != nil {
 		m[id] = obj
 	}
+	check.recordUninitUse(id, obj)
 }
 
 func (check *Checker) recordImplicit(node syntax.Node, obj Object) {
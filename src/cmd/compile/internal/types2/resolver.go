@@ -111,6 +111,36 @@ func (check *Checker) declarePkgObj(ident *syntax.Name, obj Object, d *declInfo)
 	obj.setOrder(uint32(len(check.objMap)))
 }
 
+// visibility returns the visibility of the package-level objects
+// declared at the given position with the Wo visibility modifier vis.
+func (check *Checker) visibility(at poser, vis syntax.Visibility) Visibility {
+	if vis == syntax.DefaultVis || !check.verifyWof(at, syntax.WoExport, "%s modifier", vis) {
+		return DefaultVis
+	}
+	if vis == syntax.PkgVis {
+		return PkgVis
+	}
+	return ExportVis
+}
+
+// visible reports whether the package-level object obj of an
+// imported package may be referred to by the package being checked.
+// Objects declared with a Wo pkg modifier are visible to the packages
+// of the same module.
+func (check *Checker) visible(obj Object) bool {
+	return obj.Exported() || obj.Visibility() == PkgVis && obj.Pkg().module == check.pkg.module
+}
+
+// unexportedNameError reports that the package-level object obj of
+// an imported package, denoted by the selector sel, is not visible.
+func (check *Checker) unexportedNameError(sel *syntax.Name, obj Object) {
+	if module := obj.Pkg().module; obj.Visibility() == PkgVis && module != "" {
+		check.errorf(sel, UnexportedName, "name %s is only visible in module %s", sel.Value, module)
+		return
+	}
+	check.errorf(sel, UnexportedName, "name %s not exported by package %s", sel.Value, obj.Pkg().name)
+}
+
 // filename returns a filename suitable for debugging output.
 func (check *Checker) filename(fileNo int) string {
 	file := check.files[fileNo]
@@ -220,6 +250,11 @@ func (check *Checker) collectObjects() {
 	}
 	var methods []methodInfo // collected methods with valid receivers and non-blank _ names
 
+	// Overloaded Wo functions are declared under their mangled names;
+	// the sets of overloaded functions are declared after all files
+	// have been processed.
+	overloads := check.overloadedFuncs()
+
 	fileScopes := make([]*Scope, len(check.files)) // fileScopes[i] corresponds to check.files[i]
 	for fileNo, file := range check.files {
 		check.version = asGoVersion(check.versions[file.Pos().FileBase()])
@@ -307,12 +342,13 @@ func (check *Checker) collectObjects() {
 					}
 					// merge imported scope with file scope
 					for name, obj := range imp.scope.elems {
-						// Note: Avoid eager resolve(name, obj) here, so we only
-						// resolve dot-imported objects as needed.
+						// Note: The objects must be resolved to determine their
+						// visibility, which may be set by Wo visibility modifiers.
+						obj = resolve(name, obj)
 
 						// A package scope may contain non-exported objects,
 						// do not import them!
-						if isExported(name) {
+						if check.visible(obj) {
 							// declare dot-imported object
 							// (Do not use check.declare because it modifies the object
 							// via Object.setScopePos, which leads to a race condition;
@@ -356,8 +392,10 @@ func (check *Checker) collectObjects() {
 
 				// declare all constants
 				values := syntax.UnpackListExpr(last.Values)
+				vis := check.visibility(s, s.Vis)
 				for i, name := range s.NameList {
 					obj := NewConst(name.Pos(), pkg, name.Value, nil, iota)
+					obj.vis = vis
 
 					var init syntax.Expr
 					if i < len(values) {
@@ -387,8 +425,10 @@ func (check *Checker) collectObjects() {
 
 				// declare all variables
 				values := syntax.UnpackListExpr(s.Values)
+				vis := check.visibility(s, s.Vis)
 				for i, name := range s.NameList {
 					obj := NewVar(name.Pos(), pkg, name.Value, nil)
+					obj.vis = vis
 					lhs[i] = obj
 
 					d := d1
@@ -411,13 +451,31 @@ func (check *Checker) collectObjects() {
 
 			case *syntax.TypeDecl:
 				obj := NewTypeName(s.Name.Pos(), pkg, s.Name.Value, nil)
+				obj.vis = check.visibility(s, s.Vis)
 				check.declarePkgObj(s.Name, obj, &declInfo{file: fileScope, version: check.version, tdecl: s})
 
+				// The variants of a Wo enum type are package-level objects
+				// with the visibility of the type.
+				if t, _ := syntax.Unparen(s.Type).(*syntax.EnumType); t != nil && !s.Alias && len(s.TParamList) == 0 {
+					for i, v := range t.VariantList {
+						variant := NewVariant(v.Name.Pos(), pkg, v.Name.Value, nil, i, nil, nil)
+						variant.vis = obj.vis
+						check.declarePkgObj(v.Name, variant, &declInfo{file: fileScope, version: check.version, tdecl: s})
+					}
+				}
+
 			case *syntax.FuncDecl:
 				name := s.Name.Value
+				var ov *Overload
+				if s.Recv == nil {
+					if ov = overloads[name]; ov != nil {
+						name = overloadName(name, s.Type)
+					}
+				}
 				obj := NewFunc(s.Name.Pos(), pkg, name, nil)
 				hasTParamError := false // avoid duplicate type parameter errors
 				if s.Recv == nil {
+					obj.vis = check.visibility(s.Name, s.Vis)
 					// regular function
 					if name == "init" || name == "main" && pkg.name == "main" {
 						code := InvalidInitDecl
@@ -441,6 +499,8 @@ func (check *Checker) collectObjects() {
 							// TODO(gri) make this error message consistent with the others above
 							check.softErrorf(obj.pos, MissingInitBody, "missing function body")
 						}
+					} else if ov != nil {
+						check.declareOverload(ov, s.Name, obj)
 					} else {
 						check.declare(pkg.scope, s.Name, obj, nopos)
 					}
@@ -471,6 +531,18 @@ func (check *Checker) collectObjects() {
 		}
 	}
 
+	// declare the sets of overloaded functions (sorted by name for reproducible errors)
+	if overloads != nil {
+		names := make([]string, 0, len(overloads))
+		for name := range overloads {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			check.declare(pkg.scope, nil, overloads[name], nopos)
+		}
+	}
+
 	// verify that objects in package and file scopes have different names
 	for _, scope := range fileScopes {
 		for name, obj := range scope.elems {
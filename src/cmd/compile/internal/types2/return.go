@@ -50,7 +50,9 @@ func (check *Checker) isTerminating(s syntax.Stmt, label string) bool {
 		}
 
 	case *syntax.SwitchStmt:
-		return check.isTerminatingSwitch(s.Body, label)
+		// A Wo enum switch with a case for every variant is
+		// exhaustive, like a switch with a default case.
+		return check.isTerminatingSwitch(s.Body, label, check.enumSwitches[s])
 
 	case *syntax.SelectStmt:
 		for _, cc := range s.Body {
@@ -62,10 +64,12 @@ func (check *Checker) isTerminating(s syntax.Stmt, label string) bool {
 		return true
 
 	case *syntax.ForStmt:
-		if _, ok := s.Init.(*syntax.RangeClause); ok {
+		switch s.Init.(type) {
+		case *syntax.RangeClause, *syntax.VarClause:
 			// Range clauses guarantee that the loop terminates,
 			// so the loop is not a terminating statement. See go.dev/issue/49003.
-			break
+			// The same holds for Wo conditional bindings.
+			return false
 		}
 		if s.Cond == nil && !hasBreak(s.Body, label, true) {
 			return true
@@ -85,7 +89,7 @@ func (check *Checker) isTerminatingList(list []syntax.Stmt, label string) bool {
 	return false // all statements are empty
 }
 
-func (check *Checker) isTerminatingSwitch(body []*syntax.CaseClause, label string) bool {
+func (check *Checker) isTerminatingSwitch(body []*syntax.CaseClause, label string, exhaustive bool) bool {
 	hasDefault := false
 	for _, cc := range body {
 		if cc.Cases == nil {
@@ -95,7 +99,7 @@ func (check *Checker) isTerminatingSwitch(body []*syntax.CaseClause, label strin
 			return false
 		}
 	}
-	return hasDefault
+	return hasDefault || exhaustive
 }
 
 // TODO(gri) For nested breakable statements, the current implementation of hasBreak
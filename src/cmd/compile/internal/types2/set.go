@@ -0,0 +1,24 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types2
+
+// A Set represents a Wo set type.
+//
+// The predeclared generic type set has a nil element type;
+// it must be instantiated before it can be used.
+type Set struct {
+	elem Type
+}
+
+// NewSet returns a new set for the given element type.
+func NewSet(elem Type) *Set {
+	return &Set{elem: elem}
+}
+
+// Elem returns the element type of set s.
+func (s *Set) Elem() Type { return s.elem }
+
+func (t *Set) Underlying() Type { return t }
+func (t *Set) String() string   { return TypeString(t, nil) }
@@ -0,0 +1,51 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the Wo restrictions on shadowing.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	. "internal/types/errors"
+)
+
+// checkShadow reports an error if obj, which was just declared in
+// scope, shadows a predeclared identifier or, in a local scope, the
+// name of a package imported by the file, and the file containing obj
+// is written in the Wo dialect. Go permits both, as in
+//
+//	var int int = 1
+//	strings := []string{"a"} // in a file that imports "strings"
+//
+// but the declarations hide the original meaning of the name for the
+// rest of the scope.
+func (check *Checker) checkShadow(scope *Scope, obj Object) {
+	if !check.allowWo(obj, syntax.WoShadow) {
+		return
+	}
+	name := obj.Name()
+	if Universe.Lookup(name) != nil {
+		check.errorf(obj, ShadowedPredeclared, "%s shadows predeclared identifier %s", name, name)
+		return
+	}
+	// The file scope is the child of the package scope that contains
+	// the local scope, if any. Names declared at package level cannot
+	// conflict with imports anyway.
+	var fileScope *Scope
+	for s := scope; s != nil && s != check.pkg.scope; s = s.parent {
+		if s.parent == check.pkg.scope && s != scope {
+			fileScope = s
+		}
+	}
+	if fileScope == nil {
+		return
+	}
+	if pkgName, _ := fileScope.Lookup(name).(*PkgName); pkgName != nil {
+		err := check.newError(ShadowedImport)
+		err.addf(obj, "%s shadows import of package %q", name, pkgName.imported.path)
+		err.addAltDecl(pkgName)
+		err.report()
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the Wo rules for variable declarations and
+// assignments.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	. "internal/types/errors"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// In Wo files, local variables are declared with var, or with the
+// typed declaration
+//
+//	x T = v
+//
+// which declares x like var x T = v but may also appear where var
+// declarations may not, such as in the header of an if statement.
+// A short variable declaration x := v is reserved for shadowing a
+// variable of an enclosing scope, and may not assign to variables of
+// the same scope. Assignments and short variable declarations may not
+// assign several values in parallel, as in p, q = 20, 30; several
+// variables are only assigned the results of a multi-valued
+// expression, as in v, ok = m[k]. Assigning to blank identifiers only,
+// as in _, _ = p, q, is permitted since no variable is assigned.
+
+// typedVarDecl checks the Wo typed declaration s.
+func (check *Checker) typedVarDecl(s *syntax.AssignStmt) {
+	name, init := check.typedVarDeclParts(s)
+	if name == nil {
+		return
+	}
+	check.verifyWof(s, syntax.WoAssign, "typed declaration")
+
+	top := len(check.delayed)
+	obj := NewVar(name.Pos(), check.pkg, name.Value, nil)
+	check.varDecl(obj, nil, s.Type, init)
+
+	// process function literals in the init expression before scope changes
+	check.processDelayed(top)
+
+	check.declare(check.scope, name, obj, endPos(s))
+}
+
+// woShortVarDecl reports the short variable declaration lhs := rhs at
+// pos if it is in a Wo file and assigns values in parallel, assigns to
+// variables of the current scope, or declares a variable that does not
+// shadow another one. The variables of lhs are lhsVars, and newVars
+// are the variables it declares. If header is set, the declaration is
+// in the header of a statement, where a var declaration is not
+// permitted.
+func (check *Checker) woShortVarDecl(pos poser, lhs, rhs []syntax.Expr, lhsVars, newVars []*Var, header bool) {
+	if !check.allowWo(pos, syntax.WoAssign) || check.parallelAssign(pos, lhs, rhs, true) {
+		return
+	}
+
+	isNew := make(map[*Var]bool, len(newVars))
+	for _, v := range newVars {
+		isNew[v] = true
+	}
+	var assigned []string
+	for _, v := range lhsVars {
+		if !isNew[v] && v.name != "_" {
+			assigned = append(assigned, v.name)
+		}
+	}
+	if len(assigned) > 0 {
+		var decls []string
+		for _, v := range newVars {
+			decls = append(decls, check.sprintf("var %s %s", v.name, v.typ))
+		}
+		check.errorf(pos, MixedShortVarDecl, "%s := %s declares %s but assigns to %s (declare with %s first, then assign with =)",
+			exprList(lhs), exprList(rhs), varNames(newVars), strings.Join(assigned, ", "), strings.Join(decls, "; "))
+		return
+	}
+
+	for _, v := range newVars {
+		if _, ok := check.lookup(v.name).(*Var); ok {
+			continue
+		}
+		// v doesn't shadow a variable: suggest a declaration.
+		var alts []string
+		if !header {
+			alts = append(alts, "var "+exprList(lhs)+" = "+exprList(rhs))
+		}
+		if len(lhs) == 1 {
+			if T := check.sprintf("%s", v.typ); isValid(v.typ) && startsTypeName(T) {
+				alts = append(alts, v.name+" "+T+" = "+exprList(rhs))
+			}
+		}
+		hint := "declare it before the statement with var"
+		if len(alts) > 0 {
+			hint = "use " + strings.Join(alts, " or ")
+		}
+		check.errorf(v, NonShadowingShortVarDecl, "%s := %s does not shadow a variable (%s)", exprList(lhs), exprList(rhs), hint)
+		return
+	}
+}
+
+// parallelAssign reports whether the assignment, or short variable
+// declaration if def is set, lhs = rhs at pos is in a Wo file and
+// assigns several values in parallel. If so, it reports an error.
+func (check *Checker) parallelAssign(pos poser, lhs, rhs []syntax.Expr, def bool) bool {
+	if len(lhs) < 2 || len(lhs) != len(rhs) || allBlank(lhs) || !check.allowWo(pos, syntax.WoAssign) {
+		return false
+	}
+	if def {
+		check.errorf(pos, ParallelAssign, "parallel declaration %s := %s (declare each variable in its own statement)", exprList(lhs), exprList(rhs))
+	} else {
+		check.errorf(pos, ParallelAssign, "parallel assignment %s = %s (assign each variable in its own statement)", exprList(lhs), exprList(rhs))
+	}
+	return true
+}
+
+// allBlank reports whether all expressions of list are the blank
+// identifier.
+func allBlank(list []syntax.Expr) bool {
+	for _, x := range list {
+		if name, _ := x.(*syntax.Name); name == nil || name.Value != "_" {
+			return false
+		}
+	}
+	return true
+}
+
+// exprList returns the comma-separated list of the expressions list.
+func exprList(list []syntax.Expr) string {
+	var buf strings.Builder
+	for i, x := range list {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(ExprString(x))
+	}
+	return buf.String()
+}
+
+// varNames returns the comma-separated list of the names of vars.
+func varNames(vars []*Var) string {
+	names := make([]string, len(vars))
+	for i, v := range vars {
+		names[i] = v.name
+	}
+	return strings.Join(names, ", ")
+}
+
+// startsTypeName reports whether the type spelled T may appear in a
+// typed declaration, which requires that it starts with a name or a
+// keyword.
+func startsTypeName(T string) bool {
+	r, _ := utf8.DecodeRuneInString(T)
+	return unicode.IsLetter(r) || r == '_'
+}
@@ -29,14 +29,16 @@ type Signature struct {
 	params   *Tuple         // (incoming) parameters from left to right; or nil
 	results  *Tuple         // (outgoing) results from left to right; or nil
 	variadic bool           // true if the last parameter's type is of the form ...T (or string, for append built-in only)
+	defaults []Object       // default values of the parameters (Wo), or nil
 }
 
 // NewSignatureType creates a new function type for the given receiver,
 // receiver type parameters, type parameters, parameters, and results. If
 // variadic is set, params must hold at least one parameter and the last
 // parameter's core type must be of unnamed slice or bytestring type.
-// If recv is non-nil, typeParams must be empty. If recvTypeParams is
-// non-empty, recv must be non-nil.
+// If recv and typeParams are both non-empty, the signature is the type
+// of a Wo method with type parameters. If recvTypeParams is non-empty,
+// recv must be non-nil.
 func NewSignatureType(recv *Var, recvTypeParams, typeParams []*TypeParam, params, results *Tuple, variadic bool) *Signature {
 	if variadic {
 		n := params.Len()
@@ -56,9 +58,6 @@ func NewSignatureType(recv *Var, recvTypeParams, typeParams []*TypeParam, params
 		sig.rparams = bindTParams(recvTypeParams)
 	}
 	if len(typeParams) != 0 {
-		if recv != nil {
-			panic("function with type parameters cannot have a receiver")
-		}
 		sig.tparams = bindTParams(typeParams)
 	}
 	return sig
@@ -87,6 +86,52 @@ func (s *Signature) Results() *Tuple { return s.results }
 // Variadic reports whether the signature s is variadic.
 func (s *Signature) Variadic() bool { return s.variadic }
 
+// Default returns the default value of the i'th parameter of the Wo
+// signature s, or nil if the parameter has none. The default value is
+// a (usually unnamed) *[Const] whose type is the parameter type or, for
+// a parameter of interface or optional type, the type of the value it
+// holds; the predeclared *[Nil] nil or None; or a package-level *[Var]
+// or *[Func].
+func (s *Signature) Default(i int) Object {
+	if s.defaults == nil {
+		return nil
+	}
+	return s.defaults[i]
+}
+
+// SetDefaults sets the default values of the parameters of s.
+// The list must be nil or have an entry for each parameter, and the
+// entries must be as described for [Signature.Default]. It is intended
+// for use by importers.
+func (s *Signature) SetDefaults(defaults []Object) {
+	if defaults != nil && len(defaults) != s.params.Len() {
+		panic("number of default values does not match number of parameters")
+	}
+	s.defaults = defaults
+}
+
+// Skippable reports whether the i'th result of the Wo signature s has
+// a skip modifier. When assigning the results of a call in a Wo file,
+// skippable results may be omitted if all other results are assigned.
+func (s *Signature) Skippable(i int) bool {
+	return s.results.vars[i].skip
+}
+
+// SetSkippable sets the skip modifiers of the results of s.
+// The list must be nil or have an entry for each result.
+// It is intended for use by importers.
+func (s *Signature) SetSkippable(skips []bool) {
+	if skips == nil {
+		return
+	}
+	if len(skips) != s.results.Len() {
+		panic("number of skip modifiers does not match number of results")
+	}
+	for i, skip := range skips {
+		s.results.vars[i].skip = skip
+	}
+}
+
 func (s *Signature) Underlying() Type { return s }
 func (s *Signature) String() string   { return TypeString(s, nil) }
 
@@ -112,13 +157,18 @@ func (check *Checker) funcType(sig *Signature, recvPar *syntax.Field, tparams []
 
 	// collect and declare function type parameters
 	if tparams != nil {
-		// The parser will complain about invalid type parameters for methods.
+		// The parser will complain about invalid type parameters for methods
+		// in Go files. Wo methods may have type parameters.
+		if recvPar != nil && check.woFeatures[tparams[0].Pos().FileBase()] != 0 {
+			check.verifyWof(tparams[0], syntax.WoMethodTParams, "method type parameters")
+		}
 		check.collectTypeParams(&sig.tparams, tparams)
 	}
 
 	// collect ordinary and result parameters
 	pnames, params, variadic := check.collectParams(ftyp.ParamList, true)
 	rnames, results, _ := check.collectParams(ftyp.ResultList, false)
+	defaults := check.paramDefaults(ftyp, params, variadic, append(rparams.list(), sig.tparams.list()...))
 
 	// declare named receiver, ordinary, and result parameters
 	scopePos := syntax.EndPos(ftyp) // all parameter's scopes start after the signature
@@ -133,6 +183,7 @@ func (check *Checker) funcType(sig *Signature, recvPar *syntax.Field, tparams []
 	sig.params = NewTuple(params...)
 	sig.results = NewTuple(results...)
 	sig.variadic = variadic
+	sig.defaults = defaults
 }
 
 // collectRecv extracts the method receiver and its type parameters (if any) from rparam.
@@ -331,13 +382,21 @@ func (check *Checker) collectParams(list []*syntax.Field, variadicOk bool) (name
 	}
 
 	var named, anonymous bool
+	var skips int // number of skippable results
 
 	var typ Type
 	var prev syntax.Expr
 	for i, field := range list {
 		ftype := field.Type
-		// type-check type of grouped fields only once
-		if ftype != prev {
+		if ftype == nil {
+			// The type of a Wo parameter without type is inferred
+			// from its default value (see Checker.paramDefaults).
+			typ = nil
+			if !variadicOk {
+				typ = Typ[Invalid] // result parameters have no default values
+			}
+		} else if ftype != prev {
+			// type-check type of grouped fields only once
 			prev = ftype
 			if t, _ := ftype.(*syntax.DotsType); t != nil {
 				ftype = t.Elem
@@ -348,7 +407,34 @@ func (check *Checker) collectParams(list []*syntax.Field, variadicOk bool) (name
 					// ignore ... and continue
 				}
 			}
-			typ = check.varType(ftype)
+			if t, _ := ftype.(*syntax.Operation); t != nil && t.Op == syntax.Errable && !variadicOk && len(list) == 1 && field.Name == nil {
+				// A Wo result type T! stands for the results (T, error).
+				if !check.verifyWof(t.Pos(), syntax.WoResult, "result type %s", syntax.String(t)) {
+					typ = check.varType(t.X)
+				} else {
+					par := NewParam(field.Pos(), check.pkg, "", check.varType(t.X))
+					check.recordImplicit(field, par)
+					names = []*syntax.Name{nil, nil}
+					params = []*Var{par, NewParam(t.Pos(), check.pkg, "", universeError)}
+					return
+				}
+			} else {
+				typ = check.varType(ftype)
+			}
+		}
+		if field.Default != nil && !variadicOk {
+			check.error(field.Default, InvalidDefault, "result parameters cannot have default values")
+		}
+		skip := false
+		if field.Skip {
+			if variadicOk {
+				check.error(field, InvalidSkip, "only result parameters can be skippable")
+			} else {
+				skip = check.verifyWof(field, syntax.WoSkip, "skip modifier")
+			}
+			if skip {
+				skips++
+			}
 		}
 		// The parser ensures that f.Tag is nil and we don't
 		// care if a constructed AST contains a non-nil tag.
@@ -360,6 +446,7 @@ func (check *Checker) collectParams(list []*syntax.Field, variadicOk bool) (name
 				// ok to continue
 			}
 			par := NewParam(field.Name.Pos(), check.pkg, name, typ)
+			par.skip = skip
 			// named parameter is declared by caller
 			names = append(names, field.Name)
 			params = append(params, par)
@@ -367,6 +454,7 @@ func (check *Checker) collectParams(list []*syntax.Field, variadicOk bool) (name
 		} else {
 			// anonymous parameter
 			par := NewParam(field.Pos(), check.pkg, "", typ)
+			par.skip = skip
 			check.recordImplicit(field, par)
 			names = append(names, nil)
 			params = append(params, par)
@@ -379,6 +467,13 @@ func (check *Checker) collectParams(list []*syntax.Field, variadicOk bool) (name
 		// ok to continue
 	}
 
+	if skips > 0 && skips == len(params) {
+		check.error(list[0], InvalidSkip, "cannot skip all results")
+		for _, par := range params {
+			par.skip = false
+		}
+	}
+
 	// For a variadic function, change the last parameter's type from T to []T.
 	// Since we type-checked T rather than ...T, we also need to retro-actively
 	// record the type for ...T.
@@ -26,7 +26,7 @@ func TestSizeof(t *testing.T) {
 		{Struct{}, 24, 48},
 		{Pointer{}, 8, 16},
 		{Tuple{}, 12, 24},
-		{Signature{}, 28, 56},
+		{Signature{}, 40, 80},
 		{Union{}, 12, 24},
 		{Interface{}, 40, 80},
 		{Map{}, 16, 32},
@@ -36,18 +36,18 @@ func TestSizeof(t *testing.T) {
 		{term{}, 12, 24},
 
 		// Objects
-		{PkgName{}, 64, 104},
-		{Const{}, 64, 104},
-		{TypeName{}, 56, 88},
-		{Var{}, 64, 104},
-		{Func{}, 64, 104},
-		{Label{}, 60, 96},
-		{Builtin{}, 60, 96},
-		{Nil{}, 56, 88},
+		{PkgName{}, 68, 112},
+		{Const{}, 68, 112},
+		{TypeName{}, 60, 96},
+		{Var{}, 68, 112},
+		{Func{}, 68, 112},
+		{Label{}, 64, 104},
+		{Builtin{}, 64, 104},
+		{Nil{}, 60, 96},
 
 		// Misc
 		{Scope{}, 60, 104},
-		{Package{}, 44, 88},
+		{Package{}, 52, 104},
 		{_TypeSet{}, 28, 56},
 	}
 
@@ -59,6 +59,14 @@ func (s *StdSizes) Alignof(T Type) (result int64) {
 		// spec: "For a variable x of array type: unsafe.Alignof(x)
 		// is the same as unsafe.Alignof(x[0]), but at least 1."
 		return s.Alignof(t.elem)
+	case *Optional:
+		// An optional is laid out like struct{ v T; ok bool }.
+		return s.Alignof(t.elem)
+	case *Enum:
+		if l := t.layout(); l != nil {
+			return s.Alignof(l)
+		}
+		return s.Alignof(t.TagType())
 	case *Struct:
 		if len(t.fields) == 0 && IsSyncAtomicAlign64(T) {
 			// Special case: sync/atomic.align64 is an
@@ -203,6 +211,17 @@ func (s *StdSizes) Sizeof(T Type) int64 {
 		return ea*n1 + esize // may still overflow to < 0 which is ok
 	case *Slice:
 		return s.WordSize * 3
+	case *Optional:
+		esize := s.Sizeof(t.elem)
+		if esize < 0 {
+			return -1 // element too large
+		}
+		return align(esize+1, s.Alignof(t.elem)) // may overflow to < 0 which is ok
+	case *Enum:
+		if l := t.layout(); l != nil {
+			return s.Sizeof(l)
+		}
+		return s.Sizeof(t.TagType())
 	case *Struct:
 		n := t.NumFields()
 		if n == 0 {
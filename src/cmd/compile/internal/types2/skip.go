@@ -0,0 +1,36 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the omission of skippable Wo results.
+
+package types2
+
+import "cmd/compile/internal/syntax"
+
+// unskipped returns the result variables vars of the call e without
+// the skippable ones, provided that exactly n results remain and the
+// call is in a Wo file. Otherwise it returns vars unchanged.
+//
+// The call still produces all of its results: the compiler assigns
+// the omitted ones to blank identifiers, so the function keeps the
+// same signature for Go callers.
+func (check *Checker) unskipped(e syntax.Expr, vars []*Var, n int) []*Var {
+	var list []*Var
+	for _, v := range vars {
+		if !v.skip {
+			list = append(list, v)
+		}
+	}
+	if len(list) != n || !check.allowWo(e, syntax.WoSkip) {
+		return vars
+	}
+	return list
+}
+
+// maySkip reports whether e is a call in a Wo file whose skippable
+// results, if any, may be omitted.
+func (check *Checker) maySkip(e syntax.Expr) bool {
+	_, ok := syntax.Unparen(e).(*syntax.CallExpr)
+	return ok && check.allowWo(e, syntax.WoSkip)
+}
@@ -52,6 +52,20 @@ func (check *Checker) funcBody(decl *declInfo, name string, sig *Signature, body
 	// spec: "Implementation restriction: A compiler may make it illegal to
 	// declare a variable inside a function body if the variable is never used."
 	check.usage(sig.scope)
+
+	if check.uninitVars != nil && check.allowUninit(body) {
+		check.uninit(body)
+	}
+}
+
+// unusedf reports an unused variable: as an error in Go files, and
+// as a warning in Wo files.
+func (check *Checker) unusedf(at poser, format string, args ...any) {
+	if check.allowWo(at, syntax.WoUnused) {
+		check.warnf(at, UnusedVar, format, args...)
+		return
+	}
+	check.softErrorf(at, UnusedVar, format, args...)
 }
 
 func (check *Checker) usage(scope *Scope) {
@@ -66,7 +80,7 @@ func (check *Checker) usage(scope *Scope) {
 		return cmpPos(a.pos, b.pos)
 	})
 	for _, v := range unused {
-		check.softErrorf(v.pos, UnusedVar, "declared and not used: %s", v.name)
+		check.unusedf(v.pos, "declared and not used: %s", v.name)
 	}
 
 	for _, scope := range scope.children {
@@ -93,11 +107,12 @@ const (
 	// additional context information
 	finalSwitchCase
 	inTypeSwitch
+	inHeader // header of an if, for, switch, or select case, where var declarations are not permitted
 )
 
 func (check *Checker) simpleStmt(s syntax.Stmt) {
 	if s != nil {
-		check.stmt(0, s)
+		check.stmt(inHeader, s)
 	}
 }
 
@@ -421,7 +436,7 @@ func (check *Checker) stmt(ctxt stmtContext, s syntax.Stmt) {
 	defer check.processDelayed(len(check.delayed))
 
 	// reset context for statements of inner blocks
-	inner := ctxt &^ (fallthroughOk | finalSwitchCase | inTypeSwitch)
+	inner := ctxt &^ (fallthroughOk | finalSwitchCase | inTypeSwitch | inHeader)
 
 	switch s := s.(type) {
 	case *syntax.EmptyStmt:
@@ -503,9 +518,14 @@ func (check *Checker) stmt(ctxt stmtContext, s syntax.Stmt) {
 		switch s.Op {
 		case 0:
 			check.assignVars(lhs, rhs)
+			check.parallelAssign(s.Pos(), lhs, rhs, false)
 			return
 		case syntax.Def:
-			check.shortVarDecl(s.Pos(), lhs, rhs)
+			if s.Type != nil {
+				check.typedVarDecl(s)
+				return
+			}
+			check.shortVarDecl(s.Pos(), lhs, rhs, ctxt&inHeader != 0)
 			return
 		}
 
@@ -599,13 +619,22 @@ func (check *Checker) stmt(ctxt stmtContext, s syntax.Stmt) {
 		check.openScope(s, "if")
 		defer check.closeScope()
 
-		check.simpleStmt(s.Init)
-		var x operand
-		check.expr(nil, &x, s.Cond)
-		if x.mode != invalid && !allBoolean(x.typ) {
-			check.error(s.Cond, InvalidCond, "non-boolean condition in if statement")
+		if c := varClause(s.Init); c != nil {
+			// The bound variables are only in scope in the then-branch.
+			vars := check.binding(c)
+			check.openScope(c, "if var")
+			check.declareBinding(c, vars, s.Then.Pos())
+			check.stmt(inner, s.Then)
+			check.closeScope()
+		} else {
+			check.simpleStmt(s.Init)
+			var x operand
+			check.expr(nil, &x, s.Cond)
+			if x.mode != invalid && !allBoolean(x.typ) {
+				check.error(s.Cond, InvalidCond, "non-boolean condition in if statement")
+			}
+			check.stmt(inner, s.Then)
 		}
-		check.stmt(inner, s.Then)
 		// The parser produces a correct AST but if it was modified
 		// elsewhere the else branch may be invalid. Check again.
 		switch s.Else.(type) {
@@ -667,7 +696,7 @@ func (check *Checker) stmt(ctxt stmtContext, s syntax.Stmt) {
 			}
 			check.openScope(clause, "case")
 			if clause.Comm != nil {
-				check.stmt(inner, clause.Comm)
+				check.stmt(inner|inHeader, clause.Comm)
 			}
 			check.stmtList(inner, clause.Body)
 			check.closeScope()
@@ -684,6 +713,12 @@ func (check *Checker) stmt(ctxt stmtContext, s syntax.Stmt) {
 		check.openScope(s, "for")
 		defer check.closeScope()
 
+		if c := varClause(s.Init); c != nil {
+			check.declareBinding(c, check.binding(c), s.Body.Pos())
+			check.stmt(inner, s.Body)
+			break
+		}
+
 		check.simpleStmt(s.Init)
 		if s.Cond != nil {
 			var x operand
@@ -715,6 +750,11 @@ func (check *Checker) switchStmt(inner stmtContext, s *syntax.SwitchStmt) {
 		// By checking assignment of x to an invisible temporary
 		// (as a compiler would), we get all the relevant checks.
 		check.assignment(&x, nil, "switch expression")
+		if x.mode != invalid && isEnum(x.typ) {
+			check.multipleSwitchDefaults(s.Body)
+			check.enumSwitchStmt(inner, s, &x)
+			return
+		}
 		if x.mode != invalid && !Comparable(x.typ) && !hasNil(x.typ) {
 			check.errorf(&x, InvalidExprSwitch, "cannot switch on %s (%s is not comparable)", &x, x.typ)
 			x.mode = invalid
@@ -806,7 +846,13 @@ func (check *Checker) typeSwitchStmt(inner stmtContext, s *syntax.SwitchStmt, gu
 		// If lhs exists, declare a corresponding variable in the case-local scope.
 		if lhs != nil {
 			obj := NewVar(lhs.Pos(), check.pkg, lhs.Value, T)
-			check.declare(check.scope, nil, obj, clause.Colon)
+			if len(lhsVars) == 0 {
+				check.declare(check.scope, nil, obj, clause.Colon)
+			} else {
+				// Report Wo shadowing of the lhs variable for the first clause only.
+				check.scope.Insert(obj)
+				obj.setScopePos(clause.Colon)
+			}
 			check.recordImplicit(clause, obj)
 			// For the "declared and not used" error, all lhs variables act as
 			// one; i.e., if any one of them is 'used', all of them are 'used'.
@@ -830,7 +876,7 @@ func (check *Checker) typeSwitchStmt(inner stmtContext, s *syntax.SwitchStmt, gu
 			v.used = true // avoid usage error when checking entire function
 		}
 		if !used {
-			check.softErrorf(lhs, UnusedVar, "%s declared and not used", lhs.Value)
+			check.unusedf(lhs, "%s declared and not used", lhs.Value)
 		}
 	}
 }
@@ -856,9 +902,14 @@ func (check *Checker) rangeStmt(inner stmtContext, s *syntax.ForStmt, rclause *s
 		}
 	}
 	isDef := rclause.Def
+	isColon := rclause.Colon
 	rangeVar := rclause.X
 	noNewVarPos := s
 
+	if isColon {
+		check.verifyWof(rclause, syntax.WoRange, "colon range clause")
+	}
+
 	// Do not use rclause anymore.
 	rclause = nil
 
@@ -888,6 +939,12 @@ func (check *Checker) rangeStmt(inner stmtContext, s *syntax.ForStmt, rclause *s
 			check.softErrorf(sExtra, InvalidIterVar, "range clause permits at most two iteration variables")
 		}
 		key, val = k, v
+
+		// In a colon range clause, a single iteration variable
+		// denotes the value rather than the key, if there is one.
+		if isColon && sValue == nil && v != nil {
+			sKey, sValue = nil, sKey
+		}
 	}
 
 	// Open the for-statement block scope now, after the range clause.
@@ -923,6 +980,9 @@ func (check *Checker) rangeStmt(inner stmtContext, s *syntax.ForStmt, rclause *s
 				if name != "_" {
 					vars = append(vars, obj)
 				}
+			} else if isColon {
+				check.errorf(lhs, BadDecl, "non-name %s on left side of :", lhs)
+				obj = NewVar(lhs.Pos(), check.pkg, "_", nil) // dummy variable
 			} else {
 				check.errorf(lhs, InvalidSyntaxTree, "cannot declare %s", lhs)
 				obj = NewVar(lhs.Pos(), check.pkg, "_", nil) // dummy variable
@@ -957,6 +1017,8 @@ func (check *Checker) rangeStmt(inner stmtContext, s *syntax.ForStmt, rclause *s
 			for _, obj := range vars {
 				check.declare(check.scope, nil /* recordDef already called */, obj, scopePos)
 			}
+		} else if isColon {
+			check.error(noNewVarPos, NoNewVar, "no new variables on left side of :")
 		} else {
 			check.error(noNewVarPos, NoNewVar, "no new variables on left side of :=")
 		}
@@ -1000,6 +1062,13 @@ func (check *Checker) rangeStmt(inner stmtContext, s *syntax.ForStmt, rclause *s
 		check.assignment(&x, nil, "range clause")
 	}
 
+	if _, ok := coreType(x.typ).(*Signature); ok && x.mode != invalid {
+		defer func(inRangeFunc bool) {
+			check.inRangeFunc = inRangeFunc
+		}(check.inRangeFunc)
+		check.inRangeFunc = true
+	}
+
 	check.stmt(inner, s.Body)
 }
 
@@ -1033,6 +1102,8 @@ func rangeKeyVal(typ Type, allowVersion func(goVersion) bool) (key, val Type, ca
 		return Typ[Int], typ.elem, "", true
 	case *Map:
 		return typ.key, typ.elem, "", true
+	case *Set:
+		return typ.elem, nil, "", true
 	case *Chan:
 		if typ.dir == SendOnly {
 			return bad("receive from send-only channel")
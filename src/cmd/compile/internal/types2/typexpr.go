@@ -35,6 +35,24 @@ func (check *Checker) ident(x *operand, e *syntax.Name, def *TypeName, wantType
 		if !check.verifyVersionf(e, go1_18, "predeclared %s", e.Value) {
 			return // avoid follow-on errors
 		}
+	case universeSet:
+		// set is only predeclared in Wo files.
+		if check.woFeatures[e.Pos().FileBase()] == 0 {
+			check.errorf(e, UndeclaredName, "undefined: %s", e.Value)
+			return
+		}
+		if !check.verifyWof(e, syntax.WoSet, "predeclared %s", e.Value) {
+			return // avoid follow-on errors
+		}
+	case universeNone:
+		// None is only predeclared in Wo files.
+		if check.woFeatures[e.Pos().FileBase()] == 0 {
+			check.errorf(e, UndeclaredName, "undefined: %s", e.Value)
+			return
+		}
+		if !check.verifyWof(e, syntax.WoOptional, "predeclared %s", e.Value) {
+			return // avoid follow-on errors
+		}
 	}
 	// Because the representation of any depends on gotypesalias, we don't check
 	// pointer identity here.
@@ -132,6 +150,20 @@ func (check *Checker) ident(x *operand, e *syntax.Name, def *TypeName, wantType
 		check.addDeclDep(obj)
 		x.mode = value
 
+	case *Variant:
+		if !isValid(typ) {
+			return
+		}
+		if len(obj.fields) > 0 {
+			check.errorf(e, InvalidEnum, "cannot use variant %s without values for its fields", obj.name)
+			return
+		}
+		x.mode = value
+
+	case *Overload:
+		check.overloadUseError(e, obj)
+		return
+
 	case *Builtin:
 		x.id = obj.id
 		x.mode = builtin
@@ -176,7 +208,7 @@ func (check *Checker) validVarType(e syntax.Expr, typ Type) {
 		if t, _ := under(typ).(*Interface); t != nil {
 			pos := syntax.StartPos(e)
 			tset := computeInterfaceTypeSet(check, pos, t) // TODO(gri) is this the correct position?
-			if !tset.IsMethodSet() {
+			if !tset.IsMethodSet() && !check.valueIface(e, t) {
 				if tset.comparable {
 					check.softErrorf(pos, MisplacedConstraintIface, "cannot use type %s outside a type constraint: interface is (or embeds) comparable", typ)
 				} else {
@@ -187,6 +219,14 @@ func (check *Checker) validVarType(e syntax.Expr, typ Type) {
 	}).describef(e, "check var type %s", typ)
 }
 
+// valueIface reports whether the interface t, which may have a type
+// set restricted by type terms, may be used as an ordinary type at e.
+// In Wo files, such interfaces are union types unless they are (or
+// embed) comparable.
+func (check *Checker) valueIface(e poser, t *Interface) bool {
+	return !t.typeSet().comparable && check.allowWo(e, syntax.WoInterface)
+}
+
 // definedType is like typ but also accepts a type name def.
 // If def != nil, e is the type specification for the type named def, declared
 // in a type declaration, and def.typ.underlying will be set to the type of e
@@ -332,6 +372,28 @@ func (check *Checker) typInternal(e0 syntax.Expr, def *TypeName) (T Type) {
 		check.structType(typ, e)
 		return typ
 
+	case *syntax.EnumType:
+		if !check.verifyWof(e, syntax.WoEnum, "enum type") {
+			return Typ[Invalid]
+		}
+		// Enum types declare their variants in the package scope.
+		var tdecl *syntax.TypeDecl
+		if def != nil && def.parent == check.pkg.scope && check.objMap[def] != nil {
+			tdecl = check.objMap[def].tdecl
+		}
+		if tdecl == nil || tdecl.Alias || syntax.Unparen(tdecl.Type) != e {
+			check.error(e, InvalidEnum, "enum type must be declared by a package-level type definition")
+			return Typ[Invalid]
+		}
+		if len(tdecl.TParamList) > 0 {
+			check.error(e, InvalidEnum, "enum type cannot have type parameters")
+			return Typ[Invalid]
+		}
+		typ := new(Enum)
+		setDefType(def, typ)
+		check.enumType(typ, e, def)
+		return typ
+
 	case *syntax.Operation:
 		if e.Op == syntax.Mul && e.Y == nil {
 			typ := new(Pointer)
@@ -348,16 +410,60 @@ func (check *Checker) typInternal(e0 syntax.Expr, def *TypeName) (T Type) {
 			return typ
 		}
 
+		if e.Op == syntax.Question {
+			if !check.verifyWof(e, syntax.WoOptional, "optional type") {
+				return Typ[Invalid]
+			}
+			typ := new(Optional)
+			typ.elem = Typ[Invalid] // avoid nil elem in invalid recursive type declaration
+			setDefType(def, typ)
+			typ.elem = check.varType(e.X)
+			if !isValid(typ.elem) {
+				return Typ[Invalid]
+			}
+			return typ
+		}
+
+		if e.Op == syntax.Or && e.Y != nil {
+			if !check.verifyWof(e, syntax.WoInterface, "union type") {
+				return Typ[Invalid]
+			}
+			// A union type A | B | ... is the interface <A | B | ...>.
+			iface := new(syntax.InterfaceType)
+			iface.SetPos(e.Pos())
+			iface.MethodList = []*syntax.Field{{Type: e}}
+			iface.Compact = true
+			typ := check.newInterface()
+			setDefType(def, typ)
+			check.interfaceType(typ, iface, def)
+			return typ
+		}
+
 		check.errorf(e0, NotAType, "%s is not a type", e0)
 		check.use(e0)
 
 	case *syntax.FuncType:
+		if e.Arrow {
+			check.verifyWof(e, syntax.WoArrow, "arrow function type")
+		}
 		typ := new(Signature)
 		setDefType(def, typ)
 		check.funcType(typ, nil, nil, e)
+		if typ.defaults != nil {
+			for _, f := range e.ParamList {
+				if f.Default != nil {
+					check.error(f.Default, InvalidDefault, "default parameter values are only permitted in function declarations")
+					break
+				}
+			}
+			typ.defaults = nil
+		}
 		return typ
 
 	case *syntax.InterfaceType:
+		if e.Compact && !check.verifyWof(e, syntax.WoInterface, "compact interface type") {
+			return Typ[Invalid]
+		}
 		typ := check.newInterface()
 		setDefType(def, typ)
 		check.interfaceType(typ, e, def)
@@ -462,6 +568,10 @@ func (check *Checker) instantiatedType(x syntax.Expr, xlist []syntax.Expr, def *
 	if !isValid(typ) {
 		return typ // error already reported
 	}
+	// The predeclared Wo set type is instantiated directly.
+	if _, ok := typ.(*Set); ok {
+		return check.setType(x, xlist)
+	}
 	// typ must be a generic Alias or Named type (but not a *Signature)
 	if _, ok := typ.(*Signature); ok {
 		panic("unexpected generic signature")
@@ -506,6 +616,32 @@ func (check *Checker) instantiatedType(x syntax.Expr, xlist []syntax.Expr, def *
 	return inst
 }
 
+// setType type-checks the instantiation set[xlist] of the predeclared
+// Wo set type and returns the resulting set type.
+func (check *Checker) setType(x syntax.Expr, xlist []syntax.Expr) Type {
+	if !check.validateTArgLen(x.Pos(), "set", 1, len(xlist)) {
+		check.use(xlist...)
+		return Typ[Invalid]
+	}
+
+	typ := new(Set)
+	typ.elem = check.varType(xlist[0])
+
+	// Like map keys, set elements must be comparable.
+	// Delay this check because it requires fully setup types.
+	check.later(func() {
+		if !Comparable(typ.elem) {
+			var why string
+			if isTypeParam(typ.elem) {
+				why = " (missing comparable constraint)"
+			}
+			check.errorf(xlist[0], IncomparableMapKey, "invalid set element type %s%s", typ.elem, why)
+		}
+	}).describef(xlist[0], "check set element %s", typ.elem)
+
+	return typ
+}
+
 // arrayLength type-checks the array length expression e
 // and returns the constant length >= 0, or a value < 0
 // to indicate an error (and thus an unknown length).
@@ -0,0 +1,223 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the definite-assignment analysis for Wo files.
+
+package types2
+
+import (
+	"cmd/compile/internal/syntax"
+	. "internal/types/errors"
+)
+
+// In Wo files, a local variable declared without initial value, as in
+//
+//	var s string
+//
+// must be assigned before it is read. The analysis walks a function body
+// in execution order and tracks the set of such variables that may not be
+// assigned yet. The first read of a variable in that set is reported.
+//
+// The analysis is conservative in that it never reports a read that may
+// follow an assignment:
+//
+//   - Taking the address of (a part of) a variable, explicitly or by
+//     calling a method with pointer receiver, counts as an assignment,
+//     since the variable may be assigned through the pointer.
+//   - Assigning to a field of a struct variable or to an element of an
+//     array variable counts as an assignment of the variable.
+//   - A variable assigned in a function literal counts as assigned where
+//     the function literal appears. Reads in function literals are not
+//     checked, since they happen when the function is called.
+
+// An uninitSet is the set of tracked variables that may not be assigned
+// at a point in a function body. The nil set describes unreachable
+// points, where all variables count as assigned.
+type uninitSet map[*Var]bool
+
+func (s uninitSet) clone() uninitSet {
+	if s == nil {
+		return nil
+	}
+	c := make(uninitSet, len(s))
+	for v := range s {
+		c[v] = true
+	}
+	return c
+}
+
+// join returns the union of a and b, for a point that may be reached
+// through a or b. It may modify a but not b.
+func join(a, b uninitSet) uninitSet {
+	if a == nil {
+		return b.clone()
+	}
+	for v := range b {
+		a[v] = true
+	}
+	return a
+}
+
+// An uninitTarget is a statement targeted by break and continue statements.
+type uninitTarget struct {
+	stmt  syntax.Stmt // targeted loop, switch, or select statement
+	label string      // label of stmt, or ""
+	brk   uninitSet   // joined sets at break statements
+	cont  uninitSet   // joined sets at continue statements
+}
+
+// An uninitChecker holds the state of the definite-assignment analysis
+// of a function body.
+type uninitChecker struct {
+	check    *Checker
+	targets  []*uninitTarget      // enclosing break and continue targets, innermost last
+	labels   map[string]bool      // labels seen so far
+	gotos    map[string]uninitSet // joined sets at forward goto statements, by label
+	fall     uninitSet            // set at the most recent fallthrough statement
+	reported map[*Var]bool        // variables reported so far
+}
+
+// allowUninit reports whether at is in a Wo file where variables must be
+// assigned before they are read.
+func (check *Checker) allowUninit(at poser) bool {
+	return check.allowWo(at, syntax.WoUninit)
+}
+
+// recordUninitUse records id as a use of obj if obj is a variable
+// tracked by the definite-assignment analysis.
+func (check *Checker) recordUninitUse(id *syntax.Name, obj Object) {
+	if v, _ := obj.(*Var); v != nil && check.uninitVars[v] {
+		check.uninitNames[id] = v
+	}
+}
+
+// uninit reports the variables declared without initial value in body
+// that are read before they are assigned.
+func (check *Checker) uninit(body *syntax.BlockStmt) {
+	u := uninitChecker{check: check}
+	u.stmtList(make(uninitSet), body.List)
+}
+
+func (u *uninitChecker) stmtList(s uninitSet, list []syntax.Stmt) uninitSet {
+	for _, st := range list {
+		s = u.stmt(s, st, "")
+	}
+	return s
+}
+
+func (u *uninitChecker) push(st syntax.Stmt, label string) *uninitTarget {
+	t := &uninitTarget{stmt: st, label: label}
+	u.targets = append(u.targets, t)
+	return t
+}
+
+func (u *uninitChecker) pop() {
+	u.targets = u.targets[:len(u.targets)-1]
+}
+
+// expr reports the reads of variables in s in the expression e.
+// It removes variables whose address is taken in e from s.
+func (u *uninitChecker) expr(s uninitSet, e syntax.Expr) {
+	syntax.Inspect(e, func(n syntax.Node) bool {
+		switch n := n.(type) {
+		case *syntax.Name:
+			if v := u.check.uninitNames[n]; v != nil && s[v] && !u.reported[v] {
+				if u.reported == nil {
+					u.reported = make(map[*Var]bool)
+				}
+				u.reported[v] = true
+				u.check.softErrorf(n, UnassignedVar, "use of unassigned variable %s", n.Value)
+			}
+		case *syntax.FuncLit:
+			u.capture(s, n.Body)
+			return false
+		case *syntax.LambdaExpr:
+			u.capture(s, n.Body)
+			return false
+		case *syntax.Operation:
+			if x := addressOperand(n); x != nil {
+				if v, _ := u.path(x); v != nil {
+					u.assign(s, x)
+					return false
+				}
+			}
+		case *syntax.SelectorExpr:
+			// x.m where m has a pointer receiver takes the address of x
+			if u.ptrRecv(n) {
+				u.assign(s, n.X)
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// assign removes the variable from s that is assigned by an assignment
+// to e if e denotes (a part of) a tracked variable. Otherwise, it reports
+// the reads of variables in s in e.
+func (u *uninitChecker) assign(s uninitSet, e syntax.Expr) {
+	v, _ := u.path(e)
+	if v == nil {
+		u.expr(s, e)
+		return
+	}
+	// report the reads in index expressions
+	for e := syntax.Unparen(e); ; {
+		switch x := e.(type) {
+		case *syntax.SelectorExpr:
+			e = syntax.Unparen(x.X)
+			continue
+		case *syntax.IndexExpr:
+			u.expr(s, x.Index)
+			e = syntax.Unparen(x.X)
+			continue
+		}
+		break
+	}
+	delete(s, v)
+}
+
+// path returns the tracked variable v and the type of e if e denotes v
+// or, through selections of struct fields and indexing of arrays, a part
+// of v. Otherwise, path returns nil, nil.
+func (u *uninitChecker) path(e syntax.Expr) (*Var, Type) {
+	switch e := syntax.Unparen(e).(type) {
+	case *syntax.Name:
+		if v := u.check.uninitNames[e]; v != nil {
+			return v, v.typ
+		}
+	case *syntax.SelectorExpr:
+		if v, T := u.path(e.X); v != nil && T != nil {
+			obj, _, indirect := lookupFieldOrMethod(T, false, u.check.pkg, e.Sel.Value, false)
+			if f, _ := obj.(*Var); f != nil && !indirect {
+				return v, f.typ
+			}
+		}
+	case *syntax.IndexExpr:
+		if v, T := u.path(e.X); v != nil && T != nil {
+			if a, _ := under(T).(*Array); a != nil {
+				return v, a.elem
+			}
+		}
+	}
+	return nil, nil
+}
+
+// ptrRecv reports whether the selector expression x.m denotes a method
+// with pointer receiver of (a part of) a tracked variable x, which takes
+// the address of x.
+func (u *uninitChecker) ptrRecv(e *syntax.SelectorExpr) bool {
+	_, T := u.path(e.X)
+	if T == nil || IsInterface(T) {
+		return false
+	}
+	obj, _, indirect := lookupFieldOrMethod(T, true, u.check.pkg, e.Sel.Value, false)
+	if f, _ := obj.(*Func); f != nil && !indirect {
+		if sig, _ := f.typ.(*Signature); sig != nil && sig.recv != nil {
+			_, ptr := deref(sig.recv.typ)
+			return ptr
+		}
+	}
+	return false
+}
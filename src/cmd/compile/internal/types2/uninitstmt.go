@@ -0,0 +1,257 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the statements of the definite-assignment
+// analysis for Wo files (see uninit.go).
+
+package types2
+
+import "cmd/compile/internal/syntax"
+
+// stmt returns the set of variables that may not be assigned after
+// executing st in a state described by s. It may modify s. If st is
+// labeled, label is the label name; otherwise label is "".
+func (u *uninitChecker) stmt(s uninitSet, st syntax.Stmt, label string) uninitSet {
+	switch st := st.(type) {
+	case nil, *syntax.EmptyStmt:
+		// nothing to do
+
+	case *syntax.DeclStmt:
+		for _, d := range st.DeclList {
+			d, _ := d.(*syntax.VarDecl)
+			if d == nil {
+				continue
+			}
+			if d.Values != nil {
+				u.expr(s, d.Values)
+				continue
+			}
+			for _, name := range d.NameList {
+				if v := u.check.uninitNames[name]; v != nil && s != nil {
+					s[v] = true
+				}
+			}
+		}
+
+	case *syntax.LabeledStmt:
+		name := st.Label.Value
+		s = join(s, u.gotos[name])
+		delete(u.gotos, name)
+		if u.labels == nil {
+			u.labels = make(map[string]bool)
+		}
+		u.labels[name] = true
+		return u.stmt(s, st.Stmt, name)
+
+	case *syntax.BlockStmt:
+		return u.stmtList(s, st.List)
+
+	case *syntax.ExprStmt:
+		u.expr(s, st.X)
+		if call, ok := syntax.Unparen(st.X).(*syntax.CallExpr); ok && u.check.isPanic[call] {
+			return nil
+		}
+
+	case *syntax.SendStmt:
+		u.expr(s, st.Chan)
+		u.expr(s, st.Value)
+
+	case *syntax.AssignStmt:
+		if st.Rhs == nil {
+			// x++ or x--
+			u.expr(s, st.Lhs)
+			u.assign(s, st.Lhs)
+			break
+		}
+		u.expr(s, st.Rhs)
+		for _, lhs := range syntax.UnpackListExpr(st.Lhs) {
+			if st.Op != 0 {
+				u.expr(s, lhs)
+			}
+			u.assign(s, lhs)
+		}
+
+	case *syntax.CallStmt:
+		u.expr(s, st.Call)
+
+	case *syntax.ReturnStmt:
+		if st.Results != nil {
+			u.expr(s, st.Results)
+		}
+		return nil
+
+	case *syntax.BranchStmt:
+		switch st.Tok {
+		case syntax.Break:
+			if t := u.target(st.Label, false); t != nil {
+				t.brk = join(t.brk, s)
+			}
+		case syntax.Continue:
+			if t := u.target(st.Label, true); t != nil {
+				t.cont = join(t.cont, s)
+			}
+		case syntax.Goto:
+			// Jumping back to a label cannot add variables to the
+			// set at the label: variables declared after the label
+			// are out of scope there.
+			if name := st.Label.Value; !u.labels[name] {
+				if u.gotos == nil {
+					u.gotos = make(map[string]uninitSet)
+				}
+				u.gotos[name] = join(u.gotos[name], s)
+			}
+		case syntax.Fallthrough:
+			u.fall = s.clone()
+		}
+		return nil
+
+	case *syntax.VarClause:
+		// Wo conditional binding in an if header
+		u.expr(s, st.Value)
+
+	case *syntax.IfStmt:
+		s = u.stmt(s, st.Init, "")
+		if st.Cond != nil {
+			u.expr(s, st.Cond)
+		}
+		then := u.stmt(s.clone(), st.Then, "")
+		if st.Else != nil {
+			s = u.stmt(s, st.Else, "")
+		}
+		return join(then, s)
+
+	case *syntax.SwitchStmt:
+		t := u.push(st, label)
+		s = u.stmt(s, st.Init, "")
+		if g, _ := st.Tag.(*syntax.TypeSwitchGuard); g != nil {
+			u.expr(s, g.X)
+		} else if st.Tag != nil {
+			u.expr(s, st.Tag)
+		}
+		var exit, fall uninitSet
+		hasDefault := false
+		for _, cc := range st.Body {
+			if cc.Cases == nil {
+				hasDefault = true
+			} else {
+				u.expr(s, cc.Cases)
+			}
+			u.fall = nil
+			exit = join(exit, u.stmtList(join(s.clone(), fall), cc.Body))
+			fall = u.fall
+		}
+		u.fall = nil
+		if !hasDefault && !u.check.enumSwitches[st] {
+			exit = join(exit, s)
+		}
+		u.pop()
+		return join(exit, t.brk)
+
+	case *syntax.SelectStmt:
+		t := u.push(st, label)
+		var exit uninitSet
+		for _, cc := range st.Body {
+			exit = join(exit, u.stmtList(u.stmt(s.clone(), cc.Comm, ""), cc.Body))
+		}
+		u.pop()
+		return join(exit, t.brk)
+
+	case *syntax.ForStmt:
+		// The body cannot add variables to the set at the start of
+		// the loop (variables declared in the body are out of scope
+		// there), so the set at the start of each iteration is s.
+		t := u.push(st, label)
+		if r, _ := st.Init.(*syntax.RangeClause); r != nil {
+			u.expr(s, r.X)
+			body := s.clone()
+			if r.Lhs != nil {
+				for _, lhs := range syntax.UnpackListExpr(r.Lhs) {
+					u.assign(body, lhs)
+				}
+			}
+			u.stmt(body, st.Body, "")
+			u.pop()
+			return join(s, t.brk)
+		}
+		if c, _ := st.Init.(*syntax.VarClause); c != nil {
+			u.expr(s, c.Value)
+			u.stmt(s.clone(), st.Body, "")
+			u.pop()
+			return join(s, t.brk)
+		}
+		s = u.stmt(s, st.Init, "")
+		if st.Cond != nil {
+			u.expr(s, st.Cond)
+		}
+		post := join(u.stmt(s.clone(), st.Body, ""), t.cont)
+		if post != nil {
+			u.stmt(post, st.Post, "")
+		}
+		u.pop()
+		if st.Cond == nil {
+			return t.brk
+		}
+		return join(s, t.brk)
+
+	default:
+		panic("unreachable")
+	}
+
+	return s
+}
+
+// target returns the statement targeted by a break statement, or by a
+// continue statement if cont is set, with the given label (which may
+// be nil). The result is nil if there is no such statement.
+func (u *uninitChecker) target(label *syntax.Name, cont bool) *uninitTarget {
+	for i := len(u.targets) - 1; i >= 0; i-- {
+		t := u.targets[i]
+		if label != nil {
+			if t.label == label.Value {
+				return t
+			}
+			continue
+		}
+		if _, isFor := t.stmt.(*syntax.ForStmt); isFor || !cont {
+			return t
+		}
+	}
+	return nil
+}
+
+// capture removes the variables assigned in the body of a function
+// literal from s.
+func (u *uninitChecker) capture(s uninitSet, body syntax.Node) {
+	syntax.Inspect(body, func(n syntax.Node) bool {
+		switch n := n.(type) {
+		case *syntax.AssignStmt:
+			for _, lhs := range syntax.UnpackListExpr(n.Lhs) {
+				if v, _ := u.path(lhs); v != nil {
+					delete(s, v)
+				}
+			}
+		case *syntax.RangeClause:
+			if n.Lhs != nil {
+				for _, lhs := range syntax.UnpackListExpr(n.Lhs) {
+					if v, _ := u.path(lhs); v != nil {
+						delete(s, v)
+					}
+				}
+			}
+		case *syntax.Operation:
+			if n.Op == syntax.And && n.Y == nil {
+				if v, _ := u.path(n.X); v != nil {
+					delete(s, v)
+				}
+			}
+		case *syntax.SelectorExpr:
+			if u.ptrRecv(n) {
+				if v, _ := u.path(n.X); v != nil {
+					delete(s, v)
+				}
+			}
+		}
+		return true
+	})
+}
@@ -27,6 +27,8 @@ var (
 	universeAnyAlias   *TypeName
 	universeError      Type
 	universeComparable Object
+	universeSet        Object
+	universeNone       Object
 )
 
 // Typ contains the predeclared *Basic types indexed by their
@@ -143,6 +145,9 @@ func defPredeclaredTypes() {
 		typ.SetUnderlying(ityp)
 		def(obj)
 	}
+
+	// type set[T comparable] // Wo only
+	def(NewTypeName(nopos, nil, "set", &Set{}))
 }
 
 var predeclaredConsts = [...]struct {
@@ -163,6 +168,13 @@ func defPredeclaredConsts() {
 
 func defPredeclaredNil() {
 	def(&Nil{object{name: "nil", typ: Typ[UntypedNil], color_: black}})
+
+	// None is the absent value of Wo optional types. It is inserted
+	// directly because def enters exported names into package unsafe.
+	none := &Nil{object{name: "None", typ: Typ[UntypedNil], color_: black}}
+	if Universe.Insert(none) != nil {
+		panic("double declaration of predeclared identifier")
+	}
 }
 
 // A builtinId is the id of a builtin function.
@@ -189,6 +201,17 @@ const (
 	_Real
 	_Recover
 
+	// Wo set methods
+	_SetAdd
+	_SetDelete
+
+	// Wo optional methods
+	_OptionalIsPresent
+	_OptionalOrElse
+
+	// Wo builtin methods of strings, slices and maps
+	_BuiltinMethod
+
 	// package unsafe
 	_Add
 	_Alignof
@@ -230,6 +253,14 @@ var predeclaredFuncs = [...]struct {
 	_Real:    {"real", 1, false, expression},
 	_Recover: {"recover", 0, false, statement},
 
+	_SetAdd:    {"add", 1, false, statement},
+	_SetDelete: {"delete", 1, false, statement},
+
+	_OptionalIsPresent: {"IsPresent", 0, false, expression},
+	_OptionalOrElse:    {"OrElse", 1, false, expression},
+
+	_BuiltinMethod: {"method", 0, true, statement},
+
 	_Add:        {"Add", 2, false, expression},
 	_Alignof:    {"Alignof", 1, false, expression},
 	_Offsetof:   {"Offsetof", 1, false, expression},
@@ -249,6 +280,9 @@ func defPredeclaredFuncs() {
 		if id == _Assert || id == _Trace {
 			continue // only define these in testing environment
 		}
+		if id == _SetAdd || id == _SetDelete || id == _OptionalIsPresent || id == _OptionalOrElse || id == _BuiltinMethod {
+			continue // selected from a set, optional, string, slice or map value, see Checker.selector
+		}
 		def(newBuiltin(id))
 	}
 }
@@ -279,6 +313,8 @@ func init() {
 	universeRune = Universe.Lookup("rune").Type()
 	universeError = Universe.Lookup("error").Type()
 	universeComparable = Universe.Lookup("comparable")
+	universeSet = Universe.Lookup("set")
+	universeNone = Universe.Lookup("None")
 }
 
 // Objects with names containing blanks are internal and not entered into
@@ -13,6 +13,7 @@ import (
 	"cmd/compile/internal/syntax"
 	"go/constant"
 	"go/token"
+	. "internal/types/errors"
 )
 
 const isTypes2 = true
@@ -66,3 +67,115 @@ var kind2tok = [...]token.Token{
 	syntax.RuneLit:   token.CHAR,
 	syntax.StringLit: token.STRING,
 }
+
+// lambdaBody returns the function body of the Wo function literal x:
+// { return x.Body } if the function type of x has results, and
+// { x.Body } otherwise.
+func lambdaBody(x *syntax.LambdaExpr, results bool) *syntax.BlockStmt {
+	return syntax.LambdaBody(x, results)
+}
+
+// varClause returns the Wo conditional binding init, or nil if init
+// is not one.
+func varClause(init syntax.SimpleStmt) *syntax.VarClause {
+	c, _ := init.(*syntax.VarClause)
+	return c
+}
+
+// paramDefaultExprs returns the default values of the Wo parameters
+// of ftyp, by parameter; parameters without default value have nil.
+func paramDefaultExprs(ftyp *syntax.FuncType) []syntax.Expr {
+	dflts := make([]syntax.Expr, len(ftyp.ParamList))
+	for i, field := range ftyp.ParamList {
+		dflts[i] = field.Default
+	}
+	return dflts
+}
+
+// defaultArg sets x.expr to an identifier denoting the default value
+// obj of an argument omitted from the call, positioned at the end of
+// the call. The identifier is not part of the syntax tree; it is
+// recorded like other arguments, and in Info.DefaultArgs, so that
+// later stages of the compiler see a call with all arguments present.
+func (check *Checker) defaultArg(call *syntax.CallExpr, x *operand, obj Object) {
+	c, isConst := obj.(*Const)
+	name := obj.Name()
+	if isConst {
+		name = c.val.String()
+	}
+	id := syntax.NewName(syntax.EndPos(call), name)
+	if !isConst {
+		check.recordUse(id, obj)
+	}
+	x.expr = id
+	check.record(x)
+	if m := check.DefaultArgs; m != nil {
+		m[call] = append(m[call], id)
+	}
+}
+
+// typedVarDeclParts returns the declared name and the init expression
+// of the Wo typed declaration s. If s is invalid, typedVarDeclParts
+// reports an error and returns a nil name.
+func (check *Checker) typedVarDeclParts(s *syntax.AssignStmt) (*syntax.Name, syntax.Expr) {
+	name, _ := s.Lhs.(*syntax.Name)
+	if name == nil {
+		check.error(s.Lhs, InvalidSyntaxTree, "typed declaration of a non-name")
+		check.use(s.Lhs, s.Rhs)
+		return nil, nil
+	}
+	return name, s.Rhs
+}
+
+// fieldNames returns the names of the fields in list and their type
+// expressions.
+func fieldNames(list []*syntax.Field) (names []*syntax.Name, typs []syntax.Expr) {
+	for _, f := range list {
+		names = append(names, f.Name)
+		typs = append(typs, f.Type)
+	}
+	return
+}
+
+// numFields returns the number of fields in list.
+func numFields(list []*syntax.Field) int { return len(list) }
+
+// isEnumDecl reports whether s declares a Wo enum type (which may
+// have implicit methods, see Checker.enumMethods).
+func isEnumDecl(s *syntax.TypeDecl) bool {
+	_, ok := syntax.Unparen(s.Type).(*syntax.EnumType)
+	return ok && !s.Alias && len(s.TParamList) == 0
+}
+
+// caseClauses returns the case clauses of the switch statement s.
+// Invalid entries are reported and nil.
+func (check *Checker) caseClauses(s *syntax.SwitchStmt) []*syntax.CaseClause {
+	for _, clause := range s.Body {
+		if clause == nil {
+			check.error(s, InvalidSyntaxTree, "incorrect expression switch case")
+		}
+	}
+	return s.Body
+}
+
+// caseExprs returns the expressions of the case clause, or nil for
+// the default clause.
+func caseExprs(clause *syntax.CaseClause) []syntax.Expr { return syntax.UnpackListExpr(clause.Cases) }
+
+// firstTypeParam returns the first type parameter of the function
+// declaration d, or nil.
+func firstTypeParam(d *syntax.FuncDecl) poser {
+	if len(d.TParamList) == 0 {
+		return nil
+	}
+	return d.TParamList[0]
+}
+
+// addressOperand returns the operand x of the address operation &x,
+// or nil if x is not an address operation.
+func addressOperand(x *syntax.Operation) syntax.Expr {
+	if x.Op == syntax.And && x.Y == nil {
+		return x.X
+	}
+	return nil
+}
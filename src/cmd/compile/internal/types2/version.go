@@ -5,6 +5,7 @@
 package types2
 
 import (
+	"cmd/compile/internal/syntax"
 	"fmt"
 	"go/version"
 	"internal/goversion"
@@ -64,3 +65,26 @@ func (check *Checker) verifyVersionf(at poser, v goVersion, format string, args
 	}
 	return true
 }
+
+// isWo reports whether the file containing at is written in the Wo dialect.
+func (check *Checker) isWo(at poser) bool {
+	return check.woFeatures[at.Pos().FileBase()] != 0
+}
+
+// allowWo reports whether the file containing at is written in the
+// Wo dialect and may use the Wo feature f.
+func (check *Checker) allowWo(at poser, f syntax.Features) bool {
+	return check.woFeatures[at.Pos().FileBase()]&^check.conf.DisabledWoFeatures&f != 0
+}
+
+// verifyWof is like allowWo but also accepts a format string and arguments
+// which are used to report an error if allowWo returns false.
+// It is used for syntax that is only accepted in Wo files, which
+// may only be rejected because f was disabled by the configuration.
+func (check *Checker) verifyWof(at poser, f syntax.Features, format string, args ...interface{}) bool {
+	if !check.allowWo(at, f) {
+		check.woErrorf(at, f, format, args...)
+		return false
+	}
+	return true
+}
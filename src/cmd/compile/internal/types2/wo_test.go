@@ -0,0 +1,421 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types2_test
+
+import (
+	"cmd/compile/internal/syntax"
+	"fmt"
+	"internal/types/errors"
+	"slices"
+	"strings"
+	"testing"
+
+	. "cmd/compile/internal/types2"
+)
+
+func TestDisabledWoFeatures(t *testing.T) {
+	for _, test := range []struct {
+		feature syntax.Features
+		src     string
+		err     string // expected error if feature is disabled
+	}{
+		{syntax.WoTernary, "var b bool; var _ = if b then 1 else 2", "conditional expression requires Wo feature ternary, which is disabled"},
+		{syntax.WoRange, "func _(s []int) { for v : s { _ = v } }", "colon range clause requires Wo feature range, which is disabled"},
+		{syntax.WoSet, "var _ set[int]", "predeclared set requires Wo feature set, which is disabled"},
+		{syntax.WoOptional, "var _ int?", "optional type requires Wo feature optional, which is disabled"},
+		{syntax.WoOptional, "func _(m map[int]int) int? { return m[0]? }", "optional type requires Wo feature optional, which is disabled"},
+		{syntax.WoResult, "func _() int! { return 0, nil }", "result type int! requires Wo feature result, which is disabled"},
+		{syntax.WoResult, "func _(f func() error) error { f()!; return nil }", "! operator requires Wo feature result, which is disabled"},
+		{syntax.WoArrow, "type _ int -> int", "arrow function type requires Wo feature arrow, which is disabled"},
+		{syntax.WoArrow, "var _ func(int) int = x -> x", "function literal x -> x requires Wo feature arrow, which is disabled"},
+		{syntax.WoEnum, "type E enum{A, B}", "enum type requires Wo feature enum, which is disabled"},
+		{syntax.WoInterface, "type _ <M()>", "compact interface type requires Wo feature interface, which is disabled"},
+		{syntax.WoInterface, "var _ int | string", "union type requires Wo feature interface, which is disabled"},
+		{syntax.WoExport, "export func f() {}", "export modifier requires Wo feature export, which is disabled"},
+		{syntax.WoExport, "pkg const c = 0", "pkg modifier requires Wo feature export, which is disabled"},
+		{syntax.WoOverload, "func f(int) {}; func f(string) {}", "function overloading requires Wo feature overload, which is disabled"},
+		{syntax.WoDefault, "func f(x int = 1) {}", "default parameter value requires Wo feature default, which is disabled"},
+		{syntax.WoSkip, "func f() (skip int, string) { return 0, \"\" }", "skip modifier requires Wo feature skip, which is disabled"},
+		{syntax.WoIfVar, "func _(m map[int]int) { if var v = m[0] { _ = v } }", "conditional binding requires Wo feature ifvar, which is disabled"},
+		{syntax.WoBuiltinMethods, "func _(s string) bool { return s.Contains(\"a\") }", "builtin method Contains requires Wo feature builtinmethods, which is disabled"},
+		{syntax.WoMethodTParams, "type T int; func (T) m[P any]() {}", "method type parameters requires Wo feature methodtparams, which is disabled"},
+		{syntax.WoAssign, "func _() { i int = 2; _ = i }", "typed declaration requires Wo feature assign, which is disabled"},
+	} {
+		src := "//wo:dialect\npackage p; " + test.src
+		for _, disabled := range []syntax.Features{0, test.feature} {
+			var got []string
+			conf := Config{
+				DisabledWoFeatures: disabled,
+				Error:              func(err error) { got = append(got, err.Error()) },
+				Importer:           defaultImporter(),
+			}
+			typecheck(src, &conf, nil)
+			switch {
+			case disabled == 0 && len(got) > 0:
+				t.Errorf("%s: unexpected errors: %v", test.src, got)
+			case disabled != 0 && (len(got) == 0 || !strings.Contains(got[0], test.err)):
+				t.Errorf("%s (-wo=-%s): got errors %v, want %q", test.src, test.feature, got, test.err)
+			}
+		}
+	}
+}
+
+func TestUnusedVarWarning(t *testing.T) {
+	const body = "package p; func _() { var x = 1 }"
+	for _, test := range []struct {
+		src      string
+		disabled syntax.Features
+		want     Severity
+	}{
+		{body, 0, SeverityError},
+		{"//wo:dialect\n" + body, 0, SeverityWarning},
+		{"//wo:dialect\n" + body, syntax.WoUnused, SeverityError},
+	} {
+		var got []Error
+		conf := Config{
+			DisabledWoFeatures: test.disabled,
+			Error:              func(err error) { got = append(got, err.(Error)) },
+		}
+		_, err := typecheck(test.src, &conf, nil)
+		if len(got) != 1 {
+			t.Errorf("%q (disabled %s): got %d errors, want 1", test.src, test.disabled, len(got))
+			continue
+		}
+		if got[0].Severity != test.want {
+			t.Errorf("%q (disabled %s): got severity %s, want %s", test.src, test.disabled, got[0].Severity, test.want)
+		}
+		if got[0].Code != errors.UnusedVar {
+			t.Errorf("%q (disabled %s): got code %s, want UnusedVar", test.src, test.disabled, got[0].Code)
+		}
+		// Warnings don't fail type-checking.
+		if (err != nil) != (test.want == SeverityError) {
+			t.Errorf("%q (disabled %s): Check returned %v", test.src, test.disabled, err)
+		}
+	}
+}
+
+func TestUninitDisabled(t *testing.T) {
+	const src = "//wo:dialect\npackage p; func _() { var s string; s += \".\" }"
+	for _, disabled := range []syntax.Features{0, syntax.WoUninit} {
+		var got []Error
+		conf := Config{
+			DisabledWoFeatures: disabled,
+			Error:              func(err error) { got = append(got, err.(Error)) },
+		}
+		typecheck(src, &conf, nil)
+		switch {
+		case disabled == 0 && (len(got) != 1 || got[0].Code != errors.UnassignedVar):
+			t.Errorf("got errors %v, want UnassignedVar error", got)
+		case disabled != 0 && len(got) > 0:
+			t.Errorf("-wo=-uninit: unexpected errors: %v", got)
+		}
+	}
+}
+
+func TestShadowDisabled(t *testing.T) {
+	const src = "//wo:dialect\npackage p; import \"strings\"; func _() { var len = strings.Count(\"wo\", \"o\"); var strings = 0; _, _ = len, strings }"
+	for _, disabled := range []syntax.Features{0, syntax.WoShadow} {
+		var got []Error
+		conf := Config{
+			DisabledWoFeatures: disabled,
+			Error:              func(err error) { got = append(got, err.(Error)) },
+			Importer:           defaultImporter(),
+		}
+		typecheck(src, &conf, nil)
+		switch {
+		case disabled == 0 && (len(got) != 2 || got[0].Code != errors.ShadowedPredeclared || got[1].Code != errors.ShadowedImport):
+			t.Errorf("got errors %v, want ShadowedPredeclared and ShadowedImport errors", got)
+		case disabled != 0 && len(got) > 0:
+			t.Errorf("-wo=-shadow: unexpected errors: %v", got)
+		}
+	}
+}
+
+func TestAssignDisabled(t *testing.T) {
+	const src = "//wo:dialect\npackage p; func _() { x := 1; var p, q int; p, q = x, 2; _, _ = p, q }"
+	for _, disabled := range []syntax.Features{0, syntax.WoAssign} {
+		var got []Error
+		conf := Config{
+			DisabledWoFeatures: disabled,
+			Error:              func(err error) { got = append(got, err.(Error)) },
+		}
+		typecheck(src, &conf, nil)
+		switch {
+		case disabled == 0 && (len(got) != 2 || got[0].Code != errors.NonShadowingShortVarDecl || got[1].Code != errors.ParallelAssign):
+			t.Errorf("got errors %v, want NonShadowingShortVarDecl and ParallelAssign errors", got)
+		case disabled != 0 && len(got) > 0:
+			t.Errorf("-wo=-assign: unexpected errors: %v", got)
+		}
+	}
+}
+
+func TestUnknownBuiltinMethod(t *testing.T) {
+	// Without an importer, package strings is unavailable, as it is in the
+	// compiler when the build system found no function named Frobnicate.
+	const src = "//wo:dialect\npackage p; func _(s string) { s.Frobnicate() }"
+	var errs []string
+	conf := Config{Error: func(err error) { errs = append(errs, err.(Error).Msg) }}
+	typecheck(src, &conf, nil)
+	if want := []string{"s.Frobnicate undefined (type string has no field or method Frobnicate)"}; !slices.Equal(errs, want) {
+		t.Errorf("got errors %q, want %q", errs, want)
+	}
+}
+
+func TestVisibility(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+export func แมว() {}
+func export lower() {}
+pkg func Get() {}
+pkg var v int
+export type T enum{ x, y }
+func hidden() {}
+`
+	const bsrc = `package b
+
+import "a"
+
+var _ = a.แมว
+var _ = a.lower
+var _ = a.Get
+var _ = a.v
+var _ a.T = a.x
+var _ = a.hidden
+`
+	const csrc = `package c
+
+import . "a"
+
+var _ = lower
+var _ = Get
+`
+	check := func(path, module, src string, conf *Config) (*Package, []string) {
+		var errs []string
+		conf.Error = func(err error) {
+			errs = append(errs, err.(Error).Msg)
+		}
+		pkg := NewPackage(path, "")
+		pkg.SetModule(module)
+		NewChecker(conf, pkg, nil).Files([]*syntax.File{mustParse(src)})
+		return pkg, errs
+	}
+
+	a, errs := check("a", "example.com/m", asrc, &Config{})
+	if len(errs) > 0 {
+		t.Fatalf("package a: %v", errs)
+	}
+	if obj := a.Scope().Lookup("v"); obj.Visibility() != PkgVis || obj.Exported() {
+		t.Errorf("a.v: got visibility %d, exported %t; want PkgVis, false", obj.Visibility(), obj.Exported())
+	}
+	if obj := a.Scope().Lookup("x"); obj.Visibility() != ExportVis || !obj.Exported() {
+		t.Errorf("a.x: got visibility %d, exported %t; want ExportVis, true", obj.Visibility(), obj.Exported())
+	}
+
+	for _, test := range []struct {
+		module string
+		want   []string
+	}{
+		{"example.com/m", []string{
+			"name hidden not exported by package a",
+		}},
+		{"example.com/n", []string{
+			"name Get is only visible in module example.com/m",
+			"name v is only visible in module example.com/m",
+			"name hidden not exported by package a",
+		}},
+	} {
+		_, errs := check("b", test.module, bsrc, &Config{Importer: importHelper{pkg: a}})
+		if !slices.Equal(errs, test.want) {
+			t.Errorf("package b in module %s: got errors %q, want %q", test.module, errs, test.want)
+		}
+	}
+
+	_, errs = check("c", "example.com/n", csrc, &Config{Importer: importHelper{pkg: a}})
+	if want := []string{"undefined: Get"}; !slices.Equal(errs, want) {
+		t.Errorf("package c: got errors %q, want %q", errs, want)
+	}
+}
+
+func TestOverload(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+func Print(s string) string       { return s }
+func Print(n int, s string) string { return s }
+`
+	const bsrc = `//wo:dialect
+package b
+
+import "a"
+
+var x = a.Print("x")
+var y = a.Print(1, "y")
+`
+	const csrc = `package c
+
+import "a"
+
+var _ = a.Print_3int6string(1, "y")
+var _ = a.Print("x")
+`
+	a := mustTypecheck(asrc, nil, nil)
+	ov, _ := a.Scope().Lookup("Print").(*Overload)
+	if ov == nil || ov.NumFuncs() != 2 {
+		t.Fatalf("a.Print: got %v, want overloaded func with 2 functions", a.Scope().Lookup("Print"))
+	}
+	for i, want := range []string{"Print_6string", "Print_3int6string"} {
+		if got := ov.Func(i).Name(); got != want {
+			t.Errorf("a.Print: function %d has name %s, want %s", i, got, want)
+		}
+		if a.Scope().Lookup(want) != ov.Func(i) {
+			t.Errorf("a.%s is not declared in the package scope", want)
+		}
+	}
+
+	conf := Config{Importer: importHelper{pkg: a}}
+	info := Info{Uses: make(map[*syntax.Name]Object)}
+	mustTypecheck(bsrc, &conf, &info)
+	var got []string
+	for id, obj := range info.Uses {
+		if id.Value == "Print" {
+			got = append(got, obj.Name())
+		}
+	}
+	slices.Sort(got)
+	if want := []string{"Print_3int6string", "Print_6string"}; !slices.Equal(got, want) {
+		t.Errorf("package b: a.Print denotes %v, want %v", got, want)
+	}
+
+	var errs []string
+	conf.Error = func(err error) { errs = append(errs, err.(Error).Msg) }
+	typecheck(csrc, &conf, nil)
+	if want := []string{"cannot use overloaded function Print in Go file (use one of Print_6string, Print_3int6string)"}; !slices.Equal(errs, want) {
+		t.Errorf("package c: got errors %q, want %q", errs, want)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+var Console any
+
+func Print(s string, n = 2, out = Console, f func() = nil) {}
+`
+	const bsrc = `//wo:dialect
+package b
+
+import "a"
+
+func _() { a.Print("x") }
+`
+	const csrc = `package c
+
+import "a"
+
+func _() { a.Print("x") }
+`
+	a := mustTypecheck(asrc, nil, nil)
+	sig := a.Scope().Lookup("Print").Type().(*Signature)
+	for i, want := range []string{"<nil>", "const 2", "var a.Console any", "nil"} {
+		got := fmt.Sprint(sig.Default(i))
+		if c, ok := sig.Default(i).(*Const); ok {
+			got = "const " + c.Val().String()
+		}
+		if got != want {
+			t.Errorf("a.Print: default value of parameter %d is %s, want %s", i, got, want)
+		}
+	}
+	if got := sig.Params().At(1).Type(); got != Typ[Int] {
+		t.Errorf("a.Print: parameter n has type %s, want int", got)
+	}
+
+	conf := Config{Importer: importHelper{pkg: a}}
+	info := Info{
+		Types:       make(map[syntax.Expr]TypeAndValue),
+		Uses:        make(map[*syntax.Name]Object),
+		DefaultArgs: make(map[*syntax.CallExpr][]syntax.Expr),
+	}
+	f := mustParse(bsrc)
+	if _, err := conf.Check("b", []*syntax.File{f}, &info); err != nil {
+		t.Fatal(err)
+	}
+	var call *syntax.CallExpr
+	syntax.Inspect(f, func(n syntax.Node) bool {
+		if n, ok := n.(*syntax.CallExpr); ok {
+			call = n
+		}
+		return call == nil
+	})
+	// The syntax tree is unchanged; the default values are recorded
+	// separately.
+	if got, want := len(call.ArgList), 1; got != want {
+		t.Fatalf("package b: call has %d arguments, want %d", got, want)
+	}
+	dflts := info.DefaultArgs[call]
+	if got, want := len(dflts), 3; got != want {
+		t.Fatalf("package b: call has %d default arguments, want %d", got, want)
+	}
+	if tv := info.Types[dflts[0]]; tv.Value == nil || tv.Value.String() != "2" || tv.Type != Typ[Int] {
+		t.Errorf("package b: argument 1 is %v, want constant 2 of type int", tv)
+	}
+	if obj := info.Uses[dflts[1].(*syntax.Name)]; obj != a.Scope().Lookup("Console") {
+		t.Errorf("package b: argument 2 denotes %v, want a.Console", obj)
+	}
+	if tv := info.Types[dflts[2]]; !tv.IsNil() {
+		t.Errorf("package b: argument 3 is %v, want nil", tv)
+	}
+
+	var errs []string
+	conf.Error = func(err error) { errs = append(errs, err.(Error).Msg) }
+	typecheck(csrc, &conf, nil)
+	if len(errs) == 0 || !strings.HasPrefix(errs[0], "not enough arguments in call to a.Print") {
+		t.Errorf("package c: got errors %q, want not enough arguments", errs)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+func Get() (skip n int, s string, skip err error) { return }
+`
+	const bsrc = `//wo:dialect
+package b
+
+import "a"
+
+var _ string = a.Get()
+`
+	const csrc = `package c
+
+import "a"
+
+var _ string = a.Get()
+`
+	a := mustTypecheck(asrc, nil, nil)
+	sig := a.Scope().Lookup("Get").Type().(*Signature)
+	for i, want := range []bool{true, false, true} {
+		if got := sig.Skippable(i); got != want {
+			t.Errorf("a.Get: result %d is skippable = %v, want %v", i, got, want)
+		}
+	}
+	if got, want := sig.String(), "func() (n int, s string, err error)"; got != want {
+		t.Errorf("a.Get has type %s, want %s", got, want)
+	}
+
+	conf := Config{Importer: importHelper{pkg: a}}
+	if _, err := typecheck(bsrc, &conf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var errs []string
+	conf.Error = func(err error) { errs = append(errs, err.(Error).Msg) }
+	typecheck(csrc, &conf, nil)
+	if len(errs) == 0 || !strings.HasPrefix(errs[0], "multiple-value a.Get() (value of type (n int, s string, err error)) in single-value context") {
+		t.Errorf("package c: got errors %q, want multiple-value error", errs)
+	}
+}
@@ -1300,6 +1300,57 @@ func (o *orderState) expr1(n, lhs ir.Node) ir.Node {
 		o.out = append(o.out, nif)
 		return r
 
+	case ir.OCOND:
+		// ... = if Cond then X else Y
+		//
+		// var r T
+		// if Cond {
+		//     r = X
+		// } else {
+		//     r = Y
+		// }
+		// ... = r
+		//
+		// SSA turns simple instances of this pattern into
+		// conditional moves (see the branchelim pass).
+
+		n := n.(*ir.CondExpr)
+		r := o.newTemp(n.Type(), false)
+
+		cond := o.expr(n.Cond, nil)
+
+		// Evaluate each branch, save generated code.
+		branch := func(x ir.Node) []ir.Node {
+			saveout := o.out
+			o.out = nil
+			t := o.markTemp()
+			o.edge()
+			x = o.expr(x, nil)
+			o.out = append(o.out, typecheck.Stmt(ir.NewAssignStmt(base.Pos, r, x)))
+			o.popTemp(t)
+			gen := o.out
+			o.out = saveout
+			return gen
+		}
+		o.out = append(o.out, ir.NewIfStmt(base.Pos, cond, branch(n.X), branch(n.Y)))
+		return r
+
+	case ir.OINDEXSET:
+		// ... = X[Index]
+		//
+		// var r bool
+		// _, r = X[Index]
+		// ... = r
+
+		n := n.(*ir.IndexExpr)
+		r := o.newTemp(n.Type(), false)
+
+		index := ir.NewIndexExpr(n.Pos(), n.X, n.Index)
+		index.RType = n.RType
+		as := ir.NewAssignListStmt(n.Pos(), ir.OAS2, []ir.Node{ir.BlankNode, r}, []ir.Node{index})
+		o.stmt(typecheck.Stmt(as))
+		return r
+
 	case ir.OCALLMETH:
 		base.FatalfAt(n.Pos(), "OCALLMETH missed by typecheck")
 		panic("unreachable")
@@ -632,7 +632,15 @@ caseLoop:
 		sw.Compiled.Append(ir.NewLabelStmt(ncase.Pos(), labels[i]))
 		if caseVar := ncase.Var; caseVar != nil {
 			val := s.srcName
-			if len(ncase.List) == 1 {
+			if t := caseVar.Type(); len(ncase.List) != 1 && !types.Identical(t, s.srcName.Type()) ||
+				len(ncase.List) == 1 && ncase.List[0].Op() == ir.OTYPE && !types.Identical(t, ncase.List[0].Type()) {
+				// The case has a Wo union type, which matches the
+				// types in its type set. Convert the input value to
+				// the union type.
+				if !t.IsEmptyInterface() {
+					val = ir.NewTypeAssertExpr(ncase.Pos(), s.srcName, t)
+				}
+			} else if len(ncase.List) == 1 {
 				// single type. We have to downcast the input value to the target type.
 				if ncase.List[0].Op() == ir.OTYPE { // single compile-time known type
 					t := ncase.List[0].Type()
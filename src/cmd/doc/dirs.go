@@ -124,9 +124,9 @@ func (d *Dirs) bfsWalkRoot(root Dir) {
 			for _, entry := range entries {
 				name := entry.Name()
 				// For plain files, remember if this directory contains any .go
-				// source files, but ignore them otherwise.
+				// or .wo source files, but ignore them otherwise.
 				if !entry.IsDir() {
-					if !hasGoFiles && strings.HasSuffix(name, ".go") {
+					if !hasGoFiles && (strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo")) {
 						hasGoFiles = true
 					}
 					continue
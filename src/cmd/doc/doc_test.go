@@ -39,7 +39,8 @@ func TestMain(m *testing.M) {
 	dirsInit(
 		Dir{importPath: "testdata", dir: testdataDir},
 		Dir{importPath: "testdata/nested", dir: filepath.Join(testdataDir, "nested")},
-		Dir{importPath: "testdata/nested/nested", dir: filepath.Join(testdataDir, "nested", "nested")})
+		Dir{importPath: "testdata/nested/nested", dir: filepath.Join(testdataDir, "nested", "nested")},
+		Dir{importPath: "testdata/wo", dir: filepath.Join(testdataDir, "wo")})
 
 	os.Exit(m.Run())
 }
@@ -871,6 +872,37 @@ var tests = []test{
 		},
 		[]string{"ignore:directive"},
 	},
+
+	// Wo visibility modifiers.
+	{
+		"package with only Wo files",
+		[]string{p + "/wo"},
+		[]string{
+			`Package wo has only Wo files`,
+			`func แมว\(\) string`,
+			`type point struct`,
+		},
+		[]string{
+			`Get`,
+		},
+	},
+	{
+		"Wo exported function",
+		[]string{p + "/wo", `แมว`},
+		[]string{
+			`export func แมว\(\) string`,
+			`แมว is exported by its modifier`,
+		},
+		nil,
+	},
+	{
+		"Wo unexported function with -u",
+		[]string{"-u", p + "/wo", `Get`},
+		[]string{
+			`pkg func Get\(\) int`,
+		},
+		nil,
+	},
 }
 
 func TestDoc(t *testing.T) {
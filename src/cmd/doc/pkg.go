@@ -37,9 +37,10 @@ type Package struct {
 	file        *ast.File    // Merged from all files in the package
 	doc         *doc.Package
 	build       *build.Package
-	typedValue  map[*doc.Value]bool // Consts and vars related to types.
-	constructor map[*doc.Func]bool  // Constructors.
-	fs          *token.FileSet      // Needed for printing.
+	typedValue  map[*doc.Value]bool       // Consts and vars related to types.
+	constructor map[*doc.Func]bool        // Constructors.
+	vis         map[string]ast.Visibility // Wo visibility modifiers of package-level names.
+	fs          *token.FileSet            // Needed for printing.
 	buf         pkgBuffer
 }
 
@@ -139,14 +140,19 @@ func (pkg *Package) Fatalf(format string, args ...any) {
 // we can then use to generate documentation.
 func parsePackage(writer io.Writer, pkg *build.Package, userPath string) *Package {
 	// include tells parser.ParseDir which files to include.
-	// That means the file must be in the build package's GoFiles or CgoFiles
-	// list only (no tag-ignored files, tests, swig or other non-Go files).
+	// That means the file must be in the build package's GoFiles, WoFiles or
+	// CgoFiles list only (no tag-ignored files, tests, swig or other non-Go files).
 	include := func(info fs.FileInfo) bool {
 		for _, name := range pkg.GoFiles {
 			if name == info.Name() {
 				return true
 			}
 		}
+		for _, name := range pkg.WoFiles {
+			if name == info.Name() {
+				return true
+			}
+		}
 		for _, name := range pkg.CgoFiles {
 			if name == info.Name() {
 				return true
@@ -181,43 +187,83 @@ func parsePackage(writer io.Writer, pkg *build.Package, userPath string) *Packag
 		mode |= doc.PreserveAST // See comment for Package.emit.
 	}
 	docPkg := doc.New(astPkg, pkg.ImportPath, mode)
-	typedValue := make(map[*doc.Value]bool)
-	constructor := make(map[*doc.Func]bool)
+	p := &Package{
+		writer:      writer,
+		name:        pkg.Name,
+		userPath:    userPath,
+		pkg:         astPkg,
+		file:        ast.MergePackageFiles(astPkg, 0),
+		doc:         docPkg,
+		typedValue:  make(map[*doc.Value]bool),
+		constructor: make(map[*doc.Func]bool),
+		vis:         packageVisibility(astPkg),
+		build:       pkg,
+		fs:          fset,
+	}
 	for _, typ := range docPkg.Types {
 		docPkg.Consts = append(docPkg.Consts, typ.Consts...)
 		docPkg.Vars = append(docPkg.Vars, typ.Vars...)
 		docPkg.Funcs = append(docPkg.Funcs, typ.Funcs...)
-		if isExported(typ.Name) {
+		if p.isExported(typ.Name) {
 			for _, value := range typ.Consts {
-				typedValue[value] = true
+				p.typedValue[value] = true
 			}
 			for _, value := range typ.Vars {
-				typedValue[value] = true
+				p.typedValue[value] = true
 			}
 			for _, fun := range typ.Funcs {
 				// We don't count it as a constructor bound to the type
 				// if the type itself is not exported.
-				constructor[fun] = true
+				p.constructor[fun] = true
 			}
 		}
 	}
-
-	p := &Package{
-		writer:      writer,
-		name:        pkg.Name,
-		userPath:    userPath,
-		pkg:         astPkg,
-		file:        ast.MergePackageFiles(astPkg, 0),
-		doc:         docPkg,
-		typedValue:  typedValue,
-		constructor: constructor,
-		build:       pkg,
-		fs:          fset,
-	}
 	p.buf.pkg = p
 	return p
 }
 
+// packageVisibility returns the Wo visibility modifiers of the
+// package-level names declared in pkg.
+func packageVisibility(pkg *ast.Package) map[string]ast.Visibility {
+	vis := make(map[string]ast.Visibility)
+	for _, file := range pkg.Files {
+		for _, decl := range file.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Vis == ast.DefaultVis {
+					continue
+				}
+				for _, spec := range d.Specs {
+					switch s := spec.(type) {
+					case *ast.ValueSpec:
+						for _, name := range s.Names {
+							vis[name.Name] = d.Vis
+						}
+					case *ast.TypeSpec:
+						vis[s.Name.Name] = d.Vis
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Recv == nil && d.Vis != ast.DefaultVis {
+					vis[d.Name.Name] = d.Vis
+				}
+			}
+		}
+	}
+	return vis
+}
+
+// isExported reports whether the package-level name is exported.
+// It is like the isExported function, but names declared with a Wo
+// visibility modifier are exported according to the modifier rather
+// than their capitalization.
+func (pkg *Package) isExported(name string) bool {
+	if vis, ok := pkg.vis[name]; ok {
+		return unexported || vis == ast.ExportVis
+	}
+	return isExported(name)
+}
+
 func (pkg *Package) Printf(format string, args ...any) {
 	fmt.Fprintf(&pkg.buf, format, args...)
 }
@@ -305,7 +351,7 @@ func (pkg *Package) oneLineNodeDepth(node ast.Node, depth int) string {
 				typ = ""
 			}
 
-			if !isExported(valueSpec.Names[0].Name) {
+			if !pkg.isExported(valueSpec.Names[0].Name) {
 				continue
 			}
 			val := ""
@@ -480,7 +526,7 @@ func (pkg *Package) constsDoc(printed map[*ast.GenDecl]bool) {
 		// Constants and variables come in groups, and valueDoc prints
 		// all the items in the group. We only need to find one exported symbol.
 		for _, name := range value.Names {
-			if isExported(name) && !pkg.typedValue[value] {
+			if pkg.isExported(name) && !pkg.typedValue[value] {
 				if !header {
 					pkg.printHeader("CONSTANTS")
 					header = true
@@ -500,7 +546,7 @@ func (pkg *Package) varsDoc(printed map[*ast.GenDecl]bool) {
 		// Constants and variables come in groups, and valueDoc prints
 		// all the items in the group. We only need to find one exported symbol.
 		for _, name := range value.Names {
-			if isExported(name) && !pkg.typedValue[value] {
+			if pkg.isExported(name) && !pkg.typedValue[value] {
 				if !header {
 					pkg.printHeader("VARIABLES")
 					header = true
@@ -516,7 +562,7 @@ func (pkg *Package) varsDoc(printed map[*ast.GenDecl]bool) {
 func (pkg *Package) funcsDoc() {
 	var header bool
 	for _, fun := range pkg.doc.Funcs {
-		if isExported(fun.Name) && !pkg.constructor[fun] {
+		if pkg.isExported(fun.Name) && !pkg.constructor[fun] {
 			if !header {
 				pkg.printHeader("FUNCTIONS")
 				header = true
@@ -530,7 +576,7 @@ func (pkg *Package) funcsDoc() {
 func (pkg *Package) typesDoc() {
 	var header bool
 	for _, typ := range pkg.doc.Types {
-		if isExported(typ.Name) {
+		if pkg.isExported(typ.Name) {
 			if !header {
 				pkg.printHeader("TYPES")
 				header = true
@@ -621,7 +667,7 @@ func (pkg *Package) valueSummary(values []*doc.Value, showGrouped bool) {
 	if !showGrouped {
 		isGrouped = make(map[*doc.Value]bool)
 		for _, typ := range pkg.doc.Types {
-			if !isExported(typ.Name) {
+			if !pkg.isExported(typ.Name) {
 				continue
 			}
 			for _, c := range typ.Consts {
@@ -647,7 +693,7 @@ func (pkg *Package) valueSummary(values []*doc.Value, showGrouped bool) {
 func (pkg *Package) funcSummary(funcs []*doc.Func, showConstructors bool) {
 	for _, fun := range funcs {
 		// Exported functions only. The go/doc package does not include methods here.
-		if isExported(fun.Name) {
+		if pkg.isExported(fun.Name) {
 			if showConstructors || !pkg.constructor[fun] {
 				pkg.Printf("%s\n", pkg.oneLineNode(fun.Decl))
 			}
@@ -660,7 +706,7 @@ func (pkg *Package) typeSummary() {
 	for _, typ := range pkg.doc.Types {
 		for _, spec := range typ.Decl.Specs {
 			typeSpec := spec.(*ast.TypeSpec) // Must succeed.
-			if isExported(typeSpec.Name.Name) {
+			if pkg.isExported(typeSpec.Name.Name) {
 				pkg.Printf("%s\n", pkg.oneLineNode(typeSpec))
 				// Now print the consts, vars, and constructors.
 				for _, c := range typ.Consts {
@@ -674,7 +720,7 @@ func (pkg *Package) typeSummary() {
 					}
 				}
 				for _, constructor := range typ.Funcs {
-					if isExported(constructor.Name) {
+					if pkg.isExported(constructor.Name) {
 						pkg.Printf(indent+"%s\n", pkg.oneLineNode(constructor.Decl))
 					}
 				}
@@ -699,7 +745,7 @@ func (pkg *Package) bugs() {
 func (pkg *Package) findValues(symbol string, docValues []*doc.Value) (values []*doc.Value) {
 	for _, value := range docValues {
 		for _, name := range value.Names {
-			if match(symbol, name) {
+			if pkg.match(symbol, name) {
 				values = append(values, value)
 			}
 		}
@@ -710,7 +756,7 @@ func (pkg *Package) findValues(symbol string, docValues []*doc.Value) (values []
 // findFuncs finds the doc.Funcs that describes the symbol.
 func (pkg *Package) findFuncs(symbol string) (funcs []*doc.Func) {
 	for _, fun := range pkg.doc.Funcs {
-		if match(symbol, fun.Name) {
+		if pkg.match(symbol, fun.Name) {
 			funcs = append(funcs, fun)
 		}
 	}
@@ -721,7 +767,7 @@ func (pkg *Package) findFuncs(symbol string) (funcs []*doc.Func) {
 // If symbol is empty, it finds all exported types.
 func (pkg *Package) findTypes(symbol string) (types []*doc.Type) {
 	for _, typ := range pkg.doc.Types {
-		if symbol == "" && isExported(typ.Name) || match(symbol, typ.Name) {
+		if symbol == "" && pkg.isExported(typ.Name) || pkg.match(symbol, typ.Name) {
 			types = append(types, typ)
 		}
 	}
@@ -802,7 +848,7 @@ func (pkg *Package) valueDoc(value *doc.Value, printed map[*ast.GenDecl]bool) {
 		}
 
 		for _, ident := range vspec.Names {
-			if showSrc || isExported(ident.Name) {
+			if showSrc || pkg.isExported(ident.Name) {
 				if vspec.Type == nil && vspec.Values == nil && typ != nil {
 					// This a standalone identifier, as in the case of iota usage.
 					// Thus, assume the type comes from the previous type.
@@ -846,7 +892,7 @@ func (pkg *Package) typeDoc(typ *doc.Type) {
 		values = append(values, typ.Vars...)
 		for _, value := range values {
 			for _, name := range value.Names {
-				if isExported(name) {
+				if pkg.isExported(name) {
 					pkg.valueDoc(value, printed)
 					break
 				}
@@ -855,7 +901,8 @@ func (pkg *Package) typeDoc(typ *doc.Type) {
 		funcs := typ.Funcs
 		funcs = append(funcs, typ.Methods...)
 		for _, fun := range funcs {
-			if isExported(fun.Name) {
+			// Methods cannot have visibility modifiers.
+			if fun.Recv == "" && pkg.isExported(fun.Name) || fun.Recv != "" && isExported(fun.Name) {
 				pkg.emit(fun.Doc, fun.Decl)
 				if fun.Doc == "" {
 					pkg.newlines(2)
@@ -1134,9 +1181,18 @@ func (pkg *Package) printFieldDoc(symbol, fieldName string) bool {
 // A lower-case character in the user's string matches either case in the program's.
 // The program string must be exported.
 func match(user, program string) bool {
-	if !isExported(program) {
-		return false
-	}
+	return isExported(program) && matchName(user, program)
+}
+
+// match is like the match function, but the program's symbol is a
+// package-level name that is exported according to pkg.isExported.
+func (pkg *Package) match(user, program string) bool {
+	return pkg.isExported(program) && matchName(user, program)
+}
+
+// matchName reports whether the user's symbol matches the program's,
+// regardless of whether the program's symbol is exported.
+func matchName(user, program string) bool {
 	if matchCase {
 		return user == program
 	}
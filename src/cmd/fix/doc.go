@@ -22,6 +22,16 @@ error giving the name of the file and the rewrite applied.
 If the -diff flag is set, no files are rewritten. Instead fix prints
 the differences a rewrite would introduce.
 
+If the -wo flag is set, fix also applies the rewrites that turn Go
+code into idiomatic Wo code, such as for v : xs loops, the ! operator
+and set types, and considers .wo files as well. A Go file rewritten
+this way gets a //wo:dialect directive that enables only the Wo
+features the rewrites introduced, such as //wo:dialect range,set, so
+that the rest of the file is still compiled by the Go rules. The Wo
+rewrites use the type information available in the file, and only
+rewrite code whose meaning they preserve; run fix with -wo -diff to
+review them first.
+
 The -r flag restricts the set of rewrites considered to those in the
 named list.  By default fix considers all known rewrites.  Fix's
 rewrites are idempotent, so that it is safe to apply fix to updated
@@ -18,6 +18,11 @@ type fix struct {
 	f        func(*ast.File) bool
 	desc     string
 	disabled bool // whether this fix should be disabled by default
+	wo       bool // whether this fix rewrites Go code into Wo code (see -wo)
+
+	// woFeatures lists the Wo features used by the code a Wo fix
+	// introduces, for the //wo:dialect directive of Go files.
+	woFeatures []string
 }
 
 var fixes []fix
@@ -88,11 +93,18 @@ func walkBeforeAfter(x any, before, after func(any)) {
 	case *ast.Field:
 		walkBeforeAfter(&n.Names, before, after)
 		walkBeforeAfter(&n.Type, before, after)
+		walkBeforeAfter(&n.Default, before, after)
 		walkBeforeAfter(&n.Tag, before, after)
 	case *ast.FieldList:
 		for _, field := range n.List {
 			walkBeforeAfter(field, before, after)
 		}
+	case *ast.Variant:
+		walkBeforeAfter(&n.Name, before, after)
+		walkBeforeAfter(&n.Values, before, after)
+		if n.Fields != nil {
+			walkBeforeAfter(&n.Fields, before, after)
+		}
 	case *ast.BadExpr:
 	case *ast.Ident:
 	case *ast.Ellipsis:
@@ -101,6 +113,9 @@ func walkBeforeAfter(x any, before, after func(any)) {
 	case *ast.FuncLit:
 		walkBeforeAfter(&n.Type, before, after)
 		walkBeforeAfter(&n.Body, before, after)
+	case *ast.LambdaExpr:
+		walkBeforeAfter(&n.Params, before, after)
+		walkBeforeAfter(&n.Body, before, after)
 	case *ast.CompositeLit:
 		walkBeforeAfter(&n.Type, before, after)
 		walkBeforeAfter(&n.Elts, before, after)
@@ -135,6 +150,16 @@ func walkBeforeAfter(x any, before, after func(any)) {
 	case *ast.BinaryExpr:
 		walkBeforeAfter(&n.X, before, after)
 		walkBeforeAfter(&n.Y, before, after)
+	case *ast.PostfixExpr:
+		walkBeforeAfter(&n.X, before, after)
+	case *ast.CondExpr:
+		walkBeforeAfter(&n.Cond, before, after)
+		walkBeforeAfter(&n.X, before, after)
+		walkBeforeAfter(&n.Y, before, after)
+	case *ast.BindingExpr:
+		walkBeforeAfter(&n.Names, before, after)
+		walkBeforeAfter(&n.Type, before, after)
+		walkBeforeAfter(&n.Value, before, after)
 	case *ast.KeyValueExpr:
 		walkBeforeAfter(&n.Key, before, after)
 		walkBeforeAfter(&n.Value, before, after)
@@ -154,6 +179,13 @@ func walkBeforeAfter(x any, before, after func(any)) {
 		}
 	case *ast.InterfaceType:
 		walkBeforeAfter(&n.Methods, before, after)
+	case *ast.EnumType:
+		for _, v := range n.Variants {
+			walkBeforeAfter(v, before, after)
+		}
+		if n.Fields != nil {
+			walkBeforeAfter(&n.Fields, before, after)
+		}
 	case *ast.MapType:
 		walkBeforeAfter(&n.Key, before, after)
 		walkBeforeAfter(&n.Value, before, after)
@@ -41,13 +41,14 @@ var allowed, force map[string]bool
 var (
 	doDiff    = flag.Bool("diff", false, "display diffs instead of rewriting files")
 	goVersion = flag.String("go", "", "go language version for files")
+	doWo      = flag.Bool("wo", false, "rewrite Go code into the Wo dialect")
 )
 
 // enable for debugging fix failures
 const debug = false // display incorrectly reformatted source and exit
 
 func usage() {
-	fmt.Fprintf(os.Stderr, "usage: go tool fix [-diff] [-r fixname,...] [-force fixname,...] [path ...]\n")
+	fmt.Fprintf(os.Stderr, "usage: go tool fix [-diff] [-wo] [-r fixname,...] [-force fixname,...] [path ...]\n")
 	flag.PrintDefaults()
 	fmt.Fprintf(os.Stderr, "\nAvailable rewrites are:\n")
 	slices.SortFunc(fixes, func(a, b fix) int {
@@ -56,6 +57,8 @@ func usage() {
 	for _, f := range fixes {
 		if f.disabled {
 			fmt.Fprintf(os.Stderr, "\n%s (disabled)\n", f.name)
+		} else if f.wo {
+			fmt.Fprintf(os.Stderr, "\n%s (-wo)\n", f.name)
 		} else {
 			fmt.Fprintf(os.Stderr, "\n%s\n", f.name)
 		}
@@ -78,7 +81,8 @@ func main() {
 		os.Exit(exitCode)
 	}
 
-	slices.SortFunc(fixes, func(a, b fix) int {
+	// Fixes introduced on the same day run in registration order.
+	slices.SortStableFunc(fixes, func(a, b fix) int {
 		return strings.Compare(a.date, b.date)
 	})
 
@@ -173,6 +177,9 @@ func processFile(filename string, useStdin bool) error {
 	// Apply all fixes to file.
 	newFile := file
 	fixed := false
+	mode := parserMode
+	goFile := !file.Wo
+	var woFeatures []string // Wo features introduced into a Go file
 	for _, fix := range fixes {
 		if allowed != nil && !allowed[fix.name] {
 			continue
@@ -180,10 +187,24 @@ func processFile(filename string, useStdin bool) error {
 		if fix.disabled && !force[fix.name] {
 			continue
 		}
+		if fix.wo && !*doWo {
+			continue
+		}
 		if fix.f(newFile) {
 			fixed = true
 			fmt.Fprintf(&fixlog, " %s", fix.name)
 
+			if fix.wo && goFile && len(fix.woFeatures) > 0 {
+				// The fix introduced Wo syntax into a Go file.
+				newFile.Wo = true
+				for _, name := range fix.woFeatures {
+					if !slices.Contains(woFeatures, name) {
+						woFeatures = append(woFeatures, name)
+					}
+				}
+				mode |= parser.WoDialect
+			}
+
 			// AST changed.
 			// Print and parse, to update any missing scoping
 			// or position information for subsequent fixers.
@@ -191,7 +212,7 @@ func processFile(filename string, useStdin bool) error {
 			if err != nil {
 				return err
 			}
-			newFile, err = parser.ParseFile(fset, filename, newSrc, parserMode)
+			newFile, err = parser.ParseFile(fset, filename, newSrc, mode)
 			if err != nil {
 				if debug {
 					fmt.Printf("%s", newSrc)
@@ -217,6 +238,9 @@ func processFile(filename string, useStdin bool) error {
 	if err != nil {
 		return err
 	}
+	if len(woFeatures) > 0 {
+		newSrc = addWoDirective(newSrc, woFeatures)
+	}
 
 	if *doDiff {
 		os.Stdout.Write(diff.Diff(filename, src, "fixed/"+filename, newSrc))
@@ -259,7 +283,7 @@ func visitFile(path string, f fs.DirEntry, err error) error {
 }
 
 func isGoFile(f fs.DirEntry) bool {
-	// ignore non-Go files
+	// ignore non-Go files, and Wo files unless rewriting into Wo
 	name := f.Name()
-	return !f.IsDir() && !strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".go")
+	return !f.IsDir() && !strings.HasPrefix(name, ".") && (strings.HasSuffix(name, ".go") || *doWo && strings.HasSuffix(name, ".wo"))
 }
@@ -0,0 +1,92 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// The Wo fixes rewrite Go code into idiomatic Wo code. They only run
+// with the -wo flag. A Go file they rewrite gets a //wo:dialect
+// directive that enables just the Wo features of the rewritten code
+// (see fix.woFeatures), so that the rest of the file is still checked
+// by the Go rules.
+//
+// Like the other fixes, the Wo fixes see a single file at a time and
+// have only the partial type information of typecheck. They rewrite
+// code only when the Wo form means the same as the Go form, or when a
+// wrong guess results in a compile-time error rather than a change of
+// behavior.
+
+// woDate is the date the Wo fixes were introduced. They have the same
+// date and run in the order of registration.
+const woDate = "2026-10-18"
+
+// addWoDirective returns the Go source src, marked as Wo source
+// that uses the given Wo features.
+func addWoDirective(src []byte, features []string) []byte {
+	return append([]byte("//wo:dialect "+strings.Join(features, ",")+"\n\n"), src...)
+}
+
+// isBlank reports whether n is the blank identifier.
+func isBlank(n ast.Expr) bool {
+	id, ok := n.(*ast.Ident)
+	return ok && id.Name == "_"
+}
+
+// isNew reports whether id is declared by the statement or value
+// specification decl.
+func isNew(id *ast.Ident, decl any) bool {
+	return id.Obj != nil && id.Obj.Kind == ast.Var && id.Obj.Decl == decl
+}
+
+// countUses returns the number of identifiers in n denoting obj.
+func countUses(n any, obj *ast.Object) int {
+	count := 0
+	walk(n, func(n any) {
+		if id, ok := n.(*ast.Ident); ok && id.Obj == obj {
+			count++
+		}
+	})
+	return count
+}
+
+// hasComments reports whether f has comments within the source of n.
+func hasComments(f *ast.File, n ast.Node) bool {
+	for _, c := range f.Comments {
+		if n.Pos() <= c.Pos() && c.End() <= n.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// isEmptyStructLit reports whether x is the composite literal
+// struct{}{}, or {} with the type elided.
+func isEmptyStructLit(x ast.Expr) bool {
+	lit, ok := x.(*ast.CompositeLit)
+	if !ok || len(lit.Elts) > 0 {
+		return false
+	}
+	if lit.Type == nil {
+		return true
+	}
+	st, ok := lit.Type.(*ast.StructType)
+	return ok && len(st.Fields.List) == 0
+}
+
+// isZeroLit reports whether x is a literal zero value of any type it
+// can be assigned to.
+func isZeroLit(x ast.Expr) bool {
+	switch x := x.(type) {
+	case *ast.Ident:
+		return x.Obj == nil && (x.Name == "nil" || x.Name == "false")
+	case *ast.BasicLit:
+		return x.Kind == token.INT && x.Value == "0" || x.Kind == token.STRING && (x.Value == `""` || x.Value == "``")
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"internal/testenv"
+)
+
+var woFileTests = []struct {
+	name      string
+	in        string
+	directive string // first line of the rewritten file
+}{
+	{
+		name: "range",
+		in: `package p
+
+import (
+	"strings"
+	"fmt"
+)
+
+func f(xs []string) (s string) {
+	for _, x := range xs {
+		s += strings.ToUpper(x)
+	}
+	for i := 0; i < len(xs); i++ {
+		if n := len(xs[i]); n > 0 {
+			s += fmt.Sprint(n)
+		}
+	}
+	a, b := 1, 2
+	a, b = b, a
+	var t string
+	if a > b {
+		t = "x"
+	}
+	return s + t
+}
+`,
+		directive: "//wo:dialect range",
+	},
+	{
+		name: "all",
+		in: `package p
+
+import "strconv"
+
+func f(xs []string, v interface{}) (int, error) {
+	seen := map[string]struct{}{}
+	for _, x := range xs {
+		seen[x] = struct{}{}
+	}
+	n, err := strconv.Atoi(xs[0])
+	if err != nil {
+		return 0, err
+	}
+	_, ok := seen["a"]
+	for i := range xs {
+		n += i
+	}
+	_, _ = ok, v
+	return n, nil
+}
+`,
+		directive: "//wo:dialect interface,range,result,set,assign",
+	},
+	{
+		name: "assign",
+		in: `package p
+
+func f(m map[string][]int, s []int, ch chan string) int {
+	n, err := count(s)
+	k, err := count(s)
+	p, q := 20, 30
+	p, q = q+1, 40
+	if v, ok := m["a"]; ok && err == nil {
+		n += len(v)
+	}
+	if t := n; t > 0 {
+		n += t
+	}
+	select {
+	case x, ok := <-ch:
+		n += len(x)
+		_ = ok
+	default:
+	}
+	return n + k + p + q
+}
+
+func count(s []int) (int, error) { return len(s), nil }
+`,
+		directive: "//wo:dialect assign",
+	},
+}
+
+// TestWoFiles checks that the Wo fixes turn Go files into Wo files
+// that type-check.
+func TestWoFiles(t *testing.T) {
+	testenv.MustHaveGoBuild(t)
+
+	old := *doWo
+	*doWo = true
+	defer func() { *doWo = old }()
+
+	for _, tt := range woFileTests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "p.go")
+			if err := os.WriteFile(file, []byte(tt.in), 0o666); err != nil {
+				t.Fatal(err)
+			}
+			if err := processFile(file, false); err != nil {
+				t.Fatal(err)
+			}
+			out, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if line, _, _ := strings.Cut(string(out), "\n"); line != tt.directive {
+				t.Errorf("directive is %q, want %q", line, tt.directive)
+			}
+
+			f, err := parser.ParseFile(fset, file, out, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("parsing the rewritten file: %v\n%s", err, out)
+			}
+			conf := types.Config{Importer: importer.Default()}
+			if _, err := conf.Check("p", fset, []*ast.File{f}, nil); err != nil {
+				t.Errorf("checking the rewritten file: %v\n%s", err, out)
+			}
+		})
+	}
+}
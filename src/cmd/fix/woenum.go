@@ -0,0 +1,210 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+)
+
+func init() {
+	register(woenumFix)
+}
+
+var woenumFix = fix{
+	name: "woenum",
+	date: woDate,
+	f:    woenum,
+	desc: `Rewrite integer types with an iota constant block to Wo enum types.
+
+	type color int
+
+	const (
+		red color = iota
+		green
+		blue
+	)
+
+becomes
+
+	type color enum{ red, green, blue }
+
+The fix applies only to unexported types without methods whose values
+are not used as integers in the file, and whose switches list all
+constants or have a default case. It is disabled by default, because
+the String method of enum types changes how the values print, and
+because the other files of the package may use the values as integers.
+`,
+	disabled:   true,
+	wo:         true,
+	woFeatures: []string{"enum"},
+}
+
+func woenum(f *ast.File) bool {
+	typeof, _ := typecheck(&TypeConfig{}, f)
+
+	// Find the types and their constant blocks.
+	type enum struct {
+		spec   *ast.TypeSpec
+		consts *ast.GenDecl
+		ok     bool
+	}
+	var enums []*enum
+	byObj := make(map[*ast.Object]*enum) // type and constant objects
+	byName := make(map[string]*enum)     // type names
+	for _, decl := range f.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range d.Specs {
+			spec := spec.(*ast.TypeSpec)
+			typ, ok := spec.Type.(*ast.Ident)
+			if !ok || typ.Obj != nil || spec.Name.IsExported() || spec.TypeParams != nil || spec.Assign.IsValid() {
+				continue
+			}
+			switch typ.Name {
+			case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+				e := &enum{spec: spec, ok: true}
+				enums = append(enums, e)
+				byObj[spec.Name.Obj] = e
+				byName[spec.Name.Name] = e
+			}
+		}
+	}
+	if len(enums) == 0 {
+		return false
+	}
+	for _, decl := range f.Decls {
+		d, ok := decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.CONST {
+			continue
+		}
+		first := d.Specs[0].(*ast.ValueSpec)
+		typ, ok := first.Type.(*ast.Ident)
+		if !ok || byObj[typ.Obj] == nil {
+			continue
+		}
+		e := byObj[typ.Obj]
+		if e.consts != nil || len(first.Values) != 1 || !isTopName(first.Values[0], "iota") || hasComments(f, d) {
+			e.ok = false
+			continue
+		}
+		e.consts = d
+		for i, spec := range d.Specs {
+			spec := spec.(*ast.ValueSpec)
+			if len(spec.Names) != 1 || isBlank(spec.Names[0]) || i > 0 && (spec.Type != nil || spec.Values != nil) {
+				e.ok = false
+			}
+			byObj[spec.Names[0].Obj] = e
+		}
+	}
+
+	// Check the uses of the types and constants, and of the values of
+	// the types.
+	isEnum := func(x ast.Expr) *enum {
+		if id, ok := x.(*ast.Ident); ok && id.Obj != nil {
+			if e := byObj[id.Obj]; e != nil {
+				return e
+			}
+		}
+		return byName[typeof[x]]
+	}
+	invalidate := func(xs ...ast.Expr) {
+		for _, x := range xs {
+			if e := isEnum(x); e != nil {
+				e.ok = false
+			}
+		}
+	}
+	walk(f, func(n any) {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			// no methods
+			if n.Recv != nil && len(n.Recv.List) == 1 {
+				t := n.Recv.List[0].Type
+				if star, ok := t.(*ast.StarExpr); ok {
+					t = star.X
+				}
+				invalidate(t)
+			}
+		case *ast.GenDecl:
+			// no other constants
+			if n.Tok != token.CONST {
+				return
+			}
+			for _, spec := range n.Specs {
+				if t := spec.(*ast.ValueSpec).Type; t != nil {
+					if e := isEnum(t); e != nil && e.consts != n {
+						e.ok = false
+					}
+				}
+			}
+		case *ast.CallExpr:
+			// no conversions
+			invalidate(n.Fun)
+			if id, ok := n.Fun.(*ast.Ident); ok && id.Obj == nil && len(n.Args) == 1 {
+				invalidate(n.Args[0])
+			}
+		case *ast.BinaryExpr:
+			// no arithmetic and ordering
+			if n.Op != token.EQL && n.Op != token.NEQ {
+				invalidate(n.X, n.Y)
+			}
+		case *ast.UnaryExpr:
+			invalidate(n.X)
+		case *ast.IncDecStmt:
+			invalidate(n.X)
+		case *ast.AssignStmt:
+			if n.Tok != token.ASSIGN && n.Tok != token.DEFINE {
+				invalidate(n.Lhs...)
+			}
+		case *ast.IndexExpr:
+			invalidate(n.Index)
+		case *ast.KeyValueExpr:
+			invalidate(n.Key)
+		case *ast.SwitchStmt:
+			// exhaustive switches
+			var e *enum
+			listed := make(map[*ast.Object]bool)
+			for _, c := range n.Body.List {
+				c := c.(*ast.CaseClause)
+				if c.List == nil {
+					return
+				}
+				for _, x := range c.List {
+					if id, ok := x.(*ast.Ident); ok && byObj[id.Obj] != nil {
+						e = byObj[id.Obj]
+						listed[id.Obj] = true
+					}
+				}
+			}
+			if e != nil && e.consts != nil && len(listed) < len(e.consts.Specs) {
+				e.ok = false
+			}
+		}
+	})
+
+	fixed := false
+	for _, e := range enums {
+		if !e.ok || e.consts == nil {
+			continue
+		}
+		pos := e.spec.Type.Pos()
+		t := &ast.EnumType{Enum: pos, Lbrace: pos, Rbrace: pos}
+		for _, spec := range e.consts.Specs {
+			name := spec.(*ast.ValueSpec).Names[0]
+			t.Variants = append(t.Variants, &ast.Variant{Name: &ast.Ident{NamePos: pos, Name: name.Name}})
+		}
+		e.spec.Type = t
+		f.Decls = slices.DeleteFunc(f.Decls, func(d ast.Decl) bool { return d == e.consts })
+		if doc := e.consts.Doc; doc != nil {
+			f.Comments = slices.DeleteFunc(f.Comments, func(c *ast.CommentGroup) bool { return c == doc })
+		}
+		fixed = true
+	}
+	return fixed
+}
@@ -0,0 +1,132 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+func init() {
+	addTestCases(woenumTests, woenum)
+}
+
+var woenumTests = []testCase{
+	{
+		Name: "woenum.0",
+		In: `//wo:dialect
+
+package main
+
+type color int
+
+// The colors.
+const (
+	red color = iota
+	green
+	blue
+)
+
+type state uint8
+
+const (
+	idle state = iota
+	running
+)
+
+func f(c color, s state) bool {
+	switch c {
+	case red, green:
+		return true
+	case blue:
+	}
+	switch s {
+	case idle:
+	default:
+	}
+	var d color = red
+	return c == d && s != running
+}
+`,
+		Out: `//wo:dialect
+
+package main
+
+type color enum{ red, green, blue }
+
+type state enum{ idle, running }
+
+func f(c color, s state) bool {
+	switch c {
+	case red, green:
+		return true
+	case blue:
+	}
+	switch s {
+	case idle:
+	default:
+	}
+	var d color = red
+	return c == d && s != running
+}
+`,
+	},
+	{
+		Name: "woenum.1",
+		In: `//wo:dialect
+
+package main
+
+type Exported int
+
+const (
+	A Exported = iota
+	B
+)
+
+type arith int
+
+const (
+	one arith = iota
+	two
+)
+
+type conv int
+
+const (
+	x conv = iota
+	y
+)
+
+type method int
+
+const (
+	m0 method = iota
+	m1
+)
+
+func (method) String() string { return "" }
+
+type values int
+
+const (
+	v0 values = iota + 1
+	v1
+)
+
+type partial int
+
+const (
+	p0 partial = iota
+	p1
+	p2
+)
+
+var names = [...]string{x: "x", y: "y"}
+
+func f(a arith, p partial) int {
+	switch p {
+	case p0, p1:
+	}
+	return int(a) + int(two)
+}
+`,
+	},
+}
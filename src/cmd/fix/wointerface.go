@@ -0,0 +1,55 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+func init() {
+	register(wointerfaceFix)
+}
+
+var wointerfaceFix = fix{
+	name:       "wointerface",
+	date:       woDate,
+	f:          wointerface,
+	desc:       `Rewrite the empty interface type interface{} to the Wo form <>.`,
+	wo:         true,
+	woFeatures: []string{"interface"},
+}
+
+func wointerface(f *ast.File) bool {
+	// A constraint <> in a type parameter list is ambiguous with
+	// a comparison; leave constraints alone.
+	constraints := make(map[ast.Expr]bool)
+	walk(f, func(n any) {
+		var tparams *ast.FieldList
+		switch n := n.(type) {
+		case *ast.TypeSpec:
+			tparams = n.TypeParams
+		case *ast.FuncType:
+			tparams = n.TypeParams
+		}
+		if tparams != nil {
+			for _, field := range tparams.List {
+				constraints[field.Type] = true
+			}
+		}
+	})
+
+	fixed := false
+	walk(f, func(n any) {
+		t, ok := n.(*ast.InterfaceType)
+		if !ok || t.Compact || len(t.Methods.List) > 0 || constraints[t] || hasComments(f, t) {
+			return
+		}
+		t.Interface = token.NoPos
+		t.Compact = true
+		fixed = true
+	})
+	return fixed
+}
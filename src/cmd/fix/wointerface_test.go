@@ -0,0 +1,59 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+func init() {
+	addTestCases(wointerfaceTests, wointerface)
+}
+
+var wointerfaceTests = []testCase{
+	{
+		Name: "wointerface.0",
+		In: `//wo:dialect
+
+package main
+
+type T[P interface{}] struct {
+	m map[string]interface{}
+}
+
+type Stringer interface {
+	String() string
+}
+
+func f(x interface{}, xs ...interface{}) interface{} {
+	_ = interface{}(x)
+	_ = []interface{}{x}
+	_, _ = x.(interface{})
+	_ = map[interface{}]interface {
+		M()
+	}{}
+	return T[interface{}]{}
+}
+`,
+		Out: `//wo:dialect
+
+package main
+
+type T[P interface{}] struct {
+	m map[string]<>
+}
+
+type Stringer interface {
+	String() string
+}
+
+func f(x <>, xs ...<>) <> {
+	_ = <>(x)
+	_ = []<>{x}
+	_, _ = x.(<>)
+	_ = map[<>]interface {
+		M()
+	}{}
+	return T[<>]{}
+}
+`,
+	},
+}
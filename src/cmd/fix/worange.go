@@ -0,0 +1,40 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+func init() {
+	register(worangeFix)
+}
+
+var worangeFix = fix{
+	name:       "worange",
+	date:       woDate,
+	f:          worange,
+	desc:       `Rewrite for _, v := range x loops to the Wo colon form for v : x.`,
+	wo:         true,
+	woFeatures: []string{"range"},
+}
+
+func worange(f *ast.File) bool {
+	fixed := false
+	walk(f, func(n any) {
+		r, ok := n.(*ast.RangeStmt)
+		if !ok || r.Tok != token.DEFINE || !isBlank(r.Key) || r.Value == nil || isBlank(r.Value) {
+			return
+		}
+		// A single iteration variable of a colon range clause is the
+		// value, for all types with two iteration values.
+		r.Key, r.Value = r.Value, nil
+		r.Tok = token.COLON
+		r.Range = token.NoPos
+		fixed = true
+	})
+	return fixed
+}
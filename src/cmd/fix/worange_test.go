@@ -0,0 +1,74 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+func init() {
+	addTestCases(worangeTests, worange)
+}
+
+// The inputs of the Wo test cases are marked as Wo files, so that
+// the rewritten code parses; processFile adds the //wo:dialect
+// directive to the Go files it rewrites.
+var worangeTests = []testCase{
+	{
+		Name: "worange.0",
+		In: `//wo:dialect
+
+package main
+
+func f(xs []int, m map[string]int, seq func(func(int, string) bool)) {
+	for _, x := range xs {
+		println(x)
+	}
+	for _, v := range m {
+		for _, s := range seq {
+			println(v, s)
+		}
+	}
+	for i, x := range xs {
+		println(i, x)
+	}
+	for k := range m {
+		println(k)
+	}
+	for _, _ = range xs {
+	}
+	var x int
+	for _, x = range xs {
+	}
+	for range xs {
+	}
+}
+`,
+		Out: `//wo:dialect
+
+package main
+
+func f(xs []int, m map[string]int, seq func(func(int, string) bool)) {
+	for x : xs {
+		println(x)
+	}
+	for v : m {
+		for s : seq {
+			println(v, s)
+		}
+	}
+	for i, x := range xs {
+		println(i, x)
+	}
+	for k := range m {
+		println(k)
+	}
+	for _, _ = range xs {
+	}
+	var x int
+	for _, x = range xs {
+	}
+	for range xs {
+	}
+}
+`,
+	},
+}
@@ -0,0 +1,208 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+)
+
+func init() {
+	register(woresultFix)
+}
+
+var woresultFix = fix{
+	name: "woresult",
+	date: woDate,
+	f:    woresult,
+	desc: `Rewrite error checks that return the error to the Wo ! operator.
+
+	x, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+becomes
+
+	x := f()!
+
+if the other results are returned as zero values, and err is not
+used elsewhere.
+`,
+	wo:         true,
+	woFeatures: []string{"result"},
+}
+
+func woresult(f *ast.File) bool {
+	typeof, _ := typecheck(&TypeConfig{}, f)
+	fixed := false
+
+	// The stack of enclosing functions and range statements.
+	var stack []ast.Node
+	before := func(n any) {
+		switch n := n.(type) {
+		case *ast.FuncDecl, *ast.FuncLit, *ast.RangeStmt:
+			stack = append(stack, n.(ast.Node))
+		}
+	}
+	after := func(n any) {
+		var list *[]ast.Stmt
+		switch n := n.(type) {
+		case *ast.FuncDecl, *ast.FuncLit, *ast.RangeStmt:
+			stack = stack[:len(stack)-1]
+			return
+		case *ast.BlockStmt:
+			list = &n.List
+		case *ast.CaseClause:
+			list = &n.Body
+		case *ast.CommClause:
+			list = &n.Body
+		default:
+			return
+		}
+		fn := enclosingFunc(stack, typeof)
+		if fn == nil {
+			return
+		}
+		for i := 0; i+1 < len(*list); i++ {
+			if s := propagateStmt(f, fn, (*list)[i], (*list)[i+1], typeof); s != nil {
+				(*list)[i] = s
+				*list = slices.Delete(*list, i+1, i+2)
+				fixed = true
+			}
+		}
+	}
+	walkBeforeAfter(f, before, after)
+	return fixed
+}
+
+// enclosingFunc returns the innermost function on the stack of
+// functions and range statements, or nil if there is none or the
+// function body is a range-over-func loop body.
+func enclosingFunc(stack []ast.Node, typeof map[any]string) ast.Node {
+	for i := len(stack) - 1; i >= 0; i-- {
+		r, ok := stack[i].(*ast.RangeStmt)
+		if !ok {
+			return stack[i]
+		}
+		if strings.HasPrefix(typeof[r.X], "func(") {
+			return nil
+		}
+	}
+	return nil
+}
+
+// propagateStmt returns the Wo statement using the ! operator that
+// replaces the declaration decl followed by the error check check in
+// the function fn, or nil if there is none.
+func propagateStmt(f *ast.File, fn ast.Node, decl, check ast.Stmt, typeof map[any]string) ast.Stmt {
+	var ftype *ast.FuncType
+	var body *ast.BlockStmt
+	switch fn := fn.(type) {
+	case *ast.FuncDecl:
+		ftype, body = fn.Type, fn.Body
+	case *ast.FuncLit:
+		ftype, body = fn.Type, fn.Body
+	}
+
+	// The declaration is x, err := f() or var x, err = f().
+	var lhs []ast.Expr
+	var rhs []ast.Expr
+	var obj any // declaring node of the variables
+	switch s := decl.(type) {
+	case *ast.AssignStmt:
+		if s.Tok != token.DEFINE {
+			return nil
+		}
+		lhs, rhs, obj = s.Lhs, s.Rhs, s
+	case *ast.DeclStmt:
+		d, ok := s.Decl.(*ast.GenDecl)
+		if !ok || d.Tok != token.VAR || len(d.Specs) != 1 {
+			return nil
+		}
+		spec := d.Specs[0].(*ast.ValueSpec)
+		if spec.Type != nil {
+			return nil
+		}
+		for _, name := range spec.Names {
+			lhs = append(lhs, name)
+		}
+		rhs, obj = spec.Values, spec
+	default:
+		return nil
+	}
+	if len(lhs) > 2 || len(rhs) != 1 {
+		return nil
+	}
+	call, ok := rhs[0].(*ast.CallExpr)
+	if !ok {
+		return nil
+	}
+	for _, x := range lhs {
+		if id, ok := x.(*ast.Ident); !ok || !isBlank(id) && !isNew(id, obj) {
+			return nil
+		}
+	}
+	err := lhs[len(lhs)-1].(*ast.Ident)
+	if isBlank(err) {
+		return nil
+	}
+	if t := typeof[err]; t != "" && t != "error" {
+		return nil
+	}
+
+	// The check is if err != nil { return zero, ..., err }.
+	s, ok := check.(*ast.IfStmt)
+	if !ok || s.Init != nil || s.Else != nil || len(s.Body.List) != 1 || hasComments(f, s) {
+		return nil
+	}
+	cond, ok := s.Cond.(*ast.BinaryExpr)
+	if !ok || cond.Op != token.NEQ || !isTopName(cond.Y, "nil") {
+		return nil
+	}
+	if x, ok := cond.X.(*ast.Ident); !ok || x.Obj != err.Obj {
+		return nil
+	}
+	ret, ok := s.Body.List[0].(*ast.ReturnStmt)
+	if !ok || ftype.Results == nil || len(ret.Results) != ftype.Results.NumFields() || len(ret.Results) == 0 {
+		return nil
+	}
+	results := ftype.Results.List
+	if !isTopName(results[len(results)-1].Type, "error") {
+		return nil
+	}
+	for _, x := range ret.Results[:len(ret.Results)-1] {
+		if !isZeroLit(x) {
+			return nil
+		}
+	}
+	if x, ok := ret.Results[len(ret.Results)-1].(*ast.Ident); !ok || x.Obj != err.Obj {
+		return nil
+	}
+
+	// The error variable is used in the check only.
+	if countUses(body, err.Obj) != 3 {
+		return nil
+	}
+
+	// Position the ! operator at the end of the removed check, so that
+	// the printer does not leave a blank line in its place.
+	x := &ast.PostfixExpr{X: call, OpPos: s.End() - 1, Op: token.NOT}
+	if len(lhs) == 1 || isBlank(lhs[0]) {
+		return &ast.ExprStmt{X: x}
+	}
+	switch s := decl.(type) {
+	case *ast.AssignStmt:
+		s.Lhs = lhs[:1]
+		s.Rhs = []ast.Expr{x}
+	case *ast.DeclStmt:
+		spec := s.Decl.(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+		spec.Names = spec.Names[:1]
+		spec.Values = []ast.Expr{x}
+	}
+	return decl
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+func init() {
+	addTestCases(woresultTests, woresult)
+}
+
+var woresultTests = []testCase{
+	{
+		Name: "woresult.0",
+		In: `//wo:dialect
+
+package main
+
+import "os"
+
+func open(name string) (*os.File, int, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	var g, err2 = os.Open(name)
+	if err2 != nil {
+		return nil, 0, err2
+	}
+	_, err = f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	_, err3 := g.Stat()
+	if err3 != nil {
+		return nil, 0, err3
+	}
+	err4 := os.Remove(name)
+	if err4 != nil {
+		return nil, 0, err4
+	}
+	return f, 1, nil
+}
+
+func closure(name string) func() error {
+	return func() error {
+		f, err := os.Open(name)
+		if err != nil {
+			return err
+		}
+		switch {
+		case f != nil:
+			err := f.Close()
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+`,
+		Out: `//wo:dialect
+
+package main
+
+import "os"
+
+func open(name string) (*os.File, int, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	var g = os.Open(name)!
+	_, err = f.Stat()
+	if err != nil {
+		return nil, 0, err
+	}
+	g.Stat()!
+	os.Remove(name)!
+	return f, 1, nil
+}
+
+func closure(name string) func() error {
+	return func() error {
+		f := os.Open(name)!
+		switch {
+		case f != nil:
+			f.Close()!
+		}
+		return nil
+	}
+}
+`,
+	},
+	{
+		Name: "woresult.1",
+		In: `//wo:dialect
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+func f() {
+	err := os.Remove("x")
+	if err != nil {
+		return
+	}
+}
+
+func g() (int, error) {
+	n, err := count()
+	if err != nil {
+		return -1, err
+	}
+	m, err := count()
+	if err != nil {
+		return 0, errors.Join(err, os.ErrClosed)
+	}
+	k, err := count()
+	if err != nil {
+		// Keep this comment.
+		return 0, err
+	}
+	return n + m + k, nil
+}
+
+func h() error {
+	ok, err := check()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	a, b, err := three()
+	if err != nil {
+		return err
+	}
+	_, _ = a, b
+	for range seq {
+		err := os.Remove("x")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func count() (int, error)      { return 0, nil }
+func check() (bool, bool)      { return false, false }
+func three() (int, int, error) { return 0, 0, nil }
+func seq(yield func() bool)    {}
+`,
+		Out: `//wo:dialect
+
+package main
+
+import (
+	"errors"
+	"os"
+)
+
+func f() {
+	err := os.Remove("x")
+	if err != nil {
+		return
+	}
+}
+
+func g() (int, error) {
+	n, err := count()
+	if err != nil {
+		return -1, err
+	}
+	m, err := count()
+	if err != nil {
+		return 0, errors.Join(err, os.ErrClosed)
+	}
+	k, err := count()
+	if err != nil {
+		// Keep this comment.
+		return 0, err
+	}
+	return n + m + k, nil
+}
+
+func h() error {
+	ok, err := check()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
+	}
+	a, b, err := three()
+	if err != nil {
+		return err
+	}
+	_, _ = a, b
+	for range seq {
+		err := os.Remove("x")
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func count() (int, error)      { return 0, nil }
+func check() (bool, bool)      { return false, false }
+func three() (int, int, error) { return 0, 0, nil }
+func seq(yield func() bool)    {}
+`,
+	},
+}
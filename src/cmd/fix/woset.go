@@ -0,0 +1,240 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+func init() {
+	register(wosetFix)
+}
+
+var wosetFix = fix{
+	name: "woset",
+	date: woDate,
+	f:    woset,
+	desc: `Rewrite local variables of type map[K]struct{} to the Wo type set[K].
+
+The fix applies only if the map is used as a set: elements are added
+with m[k] = struct{}{} and deleted with delete(m, k), and membership
+is tested with _, ok := m[k]. These become m.add(k), m.delete(k) and
+ok := m[k].
+`,
+	wo:         true,
+	woFeatures: []string{"set"},
+}
+
+// A setVar is a local variable of type map[K]struct{} used as a set.
+type setVar struct {
+	uses    int      // number of uses of the variable other than set operations
+	rewrite []func() // rewrites of the declaration and the set operations
+}
+
+func woset(f *ast.File) bool {
+	// set must denote the predeclared type.
+	shadowed := false
+	ast.Inspect(f, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == "set" {
+			shadowed = true
+		}
+		return !shadowed
+	})
+	if shadowed {
+		return false
+	}
+
+	// Find the declarations of the variables.
+	vars := make(map[*ast.Object]*setVar)
+	declare := func(id *ast.Ident, decl any, typ *ast.Expr, val *ast.Expr) {
+		if !isNew(id, decl) {
+			return
+		}
+		v := &setVar{uses: -1} // don't count the declaration
+		if typ != nil && *typ != nil {
+			key := setKey(*typ)
+			if key == nil {
+				return
+			}
+			v.rewrite = append(v.rewrite, func() { *typ = setType(key) })
+		}
+		if val != nil {
+			r := setValue(val)
+			if r == nil {
+				return
+			}
+			v.rewrite = append(v.rewrite, r)
+		}
+		vars[id.Obj] = v
+	}
+	walk(f, func(n any) {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok == token.DEFINE && len(n.Lhs) == 1 && len(n.Rhs) == 1 {
+				if id, ok := n.Lhs[0].(*ast.Ident); ok {
+					declare(id, n, nil, &n.Rhs[0])
+				}
+			}
+		case *ast.DeclStmt:
+			d, ok := n.Decl.(*ast.GenDecl)
+			if !ok || d.Tok != token.VAR || len(d.Specs) != 1 {
+				return
+			}
+			spec := d.Specs[0].(*ast.ValueSpec)
+			switch {
+			case len(spec.Names) != 1:
+			case len(spec.Values) == 0:
+				declare(spec.Names[0], spec, &spec.Type, nil)
+			case len(spec.Values) == 1:
+				declare(spec.Names[0], spec, &spec.Type, &spec.Values[0])
+			}
+		}
+	})
+	if len(vars) == 0 {
+		return false
+	}
+
+	// Find the set operations, and count all uses of the variables.
+	lookup := func(x ast.Expr) *setVar {
+		if id, ok := x.(*ast.Ident); ok && id.Obj != nil {
+			if v := vars[id.Obj]; v != nil {
+				v.uses--
+				return v
+			}
+		}
+		return nil
+	}
+	walk(f, func(n any) {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if v := vars[n.Obj]; v != nil {
+				v.uses++
+			}
+
+		case *ast.Stmt:
+			// m[k] = struct{}{}
+			as, ok := (*n).(*ast.AssignStmt)
+			if !ok || as.Tok != token.ASSIGN || len(as.Lhs) != 1 || len(as.Rhs) != 1 || !isEmptyStructLit(as.Rhs[0]) {
+				return
+			}
+			if index, ok := as.Lhs[0].(*ast.IndexExpr); ok {
+				if v := lookup(index.X); v != nil {
+					v.rewrite = append(v.rewrite, func() {
+						*n = &ast.ExprStmt{X: setCall(index.X, "add", index.Index, as.Rhs[0].End())}
+					})
+				}
+			}
+
+		case *ast.AssignStmt:
+			// _, ok := m[k]
+			if len(n.Lhs) != 2 || len(n.Rhs) != 1 || !isBlank(n.Lhs[0]) {
+				return
+			}
+			if index, ok := n.Rhs[0].(*ast.IndexExpr); ok {
+				if v := lookup(index.X); v != nil {
+					v.rewrite = append(v.rewrite, func() { n.Lhs = n.Lhs[1:] })
+				}
+			}
+
+		case *ast.CallExpr:
+			// delete(m, k), len(m), clear(m)
+			switch {
+			case isTopName(n.Fun, "delete") && len(n.Args) == 2:
+				if v := lookup(n.Args[0]); v != nil {
+					v.rewrite = append(v.rewrite, func() {
+						*n = *setCall(n.Args[0], "delete", n.Args[1], n.Rparen)
+					})
+				}
+			case (isTopName(n.Fun, "len") || isTopName(n.Fun, "clear")) && len(n.Args) == 1:
+				lookup(n.Args[0])
+			}
+
+		case *ast.RangeStmt:
+			// for k := range m
+			if n.Value == nil {
+				lookup(n.X)
+			}
+
+		case *ast.BinaryExpr:
+			// m == nil
+			if (n.Op == token.EQL || n.Op == token.NEQ) && isTopName(n.Y, "nil") {
+				lookup(n.X)
+			}
+		}
+	})
+
+	fixed := false
+	for _, v := range vars {
+		if v.uses == 0 {
+			for _, r := range v.rewrite {
+				r()
+			}
+			fixed = true
+		}
+	}
+	return fixed
+}
+
+// setKey returns the key type K of the map type map[K]struct{}, or nil
+// if t is not such a type.
+func setKey(t ast.Expr) ast.Expr {
+	m, ok := t.(*ast.MapType)
+	if !ok {
+		return nil
+	}
+	if st, ok := m.Value.(*ast.StructType); !ok || len(st.Fields.List) > 0 {
+		return nil
+	}
+	return m.Key
+}
+
+// setType returns the set type set[key].
+func setType(key ast.Expr) ast.Expr {
+	return &ast.IndexExpr{X: ast.NewIdent("set"), Index: key}
+}
+
+// setValue returns the rewrite of the map value *x to a set, or nil if
+// *x is not make(map[K]struct{}) or a map literal of that type.
+func setValue(x *ast.Expr) func() {
+	switch e := (*x).(type) {
+	case *ast.CallExpr:
+		if !isTopName(e.Fun, "make") || len(e.Args) == 0 {
+			return nil
+		}
+		key := setKey(e.Args[0])
+		if key == nil {
+			return nil
+		}
+		return func() { e.Args[0] = setType(key) }
+	case *ast.CompositeLit:
+		key := setKey(e.Type)
+		if key == nil {
+			return nil
+		}
+		var elts []ast.Expr
+		for _, elt := range e.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok || !isEmptyStructLit(kv.Value) {
+				return nil
+			}
+			elts = append(elts, kv.Key)
+		}
+		return func() {
+			e.Type = setType(key)
+			e.Elts = elts
+		}
+	}
+	return nil
+}
+
+// setCall returns the call s.method(x).
+func setCall(s ast.Expr, method string, x ast.Expr, rparen token.Pos) *ast.CallExpr {
+	return &ast.CallExpr{
+		Fun:    &ast.SelectorExpr{X: s, Sel: ast.NewIdent(method)},
+		Args:   []ast.Expr{x},
+		Rparen: rparen,
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+func init() {
+	addTestCases(wosetTests, woset)
+}
+
+var wosetTests = []testCase{
+	{
+		Name: "woset.0",
+		In: `//wo:dialect
+
+package main
+
+func f(names []string) int {
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+	}
+	delete(seen, "")
+	var empty = map[int]struct{}{1: {}, 2: struct{}{}}
+	for k := range empty {
+		println(k)
+	}
+	var none map[int]struct{}
+	if none == nil {
+		clear(empty)
+	}
+	return len(seen)
+}
+`,
+		Out: `//wo:dialect
+
+package main
+
+func f(names []string) int {
+	seen := make(set[string], len(names))
+	for _, name := range names {
+		if ok := seen[name]; ok {
+			continue
+		}
+		seen.add(name)
+	}
+	seen.delete("")
+	var empty = set[int]{1, 2}
+	for k := range empty {
+		println(k)
+	}
+	var none set[int]
+	if none == nil {
+		clear(empty)
+	}
+	return len(seen)
+}
+`,
+	},
+	{
+		Name: "woset.1",
+		In: `//wo:dialect
+
+package main
+
+var global = map[string]struct{}{}
+
+func f(m map[int]struct{}) {
+	a := make(map[int]struct{})
+	a[1] = struct{}{}
+	use(a)
+	b := make(map[int]struct{})
+	for k, v := range b {
+		println(k, v)
+	}
+	c := map[int]struct{}{}
+	x, ok := c[1]
+	_, _ = x, ok
+	d := make(map[int]bool)
+	d[1] = true
+}
+
+func use(map[int]struct{}) {}
+`,
+	},
+	{
+		Name: "woset.2",
+		In: `//wo:dialect
+
+package main
+
+type set []int
+
+func f() {
+	s := make(map[int]struct{})
+	s[1] = struct{}{}
+}
+`,
+	},
+}
@@ -0,0 +1,534 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+func init() {
+	register(wovarFix)
+}
+
+var wovarFix = fix{
+	name: "wovar",
+	date: woDate,
+	f:    wovar,
+	desc: `Rewrite variable declarations and assignments to the Wo rules.
+
+Wo reserves the short variable declaration x := v for shadowing and
+forbids assigning several values in parallel. The fix rewrites
+
+	x := v               to  var x = v
+	x, err := f()        to  var x T; x, err = f()   (err declared before)
+	p, q = 20, 30        to  p = 20; q = 30
+	if x := v; x > 0 {   to  if x T = v; x > 0 {
+	case x := <-ch:      to  case x = <-ch:          (var x T before select)
+
+where T is the type of v. Declarations in the header of an if or switch
+statement that cannot use the typed form x T = v move into a block that
+encloses the statement, and so do the variables declared by the cases
+of a select statement. If a file has declarations or assignments that
+cannot be rewritten, because their types are unknown or because they
+depend on assigning values in parallel, as in p, q = q, p, the file is
+left unchanged.
+`,
+	wo:         true,
+	woFeatures: []string{"assign"},
+}
+
+func wovar(f *ast.File) bool {
+	typeof, _ := typecheck(&TypeConfig{}, f)
+	v := &varFixer{typeof: typeof, labeled: make(map[ast.Stmt]bool)}
+
+	// Check that all statements can be rewritten before rewriting any.
+	walkBeforeAfter(f, v.before, v.after)
+	if v.stuck || !v.fixed {
+		return false
+	}
+	v.apply = true
+	walkBeforeAfter(f, v.before, v.after)
+	return true
+}
+
+// A varFixer rewrites the statements of a file that violate the Wo
+// rules for variable declarations and assignments. The replacement
+// statements are built from copies of the original ones, so that the
+// file only changes when they are installed, which happens if apply is
+// set.
+type varFixer struct {
+	typeof  map[any]string
+	labeled map[ast.Stmt]bool // statements with a label
+	apply   bool              // install the replacement statements
+	fixed   bool              // some statement needs to be rewritten
+	stuck   bool              // some statement cannot be rewritten
+}
+
+func (v *varFixer) before(n any) {
+	if s, ok := n.(*ast.LabeledStmt); ok {
+		v.labeled[s.Stmt] = true
+	}
+}
+
+func (v *varFixer) after(n any) {
+	switch n := n.(type) {
+	case *[]ast.Stmt:
+		v.stmtList(n)
+	case *ast.Stmt:
+		if s := v.header(*n); s != nil {
+			v.fixed = true
+			if v.apply {
+				*n = s
+			}
+		}
+	}
+}
+
+// stmtList rewrites the assignments and short variable declarations
+// of the statement list.
+func (v *varFixer) stmtList(list *[]ast.Stmt) {
+	var out []ast.Stmt
+	changed := false
+	for _, s := range *list {
+		as, ok := s.(*ast.AssignStmt)
+		if !ok {
+			out = append(out, s)
+			continue
+		}
+		repl, ok := v.assign(as)
+		switch {
+		case !ok:
+			v.stuck = true
+			out = append(out, s)
+		case repl != nil:
+			changed = true
+			out = append(out, repl...)
+		default:
+			out = append(out, s)
+		}
+	}
+	if changed {
+		v.fixed = true
+		if v.apply {
+			*list = out
+		}
+	}
+}
+
+// assign returns the statements that replace the assignment or short
+// variable declaration s of a statement list, or nil if s follows the
+// Wo rules. It reports false if s does not follow them but cannot be
+// rewritten.
+func (v *varFixer) assign(s *ast.AssignStmt) ([]ast.Stmt, bool) {
+	switch {
+	case s.Tok == token.DEFINE && s.Type == nil:
+		if d := varDecl(s); d != nil {
+			return []ast.Stmt{d}, true
+		}
+		// The declaration also assigns to declared variables.
+		if len(s.Rhs) == 1 {
+			return v.declareAndAssign(s)
+		}
+		return v.split(s)
+	case s.Tok == token.ASSIGN && len(s.Lhs) > 1 && len(s.Lhs) == len(s.Rhs) && !allBlank(s.Lhs):
+		return v.split(s)
+	}
+	return nil, true
+}
+
+// declareAndAssign returns the var declarations of the variables that
+// the short variable declaration s declares, followed by an assignment
+// of its single multi-valued expression.
+func (v *varFixer) declareAndAssign(s *ast.AssignStmt) ([]ast.Stmt, bool) {
+	var list []ast.Stmt
+	for _, x := range s.Lhs {
+		id := x.(*ast.Ident)
+		if isBlank(id) || !isNew(id, s) {
+			continue
+		}
+		// The new variable is declared before the expression is
+		// evaluated, so it must not refer to a variable of that name.
+		T := v.typeExpr(v.typeOf(id))
+		if T == nil || mentions(s.Rhs[0], id.Name) {
+			return nil, false
+		}
+		list = append(list, &ast.DeclStmt{
+			Decl: &ast.GenDecl{
+				TokPos: s.Pos(),
+				Tok:    token.VAR,
+				Specs: []ast.Spec{
+					&ast.ValueSpec{Names: []*ast.Ident{{NamePos: id.NamePos, Name: id.Name}}, Type: T},
+				},
+			},
+		})
+	}
+	return append(list, &ast.AssignStmt{Lhs: s.Lhs, TokPos: s.TokPos, Tok: token.ASSIGN, Rhs: s.Rhs}), true
+}
+
+// split returns the statements that assign the values of the parallel
+// assignment or short variable declaration s one at a time.
+// Since the values are then evaluated after the preceding variables
+// are assigned, all values but the first must be simple expressions
+// that don't refer to the preceding variables.
+func (v *varFixer) split(s *ast.AssignStmt) ([]ast.Stmt, bool) {
+	var names []string
+	for i, x := range s.Lhs {
+		id, ok := x.(*ast.Ident)
+		if !ok || i > 0 && !independent(s.Rhs[i], names) {
+			return nil, false
+		}
+		names = append(names, id.Name)
+	}
+	var list []ast.Stmt
+	for i, x := range s.Lhs {
+		id := x.(*ast.Ident)
+		pos := s.Pos()
+		if i > 0 {
+			pos = id.Pos()
+		}
+		if s.Tok == token.DEFINE && !isBlank(id) && isNew(id, s) {
+			list = append(list, &ast.DeclStmt{
+				Decl: &ast.GenDecl{
+					TokPos: pos,
+					Tok:    token.VAR,
+					Specs: []ast.Spec{
+						&ast.ValueSpec{Names: []*ast.Ident{id}, Values: []ast.Expr{s.Rhs[i]}},
+					},
+				},
+			})
+		} else {
+			list = append(list, &ast.AssignStmt{Lhs: []ast.Expr{id}, TokPos: pos, Tok: token.ASSIGN, Rhs: []ast.Expr{s.Rhs[i]}})
+		}
+	}
+	return list, true
+}
+
+// header returns the statement that replaces the if, switch, for, or
+// select statement s if its header does not follow the Wo rules, or nil.
+func (v *varFixer) header(s ast.Stmt) ast.Stmt {
+	switch s := s.(type) {
+	case *ast.IfStmt:
+		if init, hoist := v.initStmt(s.Init, true); init != s.Init || hoist != nil {
+			c := *s
+			c.Init = init
+			return block(hoist, &c)
+		}
+	case *ast.SwitchStmt:
+		if init, hoist := v.initStmt(s.Init, !v.labeled[s]); init != s.Init || hoist != nil {
+			c := *s
+			c.Init = init
+			return block(hoist, &c)
+		}
+	case *ast.TypeSwitchStmt:
+		if init, hoist := v.initStmt(s.Init, !v.labeled[s]); init != s.Init || hoist != nil {
+			c := *s
+			c.Init = init
+			return block(hoist, &c)
+		}
+	case *ast.ForStmt:
+		// The post statement cannot be split into several statements.
+		if post, ok := s.Post.(*ast.AssignStmt); ok {
+			if repl, ok := v.assign(post); !ok || repl != nil {
+				v.stuck = true
+			}
+		}
+		if init, _ := v.initStmt(s.Init, false); init != s.Init {
+			c := *s
+			c.Init = init
+			return &c
+		}
+	case *ast.SelectStmt:
+		return v.selectStmt(s)
+	}
+	return nil
+}
+
+// initStmt returns the statement that replaces the init statement s
+// of an if, switch, or for statement, and the statements that declare
+// its variables before the statement, if hoisting them is permitted.
+func (v *varFixer) initStmt(s ast.Stmt, canHoist bool) (ast.Stmt, []ast.Stmt) {
+	as, ok := s.(*ast.AssignStmt)
+	if !ok {
+		return s, nil
+	}
+	if as.Tok == token.DEFINE && as.Type == nil {
+		if typed := v.typedDecl(as); typed != nil {
+			return typed, nil
+		}
+	}
+	repl, ok := v.assign(as)
+	switch {
+	case !ok || repl != nil && !canHoist:
+		v.stuck = true
+		return s, nil
+	case repl != nil:
+		return nil, repl
+	}
+	return s, nil
+}
+
+// typedDecl returns the Wo typed declaration x T = v that replaces the
+// short variable declaration x := v, or nil if the type T of v is
+// unknown or cannot be written in a typed declaration.
+func (v *varFixer) typedDecl(s *ast.AssignStmt) *ast.AssignStmt {
+	if len(s.Lhs) != 1 || len(s.Rhs) != 1 || isBlank(s.Lhs[0]) {
+		return nil
+	}
+	T := v.typeOf(s.Rhs[0])
+	if !startsTypeName(T) {
+		return nil
+	}
+	typ := v.typeExpr(T)
+	if typ == nil {
+		return nil
+	}
+	return &ast.AssignStmt{Lhs: s.Lhs, Type: typ, TokPos: s.TokPos, Tok: token.DEFINE, Rhs: s.Rhs}
+}
+
+// selectStmt returns the statement that replaces the select statement
+// s if its cases declare variables, or nil. The variables are declared
+// before the statement, and the cases assign to them.
+func (v *varFixer) selectStmt(s *ast.SelectStmt) ast.Stmt {
+	var hoist []ast.Stmt
+	clauses := make([]ast.Stmt, len(s.Body.List))
+	declared := make(map[string]bool)
+	for i, c := range s.Body.List {
+		clauses[i] = c
+		c := c.(*ast.CommClause)
+		as, ok := c.Comm.(*ast.AssignStmt)
+		if !ok || as.Tok != token.DEFINE {
+			continue
+		}
+		if v.labeled[s] {
+			v.stuck = true
+			return nil
+		}
+		// The variables are the received value and, optionally, a
+		// bool reporting whether the channel is open.
+		recv, ok := ast.Unparen(as.Rhs[0]).(*ast.UnaryExpr)
+		if !ok {
+			v.stuck = true
+			return nil
+		}
+		types := []string{chanElem(v.typeOf(recv.X)), "bool"}
+		for j, x := range as.Lhs {
+			id := x.(*ast.Ident)
+			if isBlank(id) {
+				continue
+			}
+			T := v.typeExpr(types[j])
+			if T == nil || declared[id.Name] || mentions(recv, id.Name) {
+				v.stuck = true
+				return nil
+			}
+			declared[id.Name] = true
+			hoist = append(hoist, &ast.DeclStmt{
+				Decl: &ast.GenDecl{
+					TokPos: s.Pos(),
+					Tok:    token.VAR,
+					Specs: []ast.Spec{
+						&ast.ValueSpec{Names: []*ast.Ident{{NamePos: id.NamePos, Name: id.Name}}, Type: T},
+					},
+				},
+			})
+		}
+		cc := *c
+		cc.Comm = &ast.AssignStmt{Lhs: as.Lhs, TokPos: as.TokPos, Tok: token.ASSIGN, Rhs: as.Rhs}
+		clauses[i] = &cc
+	}
+	if hoist == nil {
+		return nil
+	}
+	body := *s.Body
+	body.List = clauses
+	c := *s
+	c.Body = &body
+	return block(hoist, &c)
+}
+
+// block returns the block of the statements list followed by s, or s
+// if list is empty.
+func block(list []ast.Stmt, s ast.Stmt) ast.Stmt {
+	if len(list) == 0 {
+		return s
+	}
+	return &ast.BlockStmt{Lbrace: s.Pos(), List: append(list, s), Rbrace: s.End()}
+}
+
+// typeOf returns the type of the expression x, or "" if it is unknown.
+// Untyped constants have their default type, which is the type of a
+// variable they initialize.
+func (v *varFixer) typeOf(x ast.Expr) string {
+	for range 10 { // follow a few variables to their initial values
+		if T := v.typeof[x]; T != "" {
+			return T
+		}
+		switch y := x.(type) {
+		case *ast.BasicLit:
+			switch y.Kind {
+			case token.INT:
+				return "int"
+			case token.FLOAT:
+				return "float64"
+			case token.IMAG:
+				return "complex128"
+			case token.CHAR:
+				return "rune"
+			case token.STRING:
+				return "string"
+			}
+			return ""
+		case *ast.ParenExpr:
+			x = y.X
+		case *ast.Ident:
+			if x = initValue(y); x == nil {
+				return ""
+			}
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
+// typeExpr returns the expression of the type T, or nil if T is not
+// a valid type expression. The expression is printed as is.
+func (v *varFixer) typeExpr(T string) ast.Expr {
+	if T == "" {
+		return nil
+	}
+	if _, err := parser.ParseExpr(T); err != nil {
+		return nil
+	}
+	return ast.NewIdent(T)
+}
+
+// initValue returns the expression that initializes the variable id
+// if it is declared without a type, or nil.
+func initValue(id *ast.Ident) ast.Expr {
+	if id.Obj == nil || id.Obj.Kind != ast.Var {
+		return nil
+	}
+	var names, values []ast.Expr
+	switch d := id.Obj.Decl.(type) {
+	case *ast.AssignStmt:
+		if d.Tok != token.DEFINE || d.Type != nil {
+			return nil
+		}
+		names, values = d.Lhs, d.Rhs
+	case *ast.ValueSpec:
+		if d.Type != nil {
+			return nil
+		}
+		for _, name := range d.Names {
+			names = append(names, name)
+		}
+		values = d.Values
+	}
+	if len(names) != len(values) {
+		return nil
+	}
+	for i, name := range names {
+		if name.(*ast.Ident).Name == id.Name {
+			return values[i]
+		}
+	}
+	return nil
+}
+
+// chanElem returns the element type of the channel type T, or "".
+func chanElem(T string) string {
+	for _, prefix := range []string{"chan ", "<-chan "} {
+		if elem, ok := strings.CutPrefix(T, prefix); ok {
+			return elem
+		}
+	}
+	return ""
+}
+
+// startsTypeName reports whether the type spelled T may appear in a
+// typed declaration, which requires that it starts with a name or a
+// keyword.
+func startsTypeName(T string) bool {
+	r, _ := utf8.DecodeRuneInString(T)
+	return unicode.IsLetter(r) || r == '_'
+}
+
+// allBlank reports whether all expressions of list are the blank
+// identifier.
+func allBlank(list []ast.Expr) bool {
+	for _, x := range list {
+		if !isBlank(x) {
+			return false
+		}
+	}
+	return true
+}
+
+// mentions reports whether x contains an identifier with the given name.
+func mentions(x ast.Node, name string) bool {
+	found := false
+	ast.Inspect(x, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && id.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// independent reports whether evaluating the expression x can neither
+// observe the variables with the given names nor have side effects.
+func independent(x ast.Expr, names []string) bool {
+	switch x := x.(type) {
+	case *ast.BasicLit:
+		return true
+	case *ast.Ident:
+		for _, name := range names {
+			if x.Name == name {
+				return false
+			}
+		}
+		return true
+	case *ast.ParenExpr:
+		return independent(x.X, names)
+	case *ast.UnaryExpr:
+		return x.Op != token.ARROW && independent(x.X, names)
+	case *ast.BinaryExpr:
+		return independent(x.X, names) && independent(x.Y, names)
+	}
+	return false
+}
+
+// varDecl returns the variable declaration equivalent to the short
+// variable declaration s, or nil if there is none.
+func varDecl(s ast.Stmt) ast.Stmt {
+	as, ok := s.(*ast.AssignStmt)
+	if !ok || as.Tok != token.DEFINE {
+		return nil
+	}
+	names := make([]*ast.Ident, len(as.Lhs))
+	for i, x := range as.Lhs {
+		id, ok := x.(*ast.Ident)
+		if !ok || !isBlank(id) && !isNew(id, as) {
+			return nil
+		}
+		names[i] = id
+	}
+	return &ast.DeclStmt{
+		Decl: &ast.GenDecl{
+			TokPos: as.Pos(),
+			Tok:    token.VAR,
+			Specs: []ast.Spec{
+				&ast.ValueSpec{Names: names, Values: as.Rhs},
+			},
+		},
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+func init() {
+	addTestCases(wovarTests, wovar)
+}
+
+var wovarTests = []testCase{
+	{
+		Name: "wovar.0",
+		In: `//wo:dialect
+
+package main
+
+func f(ch chan int) (int, error) {
+	x := 1
+	a, b := "a", 2.5
+	x, c := 2, 'c'
+	_, d := two()
+	if y := x; y > 0 {
+		z := y
+		_ = z
+	}
+	switch {
+	case x > 0:
+		w := x
+		_ = w
+	}
+	select {
+	case v := <-ch:
+		u := v
+		_ = u
+	}
+	g := func() int {
+		n := 1
+		return n
+	}
+	_, _, _, _, _ = a, b, c, d, g
+	return x, nil
+}
+
+func two() (int, int) { return 1, 2 }
+`,
+		Out: `//wo:dialect
+
+package main
+
+func f(ch chan int) (int, error) {
+	var x = 1
+	var a, b = "a", 2.5
+	x = 2
+	var c = 'c'
+	var _, d = two()
+	if y int = x; y > 0 {
+		var z = y
+		_ = z
+	}
+	switch {
+	case x > 0:
+		var w = x
+		_ = w
+	}
+	{
+		var v int
+		select {
+		case v = <-ch:
+			var u = v
+			_ = u
+		}
+	}
+	var g = func() int {
+		var n = 1
+		return n
+	}
+	_, _, _, _, _ = a, b, c, d, g
+	return x, nil
+}
+
+func two() (int, int) { return 1, 2 }
+`,
+	},
+	{
+		Name: "wovar.1",
+		In: `//wo:dialect
+
+package main
+
+func f(m map[string][]int, s []int) int {
+	n, err := count(s)
+	k, err := count(s)
+	p, q := 20, 30
+	p, q = q+1, 40
+	for i := 0; i < len(s); i++ {
+		n += s[i]
+	}
+	if v, ok := m["a"]; ok {
+		n += len(v)
+	} else if t := s; len(t) > 0 {
+		n += t[0]
+	}
+	switch e := err; e {
+	case nil:
+		n++
+	}
+	return n + k + p + q
+}
+
+func count(s []int) (int, error) { return len(s), nil }
+`,
+		Out: `//wo:dialect
+
+package main
+
+func f(m map[string][]int, s []int) int {
+	var n, err = count(s)
+	var k int
+	k, err = count(s)
+	var p, q = 20, 30
+	p = q + 1
+	q = 40
+	for i int = 0; i < len(s); i++ {
+		n += s[i]
+	}
+	{
+		var v, ok = m["a"]
+		if ok {
+			n += len(v)
+		} else {
+			var t = s
+			if len(t) > 0 {
+				n += t[0]
+			}
+		}
+	}
+	switch e error = err; e {
+	case nil:
+		n++
+	}
+	return n + k + p + q
+}
+
+func count(s []int) (int, error) { return len(s), nil }
+`,
+	},
+	{
+		Name: "wovar.2",
+		In: `//wo:dialect
+
+package main
+
+func f(p, q int) int {
+	x := p
+	p, q = q, p
+	return x + p + q
+}
+`,
+		Out: `//wo:dialect
+
+package main
+
+func f(p, q int) int {
+	x := p
+	p, q = q, p
+	return x + p + q
+}
+`,
+	},
+}
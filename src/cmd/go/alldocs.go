@@ -72,7 +72,7 @@
 //
 // Usage:
 //
-//	go build [-o output] [build flags] [packages]
+//	go build [-o output] [-entry name] [build flags] [packages]
 //
 // Build compiles the packages named by the import paths,
 // along with their dependencies, but it does not install the results.
@@ -103,6 +103,12 @@
 // ends with a slash or backslash, then any resulting executables
 // will be written to that directory.
 //
+// The -entry flag names a function of the main package to run as the
+// program entry point instead of main, as in 'go build -entry=otherMain'.
+// The function must be named main or have a name ending in Main, and it
+// must take no arguments and return no values, like main. This lets one
+// main package hold several related programs.
+//
 // The build flags are shared by the build, clean, get, install, list, run,
 // and test commands:
 //
@@ -856,10 +862,11 @@
 //
 //	    // Source files
 //	    GoFiles           []string   // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
+//	    WoFiles           []string   // .wo source files (excluding TestGoFiles, XTestGoFiles)
 //	    CgoFiles          []string   // .go source files that import "C"
-//	    CompiledGoFiles   []string   // .go files presented to compiler (when using -compiled)
-//	    IgnoredGoFiles    []string   // .go source files ignored due to build constraints
-//	    IgnoredOtherFiles []string // non-.go source files ignored due to build constraints
+//	    CompiledGoFiles   []string   // .go and .wo files presented to compiler (when using -compiled)
+//	    IgnoredGoFiles    []string   // .go and .wo source files ignored due to build constraints
+//	    IgnoredOtherFiles []string // non-.go, non-.wo source files ignored due to build constraints
 //	    CFiles            []string   // .c source files
 //	    CXXFiles          []string   // .cc, .cxx and .cpp source files
 //	    MFiles            []string   // .m source files
@@ -1735,7 +1742,7 @@
 //
 // Usage:
 //
-//	go run [build flags] [-exec xprog] package [arguments...]
+//	go run [build flags] [-exec xprog] [-entry name] package [arguments...]
 //
 // Run compiles and runs the named main Go package.
 // Typically the package is specified as a list of .go source files from a single
@@ -1769,6 +1776,9 @@
 // used by debuggers, to reduce build time. To include debugger information in
 // the binary, use 'go build'.
 //
+// The -entry flag runs the named function of the main package instead of
+// main, as in 'go run -entry=otherMain .'. See 'go help build' for details.
+//
 // The exit status of Run is not the exit status of the compiled binary.
 //
 // For more about build flags, see 'go help build'.
@@ -80,6 +80,7 @@ var (
 	BuildCover             bool                    // -cover flag
 	BuildCoverMode         string                  // -covermode flag
 	BuildCoverPkg          []string                // -coverpkg flag
+	BuildEntry             string                  // -entry flag
 	BuildJSON              bool                    // -json flag
 	BuildN                 bool                    // -n flag
 	BuildO                 string                  // -o flag
@@ -281,6 +281,7 @@ func clean(p *load.Package) {
 			}
 		}
 		keep(p.GoFiles)
+		keep(p.WoFiles)
 		keep(p.CgoFiles)
 		keep(p.TestGoFiles)
 		keep(p.XTestGoFiles)
@@ -573,14 +573,14 @@ func IsGoDir(name string) (bool, error) {
 
 	var firstErr error
 	for _, d := range fis {
-		if d.IsDir() || !strings.HasSuffix(d.Name(), ".go") {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".go") && !strings.HasSuffix(d.Name(), ".wo") {
 			continue
 		}
 		if d.Type().IsRegular() {
 			return true, nil
 		}
 
-		// d is a non-directory, non-regular .go file.
+		// d is a non-directory, non-regular .go or .wo file.
 		// Stat to see if it is a symlink, which we allow.
 		if actual := Actual(filepath.Join(name, d.Name())); actual != "" {
 			fi, err := os.Stat(actual)
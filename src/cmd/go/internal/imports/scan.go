@@ -34,7 +34,7 @@ func ScanDir(path string, tags map[string]bool) ([]string, []string, error) {
 			dir = fs.FileInfoToDirEntry(info)
 		}
 
-		if dir.Type().IsRegular() && !strings.HasPrefix(name, "_") && !strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".go") && MatchFile(name, tags) {
+		if dir.Type().IsRegular() && !strings.HasPrefix(name, "_") && !strings.HasPrefix(name, ".") && (strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo")) && MatchFile(name, tags) {
 			files = append(files, filepath.Join(path, name))
 		}
 	}
@@ -79,7 +79,7 @@ Files:
 		}
 		numFiles++
 		m := imports
-		if strings.HasSuffix(name, "_test.go") {
+		if strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_test.wo") {
 			m = testImports
 		}
 		for _, p := range list {
@@ -81,10 +81,11 @@ to -f '{{.ImportPath}}'. The struct being passed to the template is:
 
         // Source files
         GoFiles           []string   // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
+        WoFiles           []string   // .wo source files (excluding TestGoFiles, XTestGoFiles)
         CgoFiles          []string   // .go source files that import "C"
-        CompiledGoFiles   []string   // .go files presented to compiler (when using -compiled)
-        IgnoredGoFiles    []string   // .go source files ignored due to build constraints
-        IgnoredOtherFiles []string // non-.go source files ignored due to build constraints
+        CompiledGoFiles   []string   // .go and .wo files presented to compiler (when using -compiled)
+        IgnoredGoFiles    []string   // .go and .wo source files ignored due to build constraints
+        IgnoredOtherFiles []string // non-.go, non-.wo source files ignored due to build constraints
         CFiles            []string   // .c source files
         CXXFiles          []string   // .cc, .cxx and .cpp source files
         MFiles            []string   // .m source files
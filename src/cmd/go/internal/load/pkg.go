@@ -94,11 +94,12 @@ type PackagePublic struct {
 	// If you add to this list you MUST add to p.AllFiles (below) too.
 	// Otherwise file name security lists will not apply to any new additions.
 	GoFiles           []string `json:",omitempty"` // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
+	WoFiles           []string `json:",omitempty"` // .wo source files (excluding TestGoFiles, XTestGoFiles)
 	CgoFiles          []string `json:",omitempty"` // .go source files that import "C"
-	CompiledGoFiles   []string `json:",omitempty"` // .go output from running cgo on CgoFiles
-	IgnoredGoFiles    []string `json:",omitempty"` // .go source files ignored due to build constraints
-	InvalidGoFiles    []string `json:",omitempty"` // .go source files with detected problems (parse error, wrong package name, and so on)
-	IgnoredOtherFiles []string `json:",omitempty"` // non-.go source files ignored due to build constraints
+	CompiledGoFiles   []string `json:",omitempty"` // .go and .wo files presented to the compiler, including output from running cgo on CgoFiles
+	IgnoredGoFiles    []string `json:",omitempty"` // .go and .wo source files ignored due to build constraints
+	InvalidGoFiles    []string `json:",omitempty"` // .go and .wo source files with detected problems (parse error, wrong package name, and so on)
+	IgnoredOtherFiles []string `json:",omitempty"` // non-.go, non-.wo source files ignored due to build constraints
 	CFiles            []string `json:",omitempty"` // .c source files
 	CXXFiles          []string `json:",omitempty"` // .cc, .cpp and .cxx source files
 	MFiles            []string `json:",omitempty"` // .m source files
@@ -152,6 +153,7 @@ type PackagePublic struct {
 func (p *Package) AllFiles() []string {
 	files := str.StringList(
 		p.GoFiles,
+		p.WoFiles,
 		p.CgoFiles,
 		// no p.CompiledGoFiles, because they are from GoFiles or generated by us
 		p.IgnoredGoFiles,
@@ -208,11 +210,11 @@ func (p *Package) Desc() string {
 // A “test-only” package is one that:
 //   - is a test-only variant of an ordinary package, or
 //   - is a synthesized "main" package for a test binary, or
-//   - contains only _test.go files.
+//   - contains only _test.go and _test.wo files.
 func (p *Package) IsTestOnly() bool {
 	return p.ForTest != "" ||
 		p.Internal.TestmainGo != nil ||
-		len(p.TestGoFiles)+len(p.XTestGoFiles) > 0 && len(p.GoFiles)+len(p.CgoFiles) == 0
+		len(p.TestGoFiles)+len(p.XTestGoFiles) > 0 && len(p.GoFiles)+len(p.WoFiles)+len(p.CgoFiles) == 0
 }
 
 type PackageInternal struct {
@@ -410,6 +412,7 @@ func (p *Package) copyBuild(opts PackageOpts, pp *build.Package) {
 	p.Goroot = pp.Goroot || fips140.Snapshot() && str.HasFilePathPrefix(p.Dir, fips140.Dir())
 	p.Standard = p.Goroot && p.ImportPath != "" && search.IsStandardImportPath(p.ImportPath)
 	p.GoFiles = pp.GoFiles
+	p.WoFiles = pp.WoFiles
 	p.CgoFiles = pp.CgoFiles
 	p.IgnoredGoFiles = pp.IgnoredGoFiles
 	p.InvalidGoFiles = pp.InvalidGoFiles
@@ -1420,20 +1423,25 @@ HaveGoMod:
 	return path
 }
 
-// hasGoFiles reports whether dir contains any files with names ending in .go.
+// hasGoFiles reports whether dir contains any files with names ending in .go or .wo.
 // For a vendor check we must exclude directories that contain no .go files.
 // Otherwise it is not possible to vendor just a/b/c and still import the
 // non-vendored a/b. See golang.org/issue/13832.
 func hasGoFiles(dir string) bool {
 	files, _ := os.ReadDir(dir)
 	for _, f := range files {
-		if !f.IsDir() && strings.HasSuffix(f.Name(), ".go") {
+		if !f.IsDir() && IsSourceFile(f.Name()) {
 			return true
 		}
 	}
 	return false
 }
 
+// IsSourceFile reports whether name is the name of a Go or Wo source file.
+func IsSourceFile(name string) bool {
+	return strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo")
+}
+
 // reusePackage reuses package p to satisfy the import at the top
 // of the import stack stk. If this use causes an import loop,
 // reusePackage updates p's error information to record the loop.
@@ -1750,20 +1758,22 @@ func (p *Package) exeFromImportPath() string {
 }
 
 // exeFromFiles returns an executable name for a package
-// using the first element in GoFiles or CgoFiles collections without the prefix.
+// using the first element in GoFiles, WoFiles or CgoFiles collections without the prefix.
 //
 // Returns empty string in case of empty collection.
 func (p *Package) exeFromFiles() string {
 	var src string
 	if len(p.GoFiles) > 0 {
 		src = p.GoFiles[0]
+	} else if len(p.WoFiles) > 0 {
+		src = p.WoFiles[0]
 	} else if len(p.CgoFiles) > 0 {
 		src = p.CgoFiles[0]
 	} else {
 		return ""
 	}
 	_, elem := filepath.Split(src)
-	return elem[:len(elem)-len(".go")]
+	return strings.TrimSuffix(elem, filepath.Ext(elem))
 }
 
 // DefaultExecName returns the default executable name for a package
@@ -1939,6 +1949,15 @@ func (p *Package) load(ctx context.Context, opts PackageOpts, path string, stk *
 			// %go_import directives to import other packages.
 		}
 
+		// Wo builtin methods, like s.Contains(x), are calls of the
+		// functions of the strings, slices and maps packages.
+		// Standard packages don't use them, to avoid import cycles.
+		if p.UsesWo() && !p.Standard {
+			for _, path := range woBuiltinMethodImports(p, p.Internal.Build.Directives, p.GoFiles, p.WoFiles) {
+				addImport(path, true)
+			}
+		}
+
 		// The linker loads implicit dependencies.
 		if p.Name == "main" && !p.Internal.ForceLibrary {
 			ldDeps, err := LinkerDeps(p)
@@ -2707,10 +2726,10 @@ func (p *Package) mkAbs(list []string) []string {
 	return list
 }
 
-// InternalGoFiles returns the list of Go files being built for the package,
+// InternalGoFiles returns the list of Go and Wo files being built for the package,
 // using absolute paths.
 func (p *Package) InternalGoFiles() []string {
-	return p.mkAbs(str.StringList(p.GoFiles, p.CgoFiles, p.TestGoFiles))
+	return p.mkAbs(str.StringList(p.GoFiles, p.WoFiles, p.CgoFiles, p.TestGoFiles))
 }
 
 // InternalXGoFiles returns the list of Go files being built for the XTest package,
@@ -2723,7 +2742,7 @@ func (p *Package) InternalXGoFiles() []string {
 // using absolute paths. "Possibly relevant" means that files are not excluded
 // due to build tags, but files with names beginning with . or _ are still excluded.
 func (p *Package) InternalAllGoFiles() []string {
-	return p.mkAbs(str.StringList(p.IgnoredGoFiles, p.GoFiles, p.CgoFiles, p.TestGoFiles, p.XTestGoFiles))
+	return p.mkAbs(str.StringList(p.IgnoredGoFiles, p.GoFiles, p.WoFiles, p.CgoFiles, p.TestGoFiles, p.XTestGoFiles))
 }
 
 // UsesSwig reports whether the package needs to run SWIG.
@@ -2731,6 +2750,25 @@ func (p *Package) UsesSwig() bool {
 	return len(p.SwigFiles) > 0 || len(p.SwigCXXFiles) > 0
 }
 
+// UsesWo reports whether the package contains Wo files: .wo files or
+// .go files with a //wo:dialect directive.
+func (p *Package) UsesWo() bool {
+	if len(p.WoFiles) > 0 {
+		return true
+	}
+	if p.Internal.Build == nil {
+		return false
+	}
+	for _, list := range [][]build.Directive{p.Internal.Build.Directives, p.Internal.Build.TestDirectives} {
+		for _, d := range list {
+			if d.Text == "//wo:dialect" || strings.HasPrefix(d.Text, "//wo:dialect ") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // UsesCgo reports whether the package needs to run cgo
 func (p *Package) UsesCgo() bool {
 	return len(p.CgoFiles) > 0
@@ -2871,7 +2909,7 @@ func PackagesAndErrors(ctx context.Context, opts PackageOpts, patterns []string)
 		// Listing is only supported with all patterns referring to either:
 		// - Files that are part of the same directory.
 		// - Explicit package paths or patterns.
-		if strings.HasSuffix(p, ".go") {
+		if IsSourceFile(p) {
 			// We need to test whether the path is an actual Go file and not a
 			// package path or pattern ending in '.go' (see golang.org/issue/34653).
 			if fi, err := fsys.Stat(p); err == nil && !fi.IsDir() {
@@ -3189,6 +3227,10 @@ func setToolFlags(pkgs ...*Package) {
 		p.Internal.Gcflags = BuildGcflags.For(p)
 		p.Internal.Ldflags = BuildLdflags.For(p)
 		p.Internal.Gccgoflags = BuildGccgoflags.For(p)
+		if cfg.BuildEntry != "" && p.Name == "main" {
+			p.Internal.Gcflags = append(slices.Clip(p.Internal.Gcflags), "-entry="+cfg.BuildEntry)
+			p.Internal.Ldflags = append(slices.Clip(p.Internal.Ldflags), "-entry="+cfg.BuildEntry)
+		}
 	}
 }
 
@@ -3199,13 +3241,13 @@ func GoFilesPackage(ctx context.Context, opts PackageOpts, gofiles []string) *Pa
 	modload.Init()
 
 	for _, f := range gofiles {
-		if !strings.HasSuffix(f, ".go") {
+		if !IsSourceFile(f) {
 			pkg := new(Package)
 			pkg.Internal.Local = true
 			pkg.Internal.CmdlineFiles = true
 			pkg.Name = f
 			pkg.Error = &PackageError{
-				Err: fmt.Errorf("named files must be .go files: %s", pkg.Name),
+				Err: fmt.Errorf("named files must be .go or .wo files: %s", pkg.Name),
 			}
 			pkg.Incomplete = true
 			return pkg
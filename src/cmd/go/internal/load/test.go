@@ -129,6 +129,12 @@ func TestPackagesAndErrors(ctx context.Context, done func(), opts PackageOpts, p
 		p.TestImports[i] = p1.ImportPath
 		imports = append(imports, p1)
 	}
+	testWoImports, woImports, woErr := loadWoTestImports(ctx, opts, pre, p, &stk, p.Internal.Build.TestDirectives, p.TestGoFiles, p.TestImports, p.Imports, p.Internal.CompiledImports)
+	if woErr != nil && ptestErr == nil {
+		ptestErr = woErr
+		incomplete = true
+	}
+	imports = append(imports, woImports...)
 	var err error
 	p.TestEmbedFiles, testEmbed, err = resolveEmbed(p.Dir, p.TestEmbedPatterns)
 	if err != nil {
@@ -161,6 +167,11 @@ func TestPackagesAndErrors(ctx context.Context, done func(), opts PackageOpts, p
 		}
 		p.XTestImports[i] = p1.ImportPath
 	}
+	xtestWoImports, woImports, woErr := loadWoTestImports(ctx, opts, pre, p, &stk, p.Internal.Build.XTestDirectives, p.XTestGoFiles, p.XTestImports)
+	if woErr != nil && pxtestErr == nil {
+		pxtestErr = woErr
+	}
+	ximports = append(ximports, woImports...)
 	p.XTestEmbedFiles, xtestEmbed, err = resolveEmbed(p.Dir, p.XTestEmbedPatterns)
 	if err != nil && pxtestErr == nil {
 		pxtestErr = &PackageError{
@@ -201,6 +212,7 @@ func TestPackagesAndErrors(ctx context.Context, done func(), opts PackageOpts, p
 		ptest.Imports = str.StringList(p.TestImports, p.Imports)
 		ptest.Internal.Imports = append(imports, p.Internal.Imports...)
 		ptest.Internal.RawImports = str.StringList(rawTestImports, p.Internal.RawImports)
+		ptest.Internal.CompiledImports = str.StringList(p.Internal.CompiledImports, testWoImports)
 		ptest.Internal.ForceLibrary = true
 		ptest.Internal.BuildInfo = nil
 		ptest.Internal.Build = new(build.Package)
@@ -249,8 +261,9 @@ func TestPackagesAndErrors(ctx context.Context, done func(), opts PackageOpts, p
 					ImportPos:  p.Internal.Build.XTestImportPos,
 					Directives: p.Internal.Build.XTestDirectives,
 				},
-				Imports:    ximports,
-				RawImports: rawXTestImports,
+				Imports:         ximports,
+				RawImports:      rawXTestImports,
+				CompiledImports: xtestWoImports,
 
 				Asmflags:       p.Internal.Asmflags,
 				Gcflags:        p.Internal.Gcflags,
@@ -365,7 +378,7 @@ func TestPackagesAndErrors(ctx context.Context, done func(), opts PackageOpts, p
 			pmain.setLoadPackageDataError(err, p.ImportPath, &stk, nil)
 		}
 		t.Cover = cover
-		if len(ptest.GoFiles)+len(ptest.CgoFiles) > 0 {
+		if len(ptest.GoFiles)+len(ptest.WoFiles)+len(ptest.CgoFiles) > 0 {
 			pmain.Internal.Imports = append(pmain.Internal.Imports, ptest)
 			pmain.Imports = append(pmain.Imports, ptest.ImportPath)
 			t.ImportTest = true
@@ -0,0 +1,155 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package load
+
+import (
+	"context"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"cmd/go/internal/cfg"
+	"cmd/go/internal/fsys"
+	"cmd/internal/par"
+)
+
+// woBuiltinMethodPkgs are the packages whose functions provide the
+// Wo builtin methods, like s.Contains(x) for strings.Contains(s, x).
+var woBuiltinMethodPkgs = []string{"strings", "slices", "maps"}
+
+// woBuiltinMethodImports returns the packages of woBuiltinMethodPkgs
+// that the compiler may need to import for the builtin method calls
+// in the Wo files among the given files of p. The directives are those
+// of the files, which mark the .go files written in the Wo dialect.
+//
+// Which selectors denote builtin methods is only known after type
+// checking, so this is an approximation: a package is returned if it
+// has an exported function named like a selector in the Wo files.
+// Importing a package that turns out to be unused is harmless.
+func woBuiltinMethodImports(p *Package, directives []build.Directive, files ...[]string) []string {
+	sels := woSelectors(p, directives, files)
+	if len(sels) == 0 {
+		return nil
+	}
+	var paths []string
+	for _, path := range woBuiltinMethodPkgs {
+		funcs := woExportedFuncs(path)
+		for name := range sels {
+			if funcs == nil || funcs[name] {
+				paths = append(paths, path)
+				break
+			}
+		}
+	}
+	return paths
+}
+
+// woSelectors returns the set of exported selector names
+// in the Wo files among the given files of p.
+func woSelectors(p *Package, directives []build.Directive, files [][]string) map[string]bool {
+	dialect := make(map[string]bool)
+	for _, d := range directives {
+		if d.Text == "//wo:dialect" || strings.HasPrefix(d.Text, "//wo:dialect ") {
+			dialect[filepath.Base(d.Pos.Filename)] = true
+		}
+	}
+	var sels map[string]bool
+	fset := token.NewFileSet()
+	for _, list := range files {
+		for _, name := range list {
+			if !strings.HasSuffix(name, ".wo") && !dialect[name] {
+				continue
+			}
+			filename := filepath.Join(p.Dir, name)
+			src, err := fsys.ReadFile(filename)
+			if err != nil {
+				continue
+			}
+			// Parse errors are reported by the compiler;
+			// use whatever could be parsed.
+			f, _ := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+			if f == nil {
+				continue
+			}
+			ast.Inspect(f, func(n ast.Node) bool {
+				if sel, ok := n.(*ast.SelectorExpr); ok && sel.Sel.IsExported() {
+					if sels == nil {
+						sels = make(map[string]bool)
+					}
+					sels[sel.Sel.Name] = true
+				}
+				return true
+			})
+		}
+	}
+	return sels
+}
+
+var woExportedFuncsCache par.Cache[string, map[string]bool]
+
+// woExportedFuncs returns the set of names of the exported functions
+// of the standard package path, or nil if they can't be determined.
+func woExportedFuncs(path string) map[string]bool {
+	return woExportedFuncsCache.Do(path, func() map[string]bool {
+		if cfg.GOROOTsrc == "" {
+			return nil
+		}
+		dir := filepath.Join(cfg.GOROOTsrc, path)
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil
+		}
+		funcs := make(map[string]bool)
+		fset := token.NewFileSet()
+		for _, e := range entries {
+			name := e.Name()
+			if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+				continue
+			}
+			f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+			if err != nil {
+				return nil
+			}
+			for _, decl := range f.Decls {
+				if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil && fd.Name.IsExported() {
+					funcs[fd.Name.Name] = true
+				}
+			}
+		}
+		return funcs
+	})
+}
+
+// loadWoTestImports loads the packages of woBuiltinMethodImports for
+// the given test files of p, except those among the imported paths.
+// It returns their import paths, the packages, and the first error.
+func loadWoTestImports(ctx context.Context, opts PackageOpts, pre *preload, p *Package, stk *ImportStack, directives []build.Directive, files []string, imported ...[]string) ([]string, []*Package, *PackageError) {
+	if p.Standard {
+		return nil, nil, nil
+	}
+	var paths []string
+	var pkgs []*Package
+	var perr *PackageError
+Paths:
+	for _, path := range woBuiltinMethodImports(p, directives, files) {
+		for _, list := range imported {
+			if slices.Contains(list, path) {
+				continue Paths
+			}
+		}
+		p1, err := loadImport(ctx, opts, pre, path, p.Dir, p, stk, nil, ResolveImport)
+		if err != nil && perr == nil {
+			perr = err
+		}
+		paths = append(paths, p1.ImportPath)
+		pkgs = append(pkgs, p1)
+	}
+	return paths, pkgs, perr
+}
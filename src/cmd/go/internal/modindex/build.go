@@ -241,6 +241,16 @@ func nameExt(name string) string {
 	return name[i:]
 }
 
+// isSourceFile reports whether name is a Go or Wo source file name.
+func isSourceFile(name string) bool {
+	return strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo")
+}
+
+// isTestFile reports whether name is a Go or Wo test file name.
+func isTestFile(name string) bool {
+	return strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_test.wo")
+}
+
 func fileListForExt(p *build.Package, ext string) *[]string {
 	switch ext {
 	case ".c":
@@ -427,7 +437,7 @@ func getFileInfo(dir, name string, fset *token.FileSet) (*fileInfo, error) {
 	}
 	ext := name[i:]
 
-	if ext != ".go" && fileListForExt(&dummyPkg, ext) == nil {
+	if ext != ".go" && ext != ".wo" && fileListForExt(&dummyPkg, ext) == nil {
 		// skip
 		return nil, errNonSource
 	}
@@ -446,10 +456,10 @@ func getFileInfo(dir, name string, fset *token.FileSet) (*fileInfo, error) {
 	// TODO(matloob) should we decide whether to ignore binary only here or earlier
 	// when we create the index file?
 	var ignoreBinaryOnly bool
-	if strings.HasSuffix(name, ".go") {
+	if isSourceFile(name) {
 		err = readGoInfo(f, info)
-		if strings.HasSuffix(name, "_test.go") {
-			ignoreBinaryOnly = true // ignore //go:binary-only-package comments in _test.go files
+		if isTestFile(name) {
+			ignoreBinaryOnly = true // ignore //go:binary-only-package comments in test files
 		}
 	} else {
 		info.header, err = readComments(f)
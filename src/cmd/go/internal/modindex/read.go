@@ -475,7 +475,7 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 		name := tf.name()
 		// Check errors for go files and call badGoFiles to put them in
 		// InvalidGoFiles if they do have an error.
-		if strings.HasSuffix(name, ".go") {
+		if isSourceFile(name) {
 			if error := tf.error(); error != "" {
 				badGoFile(name, errors.New(tf.error()))
 				continue
@@ -506,7 +506,7 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 
 		ext := nameExt(name)
 		if !shouldBuild || tf.ignoreFile() {
-			if ext == ".go" {
+			if ext == ".go" || ext == ".wo" {
 				p.IgnoredGoFiles = append(p.IgnoredGoFiles, name)
 			} else if fileListForExt(p, ext) != nil {
 				p.IgnoredOtherFiles = append(p.IgnoredOtherFiles, name)
@@ -516,7 +516,7 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 
 		// Going to save the file. For non-Go files, can stop here.
 		switch ext {
-		case ".go":
+		case ".go", ".wo":
 			// keep going
 		case ".S", ".sx":
 			// special case for cgo, handled at end
@@ -534,7 +534,7 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 			p.IgnoredGoFiles = append(p.IgnoredGoFiles, name)
 			continue
 		}
-		isTest := strings.HasSuffix(name, "_test.go")
+		isTest := isTestFile(name)
 		isXTest := false
 		if isTest && strings.HasSuffix(tf.pkgName(), "_test") && p.Name != tf.pkgName() {
 			isXTest = true
@@ -574,6 +574,10 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 					badGoFile(name, fmt.Errorf("use of cgo in test %s not supported", name))
 					continue
 				}
+				if ext == ".wo" {
+					badGoFile(name, fmt.Errorf("use of cgo in Wo file %s not supported", name))
+					continue
+				}
 				isCgo = true
 			}
 		}
@@ -608,6 +612,11 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 			importMap = testImportPos
 			embedMap = testEmbedPos
 			directives = &p.TestDirectives
+		case ext == ".wo":
+			fileList = &p.WoFiles
+			importMap = importPos
+			embedMap = embedPos
+			directives = &p.Directives
 		default:
 			fileList = &p.GoFiles
 			importMap = importPos
@@ -654,7 +663,7 @@ func (rp *IndexPackage) Import(bctxt build.Context, mode build.ImportMode) (p *b
 	if badGoError != nil {
 		return p, badGoError
 	}
-	if len(p.GoFiles)+len(p.CgoFiles)+len(p.TestGoFiles)+len(p.XTestGoFiles) == 0 {
+	if len(p.GoFiles)+len(p.WoFiles)+len(p.CgoFiles)+len(p.TestGoFiles)+len(p.XTestGoFiles) == 0 {
 		return p, &build.NoGoError{Dir: p.Dir}
 	}
 	return p, pkgerr
@@ -693,7 +702,7 @@ func (rp *IndexPackage) IsGoDir() (_ bool, err error) {
 		}
 	}()
 	for _, sf := range rp.sourceFiles {
-		if strings.HasSuffix(sf.name(), ".go") {
+		if isSourceFile(sf.name()) {
 			return true, nil
 		}
 	}
@@ -718,7 +727,7 @@ func (rp *IndexPackage) ScanDir(tags map[string]bool) (sortedImports []string, s
 Files:
 	for _, sf := range rp.sourceFiles {
 		name := sf.name()
-		if strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") || !strings.HasSuffix(name, ".go") || !imports.MatchFile(name, tags) {
+		if strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") || !isSourceFile(name) || !imports.MatchFile(name, tags) {
 			continue
 		}
 
@@ -745,7 +754,7 @@ Files:
 		}
 		numFiles++
 		m := imports_
-		if strings.HasSuffix(name, "_test.go") {
+		if isTestFile(name) {
 			m = testImports
 		}
 		for _, p := range imps {
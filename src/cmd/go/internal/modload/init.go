@@ -1146,7 +1146,7 @@ func CreateModFile(ctx context.Context, modPath string) {
 		if strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
 			continue
 		}
-		if strings.HasSuffix(name, ".go") || f.IsDir() {
+		if strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo") || f.IsDir() {
 			empty = false
 			break
 		}
@@ -20,7 +20,7 @@ import (
 )
 
 var CmdRun = &base.Command{
-	UsageLine: "go run [build flags] [-exec xprog] package [arguments...]",
+	UsageLine: "go run [build flags] [-exec xprog] [-entry name] package [arguments...]",
 	Short:     "compile and run Go program",
 	Long: `
 Run compiles and runs the named main Go package.
@@ -53,6 +53,9 @@ By default, 'go run' compiles the binary without generating the information
 used by debuggers, to reduce build time. To include debugger information in
 the binary, use 'go build'.
 
+The -entry flag runs the named function of the main package instead of
+main, as in 'go run -entry=otherMain .'. See 'go help build' for details.
+
 The exit status of Run is not the exit status of the compiled binary.
 
 For more about build flags, see 'go help build'.
@@ -70,6 +73,7 @@ func init() {
 		work.AddCoverFlags(CmdRun, nil)
 	}
 	CmdRun.Flag.Var((*base.StringsFlag)(&work.ExecCmd), "exec", "")
+	CmdRun.Flag.StringVar(&cfg.BuildEntry, "entry", "", "")
 }
 
 func runRun(ctx context.Context, cmd *base.Command, args []string) {
@@ -95,7 +99,7 @@ func runRun(ctx context.Context, cmd *base.Command, args []string) {
 	}()
 
 	i := 0
-	for i < len(args) && strings.HasSuffix(args[i], ".go") {
+	for i < len(args) && load.IsSourceFile(args[i]) {
 		i++
 	}
 	pkgOpts := load.PackageOpts{MainOnly: true}
@@ -103,10 +107,10 @@ func runRun(ctx context.Context, cmd *base.Command, args []string) {
 	if i > 0 {
 		files := args[:i]
 		for _, file := range files {
-			if strings.HasSuffix(file, "_test.go") {
+			if strings.HasSuffix(file, "_test.go") || strings.HasSuffix(file, "_test.wo") {
 				// GoFilesPackage is going to assign this to TestGoFiles.
 				// Reject since it won't be part of the build.
-				base.Fatalf("go: cannot run *_test.go files (%s)", file)
+				base.Fatalf("go: cannot run *_test.go or *_test.wo files (%s)", file)
 			}
 		}
 		p = load.GoFilesPackage(ctx, pkgOpts, files)
@@ -152,6 +156,8 @@ func runRun(ctx context.Context, cmd *base.Command, args []string) {
 		var src string
 		if len(p.GoFiles) > 0 {
 			src = p.GoFiles[0]
+		} else if len(p.WoFiles) > 0 {
+			src = p.WoFiles[0]
 		} else if len(p.CgoFiles) > 0 {
 			src = p.CgoFiles[0]
 		} else {
@@ -163,7 +169,7 @@ func runRun(ctx context.Context, cmd *base.Command, args []string) {
 			}
 			base.Fatalf("go: no suitable source files%s", hint)
 		}
-		p.Internal.ExeName = src[:len(src)-len(".go")]
+		p.Internal.ExeName = strings.TrimSuffix(src, filepath.Ext(src))
 	} else {
 		p.Internal.ExeName = p.DefaultExecName()
 	}
@@ -189,7 +195,7 @@ func shouldUseOutsideModuleMode(args []string) bool {
 	// NOTE: "@" not allowed in import paths, but it is allowed in non-canonical
 	// versions.
 	return len(args) > 0 &&
-		!strings.HasSuffix(args[0], ".go") &&
+		!load.IsSourceFile(args[0]) &&
 		!strings.HasPrefix(args[0], "-") &&
 		strings.Contains(args[0], "@") &&
 		!build.IsLocalImport(args[0]) &&
@@ -1296,7 +1296,7 @@ func builderTest(b *work.Builder, ctx context.Context, pkgOpts load.PackageOpts,
 		}
 	}
 
-	if len(ptest.GoFiles)+len(ptest.CgoFiles) > 0 {
+	if len(ptest.GoFiles)+len(ptest.WoFiles)+len(ptest.CgoFiles) > 0 {
 		addTestVet(b, ptest, vetRunAction, installAction)
 	}
 	if pxtest != nil {
@@ -105,11 +105,11 @@ func runVet(ctx context.Context, cmd *base.Command, args []string) {
 			base.Errorf("%v", perr.Error)
 			continue
 		}
-		if len(ptest.GoFiles) == 0 && len(ptest.CgoFiles) == 0 && pxtest == nil {
+		if len(ptest.GoFiles) == 0 && len(ptest.WoFiles) == 0 && len(ptest.CgoFiles) == 0 && pxtest == nil {
 			base.Errorf("go: can't vet %s: no Go files in %s", p.ImportPath, p.Dir)
 			continue
 		}
-		if len(ptest.GoFiles) > 0 || len(ptest.CgoFiles) > 0 {
+		if len(ptest.GoFiles) > 0 || len(ptest.WoFiles) > 0 || len(ptest.CgoFiles) > 0 {
 			root.Deps = append(root.Deps, b.VetAction(work.ModeBuild, work.ModeBuild, ptest))
 		}
 		if pxtest != nil {
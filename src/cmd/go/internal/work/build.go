@@ -27,7 +27,7 @@ import (
 )
 
 var CmdBuild = &base.Command{
-	UsageLine: "go build [-o output] [build flags] [packages]",
+	UsageLine: "go build [-o output] [-entry name] [build flags] [packages]",
 	Short:     "compile packages and dependencies",
 	Long: `
 Build compiles the packages named by the import paths,
@@ -59,6 +59,12 @@ in the last two paragraphs. If the named output is an existing directory or
 ends with a slash or backslash, then any resulting executables
 will be written to that directory.
 
+The -entry flag names a function of the main package to run as the
+program entry point instead of main, as in 'go build -entry=otherMain'.
+The function must be named main or have a name ending in Main, and it
+must take no arguments and return no values, like main. This lets one
+main package hold several related programs.
+
 The build flags are shared by the build, clean, get, install, list, run,
 and test commands:
 
@@ -244,6 +250,7 @@ func init() {
 	CmdInstall.Run = runInstall
 
 	CmdBuild.Flag.StringVar(&cfg.BuildO, "o", "", "output file or directory")
+	CmdBuild.Flag.StringVar(&cfg.BuildEntry, "entry", "", "")
 
 	AddBuildFlags(CmdBuild, DefaultBuildFlags)
 	AddBuildFlags(CmdInstall, DefaultBuildFlags)
@@ -743,7 +750,7 @@ func runInstall(ctx context.Context, cmd *base.Command, args []string) {
 func omitTestOnly(pkgs []*load.Package) []*load.Package {
 	var list []*load.Package
 	for _, p := range pkgs {
-		if len(p.GoFiles)+len(p.CgoFiles) == 0 && !p.Internal.CmdlinePkgLiteral {
+		if len(p.GoFiles)+len(p.WoFiles)+len(p.CgoFiles) == 0 && !p.Internal.CmdlinePkgLiteral {
 			// Package has no source files,
 			// perhaps due to build tags or perhaps due to only having *_test.go files.
 			// Also, it is only being processed as the result of a wildcard match
@@ -384,6 +384,7 @@ func (b *Builder) buildActionID(a *Action) cache.ActionID {
 	// Input files.
 	inputFiles := str.StringList(
 		p.GoFiles,
+		p.WoFiles,
 		p.CgoFiles,
 		p.CFiles,
 		p.CXXFiles,
@@ -788,6 +789,10 @@ OverlayLoop:
 		}
 	}
 
+	// Wo files are compiled together with the package's Go files.
+	// They are not instrumented for coverage.
+	gofiles = append(gofiles, p.WoFiles...)
+
 	var srcfiles []string // .go and non-.go
 	srcfiles = append(srcfiles, gofiles...)
 	srcfiles = append(srcfiles, sfiles...)
@@ -1113,7 +1118,7 @@ func (b *Builder) loadCachedCompiledGoFiles(a *Action) error {
 	for _, name := range strings.Split(string(list), "\n") {
 		if name == "" { // end of list
 			continue
-		} else if !strings.HasSuffix(name, ".go") {
+		} else if !load.IsSourceFile(name) {
 			continue
 		}
 		if strings.HasPrefix(name, "./") {
@@ -1154,11 +1159,11 @@ type vetConfig struct {
 }
 
 func buildVetConfig(a *Action, srcfiles []string) {
-	// Classify files based on .go extension.
+	// Classify files based on .go and .wo extensions.
 	// srcfiles does not include raw cgo files.
 	var gofiles, nongofiles []string
 	for _, name := range srcfiles {
-		if strings.HasSuffix(name, ".go") {
+		if load.IsSourceFile(name) {
 			gofiles = append(gofiles, name)
 		} else {
 			nongofiles = append(nongofiles, name)
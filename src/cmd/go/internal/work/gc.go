@@ -79,6 +79,11 @@ func (gcToolchain) gc(b *Builder, a *Action, archive string, importcfg, embedcfg
 		}
 	}
 	defaultGcFlags = append(defaultGcFlags, "-lang=go"+gover.Lang(vers))
+	if p.Module != nil && p.Module.Path != "" {
+		// Objects declared with the Wo pkg modifier are visible
+		// to the packages of the same module.
+		defaultGcFlags = append(defaultGcFlags, "-module", p.Module.Path)
+	}
 	if p.Standard {
 		defaultGcFlags = append(defaultGcFlags, "-std")
 	}
@@ -283,7 +288,7 @@ func (a *Action) trimpath() string {
 				path = filepath.Join(a.Package.Dir, path)
 			}
 			base := filepath.Base(path)
-			isGo := strings.HasSuffix(filename, ".go") || strings.HasSuffix(filename, ".s")
+			isGo := load.IsSourceFile(filename) || strings.HasSuffix(filename, ".s")
 			isCgo := cgoFiles[filename] || !isGo
 			if fsys.Replaced(path) {
 				if isCgo {
@@ -588,7 +593,7 @@ func pluginPath(a *Action) string {
 		buildID = id[1] + buildIDSeparator + id[1]
 	}
 	fmt.Fprintf(h, "build ID: %s\n", buildID)
-	for _, file := range str.StringList(p.GoFiles, p.CgoFiles, p.SFiles) {
+	for _, file := range str.StringList(p.GoFiles, p.WoFiles, p.CgoFiles, p.SFiles) {
 		data, err := os.ReadFile(filepath.Join(p.Dir, file))
 		if err != nil {
 			base.Fatalf("go: %s", err)
@@ -14,6 +14,7 @@ import (
 	"cmd/go/internal/modload"
 	"cmd/internal/quoted"
 	"fmt"
+	"go/token"
 	"internal/platform"
 	"os"
 	"os/exec"
@@ -21,6 +22,7 @@ import (
 	"regexp"
 	"runtime"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -84,6 +86,15 @@ func BuildInit() {
 	if cfg.BuildRace && cfg.BuildCoverMode != "atomic" {
 		base.Fatalf(`-covermode must be "atomic", not %q, when -race is enabled`, cfg.BuildCoverMode)
 	}
+
+	if cfg.BuildEntry != "" {
+		if !token.IsIdentifier(cfg.BuildEntry) || cfg.BuildEntry != "main" && !strings.HasSuffix(cfg.BuildEntry, "Main") {
+			base.Fatalf("go: invalid -entry=%s: must be main or a function name ending in Main", cfg.BuildEntry)
+		}
+		if cfg.BuildContext.Compiler == "gccgo" {
+			base.Fatalf("go: -entry is not supported by gccgo")
+		}
+	}
 }
 
 // fuzzInstrumentFlags returns compiler flags that enable fuzzing instrumentation
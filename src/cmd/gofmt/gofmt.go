@@ -88,9 +88,9 @@ func initParserMode() {
 }
 
 func isGoFile(f fs.DirEntry) bool {
-	// ignore non-Go files
+	// ignore non-Go files; Wo files are Go files
 	name := f.Name()
-	return !strings.HasPrefix(name, ".") && strings.HasSuffix(name, ".go") && !f.IsDir()
+	return !strings.HasPrefix(name, ".") && (strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo")) && !f.IsDir()
 }
 
 // A sequencer performs concurrent tasks that may write output, but emits that
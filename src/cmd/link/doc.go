@@ -72,6 +72,10 @@ Flags:
 		system tools now assume the presence of the header.
 	-dumpdep
 		Dump symbol dependency graph.
+	-entry name
+		Run function name of package main, such as otherMain, as the
+		program entry point in place of main.main. The main package must
+		be compiled with the same -entry flag.
 	-extar ar
 		Set the external archive program (default "ar").
 		Used only for -buildmode=c-archive.
@@ -8,6 +8,7 @@ import (
 	"cmd/internal/obj"
 	"cmd/link/internal/loader"
 	"cmd/link/internal/sym"
+	"strings"
 	"sync"
 )
 
@@ -56,8 +57,8 @@ func (reporter *ErrorReporter) errorUnresolved(ldr *loader.Loader, s, rs loader.
 		}
 
 		// Give a special error message for main symbol (see #24809).
-		if name == "main.main" {
-			reporter.Errorf(s, "function main is undeclared in the main package")
+		if name == "main.main" || name == "main."+*flagEntry {
+			reporter.Errorf(s, "function %s is undeclared in the main package", strings.TrimPrefix(name, "main."))
 		} else if haveABI != ^obj.ABI(0) {
 			reporter.Errorf(s, "relocation target %s not defined for %s (but is defined for %s)", name, reqABI, haveABI)
 		} else {
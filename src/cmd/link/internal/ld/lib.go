@@ -531,6 +531,9 @@ func (ctxt *Link) loadlib() {
 		log.Fatalf("invalid -strictdups flag value %d", *FlagStrictDups)
 	}
 	ctxt.loader = loader.NewLoader(flags, &ctxt.ErrorReporter.ErrorReporter)
+	if *flagEntry != "" {
+		ctxt.loader.SetMainEntry("main." + *flagEntry)
+	}
 	ctxt.ErrorReporter.SymName = func(s loader.Sym) string {
 		return ctxt.loader.SymName(s)
 	}
@@ -2351,8 +2354,8 @@ func ldobj(ctxt *Link, f *bio.Reader, lib *sym.Library, length int64, pn string,
 	}
 
 	if !strings.HasPrefix(line, "go object ") {
-		if strings.HasSuffix(pn, ".go") {
-			Exitf("%s: uncompiled .go source file", pn)
+		if strings.HasSuffix(pn, ".go") || strings.HasSuffix(pn, ".wo") {
+			Exitf("%s: uncompiled %s source file", pn, filepath.Ext(pn))
 			return nil
 		}
 
@@ -106,6 +106,7 @@ var (
 	FlagRound         = flag.Int64("R", -1, "set address rounding `quantum`")
 	FlagTextAddr      = flag.Int64("T", -1, "set the start address of text symbols")
 	flagEntrySymbol   = flag.String("E", "", "set `entry` symbol name")
+	flagEntry         = flag.String("entry", "", "run function `name` of package main in place of main.main")
 	flagPruneWeakMap  = flag.Bool("pruneweakmap", true, "prune weak mapinit refs")
 	flagRandLayout    = flag.Int64("randlayout", 0, "randomize function layout")
 	cpuprofile        = flag.String("cpuprofile", "", "write cpu profile to `file`")
@@ -323,6 +324,9 @@ func Main(arch *sys.Arch, theArch Arch) {
 	if ctxt.linkShared && !ctxt.IsELF {
 		Exitf("-linkshared can only be used on elf systems")
 	}
+	if *flagEntry != "" && (ctxt.linkShared || (ctxt.BuildMode != BuildModeExe && ctxt.BuildMode != BuildModePIE)) {
+		Exitf("-entry can only be used when linking a standalone executable")
+	}
 
 	if ctxt.Debugvlog != 0 {
 		onOff := func(b bool) string {
@@ -189,6 +189,7 @@ type Loader struct {
 	extStaticSyms map[nameVer]Sym   // externally defined static symbols, keyed by name
 
 	extReader    *oReader // a dummy oReader, for external symbols
+	mainEntry    string   // if set, the symbol non-package references to main.main refer to
 	payloadBatch []extSymPayload
 	payloads     []*extSymPayload // contents of linker-materialized external syms
 	values       []int64          // symbol values, indexed by global sym index
@@ -2272,6 +2273,9 @@ func loadObjRefs(l *Loader, r *oReader, arch *sys.Arch) {
 	for i, n := uint32(0), uint32(r.NNonpkgref()); i < n; i++ {
 		osym := r.Sym(ndef + i)
 		name := osym.Name(r.Reader)
+		if name == "main.main" && l.mainEntry != "" {
+			name = l.mainEntry
+		}
 		v := abiToVer(osym.ABI(), r.version)
 		gi := l.LookupOrCreateSym(name, v)
 		r.syms[ndef+i] = gi
@@ -2379,6 +2383,12 @@ var blockedLinknames = map[string][]string{
 	"runtime.mapdelete_faststr":  {"runtime"},
 }
 
+// SetMainEntry makes non-package references to main.main, such as
+// the runtime's, refer to the symbol name instead.
+func (l *Loader) SetMainEntry(name string) {
+	l.mainEntry = name
+}
+
 // check if a linkname reference to symbol s from pkg is allowed
 func (l *Loader) checkLinkname(pkg, name string, s Sym) {
 	if l.flags&FlagCheckLinkname == 0 {
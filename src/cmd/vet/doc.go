@@ -59,10 +59,13 @@ To list the available checks, run "go tool vet help":
 	unreachable      check for unreachable code
 	unsafeptr        check for invalid conversions of uintptr to unsafe.Pointer
 	unusedresult     check for unused results of calls to some functions
+	woshadow         check for declarations that shadow predeclared identifiers or imports
 
 For details and flags of a particular check, such as printf, run "go tool vet help printf".
 
-By default, all checks are performed.
+By default, all checks are performed. The woshadow check reports
+declarations that are valid Go but forbidden in the Wo dialect, so it
+reports nothing unless it is enabled with -woshadow.enable.
 If any flags are explicitly set to true, only those tests are run.
 Conversely, if any flag is explicitly set to false, only those tests are disabled.
 Thus -printf=true runs the printf check,
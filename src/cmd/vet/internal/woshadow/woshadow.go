@@ -0,0 +1,105 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package woshadow defines an Analyzer that reports declarations
+// that shadow predeclared identifiers or imported packages.
+package woshadow
+
+import (
+	"go/ast"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+const Doc = `check for declarations that shadow predeclared identifiers or imports
+
+The woshadow analyzer reports declarations that the Wo dialect forbids
+because they shadow a predeclared identifier, as in
+
+	var int int = 1
+	rune := 'W'
+
+or, in a local scope, the name of a package imported by the file:
+
+	import "strings"
+
+	func f() {
+		strings := []string{"a"}
+		...
+	}
+
+The compiler reports these declarations in Wo files. The analyzer lets
+Go files opt in to the same check: since it reports valid Go code, it
+is off by default and only reports anything if it is enabled with the
+-woshadow.enable flag, as in "go vet -woshadow.enable".`
+
+var Analyzer = &analysis.Analyzer{
+	Name:             "woshadow",
+	Doc:              Doc,
+	RunDespiteErrors: true,
+	Run:              run,
+}
+
+var enable bool // -woshadow.enable flag
+
+func init() {
+	Analyzer.Flags.BoolVar(&enable, "enable", false, "report declarations that shadow predeclared identifiers or imports")
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	if !enable {
+		return nil, nil
+	}
+	for _, file := range pass.Files {
+		if file.Wo {
+			continue // checked by the compiler
+		}
+		fileScope := pass.TypesInfo.Scopes[file]
+		ast.Inspect(file, func(n ast.Node) bool {
+			id, ok := n.(*ast.Ident)
+			if !ok || id == file.Name || id.Name == "_" {
+				return true
+			}
+			obj, ok := pass.TypesInfo.Defs[id]
+			if !ok || !declares(obj) {
+				return true
+			}
+			if predeclared(id.Name) {
+				pass.Reportf(id.Pos(), "%s shadows predeclared identifier %s", id.Name, id.Name)
+				return true
+			}
+			// The symbolic variable of a type switch has no object.
+			local := obj == nil || obj.Parent() != pass.Pkg.Scope() && obj.Parent() != fileScope
+			if pkgName, ok := fileScope.Lookup(id.Name).(*types.PkgName); ok && local {
+				pass.Reportf(id.Pos(), "%s shadows import of package %q", id.Name, pkgName.Imported().Path())
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+// declares reports whether obj, defined by an identifier, is bound to
+// its name in a scope. Struct fields and methods are not.
+func declares(obj types.Object) bool {
+	switch obj := obj.(type) {
+	case *types.Var:
+		return !obj.IsField()
+	case *types.Func:
+		return obj.Type().(*types.Signature).Recv() == nil
+	case *types.Label:
+		return false
+	}
+	return true
+}
+
+// predeclared reports whether name is predeclared in Go files.
+func predeclared(name string) bool {
+	switch name {
+	case "set", "None":
+		return false // only predeclared in Wo files
+	}
+	return types.Universe.Lookup(name) != nil
+}
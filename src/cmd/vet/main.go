@@ -7,6 +7,7 @@ package main
 import (
 	"cmd/internal/objabi"
 	"cmd/internal/telemetry/counter"
+	"cmd/vet/internal/woshadow"
 	"flag"
 
 	"golang.org/x/tools/go/analysis/unitchecker"
@@ -85,6 +86,7 @@ func main() {
 		unreachable.Analyzer,
 		unsafeptr.Analyzer,
 		unusedresult.Analyzer,
+		woshadow.Analyzer,
 	)
 
 	// It's possible that unitchecker will exit early. In
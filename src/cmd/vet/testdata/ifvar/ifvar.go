@@ -0,0 +1,45 @@
+//wo:dialect
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the dead code checker on Wo
+// conditional bindings.
+
+package ifvar
+
+func pop(xs []int) (int, bool) {
+	if len(xs) == 0 {
+		return 0, false
+	}
+	return xs[0], true
+}
+
+func _(xs []int) int {
+	// A for statement with a conditional binding terminates when
+	// the binding fails, so the code after it is reachable.
+	for var x = pop(xs) {
+		print(x)
+		xs = xs[1:]
+	}
+	print(len(xs))
+	return 0
+}
+
+func _(m map[string]int) int {
+	if var x = m["x"] {
+		return x
+	}
+	print(1)
+	return 0
+}
+
+func _(m map[string]int) int {
+	for var x = m["x"] {
+		return x
+	}
+	return 0
+	println() // ERROR "unreachable code"
+	return 1
+}
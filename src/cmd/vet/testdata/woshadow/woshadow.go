@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file contains tests for the woshadow checker.
+
+package woshadow
+
+import "strings"
+
+var len = 1 // ERROR "len shadows predeclared identifier len"
+
+type T struct {
+	int  int // fields don't shadow anything
+	bool bool
+}
+
+func (T) string() {} // nor do methods
+
+func _(error error) (string, bool) { // ERROR "error shadows predeclared identifier error"
+	rune := 'W'                       // ERROR "rune shadows predeclared identifier rune"
+	strings := []string{string(rune)} // ERROR "strings shadows import of package .strings."
+	var x any = strings
+	switch new := x.(type) { // ERROR "new shadows predeclared identifier new"
+	case int:
+		_ = new
+	}
+	set := map[string]bool{} // set is only predeclared in Wo files
+	return strings[0], set[""]
+}
+
+func _() []string {
+	return strings.Fields("w o")
+}
@@ -58,6 +58,7 @@ func TestVet(t *testing.T) {
 		"deadcode",
 		"directive",
 		"httpresponse",
+		"ifvar",
 		"lostcancel",
 		"method",
 		"nilfunc",
@@ -167,6 +168,20 @@ func TestVet(t *testing.T) {
 			t.Log("vet stderr:\n", cmd.Stderr)
 		}
 	})
+
+	// The woshadow analyzer only reports anything if it is enabled.
+	t.Run("woshadow", func(t *testing.T) {
+		t.Parallel()
+		if out, err := vetCmd(t, "-woshadow", "woshadow").CombinedOutput(); err != nil {
+			t.Fatalf("vet -woshadow: %v (want no reports unless enabled)\n%s", err, out)
+		}
+		cmd := vetCmd(t, "-woshadow.enable", "woshadow")
+		gos, err := filepath.Glob(filepath.Join("testdata", "woshadow", "*.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		errchk(cmd, gos, t)
+	})
 }
 
 func cgoEnabled(t *testing.T) bool {
@@ -0,0 +1,423 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"unicode"
+	"unicode/utf8"
+)
+
+// decl translates the top-level declaration d into a list of
+// declarations.
+func (t *translator) decl(d ast.Decl) []ast.Decl {
+	t.beginDecl(d)
+	switch d := d.(type) {
+	case *ast.FuncDecl:
+		t.visibility(d.Vis, d.VisPos, d.Name)
+		d.Vis, d.VisPos = ast.DefaultVis, token.NoPos
+		t.funcDecl(d)
+	case *ast.GenDecl:
+		return t.genDecl(d)
+	}
+	return []ast.Decl{d}
+}
+
+// visibility reports an error if the names declared with the
+// visibility modifier vis cannot have the same visibility in Go.
+func (t *translator) visibility(vis ast.Visibility, pos token.Pos, names ...*ast.Ident) {
+	if vis == ast.DefaultVis {
+		return
+	}
+	for _, id := range names {
+		if !token.IsExported(id.Name) && id.Name != "_" {
+			t.errorf(pos, "cannot translate %s modifier of unexported name %s", vis, id.Name)
+		}
+	}
+}
+
+func (t *translator) funcDecl(d *ast.FuncDecl) {
+	fn, _ := t.info.Defs[d.Name].(*types.Func)
+	var sig *types.Signature
+	if fn != nil {
+		sig = fn.Type().(*types.Signature)
+		d.Name.Name = fn.Name() // the functions of an overload have mangled names
+	}
+	t.funcType(d.Type, sig)
+	if d.Recv != nil {
+		for _, f := range d.Recv.List {
+			f.Type = t.typ(f.Type)
+		}
+	}
+	if name, ok := t.methodFuncs[fn]; ok {
+		// A method with type parameters becomes a function with the
+		// type parameters of the receiver type, followed by those of
+		// the method, and the receiver as first parameter.
+		tparams := &ast.FieldList{Opening: d.Name.End()}
+		for i := range sig.RecvTypeParams().Len() {
+			tp := sig.RecvTypeParams().At(i)
+			tparams.List = append(tparams.List, &ast.Field{
+				Names: []*ast.Ident{ast.NewIdent(tp.Obj().Name())},
+				Type:  t.constraintType(tp.Constraint()),
+			})
+		}
+		tparams.List = append(tparams.List, d.Type.TypeParams.List...)
+		tparams.Closing = d.Type.TypeParams.Closing
+		d.Type.TypeParams = tparams
+
+		recv := d.Recv.List[0]
+		if len(recv.Names) == 0 {
+			recv.Names = []*ast.Ident{ast.NewIdent("_")}
+		}
+		d.Type.Params.List = append([]*ast.Field{recv}, d.Type.Params.List...)
+		d.Recv = nil
+		d.Name = &ast.Ident{NamePos: d.Name.Pos(), Name: name}
+	}
+	t.fn = &funcState{sig: sig}
+	t.funcBody(d.Body)
+	t.fn = nil
+}
+
+// constraintType returns a type expression for the type constraint T.
+func (t *translator) constraintType(T types.Type) ast.Expr {
+	if iface, ok := T.(*types.Interface); ok {
+		return t.interfaceType(iface, true)
+	}
+	return t.typeExpr(T)
+}
+
+func (t *translator) genDecl(d *ast.GenDecl) []ast.Decl {
+	if d.Tok == token.IMPORT {
+		return []ast.Decl{d}
+	}
+	for _, spec := range d.Specs {
+		switch spec := spec.(type) {
+		case *ast.ValueSpec:
+			t.visibility(d.Vis, d.VisPos, spec.Names...)
+		case *ast.TypeSpec:
+			t.visibility(d.Vis, d.VisPos, spec.Name)
+		}
+	}
+	d.Vis, d.VisPos = ast.DefaultVis, token.NoPos
+
+	var extra []ast.Decl
+	for _, spec := range d.Specs {
+		switch spec := spec.(type) {
+		case *ast.TypeSpec:
+			if x, ok := spec.Type.(*ast.EnumType); ok {
+				extra = append(extra, t.enumDecl(spec, x)...)
+				continue
+			}
+			t.typeSpec(spec)
+		case *ast.ValueSpec:
+			if d.Tok == token.CONST {
+				spec.Type = t.typ(spec.Type)
+				for i, v := range spec.Values {
+					spec.Values[i] = t.exprOnly(v)
+				}
+				continue
+			}
+			t.packageVar(spec)
+		}
+	}
+	return append([]ast.Decl{d}, extra...)
+}
+
+// packageVar translates the specification of package-level variables.
+func (t *translator) packageVar(spec *ast.ValueSpec) {
+	var T types.Type
+	if spec.Type != nil {
+		T = t.info.Types[spec.Type].Type
+	}
+	if len(spec.Values) == 1 {
+		if x, ok := ast.Unparen(spec.Values[0]).(*ast.CallExpr); ok {
+			if tuple, ok := t.info.Types[x].Type.(*types.Tuple); ok && tuple.Len() > len(spec.Names) {
+				var lhs []ast.Expr
+				for _, name := range spec.Names {
+					lhs = append(lhs, name)
+				}
+				spec.Names = nil
+				for _, e := range t.skipped(x, lhs) {
+					spec.Names = append(spec.Names, e.(*ast.Ident))
+				}
+				if T != nil {
+					// var a T = f() is var a, _ = f() if the
+					// result has type T.
+					for i, name := range spec.Names {
+						if name.Name != "_" && !types.Identical(tuple.At(i).Type(), T) {
+							t.errorf(spec.Pos(), "cannot translate typed declaration with skipped results")
+						}
+					}
+					spec.Type = nil
+				}
+				spec.Values[0] = t.exprOnly(x)
+				return
+			}
+		}
+	}
+	spec.Type = t.typ(spec.Type)
+	for i, v := range spec.Values {
+		VT := T
+		if VT == nil && i < len(spec.Names) {
+			VT = t.lhsType(spec.Names[i])
+		}
+		spec.Values[i] = t.valueOnly(v, VT)
+	}
+}
+
+// typeSpec translates the declaration of a type that is not an enum.
+func (t *translator) typeSpec(spec *ast.TypeSpec) {
+	if spec.TypeParams != nil {
+		for _, f := range spec.TypeParams.List {
+			f.Type = t.constraint(f.Type)
+		}
+	}
+	obj, _ := t.info.Defs[spec.Name].(*types.TypeName)
+	if obj != nil && t.constraints[obj] && isTerm(spec.Type) {
+		// A union type used as a constraint is a constraint
+		// interface in Go.
+		spec.Type = &ast.InterfaceType{Methods: &ast.FieldList{List: []*ast.Field{{Type: t.constraint(spec.Type)}}}}
+		return
+	}
+	if x, ok := spec.Type.(*ast.InterfaceType); ok && obj != nil && t.constraints[obj] {
+		spec.Type = t.interfaceExpr(x, true)
+		return
+	}
+	spec.Type = t.typ(spec.Type)
+}
+
+// implicitMethod reports whether the method name of the enum type obj
+// is one that the enum has implicitly rather than by a declaration.
+// The type checker puts implicit methods at the position of obj.
+func implicitMethod(obj *types.TypeName, name string) bool {
+	named := obj.Type().(*types.Named)
+	for i := range named.NumMethods() {
+		if m := named.Method(i); m.Name() == name {
+			return m.Pos() == obj.Pos()
+		}
+	}
+	return false
+}
+
+// enumDecl translates the declaration of the enum type spec. It
+// replaces the type of spec with the representation of the enum in
+// Go and returns the declarations of its variants and methods.
+//
+// The type of a simple enum is an unsigned integer that holds the
+// index of a variant. The type of a sum enum is a struct with the
+// index and a struct field for the fields of each variant.
+func (t *translator) enumDecl(spec *ast.TypeSpec, x *ast.EnumType) []ast.Decl {
+	obj, _ := t.info.Defs[spec.Name].(*types.TypeName)
+	if obj == nil {
+		return nil
+	}
+	T := obj.Type()
+	enum := T.Underlying().(*types.Enum)
+	name := spec.Name.Name
+	recv := func() *ast.FieldList {
+		return &ast.FieldList{List: []*ast.Field{{Names: []*ast.Ident{ast.NewIdent("x")}, Type: ast.NewIdent(name)}}}
+	}
+	method := func(name string, result ast.Expr, body ...ast.Stmt) *ast.FuncDecl {
+		return &ast.FuncDecl{
+			Recv: recv(),
+			Name: ast.NewIdent(name),
+			Type: &ast.FuncType{Params: &ast.FieldList{}, Results: &ast.FieldList{List: []*ast.Field{{Type: result}}}},
+			Body: &ast.BlockStmt{List: body},
+		}
+	}
+
+	// index is the expression for the index of the variant of x.
+	var index ast.Expr = ast.NewIdent("x")
+	var decls []ast.Decl
+	if !enum.IsSum() {
+		spec.Type = t.typeExpr(enum.TagType())
+		consts := &ast.GenDecl{Tok: token.CONST, Lparen: spec.Type.End()}
+		for i := range enum.NumVariants() {
+			v := enum.Variant(i)
+			vs := &ast.ValueSpec{Names: []*ast.Ident{{NamePos: x.Variants[i].Name.Pos(), Name: v.Name()}}}
+			if i == 0 {
+				vs.Type = ast.NewIdent(name)
+				vs.Values = []ast.Expr{ast.NewIdent("iota")}
+			}
+			consts.Specs = append(consts.Specs, vs)
+		}
+		if len(consts.Specs) == 1 {
+			consts.Lparen = token.NoPos
+		}
+		decls = append(decls, consts)
+	} else {
+		index = &ast.SelectorExpr{X: ast.NewIdent("x"), Sel: ast.NewIdent("Tag")}
+		fields := &ast.FieldList{List: []*ast.Field{{Names: []*ast.Ident{ast.NewIdent("Tag")}, Type: t.typeExpr(enum.TagType())}}}
+		payload := make([]func() ast.Expr, enum.NumVariants())
+		for i := range enum.NumVariants() {
+			v := enum.Variant(i)
+			payload[i] = func() ast.Expr {
+				return &ast.StructType{Fields: t.variantFields(v, exported)}
+			}
+			// Comments on the variant go with its field.
+			id := &ast.Ident{NamePos: x.Variants[i].Name.Pos(), Name: variantField(v)}
+			fields.List = append(fields.List, &ast.Field{Names: []*ast.Ident{id}, Type: payload[i]()})
+		}
+		spec.Type = &ast.StructType{Struct: x.Enum, Fields: fields}
+
+		for i := range enum.NumVariants() {
+			v := enum.Variant(i)
+			lit := &ast.CompositeLit{Type: ast.NewIdent(name)}
+			if i > 0 {
+				lit.Elts = []ast.Expr{&ast.KeyValueExpr{Key: ast.NewIdent("Tag"), Value: intLit(int64(i))}}
+			}
+			if v.NumFields() == 0 {
+				decls = append(decls, &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{&ast.ValueSpec{
+					Names:  []*ast.Ident{ast.NewIdent(v.Name())},
+					Values: []ast.Expr{lit},
+				}}})
+				continue
+			}
+
+			// func V(f1 T1, f2 T2) E {
+			//	return E{Tag: i, V: struct{ F1 T1; F2 T2 }{f1, f2}}
+			// }
+			params := t.variantFields(v, func(name string) string { return name })
+			var args []ast.Expr
+			for j := range v.NumFields() {
+				args = append(args, ast.NewIdent(v.Field(j).Name()))
+			}
+			lit.Elts = append(lit.Elts, &ast.KeyValueExpr{
+				Key:   ast.NewIdent(variantField(v)),
+				Value: &ast.CompositeLit{Type: payload[i](), Elts: args},
+			})
+			decls = append(decls, &ast.FuncDecl{
+				Name: ast.NewIdent(v.Name()),
+				Type: &ast.FuncType{Params: params, Results: &ast.FieldList{List: []*ast.Field{{Type: ast.NewIdent(name)}}}},
+				Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{lit}}}},
+			})
+		}
+	}
+
+	// The methods String and MarshalText return the name of the
+	// variant, UnmarshalText sets the variant by name, and the shared
+	// fields are methods that return their values for the variant.
+	table := func(elem ast.Expr, values []ast.Expr) ast.Expr {
+		return &ast.IndexExpr{
+			X:     &ast.CompositeLit{Type: &ast.ArrayType{Len: &ast.Ellipsis{}, Elt: elem}, Elts: values},
+			Index: index,
+		}
+	}
+	if implicitMethod(obj, "String") {
+		decls = append(decls, method("String", ast.NewIdent("string"), &ast.ReturnStmt{Results: []ast.Expr{table(ast.NewIdent("string"), variantNames(enum))}}))
+	}
+	if implicitMethod(obj, "MarshalText") {
+		decls = append(decls, &ast.FuncDecl{
+			Recv: recv(),
+			Name: ast.NewIdent("MarshalText"),
+			Type: &ast.FuncType{Params: &ast.FieldList{}, Results: &ast.FieldList{List: []*ast.Field{
+				{Type: &ast.ArrayType{Elt: ast.NewIdent("byte")}},
+				{Type: ast.NewIdent("error")},
+			}}},
+			Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{
+				call(&ast.ArrayType{Elt: ast.NewIdent("byte")}, table(ast.NewIdent("string"), variantNames(enum))),
+				ast.NewIdent("nil"),
+			}}}},
+		})
+	}
+	if implicitMethod(obj, "UnmarshalText") {
+		// func (x *E) UnmarshalText(text []byte) error {
+		//	switch string(text) {
+		//	case "V0":
+		//		*x = V0
+		//		return nil
+		//	...
+		//	}
+		//	return errors.New("wo: p.E has no variant \"" + string(text) + "\"")
+		// }
+		text := func() ast.Expr { return call(ast.NewIdent("string"), ast.NewIdent("text")) }
+		names := variantNames(enum)
+		var clauses []ast.Stmt
+		for i := range enum.NumVariants() {
+			var value ast.Expr = ast.NewIdent(enum.Variant(i).Name())
+			if enum.IsSum() {
+				lit := &ast.CompositeLit{Type: ast.NewIdent(name)}
+				if i > 0 {
+					lit.Elts = []ast.Expr{&ast.KeyValueExpr{Key: ast.NewIdent("Tag"), Value: intLit(int64(i))}}
+				}
+				value = lit
+			}
+			clauses = append(clauses, &ast.CaseClause{List: []ast.Expr{names[i]}, Body: []ast.Stmt{
+				&ast.AssignStmt{Lhs: []ast.Expr{&ast.StarExpr{X: ast.NewIdent("x")}}, Tok: token.ASSIGN, Rhs: []ast.Expr{value}},
+				&ast.ReturnStmt{Results: []ast.Expr{ast.NewIdent("nil")}},
+			}})
+		}
+		prefix := &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote("wo: " + obj.Pkg().Name() + "." + obj.Name() + " has no variant \"")}
+		quote := &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote("\"")}
+		msg := &ast.BinaryExpr{X: &ast.BinaryExpr{X: prefix, Op: token.ADD, Y: text()}, Op: token.ADD, Y: quote}
+		decls = append(decls, &ast.FuncDecl{
+			Recv: &ast.FieldList{List: []*ast.Field{{Names: []*ast.Ident{ast.NewIdent("x")}, Type: &ast.StarExpr{X: ast.NewIdent(name)}}}},
+			Name: ast.NewIdent("UnmarshalText"),
+			Type: &ast.FuncType{
+				Params:  &ast.FieldList{List: []*ast.Field{{Names: []*ast.Ident{ast.NewIdent("text")}, Type: &ast.ArrayType{Elt: ast.NewIdent("byte")}}}},
+				Results: &ast.FieldList{List: []*ast.Field{{Type: ast.NewIdent("error")}}},
+			},
+			Body: &ast.BlockStmt{List: []ast.Stmt{
+				&ast.SwitchStmt{Tag: text(), Body: &ast.BlockStmt{List: clauses}},
+				&ast.ReturnStmt{Results: []ast.Expr{call(&ast.SelectorExpr{X: ast.NewIdent(t.pkgName(types.NewPackage("errors", "errors"))), Sel: ast.NewIdent("New")}, msg)}},
+			}},
+		})
+	}
+	for i := range enum.NumFields() {
+		f := enum.Field(i)
+		if f.Name() == "_" {
+			continue
+		}
+		var values []ast.Expr
+		for j := range enum.NumVariants() {
+			values = append(values, constLit(enum.Variant(j).Value(i), f.Type()))
+		}
+		decls = append(decls, method(f.Name(), t.typeExpr(f.Type()), &ast.ReturnStmt{Results: []ast.Expr{table(t.typeExpr(f.Type()), values)}}))
+	}
+	return decls
+}
+
+// variantNames returns string literals for the names of the variants
+// of enum.
+func variantNames(enum *types.Enum) []ast.Expr {
+	var names []ast.Expr
+	for i := range enum.NumVariants() {
+		names = append(names, &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(enum.Variant(i).Name())})
+	}
+	return names
+}
+
+// variantFields returns the fields of the variant v, with names
+// mapped by rename. Consecutive fields of the same type share a type.
+func (t *translator) variantFields(v *types.Variant, rename func(string) string) *ast.FieldList {
+	list := &ast.FieldList{}
+	for j := range v.NumFields() {
+		f := v.Field(j)
+		name := ast.NewIdent(rename(f.Name()))
+		if j > 0 && types.Identical(f.Type(), v.Field(j-1).Type()) {
+			last := list.List[len(list.List)-1]
+			last.Names = append(last.Names, name)
+			continue
+		}
+		list.List = append(list.List, &ast.Field{Names: []*ast.Ident{name}, Type: t.typeExpr(f.Type())})
+	}
+	return list
+}
+
+// variantField returns the name of the field of the struct that
+// represents a sum enum that holds the fields of the variant v.
+func variantField(v *types.Variant) string {
+	return exported(v.Name())
+}
+
+// exported returns name with the first letter in upper case, so that
+// fields of enum values are accessible from other packages.
+func exported(name string) string {
+	r, size := utf8.DecodeRuneInString(name)
+	return string(unicode.ToUpper(r)) + name[size:]
+}
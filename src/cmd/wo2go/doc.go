@@ -0,0 +1,63 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+/*
+Wo2go translates a package written in the Wo dialect into plain Go,
+for use with toolchains that don't support Wo.
+
+Usage:
+
+	go tool wo2go [-o dir] [dir | files...]
+
+Wo2go type-checks the named package, given as a directory (by default
+the current directory) or as a list of its source files, and prints
+equivalent Go code. Each Wo source file is translated into a Go file of
+the same name, with a .go extension. Without -o, wo2go writes the
+translation of a single file to standard output; with -o, it writes
+the Go files into the named directory.
+
+The translation is gofmt-formatted Go code that compiles to a program
+with the same behavior:
+
+  - x! and x? become statements that return the error, or None,
+    from the enclosing function.
+
+  - Conditional expressions become if statements.
+
+  - Optional types T? become woOptional[T], an alias of the struct
+    type struct{ Value T; Ok bool } that the translation declares,
+    and sets set[T] become maps map[T]struct{}.
+
+  - Enum types become unsigned integer types holding the index of a
+    variant, or for enums whose variants have fields, structs with
+    the index in a Tag field and the fields of each variant in a
+    field named like the variant. The translation declares the
+    variants and, unless the program declares them itself, the String,
+    MarshalText, and UnmarshalText methods of the enum, and shared
+    fields become methods.
+
+  - Calls that omit arguments pass the default values explicitly.
+
+  - Overloaded functions and methods with type parameters become
+    ordinary functions named like the corresponding symbols of the
+    compiled Wo code: the overload of write for a string argument
+    is write_6string, and the method Map of type List is the generic
+    function List_Map.
+
+  - Typed declarations x T = v become var x T = v, or x := T(v)
+    in the header of an if, for, or switch statement.
+
+  - Arrow functions, colon range clauses, compact interfaces,
+    conditional bindings, skippable results, builtin methods and
+    visibility modifiers become their Go spelling.
+
+Names of temporary variables and helper declarations are derived from
+the names used in the package, so that the translation is the same
+every time it is run.
+
+Wo2go reports an error for constructs that have no Go equivalent, such
+as an export modifier on an identifier that Go would not export, or a
+? operator in the initializer of a package-level variable.
+*/
+package main
@@ -0,0 +1,1199 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+)
+
+// This file translates expressions. Constructs that have no Go
+// expression equivalent, such as x? and conditional expressions,
+// become statements that are appended to t.pre, to be inserted before
+// the statement being translated.
+
+// expr translates the expression e. It returns nil if e has no value
+// and its translation consists of statements only.
+func (t *translator) expr(e ast.Expr) ast.Expr {
+	if e == nil {
+		return nil
+	}
+	if tv, ok := t.info.Types[e]; ok && tv.IsType() {
+		return t.typ(e)
+	}
+	switch x := e.(type) {
+	case *ast.Ident:
+		return t.ident(x)
+	case *ast.BasicLit:
+		return x
+	case *ast.FuncLit:
+		return t.funcLit(x)
+	case *ast.LambdaExpr:
+		return t.lambda(x)
+	case *ast.CompositeLit:
+		return t.compositeLit(x)
+	case *ast.ParenExpr:
+		x.X = t.expr(x.X)
+		if _, ok := x.X.(*ast.Ident); ok {
+			return x.X
+		}
+		return x
+	case *ast.SelectorExpr:
+		return t.selector(x)
+	case *ast.IndexExpr:
+		return t.index(x)
+	case *ast.IndexListExpr:
+		x.X = t.expr(x.X)
+		for i, y := range x.Indices {
+			x.Indices[i] = t.typ(y)
+		}
+		return x
+	case *ast.SliceExpr:
+		res := t.ordered([]ast.Expr{x.X, x.Low, x.High, x.Max}, t.exprFunc)
+		x.X, x.Low, x.High, x.Max = res[0], res[1], res[2], res[3]
+		return x
+	case *ast.TypeAssertExpr:
+		x.X = t.expr(x.X)
+		if x.Type != nil {
+			if tv, ok := t.info.Types[x.Type]; ok && unionTerms(tv.Type) != nil {
+				t.errorf(x.Type.Pos(), "cannot translate type assertion to union type %s", tv.Type)
+			}
+			x.Type = t.typ(x.Type)
+		}
+		return x
+	case *ast.CallExpr:
+		return t.call(x)
+	case *ast.StarExpr:
+		x.X = t.expr(x.X)
+		return x
+	case *ast.UnaryExpr:
+		x.X = t.expr(x.X)
+		return x
+	case *ast.BinaryExpr:
+		return t.binary(x)
+	case *ast.KeyValueExpr:
+		x.Key = t.expr(x.Key)
+		x.Value = t.expr(x.Value)
+		return x
+	case *ast.PostfixExpr:
+		return t.postfix(x)
+	case *ast.CondExpr:
+		return t.cond(x)
+	}
+	t.errorf(e.Pos(), "unexpected expression")
+	return e
+}
+
+func (t *translator) exprFunc(_ int, e ast.Expr) ast.Expr {
+	return t.expr(e)
+}
+
+// typeOf returns the type of the expression e, with untyped types
+// replaced by their default types.
+func (t *translator) typeOf(e ast.Expr) types.Type {
+	tv, ok := t.info.Types[e]
+	if !ok || tv.Type == nil {
+		return nil
+	}
+	if b, ok := tv.Type.(*types.Basic); ok && b.Info()&types.IsUntyped != 0 && b.Kind() != types.UntypedNil {
+		return types.Default(b)
+	}
+	return tv.Type
+}
+
+// ordered translates the operands xs, which are evaluated from left
+// to right, by calling f for each non-nil one. If the translation of
+// an operand inserts statements, the preceding operands that involve
+// function calls or receive operations are evaluated first and saved
+// in temporaries.
+func (t *translator) ordered(xs []ast.Expr, f func(i int, x ast.Expr) ast.Expr) []ast.Expr {
+	res := make([]ast.Expr, len(xs))
+	marks := make([]int, len(xs))
+	for i, x := range xs {
+		if x != nil {
+			res[i] = f(i, x)
+		}
+		marks[i] = len(t.pre)
+	}
+	for i := len(xs) - 2; i >= 0; i-- {
+		if marks[len(xs)-1] == marks[i] || res[i] == nil || !t.hasCallOrRecv(xs[i]) {
+			continue
+		}
+		if _, ok := t.info.Types[xs[i]].Type.(*types.Tuple); ok {
+			continue
+		}
+		v := t.newName("v")
+		t.pre = slices.Insert(t.pre, marks[i], ast.Stmt(define(v, res[i])))
+		res[i] = ast.NewIdent(v.Name)
+	}
+	return res
+}
+
+// hasCallOrRecv reports whether e contains a function call (other
+// than a conversion or call of a builtin without side effects) or a
+// receive operation that the translation of e doesn't hoist already.
+func (t *translator) hasCallOrRecv(e ast.Expr) bool {
+	found := false
+	ast.Inspect(e, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit, *ast.LambdaExpr, *ast.PostfixExpr, *ast.CondExpr:
+			return false
+		case *ast.CallExpr:
+			tv := t.info.Types[n.Fun]
+			if tv.IsType() {
+				break
+			}
+			if tv.IsBuiltin() {
+				if id, ok := ast.Unparen(n.Fun).(*ast.Ident); ok {
+					switch id.Name {
+					case "len", "cap", "min", "max", "complex", "real", "imag":
+						return true
+					}
+				}
+			}
+			found = true
+		case *ast.UnaryExpr:
+			if n.Op == token.ARROW {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// withPre calls f, which translates statements, and returns the
+// statements inserted by the translation followed by those returned
+// by f.
+func (t *translator) withPre(f func() []ast.Stmt) []ast.Stmt {
+	saved := t.pre
+	t.pre = nil
+	list := f()
+	list = append(t.pre, list...)
+	t.pre = saved
+	return list
+}
+
+// exprOnly translates e in a context that cannot insert statements
+// before e. If the translation needs statements, it becomes a call of
+// a function literal.
+func (t *translator) exprOnly(e ast.Expr) ast.Expr {
+	return t.valueOnly(e, nil)
+}
+
+// valueOnly is like exprOnly for a value assigned to a variable of
+// type T (see convert).
+func (t *translator) valueOnly(e ast.Expr, T types.Type) ast.Expr {
+	VT := T
+	if VT == nil {
+		VT = t.typeOf(e)
+	}
+	saved, savedRet := t.pre, t.ret
+	t.pre, t.ret = nil, false
+	defer func() { t.pre, t.ret = saved, savedRet }()
+	if c, ok := ast.Unparen(e).(*ast.CondExpr); ok && VT != nil {
+		return t.iife(VT, t.withPre(func() []ast.Stmt { return t.condReturn(c, VT) }))
+	}
+	var x ast.Expr
+	list := t.withPre(func() []ast.Stmt {
+		x = t.convert(e, T)
+		return nil
+	})
+	if len(list) == 0 {
+		return x
+	}
+	if t.ret {
+		t.errorf(e.Pos(), "cannot translate ? or ! operator in this context")
+		return x
+	}
+	return t.iife(VT, append(list, &ast.ReturnStmt{Results: []ast.Expr{x}}))
+}
+
+// iife returns a call of a function literal with the given body,
+// which returns a value of type T.
+func (t *translator) iife(T types.Type, body []ast.Stmt) ast.Expr {
+	ft := &ast.FuncType{Params: &ast.FieldList{}}
+	if T != nil {
+		ft.Results = &ast.FieldList{List: []*ast.Field{{Type: t.typeExpr(T)}}}
+	}
+	return &ast.CallExpr{Fun: &ast.FuncLit{Type: ft, Body: &ast.BlockStmt{List: body}}}
+}
+
+// convert translates the expression e, whose value is assigned to a
+// variable of type T (or used as an operand if T is nil), adding the
+// implicit conversion to an optional type if necessary.
+func (t *translator) convert(e ast.Expr, T types.Type) ast.Expr {
+	if !isOptional(T) {
+		return t.expr(e)
+	}
+	if t.isNone(e) {
+		return t.zero(T)
+	}
+	tv := t.info.Types[e]
+	if tuple, ok := tv.Type.(*types.Tuple); ok && tuple.Len() == 2 {
+		// A comma-ok expression provides an optional value.
+		x := t.expr(e)
+		v, ok := t.newName("v"), t.newName("ok")
+		t.pre = append(t.pre, define2(v, ok, x))
+		return &ast.CompositeLit{Type: t.typeExpr(T), Elts: []ast.Expr{ast.NewIdent(v.Name), ast.NewIdent(ok.Name)}}
+	}
+	if isOptional(tv.Type) {
+		return t.expr(e)
+	}
+	elem := T.Underlying().(*types.Optional).Elem()
+	return &ast.CompositeLit{Type: t.typeExpr(T), Elts: []ast.Expr{t.convert(e, elem), ast.NewIdent("true")}}
+}
+
+// exactly is like convert, but also converts a typed value to T if it
+// has a different type, as needed for the arguments of the generic
+// helper functions, whose type arguments are inferred.
+func (t *translator) exactly(e ast.Expr, T types.Type) ast.Expr {
+	x := t.convert(e, T)
+	tv := t.info.Types[e]
+	if b, ok := tv.Type.(*types.Basic); tv.Type == nil || ok && b.Info()&types.IsUntyped != 0 || types.Identical(tv.Type, T) || isOptional(T) {
+		return x
+	}
+	return call(t.typeExpr(T), x)
+}
+
+// wrap converts the translated expression x of type from to the
+// optional type to, if necessary.
+func (t *translator) wrap(x ast.Expr, from, to types.Type) ast.Expr {
+	if !isOptional(to) || isOptional(from) {
+		return x
+	}
+	return &ast.CompositeLit{Type: t.typeExpr(to), Elts: []ast.Expr{x, ast.NewIdent("true")}}
+}
+
+// isNone reports whether e is nil or None.
+func (t *translator) isNone(e ast.Expr) bool {
+	if id, ok := ast.Unparen(e).(*ast.Ident); ok {
+		_, isNil := t.info.Uses[id].(*types.Nil)
+		return isNil
+	}
+	return false
+}
+
+func (t *translator) ident(x *ast.Ident) ast.Expr {
+	switch obj := t.info.Uses[x].(type) {
+	case *types.Func:
+		// The functions of an overload have mangled names.
+		x.Name = obj.Name()
+	case *types.Nil:
+		if x.Name != "nil" {
+			t.errorf(x.Pos(), "cannot translate %s without type", x.Name)
+		}
+	}
+	return x
+}
+
+func (t *translator) selector(x *ast.SelectorExpr) ast.Expr {
+	if id, ok := x.X.(*ast.Ident); ok {
+		if _, ok := t.info.Uses[id].(*types.PkgName); ok {
+			t.ident(x.Sel)
+			return x
+		}
+	}
+	sel := t.info.Selections[x]
+	if enum := enumOf(t.typeOf(x.X)); enum != nil && (sel == nil || sel.Kind() == types.FieldVal) {
+		return t.enumField(x, enum)
+	}
+	if sel != nil && sel.Kind() != types.FieldVal {
+		if fn, ok := sel.Obj().(*types.Func); ok && t.methodFunc(fn) != nil {
+			t.errorf(x.Sel.Pos(), "cannot translate method value %s with type parameters", x.Sel.Name)
+		}
+	}
+	x.X = t.expr(x.X)
+	return x
+}
+
+func (t *translator) index(x *ast.IndexExpr) ast.Expr {
+	XT := t.typeOf(x.X)
+	if tv, ok := t.info.Types[x.Index]; ok && tv.IsType() {
+		// instantiation
+		x.X = t.expr(x.X)
+		x.Index = t.typ(x.Index)
+		return x
+	}
+	var key types.Type
+	switch u := under(XT).(type) {
+	case *types.Set:
+		res := t.ordered([]ast.Expr{x.X, x.Index}, func(i int, e ast.Expr) ast.Expr {
+			if i == 0 {
+				return t.expr(e)
+			}
+			return t.exactly(e, u.Elem())
+		})
+		return call(t.helper(helperContains), res...)
+	case *types.Map:
+		key = u.Key()
+	}
+	res := t.ordered([]ast.Expr{x.X, x.Index}, func(i int, e ast.Expr) ast.Expr {
+		if i == 0 {
+			return t.expr(e)
+		}
+		return t.convert(e, key)
+	})
+	x.X, x.Index = res[0], res[1]
+	return x
+}
+
+// under is like types.Type.Underlying, but also accepts nil and
+// returns the core type of type parameters with one.
+func under(T types.Type) types.Type {
+	if T == nil {
+		return nil
+	}
+	if tp, ok := types.Unalias(T).(*types.TypeParam); ok {
+		iface := tp.Constraint().Underlying().(*types.Interface)
+		var core types.Type
+		for i := range iface.NumEmbeddeds() {
+			u, ok := iface.EmbeddedType(i).(*types.Union)
+			if !ok {
+				if e, ok := iface.EmbeddedType(i).Underlying().(*types.Interface); ok && e.NumEmbeddeds() == 0 {
+					continue
+				}
+				return T.Underlying()
+			}
+			for j := range u.Len() {
+				uj := u.Term(j).Type().Underlying()
+				if core != nil && !types.Identical(core, uj) {
+					return T.Underlying()
+				}
+				core = uj
+			}
+		}
+		if core != nil {
+			return core
+		}
+	}
+	return T.Underlying()
+}
+
+func (t *translator) binary(x *ast.BinaryExpr) ast.Expr {
+	switch x.Op {
+	case token.LAND, token.LOR:
+		return t.logical(x)
+	case token.EQL, token.NEQ:
+		xt, yt := t.typeOf(x.X), t.typeOf(x.Y)
+		switch {
+		case isOptional(xt) && t.isNone(x.Y):
+			return t.present(t.expr(x.X), x.Op == token.NEQ)
+		case isOptional(yt) && t.isNone(x.X):
+			return t.present(t.expr(x.Y), x.Op == token.NEQ)
+		case isOptional(xt) && !isOptional(yt):
+			res := t.ordered([]ast.Expr{x.X, x.Y}, func(i int, e ast.Expr) ast.Expr {
+				if i == 1 {
+					return t.convert(e, xt)
+				}
+				return t.expr(e)
+			})
+			x.X, x.Y = res[0], res[1]
+			return x
+		case isOptional(yt) && !isOptional(xt):
+			res := t.ordered([]ast.Expr{x.X, x.Y}, func(i int, e ast.Expr) ast.Expr {
+				if i == 0 {
+					return t.convert(e, yt)
+				}
+				return t.expr(e)
+			})
+			x.X, x.Y = res[0], res[1]
+			return x
+		}
+	}
+	res := t.ordered([]ast.Expr{x.X, x.Y}, t.exprFunc)
+	x.X, x.Y = res[0], res[1]
+	return x
+}
+
+// present returns the expression that reports whether the optional
+// value x is present, or absent if not want.
+func (t *translator) present(x ast.Expr, want bool) ast.Expr {
+	var ok ast.Expr = &ast.SelectorExpr{X: operand(x), Sel: ast.NewIdent("Ok")}
+	if !want {
+		ok = &ast.UnaryExpr{Op: token.NOT, X: ok}
+	}
+	return ok
+}
+
+// logical translates x && y and x || y. If y needs statements, they
+// are only executed if the result is not determined by x.
+func (t *translator) logical(x *ast.BinaryExpr) ast.Expr {
+	lhs := t.expr(x.X)
+	var rhs ast.Expr
+	list := t.withPre(func() []ast.Stmt {
+		rhs = t.expr(x.Y)
+		return nil
+	})
+	if len(list) == 0 {
+		x.X, x.Y = lhs, rhs
+		return x
+	}
+	v := t.newName("v")
+	T := t.typeOf(x)
+	if T == types.Typ[types.Bool] {
+		t.pre = append(t.pre, define(v, lhs))
+	} else {
+		t.pre = append(t.pre, varDecl(v, t.typeExpr(T), lhs))
+	}
+	var cond ast.Expr = ast.NewIdent(v.Name)
+	if x.Op == token.LOR {
+		cond = &ast.UnaryExpr{Op: token.NOT, X: cond}
+	}
+	list = append(list, assign(ast.NewIdent(v.Name), rhs))
+	t.pre = append(t.pre, &ast.IfStmt{Cond: cond, Body: &ast.BlockStmt{List: list}})
+	return ast.NewIdent(v.Name)
+}
+
+// postfix translates x? and x!, which return from the enclosing
+// function if x provides no value.
+func (t *translator) postfix(e *ast.PostfixExpr) ast.Expr {
+	if t.fn == nil {
+		t.errorf(e.OpPos, "unexpected %s operator", e.Op)
+		return t.expr(e.X)
+	}
+	XT := t.info.Types[e.X].Type
+	x := t.expr(e.X)
+	t.ret = true
+	tuple, _ := XT.(*types.Tuple)
+	if e.Op == token.QUESTION {
+		if tuple != nil {
+			v := t.newName("v")
+			ok := t.okFor(tuple.At(1).Type())
+			t.pre = append(t.pre,
+				define2(v, ok, x),
+				t.returnIf(&ast.UnaryExpr{Op: token.NOT, X: ast.NewIdent(ok.Name)}, nil))
+			return ast.NewIdent(v.Name)
+		}
+		var v ast.Expr = x
+		if _, ok := x.(*ast.Ident); !ok {
+			id := t.newName("v")
+			t.pre = append(t.pre, define(id, x))
+			v = ast.NewIdent(id.Name)
+		}
+		t.pre = append(t.pre, t.returnIf(t.present(v, false), nil))
+		return &ast.SelectorExpr{X: v, Sel: ast.NewIdent("Value")}
+	}
+
+	// x!
+	err := t.errIdent()
+	if tuple == nil {
+		t.pre = append(t.pre, t.checkErr(nil, x))
+		return nil
+	}
+	v := t.newName("v")
+	t.pre = append(t.pre,
+		define2(v, err, x),
+		t.returnIf(&ast.BinaryExpr{X: ast.NewIdent(err.Name), Op: token.NEQ, Y: ast.NewIdent("nil")}, ast.NewIdent(err.Name)))
+	return ast.NewIdent(v.Name)
+}
+
+// okFor returns the name of a boolean temporary of type T.
+func (t *translator) okFor(T types.Type) *ast.Ident {
+	if T == types.Typ[types.Bool] {
+		return t.okIdent()
+	}
+	return t.newName("ok")
+}
+
+// checkErr returns the statement
+//
+//	if [v, ]err := x; err != nil {
+//		return ..., err
+//	}
+//
+// where v is the blank identifier if it is not nil.
+func (t *translator) checkErr(v ast.Expr, x ast.Expr) ast.Stmt {
+	err := t.errIdent()
+	lhs := []ast.Expr{err}
+	if v != nil {
+		lhs = []ast.Expr{v, err}
+	}
+	s := t.returnIf(&ast.BinaryExpr{X: ast.NewIdent(err.Name), Op: token.NEQ, Y: ast.NewIdent("nil")}, ast.NewIdent(err.Name)).(*ast.IfStmt)
+	s.Init = &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE, Rhs: []ast.Expr{x}}
+	return s
+}
+
+// returnIf returns the statement "if cond { return ... }" that
+// returns the zero values of the results of the enclosing function,
+// or err as the last result if it is not nil.
+func (t *translator) returnIf(cond ast.Expr, err ast.Expr) ast.Stmt {
+	results := t.fn.sig.Results()
+	var list []ast.Expr
+	for i := range results.Len() {
+		if i == results.Len()-1 && err != nil {
+			list = append(list, err)
+			break
+		}
+		list = append(list, t.zero(results.At(i).Type()))
+	}
+	return &ast.IfStmt{
+		Cond: cond,
+		Body: &ast.BlockStmt{List: []ast.Stmt{&ast.ReturnStmt{Results: list}}},
+	}
+}
+
+// cond translates the conditional expression e into a variable
+// assigned by an if statement.
+func (t *translator) cond(e *ast.CondExpr) ast.Expr {
+	T := t.typeOf(e)
+	v := t.newName("v")
+	t.pre = append(t.pre, varDecl(v, t.typeExpr(T), nil))
+	t.pre = append(t.pre, t.condStmts(e, func(x ast.Expr) []ast.Stmt {
+		return []ast.Stmt{assign(ast.NewIdent(v.Name), t.convert(x, T))}
+	})...)
+	return ast.NewIdent(v.Name)
+}
+
+// condStmts returns an if statement that evaluates the condition of
+// the conditional expression e and the statements that f returns for
+// the selected value. The statements that the condition needs are
+// appended to t.pre.
+func (t *translator) condStmts(e *ast.CondExpr, f func(x ast.Expr) []ast.Stmt) []ast.Stmt {
+	cond := t.expr(e.Cond)
+	body := t.withPre(func() []ast.Stmt { return f(e.X) })
+	var els ast.Stmt
+	if y, ok := ast.Unparen(e.Y).(*ast.CondExpr); ok {
+		list := t.withPre(func() []ast.Stmt { return t.condStmts(y, f) })
+		if len(list) == 1 {
+			els = list[0]
+		} else {
+			els = &ast.BlockStmt{List: list}
+		}
+	} else {
+		els = &ast.BlockStmt{List: t.withPre(func() []ast.Stmt { return f(e.Y) })}
+	}
+	return []ast.Stmt{&ast.IfStmt{If: e.If, Cond: cond, Body: &ast.BlockStmt{List: body}, Else: els}}
+}
+
+// condReturn returns the statements that return the conditional
+// expression e, whose value is converted to T.
+func (t *translator) condReturn(e *ast.CondExpr, T types.Type) []ast.Stmt {
+	cond := t.expr(e.Cond)
+	body := t.withPre(func() []ast.Stmt {
+		return []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{t.convert(e.X, T)}}}
+	})
+	list := []ast.Stmt{&ast.IfStmt{If: e.If, Cond: cond, Body: &ast.BlockStmt{List: body}}}
+	return append(list, t.withPre(func() []ast.Stmt {
+		if y, ok := ast.Unparen(e.Y).(*ast.CondExpr); ok {
+			return t.condReturn(y, T)
+		}
+		return []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{t.convert(e.Y, T)}}}
+	})...)
+}
+
+func (t *translator) funcLit(x *ast.FuncLit) ast.Expr {
+	sig, _ := t.info.Types[x].Type.(*types.Signature)
+	t.funcType(x.Type, sig)
+	t.fn = &funcState{sig: sig, outer: t.fn}
+	x.Body.List = t.withPre(func() []ast.Stmt { return t.stmtList(x.Body.List) })
+	t.fn = t.fn.outer
+	return x
+}
+
+// lambda translates the function literal x -> e.
+func (t *translator) lambda(x *ast.LambdaExpr) ast.Expr {
+	sig, ok := t.info.Types[x].Type.(*types.Signature)
+	if !ok {
+		t.errorf(x.Pos(), "missing type of function literal")
+		return x
+	}
+	for _, p := range x.Params {
+		if obj := t.info.Defs[p]; obj != nil && t.unused[obj.Pos()] {
+			p.Name = "_"
+		}
+	}
+	ft := t.signature(sig, x.Params)
+	ft.Func = x.Pos()
+	t.fn = &funcState{sig: sig, outer: t.fn}
+	saved, savedRet := t.pre, t.ret
+	t.pre = nil
+	body := t.withPre(func() []ast.Stmt {
+		results := sig.Results()
+		if results.Len() == 0 {
+			v := t.expr(x.Body)
+			switch {
+			case v == nil:
+				return nil
+			case isCallOrRecv(v):
+				return []ast.Stmt{&ast.ExprStmt{X: v}}
+			}
+			return []ast.Stmt{assign(ast.NewIdent("_"), v)}
+		}
+		if results.Len() == 1 {
+			if c, ok := ast.Unparen(x.Body).(*ast.CondExpr); ok {
+				return t.condReturn(c, results.At(0).Type())
+			}
+			return []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{t.convert(x.Body, results.At(0).Type())}}}
+		}
+		return []ast.Stmt{&ast.ReturnStmt{Results: []ast.Expr{t.expr(x.Body)}}}
+	})
+	t.pre, t.ret = saved, savedRet
+	t.fn = t.fn.outer
+	return &ast.FuncLit{Type: ft, Body: &ast.BlockStmt{Lbrace: x.Arrow, List: body, Rbrace: x.Body.End()}}
+}
+
+// isCallOrRecv reports whether x may be used as an expression statement.
+func isCallOrRecv(x ast.Expr) bool {
+	switch x := ast.Unparen(x).(type) {
+	case *ast.CallExpr:
+		return true
+	case *ast.UnaryExpr:
+		return x.Op == token.ARROW
+	}
+	return false
+}
+
+func (t *translator) compositeLit(x *ast.CompositeLit) ast.Expr {
+	T := t.typeOf(x)
+	u := under(T)
+	if p, ok := u.(*types.Pointer); ok {
+		u = under(p.Elem())
+	}
+	if x.Type != nil {
+		x.Type = t.typ(x.Type)
+	}
+
+	// Collect the operands, the values of the elements and the keys of
+	// map elements, with their types.
+	var ops []ast.Expr
+	var typs []types.Type
+	for i, elt := range x.Elts {
+		kv, _ := elt.(*ast.KeyValueExpr)
+		var key, val ast.Expr = nil, elt
+		if kv != nil {
+			key, val = kv.Key, kv.Value
+		}
+		var keyType, valType types.Type
+		switch u := u.(type) {
+		case *types.Struct:
+			if kv != nil {
+				if id, ok := key.(*ast.Ident); ok {
+					if f, ok := t.info.Uses[id].(*types.Var); ok {
+						valType = f.Type()
+					}
+				}
+			} else if i < u.NumFields() {
+				valType = u.Field(i).Type()
+			}
+		case *types.Array:
+			valType = u.Elem()
+		case *types.Slice:
+			valType = u.Elem()
+		case *types.Map:
+			keyType, valType = u.Key(), u.Elem()
+		case *types.Set:
+			valType = u.Elem()
+		}
+		if keyType != nil && kv != nil {
+			ops, typs = append(ops, key), append(typs, keyType)
+		}
+		ops, typs = append(ops, val), append(typs, valType)
+	}
+	res := t.ordered(ops, func(i int, e ast.Expr) ast.Expr {
+		return t.convert(e, typs[i])
+	})
+
+	j := 0
+	for i, elt := range x.Elts {
+		kv, _ := elt.(*ast.KeyValueExpr)
+		if _, ok := u.(*types.Map); ok && kv != nil {
+			kv.Key = res[j]
+			j++
+		}
+		if kv != nil {
+			kv.Value = res[j]
+		} else {
+			x.Elts[i] = res[j]
+		}
+		j++
+	}
+
+	if set, ok := u.(*types.Set); ok {
+		if x.Type == nil {
+			x.Type = nil
+		}
+		_ = set
+		for i, elt := range x.Elts {
+			x.Elts[i] = &ast.KeyValueExpr{Key: elt, Value: &ast.CompositeLit{}}
+		}
+	}
+	return x
+}
+
+// call translates the call x.
+func (t *translator) call(x *ast.CallExpr) ast.Expr {
+	fun := ast.Unparen(x.Fun)
+	tv := t.info.Types[x.Fun]
+	if tv.IsType() {
+		// conversion
+		if isOptional(tv.Type) && len(x.Args) == 1 {
+			return t.convert(x.Args[0], tv.Type)
+		}
+		x.Fun = t.typ(x.Fun)
+		for i, arg := range x.Args {
+			x.Args[i] = t.expr(arg)
+		}
+		return x
+	}
+	if sel, ok := fun.(*ast.SelectorExpr); ok {
+		if y := t.builtinMethodCall(x, sel); y != nil {
+			return y
+		}
+	}
+	if y := t.methodCall(x); y != nil {
+		return y
+	}
+	if v := t.variant(x.Fun); v != nil {
+		// construction of a sum enum value
+		res := t.ordered(x.Args, func(i int, e ast.Expr) ast.Expr {
+			if i < v.NumFields() {
+				return t.convert(e, v.Field(i).Type())
+			}
+			return t.expr(e)
+		})
+		x.Fun = t.expr(x.Fun)
+		x.Args = res
+		return x
+	}
+
+	sig, _ := under(tv.Type).(*types.Signature)
+	ops := append([]ast.Expr{x.Fun}, x.Args...)
+	res := t.ordered(ops, func(i int, e ast.Expr) ast.Expr {
+		if i == 0 {
+			return t.expr(e)
+		}
+		if len(x.Args) == 1 {
+			if _, ok := t.info.Types[e].Type.(*types.Tuple); ok {
+				return t.expr(e)
+			}
+		}
+		return t.convert(e, paramType(sig, i-1, x.Ellipsis.IsValid()))
+	})
+	x.Fun, x.Args = res[0], res[1:]
+	x.Args = append(x.Args, t.defaults(x, sig)...)
+	return x
+}
+
+// paramType returns the type of the i'th argument of a call of a
+// function with signature sig, or nil.
+func paramType(sig *types.Signature, i int, dots bool) types.Type {
+	if sig == nil {
+		return nil
+	}
+	n := sig.Params().Len()
+	if sig.Variadic() && i >= n-1 {
+		if dots {
+			return nil
+		}
+		return sig.Params().At(n - 1).Type().(*types.Slice).Elem()
+	}
+	if i < n {
+		return sig.Params().At(i).Type()
+	}
+	return nil
+}
+
+// defaults returns the default values of the parameters of the
+// function with signature sig that the call x omits.
+func (t *translator) defaults(x *ast.CallExpr, sig *types.Signature) []ast.Expr {
+	if sig == nil || x.Ellipsis.IsValid() {
+		return nil
+	}
+	n := sig.Params().Len()
+	if sig.Variadic() {
+		n--
+	}
+	nargs := len(x.Args)
+	if nargs == 1 {
+		if tuple, ok := t.info.Types[x.Args[0]].Type.(*types.Tuple); ok {
+			nargs = tuple.Len()
+		}
+	}
+	var list []ast.Expr
+	for i := nargs; i < n; i++ {
+		d := sig.Default(i)
+		if d == nil {
+			return nil
+		}
+		list = append(list, t.defaultValue(d, sig.Params().At(i).Type(), x.Rparen))
+	}
+	return list
+}
+
+// defaultValue returns the default value obj of a parameter of type T.
+func (t *translator) defaultValue(obj types.Object, T types.Type, pos token.Pos) ast.Expr {
+	switch obj := obj.(type) {
+	case *types.Const:
+		x := constLit(obj.Val(), obj.Type())
+		if types.IsInterface(T) || isOptional(T) {
+			if lit, ok := x.(*ast.BasicLit); ok && !types.Identical(obj.Type(), defaultType(lit)) {
+				x = call(t.typeExpr(obj.Type()), x)
+			}
+		}
+		return t.wrap(x, obj.Type(), T)
+	case *types.Nil:
+		return t.zero(T)
+	case *types.Var, *types.Func:
+		if obj.Pkg() != t.pkg {
+			if !obj.Exported() {
+				t.errorf(pos, "cannot translate default value %s of package %s", obj.Name(), obj.Pkg().Path())
+			}
+			return &ast.SelectorExpr{X: ast.NewIdent(t.pkgName(obj.Pkg())), Sel: ast.NewIdent(obj.Name())}
+		}
+		return ast.NewIdent(obj.Name())
+	}
+	t.errorf(pos, "unexpected default value %s", obj)
+	return ast.NewIdent("nil")
+}
+
+// defaultType returns the type of the untyped constant lit in a
+// context that requires a typed value.
+func defaultType(lit *ast.BasicLit) types.Type {
+	switch lit.Kind {
+	case token.INT:
+		return types.Typ[types.Int]
+	case token.FLOAT:
+		return types.Typ[types.Float64]
+	case token.IMAG:
+		return types.Typ[types.Complex128]
+	case token.CHAR:
+		return types.Typ[types.Rune]
+	}
+	return types.Typ[types.String]
+}
+
+// constLit returns a literal for the constant value val of type T.
+func constLit(val constant.Value, T types.Type) ast.Expr {
+	switch val.Kind() {
+	case constant.Bool:
+		return ast.NewIdent(strconv.FormatBool(constant.BoolVal(val)))
+	case constant.String:
+		return &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(constant.StringVal(val))}
+	case constant.Int:
+		return &ast.BasicLit{Kind: token.INT, Value: val.ExactString()}
+	case constant.Float:
+		bits := 64
+		if b, ok := T.Underlying().(*types.Basic); ok && b.Kind() == types.Float32 {
+			bits = 32
+		}
+		f, _ := constant.Float64Val(val)
+		s := strconv.FormatFloat(f, 'g', -1, bits)
+		if !strconv.IsPrint(rune(s[len(s)-1])) || !containsAny(s, ".e") {
+			s += ".0"
+		}
+		return &ast.BasicLit{Kind: token.FLOAT, Value: s}
+	case constant.Complex:
+		return call(ast.NewIdent("complex"), constLit(constant.Real(val), types.Typ[types.Float64]), constLit(constant.Imag(val), types.Typ[types.Float64]))
+	}
+	return &ast.BasicLit{Kind: token.INT, Value: val.ExactString()}
+}
+
+func containsAny(s, chars string) bool {
+	for _, c := range chars {
+		for _, d := range s {
+			if c == d {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// builtinMethodCall translates the call x of a Wo builtin method, or
+// returns nil if x is not such a call.
+func (t *translator) builtinMethodCall(x *ast.CallExpr, sel *ast.SelectorExpr) ast.Expr {
+	if t.info.Selections[sel] != nil {
+		return nil
+	}
+	if id, ok := sel.X.(*ast.Ident); ok {
+		if _, ok := t.info.Uses[id].(*types.PkgName); ok {
+			return nil
+		}
+	}
+	XT := t.info.Types[sel.X].Type
+	if fn, ok := t.info.Uses[sel.Sel].(*types.Func); ok && types.IsBuiltinMethod(XT, fn) {
+		// x.f(args) is pkg.f(x, args).
+		sig, _ := t.info.Types[x.Fun].Type.(*types.Signature)
+		ops := append([]ast.Expr{sel.X}, x.Args...)
+		res := t.ordered(ops, func(i int, e ast.Expr) ast.Expr {
+			return t.convert(e, paramType(sig, i, x.Ellipsis.IsValid()))
+		})
+		x.Fun = &ast.SelectorExpr{X: ast.NewIdent(t.pkgName(fn.Pkg())), Sel: sel.Sel}
+		x.Args = res
+		return x
+	}
+	switch u := under(XT).(type) {
+	case *types.Set:
+		switch sel.Sel.Name {
+		case "delete":
+			res := t.ordered([]ast.Expr{sel.X, x.Args[0]}, func(i int, e ast.Expr) ast.Expr {
+				if i == 1 {
+					return t.convert(e, u.Elem())
+				}
+				return t.expr(e)
+			})
+			return &ast.CallExpr{Fun: ast.NewIdent("delete"), Lparen: x.Lparen, Args: res, Rparen: x.Rparen}
+		case "add":
+			t.errorf(sel.Sel.Pos(), "cannot translate %s.add in this context", sel.X)
+			return x
+		}
+	case *types.Optional:
+		switch sel.Sel.Name {
+		case "IsPresent":
+			return t.present(t.expr(sel.X), true)
+		case "OrElse":
+			res := t.ordered([]ast.Expr{sel.X, x.Args[0]}, func(i int, e ast.Expr) ast.Expr {
+				if i == 1 {
+					return t.exactly(e, u.Elem())
+				}
+				return t.expr(e)
+			})
+			return &ast.CallExpr{Fun: t.helper(helperOrElse), Lparen: x.Lparen, Args: res, Rparen: x.Rparen}
+		}
+	}
+	return nil
+}
+
+// setAdd translates the statement s.add(x) into an assignment, or
+// returns nil if call is not such a call.
+func (t *translator) setAdd(x *ast.CallExpr) ast.Stmt {
+	sel, ok := ast.Unparen(x.Fun).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "add" || t.info.Selections[sel] != nil || len(x.Args) != 1 {
+		return nil
+	}
+	set, ok := under(t.info.Types[sel.X].Type).(*types.Set)
+	if !ok {
+		return nil
+	}
+	res := t.ordered([]ast.Expr{sel.X, x.Args[0]}, func(i int, e ast.Expr) ast.Expr {
+		if i == 1 {
+			return t.convert(e, set.Elem())
+		}
+		return t.expr(e)
+	})
+	return assign(&ast.IndexExpr{X: operand(res[0]), Index: res[1]}, &ast.CompositeLit{Type: emptyStruct()})
+}
+
+// methodFunc returns the signature of the method fn if it has type
+// parameters, or nil.
+func (t *translator) methodFunc(fn *types.Func) *types.Signature {
+	sig := fn.Type().(*types.Signature)
+	if sig.Recv() == nil || sig.TypeParams().Len() == 0 {
+		return nil
+	}
+	return sig
+}
+
+// methodFuncName returns an expression for the function that replaces
+// the method fn with type parameters.
+func (t *translator) methodFuncName(fn *types.Func) ast.Expr {
+	fn = fn.Origin()
+	if name, ok := t.methodFuncs[fn]; ok {
+		return ast.NewIdent(name)
+	}
+	recv := fn.Type().(*types.Signature).Recv().Type()
+	if p, ok := recv.(*types.Pointer); ok {
+		recv = p.Elem()
+	}
+	name := recv.(*types.Named).Obj().Name() + "_" + fn.Name()
+	return &ast.SelectorExpr{X: ast.NewIdent(t.pkgName(fn.Pkg())), Sel: ast.NewIdent(name)}
+}
+
+// methodCall translates the call x of a method with type parameters
+// into a call of the function that replaces it, or returns nil if x
+// is not such a call.
+func (t *translator) methodCall(x *ast.CallExpr) ast.Expr {
+	fun := ast.Unparen(x.Fun)
+	var targs []ast.Expr
+	switch f := fun.(type) {
+	case *ast.IndexExpr:
+		fun, targs = ast.Unparen(f.X), []ast.Expr{f.Index}
+	case *ast.IndexListExpr:
+		fun, targs = ast.Unparen(f.X), f.Indices
+	}
+	sel, ok := fun.(*ast.SelectorExpr)
+	if !ok {
+		return nil
+	}
+	selection := t.info.Selections[sel]
+	if selection == nil || selection.Kind() != types.MethodVal {
+		return nil
+	}
+	fn := selection.Obj().(*types.Func)
+	msig := t.methodFunc(fn)
+	if msig == nil {
+		return nil
+	}
+
+	var f ast.Expr = t.methodFuncName(fn)
+	if targs != nil {
+		var list []ast.Expr
+		recv := msig.Recv().Type()
+		if p, ok := recv.(*types.Pointer); ok {
+			recv = p.Elem()
+		}
+		if named, ok := recv.(*types.Named); ok {
+			for i := range named.TypeArgs().Len() {
+				list = append(list, t.typeExpr(named.TypeArgs().At(i)))
+			}
+		}
+		for _, targ := range targs {
+			list = append(list, t.typ(targ))
+		}
+		f = index(f, list)
+	}
+
+	sig, _ := t.info.Types[x.Fun].Type.(*types.Signature)
+	ops := append([]ast.Expr{sel.X}, x.Args...)
+	var spread []ast.Expr // values of a multi-value argument
+	res := t.ordered(ops, func(i int, e ast.Expr) ast.Expr {
+		if i == 0 {
+			return t.receiver(sel, selection, msig)
+		}
+		if tuple, ok := t.info.Types[e].Type.(*types.Tuple); ok && len(x.Args) == 1 {
+			// f(g()) with a multi-value g needs temporaries
+			// to pass the receiver, too.
+			var lhs []ast.Expr
+			for range tuple.Len() {
+				v := t.newName("v")
+				lhs = append(lhs, v)
+				spread = append(spread, ast.NewIdent(v.Name))
+			}
+			t.pre = append(t.pre, &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE, Rhs: []ast.Expr{t.expr(e)}})
+			return nil
+		}
+		return t.convert(e, paramType(sig, i-1, x.Ellipsis.IsValid()))
+	})
+	args := []ast.Expr{res[0]}
+	if spread != nil {
+		args = append(args, spread...)
+	} else {
+		args = append(args, res[1:]...)
+	}
+	x.Fun = f
+	x.Args = append(args, t.defaults(x, sig)...)
+	return x
+}
+
+// receiver returns the receiver argument of the method call sel of a
+// method with type parameters and signature msig, which becomes the
+// first argument of the function that replaces the method.
+func (t *translator) receiver(sel *ast.SelectorExpr, selection *types.Selection, msig *types.Signature) ast.Expr {
+	x := t.expr(sel.X)
+	T := t.typeOf(sel.X)
+	path := selection.Index()
+	for _, i := range path[:len(path)-1] {
+		if p, ok := T.Underlying().(*types.Pointer); ok {
+			T = p.Elem()
+		}
+		f := T.Underlying().(*types.Struct).Field(i)
+		x = &ast.SelectorExpr{X: operand(x), Sel: ast.NewIdent(f.Name())}
+		T = f.Type()
+	}
+	_, isPtr := T.Underlying().(*types.Pointer)
+	_, wantPtr := msig.Recv().Type().(*types.Pointer)
+	switch {
+	case wantPtr && !isPtr:
+		if u, ok := x.(*ast.StarExpr); ok {
+			return u.X
+		}
+		return &ast.UnaryExpr{Op: token.AND, X: x}
+	case !wantPtr && isPtr:
+		return &ast.StarExpr{X: x}
+	}
+	return x
+}
+
+// variant returns the enum variant with fields that e denotes, or nil.
+func (t *translator) variant(e ast.Expr) *types.Variant {
+	var id *ast.Ident
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	}
+	if id == nil {
+		return nil
+	}
+	v, _ := t.info.Uses[id].(*types.Variant)
+	if v == nil || v.NumFields() == 0 {
+		return nil
+	}
+	return v
+}
+
+// enumField translates the selection x of a field of an enum value.
+func (t *translator) enumField(x *ast.SelectorExpr, enum *types.Enum) ast.Expr {
+	v := t.expr(x.X)
+	_, isPtr := under(t.typeOf(x.X)).(*types.Pointer)
+	if name := x.Sel.Name; name == "name" || name == "pos" {
+		// tag is the index of the variant of v.
+		tag := v
+		if enum.IsSum() {
+			tag = &ast.SelectorExpr{X: operand(v), Sel: ast.NewIdent("Tag")}
+		} else if isPtr {
+			tag = &ast.StarExpr{X: v}
+		}
+		if name == "name" {
+			names := &ast.CompositeLit{Type: &ast.ArrayType{Len: &ast.Ellipsis{}, Elt: ast.NewIdent("string")}, Elts: variantNames(enum)}
+			return &ast.IndexExpr{X: names, Index: tag}
+		}
+		return call(ast.NewIdent("int"), tag)
+	}
+	return call(&ast.SelectorExpr{X: operand(v), Sel: x.Sel})
+}
+
+// Syntax tree construction.
+
+func call(fun ast.Expr, args ...ast.Expr) *ast.CallExpr {
+	return &ast.CallExpr{Fun: fun, Args: args}
+}
+
+func index(x ast.Expr, list []ast.Expr) ast.Expr {
+	if len(list) == 1 {
+		return &ast.IndexExpr{X: x, Index: list[0]}
+	}
+	return &ast.IndexListExpr{X: x, Indices: list}
+}
+
+func intLit(n int64) *ast.BasicLit {
+	return &ast.BasicLit{Kind: token.INT, Value: strconv.FormatInt(n, 10)}
+}
+
+// operand parenthesizes x if necessary for use as the operand of a
+// selector or index expression.
+func operand(x ast.Expr) ast.Expr {
+	switch x.(type) {
+	case *ast.Ident, *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr, *ast.CallExpr, *ast.ParenExpr, *ast.CompositeLit, *ast.BasicLit, *ast.SliceExpr, *ast.TypeAssertExpr:
+		return x
+	}
+	return &ast.ParenExpr{X: x}
+}
+
+func define(lhs *ast.Ident, rhs ast.Expr) ast.Stmt {
+	return &ast.AssignStmt{Lhs: []ast.Expr{lhs}, Tok: token.DEFINE, Rhs: []ast.Expr{rhs}}
+}
+
+func define2(lhs1, lhs2 *ast.Ident, rhs ast.Expr) ast.Stmt {
+	return &ast.AssignStmt{Lhs: []ast.Expr{lhs1, lhs2}, Tok: token.DEFINE, Rhs: []ast.Expr{rhs}}
+}
+
+func assign(lhs, rhs ast.Expr) ast.Stmt {
+	return &ast.AssignStmt{Lhs: []ast.Expr{lhs}, Tok: token.ASSIGN, Rhs: []ast.Expr{rhs}}
+}
+
+func varDecl(name *ast.Ident, typ ast.Expr, value ast.Expr) ast.Stmt {
+	spec := &ast.ValueSpec{Names: []*ast.Ident{name}, Type: typ}
+	if value != nil {
+		spec.Values = []ast.Expr{value}
+	}
+	return &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{spec}}}
+}
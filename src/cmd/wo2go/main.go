@@ -0,0 +1,128 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/build"
+	"go/importer"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"cmd/internal/telemetry/counter"
+)
+
+var outDir = flag.String("o", "", "write the Go files into `dir`")
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: go tool wo2go [-o dir] [dir | files...]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	counter.Open()
+	flag.Usage = usage
+	flag.Parse()
+	counter.Inc("wo2go/invocations")
+	counter.CountFlags("wo2go/flag:", *flag.CommandLine)
+
+	filenames, err := sourceFiles(flag.Args())
+	if err != nil {
+		fatalf("%v", err)
+	}
+	if *outDir == "" && len(filenames) != 1 {
+		fatalf("package has %d files; use -o to write them into a directory", len(filenames))
+	}
+	lookup, err := exportLookup("", append([]string{"--"}, filenames...))
+	if err != nil {
+		fatalf("%v", err)
+	}
+	out, err := translate(filenames, importer.ForCompiler(fset, "gc", lookup))
+	if err != nil {
+		fatalf("%v", err)
+	}
+
+	if *outDir == "" {
+		os.Stdout.Write(out[0])
+		return
+	}
+	if err := os.MkdirAll(*outDir, 0o777); err != nil {
+		fatalf("%v", err)
+	}
+	for i, name := range filenames {
+		name = strings.TrimSuffix(filepath.Base(name), filepath.Ext(name)) + ".go"
+		if err := os.WriteFile(filepath.Join(*outDir, name), out[i], 0o666); err != nil {
+			fatalf("%v", err)
+		}
+	}
+}
+
+func fatalf(format string, args ...any) {
+	fmt.Fprintf(os.Stderr, "wo2go: "+format+"\n", args...)
+	os.Exit(1)
+}
+
+// sourceFiles returns the source files of the package named by args:
+// the Go and Wo files of a directory (by default the current
+// directory), or a list of files.
+func sourceFiles(args []string) ([]string, error) {
+	if len(args) == 0 {
+		args = []string{"."}
+	}
+	if len(args) == 1 {
+		if fi, err := os.Stat(args[0]); err == nil && fi.IsDir() {
+			p, err := build.ImportDir(args[0], 0)
+			if err != nil {
+				return nil, err
+			}
+			var list []string
+			for _, name := range append(p.GoFiles, p.WoFiles...) {
+				list = append(list, filepath.Join(args[0], name))
+			}
+			return list, nil
+		}
+	}
+	for _, name := range args {
+		if ext := filepath.Ext(name); ext != ".go" && ext != ".wo" {
+			return nil, fmt.Errorf("%s is not a Go or Wo source file", name)
+		}
+	}
+	return args, nil
+}
+
+// exportLookup returns a function that opens the export data of the
+// packages named by the go list arguments args, run in directory dir,
+// and of their dependencies, which the go command builds if necessary.
+func exportLookup(dir string, args []string) (func(path string) (io.ReadCloser, error), error) {
+	cmd := exec.Command("go", "list", "-deps", "-export", "-f", "{{if .Export}}{{.ImportPath}} {{.Export}}{{end}}")
+	cmd.Args = append(cmd.Args, args...)
+	cmd.Dir = dir
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %v", err)
+	}
+	exports := make(map[string]string)
+	sc := bufio.NewScanner(bytes.NewReader(out))
+	for sc.Scan() {
+		if path, file, ok := strings.Cut(sc.Text(), " "); ok {
+			exports[path] = file
+		}
+	}
+	return func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %q", path)
+		}
+		return os.Open(file)
+	}, nil
+}
@@ -0,0 +1,997 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// stmtList translates a list of statements.
+func (t *translator) stmtList(list []ast.Stmt) []ast.Stmt {
+	var out []ast.Stmt
+	for _, s := range list {
+		out = append(out, t.stmt(s)...)
+	}
+	return out
+}
+
+// funcBody translates the body of a function.
+func (t *translator) funcBody(body *ast.BlockStmt) {
+	if body == nil {
+		return
+	}
+	body.List = t.stmtList(body.List)
+}
+
+// exhaustive reports whether s is a switch on an enum value that has
+// a case for every variant and no default case.
+func (t *translator) exhaustive(s *ast.SwitchStmt) bool {
+	if s.Tag == nil {
+		return false
+	}
+	enum := enumOf(t.typeOf(s.Tag))
+	if enum == nil {
+		return false
+	}
+	seen := make(map[*types.Variant]bool)
+	for _, c := range s.Body.List {
+		c := c.(*ast.CaseClause)
+		if c.List == nil {
+			return false
+		}
+		for _, e := range c.List {
+			if v := t.caseVariant(e); v != nil {
+				seen[v] = true
+			}
+		}
+	}
+	return len(seen) == enum.NumVariants()
+}
+
+// caseVariant returns the variant that the case expression e denotes,
+// or nil.
+func (t *translator) caseVariant(e ast.Expr) *types.Variant {
+	if c, ok := e.(*ast.CallExpr); ok {
+		e = c.Fun
+	}
+	var id *ast.Ident
+	switch e := ast.Unparen(e).(type) {
+	case *ast.Ident:
+		id = e
+	case *ast.SelectorExpr:
+		id = e.Sel
+	}
+	if id == nil {
+		return nil
+	}
+	v, _ := t.info.Uses[id].(*types.Variant)
+	return v
+}
+
+// stmt translates the statement s into a list of statements.
+func (t *translator) stmt(s ast.Stmt) []ast.Stmt {
+	saved, savedRet := t.pre, t.ret
+	t.pre, t.ret = nil, false
+	list := t.stmt1(s)
+	list = append(t.pre, list...)
+	for _, s1 := range list {
+		// The statements take the place of s.
+		fixPositions(s1, s.Pos())
+	}
+	t.pre, t.ret = saved, savedRet
+	return list
+}
+
+// stmt1 translates the statement s. The statements it needs before s
+// are appended to t.pre.
+func (t *translator) stmt1(s ast.Stmt) []ast.Stmt {
+	switch s := s.(type) {
+	case *ast.ExprStmt:
+		return t.exprStmt(s)
+	case *ast.AssignStmt:
+		return t.assignStmt(s)
+	case *ast.DeclStmt:
+		return t.declStmt(s)
+	case *ast.ReturnStmt:
+		return t.returnStmt(s)
+	case *ast.IncDecStmt:
+		s.X = t.expr(s.X)
+	case *ast.SendStmt:
+		var elem types.Type
+		if ch, ok := under(t.typeOf(s.Chan)).(*types.Chan); ok {
+			elem = ch.Elem()
+		}
+		res := t.ordered([]ast.Expr{s.Chan, s.Value}, func(i int, e ast.Expr) ast.Expr {
+			if i == 1 {
+				return t.convert(e, elem)
+			}
+			return t.expr(e)
+		})
+		s.Chan, s.Value = res[0], res[1]
+	case *ast.GoStmt:
+		s.Call = t.deferredCall(s.Call)
+	case *ast.DeferStmt:
+		s.Call = t.deferredCall(s.Call)
+	case *ast.LabeledStmt:
+		list := t.stmt1(s.Stmt)
+		if len(list) == 0 {
+			s.Stmt = &ast.EmptyStmt{Semicolon: s.Colon + 1, Implicit: true}
+			return []ast.Stmt{s}
+		}
+		s.Stmt = list[0]
+		list[0] = s
+		return list
+	case *ast.BlockStmt:
+		s.List = t.stmtList(s.List)
+	case *ast.IfStmt:
+		return t.ifStmt(s)
+	case *ast.SwitchStmt:
+		return t.switchStmt(s)
+	case *ast.TypeSwitchStmt:
+		return t.typeSwitchStmt(s)
+	case *ast.SelectStmt:
+		for _, c := range s.Body.List {
+			c := c.(*ast.CommClause)
+			if c.Comm != nil {
+				list := t.stmt(c.Comm)
+				if len(list) != 1 {
+					t.errorf(c.Comm.Pos(), "cannot translate communication clause")
+				} else {
+					c.Comm = list[0]
+				}
+			}
+			c.Body = t.stmtList(c.Body)
+		}
+	case *ast.ForStmt:
+		return t.forStmt(s)
+	case *ast.RangeStmt:
+		return t.rangeStmt(s)
+	case *ast.BranchStmt, *ast.EmptyStmt:
+	default:
+		t.errorf(s.Pos(), "unexpected statement")
+	}
+	return []ast.Stmt{s}
+}
+
+// unusedStmts returns assignments to the blank identifier that use
+// the variables declared by names that Wo reports as unused.
+func (t *translator) unusedStmts(names []ast.Expr) []ast.Stmt {
+	var list []ast.Stmt
+	for _, name := range names {
+		id, ok := name.(*ast.Ident)
+		if !ok || id.Name == "_" {
+			continue
+		}
+		if obj := t.info.Defs[id]; obj != nil && t.unused[obj.Pos()] {
+			list = append(list, assign(ast.NewIdent("_"), ast.NewIdent(id.Name)))
+		}
+	}
+	return list
+}
+
+func (t *translator) exprStmt(s *ast.ExprStmt) []ast.Stmt {
+	switch x := ast.Unparen(s.X).(type) {
+	case *ast.CallExpr:
+		if a := t.setAdd(x); a != nil {
+			return []ast.Stmt{a}
+		}
+	case *ast.PostfixExpr:
+		if t.fn == nil {
+			break
+		}
+		tuple, _ := t.info.Types[x.X].Type.(*types.Tuple)
+		v := t.expr(x.X)
+		t.ret = true
+		if x.Op == token.NOT {
+			if tuple != nil {
+				return []ast.Stmt{t.checkErr(ast.NewIdent("_"), v)}
+			}
+			return []ast.Stmt{t.checkErr(nil, v)}
+		}
+		if tuple != nil {
+			ok := t.okFor(tuple.At(1).Type())
+			s := t.returnIf(&ast.UnaryExpr{Op: token.NOT, X: ast.NewIdent(ok.Name)}, nil).(*ast.IfStmt)
+			s.Init = &ast.AssignStmt{Lhs: []ast.Expr{ast.NewIdent("_"), ok}, Tok: token.DEFINE, Rhs: []ast.Expr{v}}
+			return []ast.Stmt{s}
+		}
+		return []ast.Stmt{t.returnIf(t.present(v, false), nil)}
+	}
+	x := t.expr(s.X)
+	if x == nil {
+		return nil
+	}
+	if !isCallOrRecv(x) {
+		return []ast.Stmt{assign(ast.NewIdent("_"), x)}
+	}
+	s.X = x
+	return []ast.Stmt{s}
+}
+
+// deferredCall translates the call of a go or defer statement.
+func (t *translator) deferredCall(x *ast.CallExpr) *ast.CallExpr {
+	if a := t.setAdd(x); a != nil {
+		t.errorf(x.Pos(), "cannot translate deferred call of add")
+		return x
+	}
+	y := t.expr(x)
+	c, ok := y.(*ast.CallExpr)
+	if !ok {
+		t.errorf(x.Pos(), "cannot translate deferred call of builtin method")
+		return x
+	}
+	return c
+}
+
+// lhsType returns the type of the variable that the left-hand side
+// expression e of an assignment or definition denotes, or nil.
+func (t *translator) lhsType(e ast.Expr) types.Type {
+	if id, ok := e.(*ast.Ident); ok {
+		if id.Name == "_" {
+			return nil
+		}
+		if obj := t.info.Defs[id]; obj != nil {
+			return obj.Type()
+		}
+		if obj := t.info.Uses[id]; obj != nil {
+			return obj.Type()
+		}
+	}
+	return t.typeOf(e)
+}
+
+func (t *translator) assignStmt(s *ast.AssignStmt) []ast.Stmt {
+	if s.Tok != token.ASSIGN && s.Tok != token.DEFINE {
+		res := t.ordered([]ast.Expr{s.Lhs[0], s.Rhs[0]}, t.exprFunc)
+		s.Lhs[0], s.Rhs[0] = res[0], res[1]
+		return []ast.Stmt{s}
+	}
+	var unused []ast.Stmt
+	if s.Tok == token.DEFINE {
+		unused = t.unusedStmts(s.Lhs)
+	}
+	if s.Type != nil {
+		// The typed declaration x T = v is var x T = v.
+		id := s.Lhs[0].(*ast.Ident)
+		T := t.lhsType(id)
+		return append([]ast.Stmt{varDecl(id, t.typeExpr(T), t.convert(s.Rhs[0], T))}, unused...)
+	}
+
+	if len(s.Rhs) == 1 {
+		rhs := ast.Unparen(s.Rhs[0])
+		tuple, _ := t.info.Types[rhs].Type.(*types.Tuple)
+		id, _ := s.Lhs[0].(*ast.Ident)
+		single := len(s.Lhs) == 1 && id != nil && id.Name != "_"
+		switch x := rhs.(type) {
+		case *ast.PostfixExpr:
+			// x := f()! and x := m[k]? declare the temporary
+			// directly.
+			xt, _ := t.info.Types[x.X].Type.(*types.Tuple)
+			if single && s.Tok == token.DEFINE && xt != nil && t.fn != nil && t.info.Defs[id] != nil {
+				v := t.expr(x.X)
+				t.ret = true
+				if x.Op == token.NOT {
+					err := t.errIdent()
+					return append([]ast.Stmt{
+						define2(id, err, v),
+						t.returnIf(&ast.BinaryExpr{X: ast.NewIdent(err.Name), Op: token.NEQ, Y: ast.NewIdent("nil")}, ast.NewIdent(err.Name)),
+					}, unused...)
+				}
+				ok := t.okFor(xt.At(1).Type())
+				return append([]ast.Stmt{
+					define2(id, ok, v),
+					t.returnIf(&ast.UnaryExpr{Op: token.NOT, X: ast.NewIdent(ok.Name)}, nil),
+				}, unused...)
+			}
+		case *ast.CondExpr:
+			if single && (s.Tok == token.ASSIGN || t.info.Defs[id] != nil) {
+				T := t.lhsType(id)
+				var list []ast.Stmt
+				if s.Tok == token.DEFINE {
+					list = append(list, varDecl(id, t.typeExpr(T), nil))
+				}
+				list = append(list, t.condStmts(x, func(e ast.Expr) []ast.Stmt {
+					return []ast.Stmt{assign(ast.NewIdent(id.Name), t.convert(e, T))}
+				})...)
+				return append(list, unused...)
+			}
+		case *ast.CallExpr:
+			if tuple != nil && tuple.Len() > len(s.Lhs) {
+				s.Lhs = t.skipped(x, s.Lhs)
+			}
+		}
+		if tuple != nil && len(s.Lhs) > 1 {
+			for i, e := range s.Lhs {
+				s.Lhs[i] = t.expr(e)
+			}
+			s.Rhs[0] = t.expr(s.Rhs[0])
+			return append([]ast.Stmt{s}, unused...)
+		}
+	}
+
+	lhs := make([]types.Type, len(s.Lhs))
+	for i, e := range s.Lhs {
+		lhs[i] = t.lhsType(e)
+		if s.Tok == token.ASSIGN {
+			s.Lhs[i] = t.expr(e)
+		}
+	}
+	res := t.ordered(s.Rhs, func(i int, e ast.Expr) ast.Expr {
+		return t.convert(e, lhs[i])
+	})
+	copy(s.Rhs, res)
+	return append([]ast.Stmt{s}, unused...)
+}
+
+// skipped returns the left-hand side expressions lhs of an assignment
+// of the results of the call x, with the blank identifier for the
+// skippable results that lhs omits.
+func (t *translator) skipped(x *ast.CallExpr, lhs []ast.Expr) []ast.Expr {
+	sig, _ := under(t.info.Types[x.Fun].Type).(*types.Signature)
+	if sig == nil {
+		return lhs
+	}
+	var list []ast.Expr
+	j := 0
+	for i := range sig.Results().Len() {
+		if sig.Skippable(i) {
+			list = append(list, ast.NewIdent("_"))
+			continue
+		}
+		if j < len(lhs) {
+			list = append(list, lhs[j])
+			j++
+		}
+	}
+	return list
+}
+
+func (t *translator) declStmt(s *ast.DeclStmt) []ast.Stmt {
+	d := s.Decl.(*ast.GenDecl)
+	switch d.Tok {
+	case token.TYPE:
+		for _, spec := range d.Specs {
+			spec := spec.(*ast.TypeSpec)
+			if _, ok := spec.Type.(*ast.EnumType); ok {
+				t.errorf(spec.Pos(), "cannot translate local enum type %s", spec.Name.Name)
+				continue
+			}
+			t.typeSpec(spec)
+		}
+		return []ast.Stmt{s}
+	case token.CONST:
+		for _, spec := range d.Specs {
+			spec := spec.(*ast.ValueSpec)
+			spec.Type = t.typ(spec.Type)
+			for i, v := range spec.Values {
+				spec.Values[i] = t.exprOnly(v)
+			}
+		}
+		return []ast.Stmt{s}
+	}
+
+	// Variable declarations that need statements are split into one
+	// declaration per specification.
+	var list []ast.Stmt
+	var specs []ast.Spec
+	flush := func() {
+		if len(specs) > 0 {
+			d := *d
+			d.Specs = specs
+			if len(specs) == 1 {
+				d.Lparen, d.Rparen = token.NoPos, token.NoPos
+			}
+			list = append(list, &ast.DeclStmt{Decl: &d})
+			specs = nil
+		}
+	}
+	for _, spec := range d.Specs {
+		spec := spec.(*ast.ValueSpec)
+		var names []ast.Expr
+		for _, id := range spec.Names {
+			names = append(names, id)
+		}
+		unused := t.unusedStmts(names)
+		pre := t.withPre(func() []ast.Stmt {
+			stmts := t.varSpec(spec)
+			if stmts == nil {
+				return nil
+			}
+			return append(stmts, unused...)
+		})
+		if len(pre) == 0 && len(unused) == 0 {
+			specs = append(specs, spec)
+			continue
+		}
+		flush()
+		if len(pre) > 0 && t.replaced(pre) {
+			list = append(list, pre...)
+			continue
+		}
+		list = append(list, pre...)
+		list = append(list, &ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, TokPos: d.TokPos, Specs: []ast.Spec{spec}}})
+		list = append(list, unused...)
+	}
+	flush()
+	return list
+}
+
+// replaced reports whether the statements list returned by varSpec
+// replace the specification.
+func (t *translator) replaced(list []ast.Stmt) bool {
+	_, ok := list[len(list)-1].(*replacedStmt)
+	if ok {
+		list[len(list)-1] = &ast.EmptyStmt{Implicit: true}
+	}
+	return ok
+}
+
+// varSpec translates the variable specification spec in a function.
+// It returns nil if spec remains a declaration, or the statements
+// that replace it.
+func (t *translator) varSpec(spec *ast.ValueSpec) []ast.Stmt {
+	var T types.Type
+	if spec.Type != nil {
+		T = t.info.Types[spec.Type].Type
+		spec.Type = t.typ(spec.Type)
+	}
+	if len(spec.Values) == 1 && len(spec.Names) >= 1 {
+		v := ast.Unparen(spec.Values[0])
+		tuple, _ := t.info.Types[v].Type.(*types.Tuple)
+		id := spec.Names[0]
+		switch x := v.(type) {
+		case *ast.PostfixExpr:
+			xt, _ := t.info.Types[x.X].Type.(*types.Tuple)
+			if len(spec.Names) == 1 && id.Name != "_" && spec.Type == nil && xt != nil && t.fn != nil {
+				s := &ast.AssignStmt{Lhs: []ast.Expr{id}, Tok: token.DEFINE, Rhs: []ast.Expr{x}}
+				return t.replace(t.assignStmt(s))
+			}
+		case *ast.CondExpr:
+			if len(spec.Names) == 1 && id.Name != "_" {
+				T := t.lhsType(id)
+				spec.Type = t.typeExpr(T)
+				spec.Values = nil
+				list := []ast.Stmt{&ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{spec}}}}
+				list = append(list, t.condStmts(x, func(e ast.Expr) []ast.Stmt {
+					return []ast.Stmt{assign(ast.NewIdent(id.Name), t.convert(e, T))}
+				})...)
+				return t.replace(list)
+			}
+		case *ast.CallExpr:
+			var lhs []ast.Expr
+			if tuple != nil && tuple.Len() > len(spec.Names) {
+				for _, name := range spec.Names {
+					lhs = append(lhs, name)
+				}
+				lhs = t.skipped(x, lhs)
+			}
+			// Without skipped results, var a T? = f() converts
+			// the comma-ok results of f, below.
+			if tuple != nil && len(lhs) == tuple.Len() {
+				if spec.Type == nil {
+					spec.Names = nil
+					for _, e := range lhs {
+						spec.Names = append(spec.Names, e.(*ast.Ident))
+					}
+					spec.Values[0] = t.expr(x)
+					return nil
+				}
+				// var a T = f() with a skipped result is
+				// _, v, _ := f(); var a T = v.
+				var temps []ast.Expr
+				var values []ast.Expr
+				for i, e := range lhs {
+					if e.(*ast.Ident).Name == "_" {
+						temps = append(temps, e)
+						continue
+					}
+					v := t.newName("v")
+					temps = append(temps, v)
+					values = append(values, t.wrap(ast.NewIdent(v.Name), tuple.At(i).Type(), T))
+				}
+				def := &ast.AssignStmt{Lhs: temps, Tok: token.DEFINE, Rhs: []ast.Expr{t.expr(x)}}
+				spec.Values = values
+				return t.replace([]ast.Stmt{
+					def,
+					&ast.DeclStmt{Decl: &ast.GenDecl{Tok: token.VAR, Specs: []ast.Spec{spec}}},
+				})
+			}
+		}
+		if tuple != nil && len(spec.Names) > 1 {
+			spec.Values[0] = t.expr(spec.Values[0])
+			return nil
+		}
+	}
+	res := t.ordered(spec.Values, func(i int, e ast.Expr) ast.Expr {
+		if T != nil {
+			return t.convert(e, T)
+		}
+		return t.convert(e, t.lhsType(spec.Names[i]))
+	})
+	copy(spec.Values, res)
+	return nil
+}
+
+// A replacedStmt marks the end of the statements that replace a
+// variable specification.
+type replacedStmt struct {
+	ast.EmptyStmt
+}
+
+func (t *translator) replace(list []ast.Stmt) []ast.Stmt {
+	return append(list, &replacedStmt{})
+}
+
+func (t *translator) returnStmt(s *ast.ReturnStmt) []ast.Stmt {
+	if t.fn == nil || len(s.Results) == 0 {
+		return []ast.Stmt{s}
+	}
+	results := t.fn.sig.Results()
+	if len(s.Results) == 1 && results.Len() == 1 {
+		if c, ok := ast.Unparen(s.Results[0]).(*ast.CondExpr); ok {
+			return t.condReturn(c, results.At(0).Type())
+		}
+	}
+	if len(s.Results) != results.Len() {
+		s.Results[0] = t.expr(s.Results[0])
+		return []ast.Stmt{s}
+	}
+	res := t.ordered(s.Results, func(i int, e ast.Expr) ast.Expr {
+		return t.convert(e, results.At(i).Type())
+	})
+	copy(s.Results, res)
+	return []ast.Stmt{s}
+}
+
+// scoped returns the statements init followed by s. If init declares
+// variables, they are wrapped in a block to limit their scope.
+func scoped(init []ast.Stmt, s ast.Stmt) []ast.Stmt {
+	if len(init) == 0 {
+		return []ast.Stmt{s}
+	}
+	return []ast.Stmt{&ast.BlockStmt{List: append(init, s)}}
+}
+
+// initStmt translates the init statement s of an if, for, or switch
+// statement. Go permits no var declarations there, so the typed
+// declaration x T = v becomes x := T(v).
+func (t *translator) initStmt(s ast.Stmt) []ast.Stmt {
+	list := t.stmt(s)
+	a, ok := s.(*ast.AssignStmt)
+	if !ok || a.Type == nil {
+		return list
+	}
+	for i, s := range list {
+		if decl, ok := s.(*ast.DeclStmt); ok {
+			spec := decl.Decl.(*ast.GenDecl).Specs[0].(*ast.ValueSpec)
+			if spec.Names[0] == a.Lhs[0] {
+				list[i] = define(spec.Names[0], &ast.CallExpr{Fun: operand(spec.Type), Args: spec.Values})
+				break
+			}
+		}
+	}
+	return list
+}
+
+func (t *translator) ifStmt(s *ast.IfStmt) []ast.Stmt {
+	if c, ok := s.Cond.(*ast.BindingExpr); ok {
+		return t.ifVar(s, c)
+	}
+	var init []ast.Stmt
+	if s.Init != nil {
+		init = t.initStmt(s.Init)
+	}
+	var cond ast.Expr
+	condPre := t.withPre(func() []ast.Stmt {
+		cond = t.expr(s.Cond)
+		return nil
+	})
+	s.Cond = cond
+	s.Body.List = t.stmtList(s.Body.List)
+	t.elseStmt(s)
+	switch {
+	case len(condPre) == 0 && len(init) <= 1:
+		s.Init = nil
+		if len(init) == 1 {
+			s.Init = init[0]
+		}
+		return []ast.Stmt{s}
+	case len(init) == 0:
+		t.pre = append(t.pre, condPre...)
+		return []ast.Stmt{s}
+	}
+	s.Init = nil
+	return scoped(append(init, condPre...), s)
+}
+
+// elseStmt translates the else branch of s.
+func (t *translator) elseStmt(s *ast.IfStmt) {
+	if s.Else == nil {
+		return
+	}
+	list := t.stmt(s.Else)
+	if len(list) == 1 {
+		s.Else = list[0]
+	} else {
+		s.Else = &ast.BlockStmt{List: list}
+	}
+}
+
+// ifVar translates the conditional binding if var x = v.
+func (t *translator) ifVar(s *ast.IfStmt, c *ast.BindingExpr) []ast.Stmt {
+	init, cond, bind := t.binding(c)
+	s.Init, s.Cond = init, cond
+	s.Body.List = append(bind, t.stmtList(s.Body.List)...)
+	t.elseStmt(s)
+	return []ast.Stmt{s}
+}
+
+// binding translates the conditional binding var names = v of an if or
+// for statement. It returns the initialization statement and condition
+// of an equivalent if statement, and the statements that bind the
+// names at the beginning of the body.
+func (t *translator) binding(c *ast.BindingExpr) (init ast.Stmt, cond ast.Expr, bind []ast.Stmt) {
+	v := ast.Unparen(c.Value)
+	VT := t.info.Types[v].Type
+	x := t.expr(v)
+	var lhs []ast.Expr
+	for _, id := range c.Names {
+		if obj := t.info.Defs[id]; obj != nil && t.unused[obj.Pos()] {
+			id.Name = "_"
+		}
+		lhs = append(lhs, id)
+	}
+	if tuple, ok := VT.(*types.Tuple); ok {
+		last := tuple.At(tuple.Len() - 1).Type()
+		if types.Identical(last, types.Universe.Lookup("error").Type()) {
+			err := t.errIdent()
+			init = &ast.AssignStmt{Lhs: append(lhs, err), Tok: token.DEFINE, Rhs: []ast.Expr{x}}
+			cond = &ast.BinaryExpr{X: ast.NewIdent(err.Name), Op: token.EQL, Y: ast.NewIdent("nil")}
+			return
+		}
+		ok := t.okFor(last)
+		init = &ast.AssignStmt{Lhs: append(lhs, ok), Tok: token.DEFINE, Rhs: []ast.Expr{x}}
+		cond = ast.NewIdent(ok.Name)
+		return
+	}
+
+	// optional value
+	id := c.Names[0]
+	o, isIdent := x.(*ast.Ident)
+	if !isIdent {
+		o = t.newName("v")
+		init = define(o, x)
+	}
+	cond = t.present(ast.NewIdent(o.Name), true)
+	if id.Name != "_" {
+		bind = []ast.Stmt{define(id, &ast.SelectorExpr{X: ast.NewIdent(o.Name), Sel: ast.NewIdent("Value")})}
+	}
+	return
+}
+
+func (t *translator) forStmt(s *ast.ForStmt) []ast.Stmt {
+	if c, ok := s.Cond.(*ast.BindingExpr); ok {
+		// for var x = v { ... } is
+		//
+		//	for {
+		//		x, ok := v
+		//		if !ok {
+		//			break
+		//		}
+		//		...
+		//	}
+		var head []ast.Stmt
+		head = t.withPre(func() []ast.Stmt {
+			init, cond, bind := t.binding(c)
+			list := []ast.Stmt{init}
+			if init == nil {
+				list = nil
+			}
+			list = append(list, &ast.IfStmt{
+				Cond: negate(cond),
+				Body: &ast.BlockStmt{List: []ast.Stmt{&ast.BranchStmt{Tok: token.BREAK}}},
+			})
+			return append(list, bind...)
+		})
+		s.Cond = nil
+		s.Body.List = append(head, t.stmtList(s.Body.List)...)
+		return []ast.Stmt{s}
+	}
+
+	var init []ast.Stmt
+	if s.Init != nil {
+		init = t.initStmt(s.Init)
+		s.Init = init[len(init)-1]
+		init = init[:len(init)-1]
+	}
+	var cond ast.Expr
+	condPre := t.withPre(func() []ast.Stmt {
+		cond = t.expr(s.Cond)
+		return nil
+	})
+	s.Cond = cond
+	if s.Post != nil {
+		post := t.stmt(s.Post)
+		if len(post) != 1 {
+			t.errorf(s.Post.Pos(), "cannot translate post statement")
+		} else {
+			s.Post = post[0]
+		}
+	}
+	body := t.stmtList(s.Body.List)
+	if len(condPre) > 0 {
+		// The condition is evaluated at the beginning of each
+		// iteration.
+		check := &ast.IfStmt{
+			Cond: negate(cond),
+			Body: &ast.BlockStmt{List: []ast.Stmt{&ast.BranchStmt{Tok: token.BREAK}}},
+		}
+		body = append(append(condPre, check), body...)
+		s.Cond = nil
+	}
+	s.Body.List = body
+	t.pre = append(t.pre, init...)
+	return []ast.Stmt{s}
+}
+
+// negate returns the negation of the condition x.
+func negate(x ast.Expr) ast.Expr {
+	switch y := x.(type) {
+	case *ast.UnaryExpr:
+		if y.Op == token.NOT {
+			return y.X
+		}
+	case *ast.BinaryExpr:
+		switch y.Op {
+		case token.EQL:
+			return &ast.BinaryExpr{X: y.X, Op: token.NEQ, Y: y.Y}
+		case token.NEQ:
+			return &ast.BinaryExpr{X: y.X, Op: token.EQL, Y: y.Y}
+		}
+	}
+	return &ast.UnaryExpr{Op: token.NOT, X: operand(x)}
+}
+
+func (t *translator) rangeStmt(s *ast.RangeStmt) []ast.Stmt {
+	if s.Tok == token.COLON {
+		s.Tok = token.DEFINE
+		s.Range = s.TokPos
+		if s.Key != nil && s.Value == nil && singleValue(t.typeOf(s.X)) {
+			// for v : x ranges over the values of x.
+			s.Key, s.Value = ast.NewIdent("_"), s.Key
+		}
+	}
+	if s.Tok == token.DEFINE {
+		for _, e := range []*ast.Expr{&s.Key, &s.Value} {
+			if id, ok := (*e).(*ast.Ident); ok {
+				if obj := t.info.Defs[id]; obj != nil && t.unused[obj.Pos()] {
+					id.Name = "_"
+				}
+			}
+		}
+		if isBlank(s.Value) {
+			s.Value = nil
+		}
+		if s.Value == nil && isBlank(s.Key) {
+			s.Key, s.Tok, s.TokPos = nil, token.ILLEGAL, token.NoPos
+		}
+	} else {
+		s.Key = t.expr(s.Key)
+		s.Value = t.expr(s.Value)
+	}
+	s.X = t.expr(s.X)
+	s.Body.List = t.stmtList(s.Body.List)
+	return []ast.Stmt{s}
+}
+
+func isBlank(e ast.Expr) bool {
+	id, ok := e.(*ast.Ident)
+	return ok && id.Name == "_"
+}
+
+// singleValue reports whether a Wo range clause with a single variable
+// over a value of type T binds the values of the elements, rather than
+// the keys or indices.
+func singleValue(T types.Type) bool {
+	switch u := under(T).(type) {
+	case *types.Basic:
+		return u.Info()&types.IsString != 0
+	case *types.Slice, *types.Array, *types.Map:
+		return true
+	case *types.Pointer:
+		_, ok := under(u.Elem()).(*types.Array)
+		return ok
+	case *types.Signature:
+		// iter.Seq2
+		return u.Params().Len() == 1 && u.Params().At(0).Type().(*types.Signature).Params().Len() == 2
+	}
+	return false
+}
+
+func (t *translator) switchStmt(s *ast.SwitchStmt) []ast.Stmt {
+	var init []ast.Stmt
+	if s.Init != nil {
+		init = t.initStmt(s.Init)
+		s.Init = nil
+		if len(init) == 1 {
+			s.Init, init = init[0], nil
+		}
+	}
+	// A switch that covers all variants of an enum type is a
+	// terminating statement in Wo if its cases are; Go needs a
+	// default case for that.
+	exhaustive := t.exhaustive(s)
+	var tagPre []ast.Stmt
+	var enum *types.Enum
+	if s.Tag != nil {
+		enum = enumOf(t.typeOf(s.Tag))
+		var tag ast.Expr
+		tagPre = t.withPre(func() []ast.Stmt {
+			tag = t.expr(s.Tag)
+			return nil
+		})
+		s.Tag = tag
+	}
+	if enum != nil && enum.IsSum() {
+		t.sumSwitch(s, init != nil || len(tagPre) > 0)
+	} else {
+		for _, c := range s.Body.List {
+			c := c.(*ast.CaseClause)
+			for i, e := range c.List {
+				c.List[i] = t.exprOnly(e)
+			}
+			c.Body = t.stmtList(c.Body)
+		}
+	}
+	if exhaustive {
+		s.Body.List = append(s.Body.List, &ast.CaseClause{
+			Body: []ast.Stmt{&ast.ExprStmt{X: call(ast.NewIdent("panic"), &ast.BasicLit{Kind: token.STRING, Value: `"unreachable"`})}},
+		})
+	}
+	if init == nil && s.Init == nil {
+		t.pre = append(t.pre, tagPre...)
+		return []ast.Stmt{s}
+	}
+	if s.Init != nil && len(tagPre) > 0 {
+		init, s.Init = append(init, s.Init), nil
+	}
+	return scoped(append(init, tagPre...), s)
+}
+
+// sumSwitch translates the switch s on a value of a sum enum type into
+// a switch on its tag. If hasInit is set, s.Init is not available for
+// the temporary that holds the value.
+func (t *translator) sumSwitch(s *ast.SwitchStmt, hasInit bool) {
+	x := s.Tag
+	if _, ok := x.(*ast.Ident); !ok {
+		v := t.newName("v")
+		if s.Init == nil && !hasInit {
+			s.Init = define(v, x)
+		} else {
+			t.pre = append(t.pre, define(v, x))
+		}
+		x = ast.NewIdent(v.Name)
+	}
+	s.Tag = &ast.SelectorExpr{X: x, Sel: ast.NewIdent("Tag")}
+	for _, c := range s.Body.List {
+		c := c.(*ast.CaseClause)
+		var bind []ast.Stmt
+		for i, e := range c.List {
+			v := t.caseVariant(e)
+			if v == nil {
+				t.errorf(e.Pos(), "unexpected case")
+				continue
+			}
+			c.List[i] = intLit(int64(v.Index()))
+			call, ok := e.(*ast.CallExpr)
+			if !ok {
+				continue
+			}
+			var lhs, rhs []ast.Expr
+			for j, arg := range call.Args {
+				id := arg.(*ast.Ident)
+				if id.Name == "_" {
+					continue
+				}
+				if obj := t.info.Defs[id]; obj != nil && t.unused[obj.Pos()] {
+					continue
+				}
+				lhs = append(lhs, id)
+				rhs = append(rhs, &ast.SelectorExpr{
+					X:   &ast.SelectorExpr{X: ast.NewIdent(x.(*ast.Ident).Name), Sel: ast.NewIdent(variantField(v))},
+					Sel: ast.NewIdent(exported(v.Field(j).Name())),
+				})
+			}
+			if lhs != nil {
+				bind = append(bind, &ast.AssignStmt{Lhs: lhs, Tok: token.DEFINE, Rhs: rhs})
+			}
+		}
+		c.Body = append(bind, t.stmtList(c.Body)...)
+	}
+}
+
+func (t *translator) typeSwitchStmt(s *ast.TypeSwitchStmt) []ast.Stmt {
+	var init []ast.Stmt
+	if s.Init != nil {
+		init = t.initStmt(s.Init)
+		s.Init = nil
+		if len(init) == 1 {
+			s.Init, init = init[0], nil
+		}
+	}
+
+	var x ast.Expr     // type assertion
+	var sym *ast.Ident // symbolic variable; or nil
+	switch a := s.Assign.(type) {
+	case *ast.AssignStmt:
+		x, sym = a.Rhs[0], a.Lhs[0].(*ast.Ident)
+	case *ast.ExprStmt:
+		x = a.X
+	}
+	assert := x.(*ast.TypeAssertExpr)
+	XT := t.typeOf(assert.X)
+	var pre []ast.Stmt
+	pre = t.withPre(func() []ast.Stmt {
+		assert.X = t.expr(assert.X)
+		return nil
+	})
+
+	used := false
+	for _, c := range s.Body.List {
+		c := c.(*ast.CaseClause)
+		var list []ast.Expr
+		for _, e := range c.List {
+			tv := t.info.Types[e]
+			if terms := unionTerms(tv.Type); terms != nil {
+				for _, term := range terms {
+					list = append(list, t.typeExpr(term))
+				}
+				continue
+			}
+			if t.isNone(e) {
+				list = append(list, ast.NewIdent("nil"))
+				continue
+			}
+			list = append(list, t.typ(e))
+		}
+		c.List = list
+		body := t.stmtList(c.Body)
+		if obj, ok := t.info.Implicits[c].(*types.Var); ok && sym != nil {
+			if !t.unused[obj.Pos()] {
+				used = true
+			}
+			if len(list) > 1 && isUnionValue(obj.Type()) && !types.Identical(obj.Type(), XT) && hasMethods(obj.Type()) {
+				// The variable of a case of a union type has the
+				// union type in Wo; rebind it.
+				body = append([]ast.Stmt{define(ast.NewIdent(sym.Name), &ast.TypeAssertExpr{X: ast.NewIdent(sym.Name), Type: t.typeExpr(obj.Type())})}, body...)
+			}
+		}
+		c.Body = body
+	}
+	if sym != nil && !used {
+		s.Assign = &ast.ExprStmt{X: x}
+	}
+
+	if init == nil && s.Init == nil {
+		t.pre = append(t.pre, pre...)
+		return []ast.Stmt{s}
+	}
+	if s.Init != nil && len(pre) > 0 {
+		init, s.Init = append(init, s.Init), nil
+	}
+	return scoped(append(init, pre...), s)
+}
+
+// isUnionValue reports whether T is a union type used as a value type.
+func isUnionValue(T types.Type) bool {
+	return unionTerms(T) != nil
+}
+
+// hasMethods reports whether the union type T has methods.
+func hasMethods(T types.Type) bool {
+	iface, ok := T.Underlying().(*types.Interface)
+	return ok && iface.NumMethods() > 0
+}
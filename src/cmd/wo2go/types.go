@@ -0,0 +1,480 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+)
+
+// This file translates types and type expressions.
+
+// typeExpr returns an expression denoting the type T.
+func (t *translator) typeExpr(T types.Type) ast.Expr {
+	switch T := T.(type) {
+	case *types.Basic:
+		if T.Info()&types.IsUntyped != 0 {
+			T = types.Default(T).(*types.Basic)
+		}
+		if T.Kind() == types.UnsafePointer {
+			return &ast.SelectorExpr{X: ast.NewIdent(t.pkgName(types.Unsafe)), Sel: ast.NewIdent("Pointer")}
+		}
+		return ast.NewIdent(T.Name())
+	case *types.Alias:
+		return t.typeName(T.Obj(), T.TypeArgs())
+	case *types.Named:
+		return t.typeName(T.Obj(), T.TypeArgs())
+	case *types.TypeParam:
+		return ast.NewIdent(T.Obj().Name())
+	case *types.Pointer:
+		return &ast.StarExpr{X: t.typeExpr(T.Elem())}
+	case *types.Slice:
+		return &ast.ArrayType{Elt: t.typeExpr(T.Elem())}
+	case *types.Array:
+		return &ast.ArrayType{Len: intLit(T.Len()), Elt: t.typeExpr(T.Elem())}
+	case *types.Map:
+		return &ast.MapType{Key: t.typeExpr(T.Key()), Value: t.typeExpr(T.Elem())}
+	case *types.Chan:
+		elem := t.typeExpr(T.Elem())
+		dir := ast.SEND | ast.RECV
+		switch T.Dir() {
+		case types.SendOnly:
+			dir = ast.SEND
+		case types.RecvOnly:
+			dir = ast.RECV
+		}
+		if c, ok := T.Elem().(*types.Chan); ok && dir == ast.SEND|ast.RECV && c.Dir() == types.RecvOnly {
+			elem = &ast.ParenExpr{X: elem}
+		}
+		return &ast.ChanType{Dir: dir, Value: elem}
+	case *types.Signature:
+		return t.signature(T, nil)
+	case *types.Struct:
+		fields := &ast.FieldList{}
+		for i := range T.NumFields() {
+			f := T.Field(i)
+			field := &ast.Field{Type: t.typeExpr(f.Type())}
+			if !f.Embedded() {
+				field.Names = []*ast.Ident{ast.NewIdent(f.Name())}
+			}
+			if tag := T.Tag(i); tag != "" {
+				field.Tag = &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(tag)}
+			}
+			fields.List = append(fields.List, field)
+		}
+		return &ast.StructType{Fields: fields}
+	case *types.Interface:
+		return t.interfaceType(T, false)
+	case *types.Optional:
+		return t.optionalType(t.typeExpr(T.Elem()))
+	case *types.Set:
+		return setType(t.typeExpr(T.Elem()))
+	case *types.Union:
+		var x ast.Expr
+		for i := range T.Len() {
+			term := T.Term(i)
+			y := t.typeExpr(term.Type())
+			if term.Tilde() {
+				y = &ast.UnaryExpr{Op: token.TILDE, X: y}
+			}
+			if x == nil {
+				x = y
+			} else {
+				x = &ast.BinaryExpr{X: x, Op: token.OR, Y: y}
+			}
+		}
+		return x
+	}
+	panic("unexpected type " + T.String())
+}
+
+// typeName returns an expression denoting the type named obj,
+// instantiated with targs.
+func (t *translator) typeName(obj *types.TypeName, targs *types.TypeList) ast.Expr {
+	var x ast.Expr = ast.NewIdent(obj.Name())
+	if obj.Pkg() != nil && obj.Pkg() != t.pkg {
+		if !obj.Exported() {
+			t.errorf(t.pos(), "cannot refer to unexported type %s of package %s", obj.Name(), obj.Pkg().Path())
+		}
+		x = &ast.SelectorExpr{X: ast.NewIdent(t.pkgName(obj.Pkg())), Sel: x.(*ast.Ident)}
+	}
+	if targs.Len() == 0 {
+		return x
+	}
+	var list []ast.Expr
+	for i := range targs.Len() {
+		list = append(list, t.typeExpr(targs.At(i)))
+	}
+	return index(x, list)
+}
+
+// signature returns the function type of sig, with the given names
+// for the parameters if they are not nil.
+func (t *translator) signature(sig *types.Signature, names []*ast.Ident) *ast.FuncType {
+	params := &ast.FieldList{}
+	for i := range sig.Params().Len() {
+		typ := t.typeExpr(sig.Params().At(i).Type())
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = &ast.Ellipsis{Elt: typ.(*ast.ArrayType).Elt}
+		}
+		field := &ast.Field{Type: typ}
+		if names != nil {
+			field.Names = []*ast.Ident{names[i]}
+		}
+		params.List = append(params.List, field)
+	}
+	var results *ast.FieldList
+	if sig.Results().Len() > 0 {
+		results = &ast.FieldList{}
+		for i := range sig.Results().Len() {
+			results.List = append(results.List, &ast.Field{Type: t.typeExpr(sig.Results().At(i).Type())})
+		}
+	}
+	return &ast.FuncType{Params: params, Results: results}
+}
+
+// interfaceType returns an expression denoting the interface T. Unless
+// constraint is set, it denotes an ordinary interface with the methods
+// of T, which represents a Wo union value type.
+func (t *translator) interfaceType(T *types.Interface, constraint bool) ast.Expr {
+	if constraint && T.IsImplicit() {
+		return t.typeExpr(T.EmbeddedType(0))
+	}
+	methods := &ast.FieldList{}
+	if constraint || T.IsMethodSet() {
+		for i := range T.NumEmbeddeds() {
+			var x ast.Expr
+			if u, ok := T.EmbeddedType(i).(*types.Union); ok {
+				x = t.typeExpr(u)
+			} else {
+				x = t.typeExpr(T.EmbeddedType(i))
+			}
+			methods.List = append(methods.List, &ast.Field{Type: x})
+		}
+		for i := range T.NumExplicitMethods() {
+			m := T.ExplicitMethod(i)
+			methods.List = append(methods.List, &ast.Field{
+				Names: []*ast.Ident{ast.NewIdent(m.Name())},
+				Type:  t.signature(m.Type().(*types.Signature), nil),
+			})
+		}
+	} else {
+		for i := range T.NumMethods() {
+			m := T.Method(i)
+			methods.List = append(methods.List, &ast.Field{
+				Names: []*ast.Ident{ast.NewIdent(m.Name())},
+				Type:  t.signature(m.Type().(*types.Signature), nil),
+			})
+		}
+	}
+	return &ast.InterfaceType{Methods: methods}
+}
+
+// optionalType returns the representation of the optional type elem?.
+func (t *translator) optionalType(elem ast.Expr) ast.Expr {
+	return &ast.IndexExpr{X: t.helper(helperOptional), Index: elem}
+}
+
+// setType returns the representation of the set type set[elem].
+func setType(elem ast.Expr) ast.Expr {
+	return &ast.MapType{Key: elem, Value: emptyStruct()}
+}
+
+func emptyStruct() *ast.StructType {
+	return &ast.StructType{Fields: &ast.FieldList{}}
+}
+
+// zero returns an expression for the zero value of type T.
+func (t *translator) zero(T types.Type) ast.Expr {
+	if _, ok := types.Unalias(T).(*types.TypeParam); ok {
+		return &ast.StarExpr{X: call(ast.NewIdent("new"), t.typeExpr(T))}
+	}
+	switch u := T.Underlying().(type) {
+	case *types.Basic:
+		switch {
+		case u.Info()&types.IsBoolean != 0:
+			return ast.NewIdent("false")
+		case u.Info()&types.IsString != 0:
+			return &ast.BasicLit{Kind: token.STRING, Value: `""`}
+		case u.Info()&types.IsNumeric != 0:
+			return intLit(0)
+		}
+		return ast.NewIdent("nil")
+	case *types.Enum:
+		if !u.IsSum() {
+			return intLit(0)
+		}
+	case *types.Struct, *types.Array, *types.Optional:
+	default:
+		return ast.NewIdent("nil")
+	}
+	return &ast.CompositeLit{Type: t.typeExpr(T)}
+}
+
+// isOptional reports whether T is an optional type.
+func isOptional(T types.Type) bool {
+	if T == nil {
+		return false
+	}
+	_, ok := T.Underlying().(*types.Optional)
+	return ok
+}
+
+// isSet reports whether T is a set type.
+func isSet(T types.Type) bool {
+	if T == nil {
+		return false
+	}
+	_, ok := T.Underlying().(*types.Set)
+	return ok
+}
+
+// enumOf returns the enum type of T, or of the type T points to, or nil.
+func enumOf(T types.Type) *types.Enum {
+	if T == nil {
+		return nil
+	}
+	if p, ok := T.Underlying().(*types.Pointer); ok {
+		T = p.Elem()
+	}
+	e, _ := T.Underlying().(*types.Enum)
+	return e
+}
+
+// unionTerms returns the types in the type set of the Wo union type
+// T, or nil if T is not a union type. It corresponds to the function
+// of the same name in cmd/compile/internal/noder.
+func unionTerms(T types.Type) []types.Type {
+	iface, _ := T.Underlying().(*types.Interface)
+	if iface == nil || iface.IsMethodSet() {
+		return nil
+	}
+	var terms []types.Type
+	var collect func(t *types.Interface)
+	collect = func(t *types.Interface) {
+		for i := range t.NumEmbeddeds() {
+			switch e := t.EmbeddedType(i).Underlying().(type) {
+			case *types.Interface:
+				collect(e)
+			case *types.Union:
+				for j := range e.Len() {
+					term := e.Term(j)
+					if sub, ok := term.Type().Underlying().(*types.Interface); ok {
+						collect(sub)
+						continue
+					}
+					if term.Tilde() || !types.Implements(term.Type(), iface) || slices.ContainsFunc(terms, func(t types.Type) bool {
+						return types.Identical(t, term.Type())
+					}) {
+						continue
+					}
+					terms = append(terms, term.Type())
+				}
+			}
+		}
+	}
+	collect(iface)
+	return terms
+}
+
+// typ translates the type expression e in a value position.
+func (t *translator) typ(e ast.Expr) ast.Expr {
+	switch x := e.(type) {
+	case nil:
+		return nil
+	case *ast.Ident, *ast.SelectorExpr:
+		return e
+	case *ast.ParenExpr:
+		x.X = t.typ(x.X)
+	case *ast.StarExpr:
+		x.X = t.typ(x.X)
+	case *ast.ArrayType:
+		if x.Len != nil {
+			if _, ok := x.Len.(*ast.Ellipsis); !ok {
+				x.Len = t.expr(x.Len)
+			}
+		}
+		x.Elt = t.typ(x.Elt)
+	case *ast.Ellipsis:
+		x.Elt = t.typ(x.Elt)
+	case *ast.MapType:
+		x.Key = t.typ(x.Key)
+		x.Value = t.typ(x.Value)
+	case *ast.ChanType:
+		x.Value = t.typ(x.Value)
+	case *ast.FuncType:
+		t.funcType(x, nil)
+	case *ast.StructType:
+		for _, f := range x.Fields.List {
+			f.Type = t.typ(f.Type)
+		}
+	case *ast.InterfaceType:
+		return t.interfaceExpr(x, false)
+	case *ast.IndexExpr:
+		if t.isUniverse(x.X, "set") {
+			return setType(t.typ(x.Index))
+		}
+		x.X = t.typ(x.X)
+		x.Index = t.typ(x.Index)
+	case *ast.IndexListExpr:
+		x.X = t.typ(x.X)
+		for i, y := range x.Indices {
+			x.Indices[i] = t.typ(y)
+		}
+	case *ast.PostfixExpr:
+		if x.Op == token.QUESTION {
+			return t.optionalType(t.typ(x.X))
+		}
+		t.errorf(x.OpPos, "unexpected result type")
+	case *ast.BinaryExpr, *ast.UnaryExpr:
+		// A union type in a value position is an interface.
+		if tv, ok := t.info.Types[e]; ok {
+			return t.typeExpr(tv.Type)
+		}
+		return &ast.InterfaceType{Methods: &ast.FieldList{}}
+	default:
+		t.errorf(e.Pos(), "unexpected type expression")
+	}
+	return e
+}
+
+// constraint translates the type expression e in a constraint position.
+func (t *translator) constraint(e ast.Expr) ast.Expr {
+	switch x := e.(type) {
+	case *ast.BinaryExpr:
+		x.X = t.constraint(x.X)
+		x.Y = t.constraint(x.Y)
+	case *ast.UnaryExpr:
+		x.X = t.typ(x.X)
+	case *ast.ParenExpr:
+		x.X = t.constraint(x.X)
+	case *ast.InterfaceType:
+		return t.interfaceExpr(x, true)
+	default:
+		return t.typ(e)
+	}
+	return e
+}
+
+// interfaceExpr translates the interface type expression x. Unless
+// constraint is set, the type terms of x are dropped.
+func (t *translator) interfaceExpr(x *ast.InterfaceType, constraint bool) ast.Expr {
+	x.Compact = false
+	if x.Methods == nil {
+		x.Methods = &ast.FieldList{}
+	}
+	list := x.Methods.List[:0]
+	for _, f := range x.Methods.List {
+		if len(f.Names) > 0 {
+			t.funcType(f.Type.(*ast.FuncType), nil)
+			list = append(list, f)
+			continue
+		}
+		if constraint {
+			f.Type = t.constraint(f.Type)
+			list = append(list, f)
+			continue
+		}
+		tv, ok := t.info.Types[f.Type]
+		if ok && types.IsInterface(tv.Type) && !isTerm(f.Type) {
+			f.Type = t.typ(f.Type)
+			list = append(list, f)
+		}
+	}
+	x.Methods.List = list
+	if len(list) == 0 {
+		x.Methods.Opening, x.Methods.Closing = token.NoPos, token.NoPos
+	}
+	return x
+}
+
+// isTerm reports whether e is a union or a ~ term.
+func isTerm(e ast.Expr) bool {
+	switch e := ast.Unparen(e).(type) {
+	case *ast.BinaryExpr:
+		return e.Op == token.OR
+	case *ast.UnaryExpr:
+		return e.Op == token.TILDE
+	}
+	return false
+}
+
+// isUniverse reports whether e denotes the predeclared object name.
+func (t *translator) isUniverse(e ast.Expr, name string) bool {
+	id, ok := ast.Unparen(e).(*ast.Ident)
+	return ok && id.Name == name && t.info.Uses[id] == types.Universe.Lookup(name)
+}
+
+// funcType translates the function type x of a function or method
+// with the signature sig, or of a function type expression if sig is
+// nil.
+func (t *translator) funcType(x *ast.FuncType, sig *types.Signature) {
+	x.Arrow = token.NoPos
+	if x.TypeParams != nil {
+		for _, f := range x.TypeParams.List {
+			f.Type = t.constraint(f.Type)
+		}
+	}
+	t.fieldList(x.Params, sig, false)
+	if x.Results != nil && len(x.Results.List) == 1 {
+		// The result type T! is (T, error).
+		f := x.Results.List[0]
+		if p, ok := f.Type.(*ast.PostfixExpr); ok && p.Op == token.NOT && len(f.Names) == 0 {
+			x.Results.List = []*ast.Field{{Type: t.typ(p.X)}, {Type: ast.NewIdent("error")}}
+			if !x.Results.Opening.IsValid() {
+				x.Results.Opening = p.Pos()
+				x.Results.Closing = p.OpPos
+			}
+			return
+		}
+	}
+	t.fieldList(x.Results, sig, true)
+	if x.Results != nil && len(x.Results.List) > 0 && !x.Results.Opening.IsValid() && (len(x.Results.List) > 1 || len(x.Results.List[0].Names) > 0) {
+		x.Results.Opening = x.Results.List[0].Pos()
+		x.Results.Closing = x.Results.List[len(x.Results.List)-1].End()
+	}
+}
+
+// fieldList translates the parameters or results list of a function
+// type with signature sig, which may be nil.
+func (t *translator) fieldList(list *ast.FieldList, sig *types.Signature, results bool) {
+	if list == nil {
+		return
+	}
+	if !list.Opening.IsValid() && len(list.List) > 0 && !results {
+		list.Opening = list.List[0].Pos()
+		list.Closing = list.List[len(list.List)-1].End()
+	}
+	for _, f := range list.List {
+		f.Skip = token.NoPos
+		if f.Type == nil {
+			// A parameter with a default value may omit its type.
+			var obj types.Object
+			if len(f.Names) > 0 {
+				obj = t.info.Defs[f.Names[0]]
+			}
+			if obj == nil {
+				t.errorf(f.Pos(), "missing type of parameter")
+				continue
+			}
+			f.Type = t.typeExpr(obj.Type())
+		} else {
+			f.Type = t.typ(f.Type)
+		}
+		f.Default = nil
+	}
+}
+
+// pos returns a position for error messages about the current
+// declaration.
+func (t *translator) pos() token.Pos {
+	if t.file != nil {
+		return t.file.Package
+	}
+	return token.NoPos
+}
@@ -0,0 +1,529 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var fset = token.NewFileSet()
+
+// translate type-checks the package consisting of the named files and
+// returns the Go translations of the files, in the same order.
+func translate(filenames []string, imp types.Importer) ([][]byte, error) {
+	var files []*ast.File
+	var errs scanner.ErrorList
+	for _, name := range filenames {
+		f, err := parser.ParseFile(fset, name, nil, parser.ParseComments|parser.SkipObjectResolution)
+		if err != nil {
+			if list, ok := err.(scanner.ErrorList); ok {
+				errs = append(errs, list...)
+				continue
+			}
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
+
+	t := &translator{
+		info: &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Instances:  make(map[*ast.Ident]types.Instance),
+			Defs:       make(map[*ast.Ident]types.Object),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Implicits:  make(map[ast.Node]types.Object),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+			Scopes:     make(map[ast.Node]*types.Scope),
+		},
+		files:   files,
+		unused:  make(map[token.Pos]bool),
+		helpers: make(map[string]string),
+	}
+	conf := types.Config{
+		Importer: imp,
+		Error: func(err error) {
+			terr := err.(types.Error)
+			if terr.Severity == types.SeverityWarning {
+				// The only warnings are those about unused
+				// variables, which are errors in Go.
+				t.unused[terr.Pos] = true
+				return
+			}
+			errs.Add(fset.Position(terr.Pos), terr.Msg)
+		},
+	}
+	path := files[0].Name.Name
+	t.pkg, _ = conf.Check(path, fset, files, t.info)
+	if err := errs.Err(); err != nil {
+		errs.Sort()
+		return nil, err
+	}
+
+	t.prepare()
+	var out [][]byte
+	for _, f := range files {
+		t.translateFile(f)
+	}
+	if err := t.errs.Err(); err != nil {
+		t.errs.Sort()
+		return nil, err
+	}
+	for i, f := range files {
+		var buf bytes.Buffer
+		if err := (&printer.Config{Mode: printer.UseSpaces | printer.TabIndent, Tabwidth: 8}).Fprint(&buf, fset, f); err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			buf.WriteString(t.helperDecls())
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid translation: %v", filenames[i], err)
+		}
+		out = append(out, src)
+	}
+	return out, nil
+}
+
+// A translator translates the files of a type-checked Wo package into
+// Go. It rewrites the syntax trees of the files in place.
+type translator struct {
+	pkg    *types.Package
+	info   *types.Info
+	files  []*ast.File
+	unused map[token.Pos]bool // positions of the unused variables
+	errs   scanner.ErrorList
+
+	helpers     map[string]string         // names of the helper declarations in use, by base name
+	methodFuncs map[*types.Func]string    // functions that replace methods with type parameters
+	constraints map[*types.TypeName]bool  // defined union types used as constraints
+	pkgNames    map[string]bool           // names declared in the package or file scopes
+	bindings    map[*ast.Ident]*types.Var // variables bound by cases of sum enum switches
+
+	// Per file.
+	file    *ast.File
+	imports map[string]string // local names of the imported packages, by path
+
+	// Per top-level declaration.
+	names   map[string]bool // identifiers that temporaries must not use
+	errName string          // name of the error temporaries; or ""
+	okName  string          // name of the boolean temporaries; or ""
+	fn      *funcState      // innermost enclosing function; or nil
+
+	// Per statement.
+	pre []ast.Stmt // statements to insert before the current statement
+	ret bool       // whether pre contains a return statement
+}
+
+// A funcState describes a function being translated.
+type funcState struct {
+	sig   *types.Signature
+	outer *funcState
+}
+
+func (t *translator) errorf(pos token.Pos, format string, args ...any) {
+	t.errs.Add(fset.Position(pos), fmt.Sprintf(format, args...))
+}
+
+// prepare collects information about the whole package that the
+// translation of a file needs.
+func (t *translator) prepare() {
+	t.pkgNames = make(map[string]bool)
+	t.methodFuncs = make(map[*types.Func]string)
+	t.constraints = make(map[*types.TypeName]bool)
+	t.bindings = make(map[*ast.Ident]*types.Var)
+	for _, name := range t.pkg.Scope().Names() {
+		t.pkgNames[name] = true
+	}
+	for _, f := range t.files {
+		for _, spec := range f.Imports {
+			if spec.Name != nil {
+				t.pkgNames[spec.Name.Name] = true
+			} else if obj, _ := t.info.Implicits[spec].(*types.PkgName); obj != nil {
+				t.pkgNames[obj.Name()] = true
+			}
+		}
+	}
+
+	// Methods with type parameters become functions named like the
+	// symbols of the methods, Recv.Method, with an underscore.
+	for _, f := range t.files {
+		for _, d := range f.Decls {
+			d, _ := d.(*ast.FuncDecl)
+			if d == nil || d.Recv == nil || d.Type.TypeParams == nil {
+				continue
+			}
+			fn, _ := t.info.Defs[d.Name].(*types.Func)
+			if fn == nil {
+				continue
+			}
+			recv := fn.Type().(*types.Signature).Recv().Type()
+			if p, ok := recv.(*types.Pointer); ok {
+				recv = p.Elem()
+			}
+			name := t.unique(recv.(*types.Named).Obj().Name()+"_"+fn.Name(), t.pkgNames)
+			t.pkgNames[name] = true
+			t.methodFuncs[fn] = name
+		}
+	}
+
+	// Defined union types remain constraints if they are used as such.
+	for _, f := range t.files {
+		ast.Inspect(f, func(n ast.Node) bool {
+			var list *ast.FieldList
+			switch n := n.(type) {
+			case *ast.FuncType:
+				list = n.TypeParams
+			case *ast.TypeSpec:
+				list = n.TypeParams
+			}
+			if list != nil {
+				for _, field := range list.List {
+					ast.Inspect(field.Type, func(n ast.Node) bool {
+						if id, ok := n.(*ast.Ident); ok {
+							if obj, ok := t.info.Uses[id].(*types.TypeName); ok && obj.Pkg() == t.pkg {
+								t.constraints[obj] = true
+							}
+						}
+						return true
+					})
+				}
+			}
+			return true
+		})
+	}
+}
+
+// translateFile translates the file f.
+func (t *translator) translateFile(f *ast.File) {
+	t.file = f
+	t.imports = make(map[string]string)
+	for _, spec := range f.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		obj, _ := t.info.Implicits[spec].(*types.PkgName)
+		if spec.Name != nil {
+			obj, _ = t.info.Defs[spec.Name].(*types.PkgName)
+		}
+		if obj != nil {
+			t.imports[path] = obj.Name()
+		}
+	}
+
+	// Replace the //wo:dialect directive by a header.
+	for _, g := range f.Comments {
+		if g.Pos() > f.Package {
+			break
+		}
+		for _, c := range g.List {
+			if c.Text == "//wo:dialect" || strings.HasPrefix(c.Text, "//wo:dialect ") {
+				c.Text = "// Code generated by wo2go. DO NOT EDIT."
+			}
+		}
+	}
+	f.Wo = false
+
+	var decls []ast.Decl
+	for _, d := range f.Decls {
+		decls = append(decls, t.decl(d)...)
+	}
+	if len(f.Decls) > 0 {
+		if d, ok := f.Decls[0].(*ast.GenDecl); ok && d.Tok == token.IMPORT && (len(decls) == 0 || decls[0] != ast.Decl(d)) {
+			// pkgName added an import declaration.
+			decls = append([]ast.Decl{d}, decls...)
+		}
+	}
+	f.Decls = decls
+	t.removeUnusedImports(f)
+	ast.SortImports(fset, f)
+	fixPositions(f, token.NoPos)
+}
+
+// positionFields are the names of the position fields of syntax
+// nodes that only serve to place comments and line breaks.
+var positionFields = map[string]bool{
+	"NamePos": true, "ValuePos": true, "OpPos": true, "TokPos": true,
+	"Lparen": true, "Rparen": true, "Lbrack": true, "Rbrack": true,
+	"Opening": true, "Closing": true, "Star": true, "Colon": true,
+	"If": true, "For": true, "Switch": true, "Return": true,
+	"Defer": true, "Go": true, "Case": true, "Struct": true, "Map": true,
+}
+
+// fixPositions gives the nodes in n that the translation created the
+// position of the preceding original node, or pos if there is none.
+// Otherwise, the printer would place the comments that follow them
+// inside of them.
+func fixPositions(n ast.Node, pos token.Pos) {
+	cur := pos
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.GenDecl:
+			if !n.TokPos.IsValid() {
+				n.TokPos = cur
+			}
+			cur = n.TokPos
+			return true
+		case nil, *ast.RangeStmt, *ast.BlockStmt:
+			// The positions of these nodes determine how they
+			// are printed.
+			if n != nil && n.Pos().IsValid() {
+				cur = n.Pos()
+			}
+			return true
+		}
+		v := reflect.ValueOf(n).Elem()
+		for i := range v.NumField() {
+			fv := v.Field(i)
+			if fv.Type() != reflect.TypeFor[token.Pos]() || !positionFields[v.Type().Field(i).Name] {
+				continue
+			}
+			if pos := token.Pos(fv.Int()); pos.IsValid() {
+				cur = pos
+			} else if cur.IsValid() {
+				fv.SetInt(int64(cur))
+			}
+		}
+		return true
+	})
+}
+
+// beginDecl prepares the translation of the top-level declaration d.
+func (t *translator) beginDecl(d ast.Node) {
+	t.names = make(map[string]bool)
+	for name := range t.pkgNames {
+		t.names[name] = true
+	}
+	for _, name := range t.helpers {
+		t.names[name] = true
+	}
+	ast.Inspect(d, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			t.names[id.Name] = true
+		}
+		return true
+	})
+	t.errName = ""
+	t.okName = ""
+	t.fn = nil
+	t.pre = nil
+}
+
+// unique returns base, or base followed by the smallest positive
+// number, whichever is not in names.
+func (t *translator) unique(base string, names map[string]bool) string {
+	name := base
+	for i := 1; names[name]; i++ {
+		name = base + strconv.Itoa(i)
+	}
+	return name
+}
+
+// newName returns the name of a new temporary variable.
+func (t *translator) newName(base string) *ast.Ident {
+	name := t.unique(base, t.names)
+	t.names[name] = true
+	return ast.NewIdent(name)
+}
+
+// errIdent returns the name of the error temporaries of the current
+// top-level declaration, which may be redeclared in each statement.
+func (t *translator) errIdent() *ast.Ident {
+	if t.errName == "" {
+		t.errName = t.newName("err").Name
+	}
+	return ast.NewIdent(t.errName)
+}
+
+// okIdent is like errIdent for boolean temporaries.
+func (t *translator) okIdent() *ast.Ident {
+	if t.okName == "" {
+		t.okName = t.newName("ok").Name
+	}
+	return ast.NewIdent(t.okName)
+}
+
+// pkgName returns the name by which the current file refers to the
+// package pkg, adding an import of pkg if necessary.
+func (t *translator) pkgName(pkg *types.Package) string {
+	if name, ok := t.imports[pkg.Path()]; ok {
+		return name
+	}
+	names := make(map[string]bool)
+	for name := range t.pkgNames {
+		names[name] = true
+	}
+	for _, name := range t.imports {
+		names[name] = true
+	}
+	name := t.unique(pkg.Name(), names)
+	spec := &ast.ImportSpec{Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(pkg.Path())}}
+	if name != pkg.Name() {
+		spec.Name = ast.NewIdent(name)
+	}
+	t.imports[pkg.Path()] = name
+	t.pkgNames[name] = true
+	if t.names != nil {
+		t.names[name] = true
+	}
+
+	f := t.file
+	var decl *ast.GenDecl
+	if len(f.Decls) > 0 {
+		if d, ok := f.Decls[0].(*ast.GenDecl); ok && d.Tok == token.IMPORT {
+			decl = d
+		}
+	}
+	if decl == nil {
+		decl = &ast.GenDecl{Tok: token.IMPORT, TokPos: f.Name.End()}
+		f.Decls = append([]ast.Decl{decl}, f.Decls...)
+	}
+	if !decl.Lparen.IsValid() && len(decl.Specs) > 0 {
+		decl.Lparen = decl.TokPos
+		decl.Rparen = decl.Specs[0].End()
+	}
+	if len(decl.Specs) > 0 {
+		spec.Path.ValuePos = decl.Specs[len(decl.Specs)-1].End()
+	} else {
+		spec.Path.ValuePos = decl.TokPos
+	}
+	decl.Specs = append(decl.Specs, spec)
+	f.Imports = append(f.Imports, spec)
+	return name
+}
+
+// removeUnusedImports removes the imports of f that the translation
+// no longer refers to, which may happen if union types are dropped.
+func (t *translator) removeUnusedImports(f *ast.File) {
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+	for _, d := range f.Decls {
+		d, ok := d.(*ast.GenDecl)
+		if !ok || d.Tok != token.IMPORT {
+			continue
+		}
+		specs := d.Specs[:0]
+		for _, s := range d.Specs {
+			s := s.(*ast.ImportSpec)
+			path, _ := strconv.Unquote(s.Path.Value)
+			name, ok := t.imports[path]
+			if ok && !used[name] && (s.Name == nil || s.Name.Name != "_" && s.Name.Name != ".") {
+				continue
+			}
+			specs = append(specs, s)
+		}
+		d.Specs = specs
+	}
+	imports := f.Imports[:0]
+	for _, s := range f.Imports {
+		path, _ := strconv.Unquote(s.Path.Value)
+		if name, ok := t.imports[path]; !ok || used[name] || s.Name != nil && (s.Name.Name == "_" || s.Name.Name == ".") {
+			imports = append(imports, s)
+		}
+	}
+	f.Imports = imports
+}
+
+// Helper declarations.
+const (
+	helperOptional = "woOptional"
+	helperOrElse   = "woOrElse"
+	helperContains = "woContains"
+)
+
+var helperSource = map[string]string{
+	helperOptional: `
+// woOptional represents the Wo optional type T?.
+type woOptional[T any] = struct {
+	Value T
+	Ok    bool
+}
+`,
+	helperOrElse: `
+// woOrElse returns the value of o if it is present and v otherwise.
+func woOrElse[T any](o woOptional[T], v T) T {
+	if o.Ok {
+		return o.Value
+	}
+	return v
+}
+`,
+	helperContains: `
+// woContains reports whether the Wo set s contains e.
+func woContains[E comparable](s map[E]struct{}, e E) bool {
+	_, ok := s[e]
+	return ok
+}
+`,
+}
+
+// helper returns the name of the helper declaration with the given
+// base name.
+func (t *translator) helper(base string) *ast.Ident {
+	if base == helperOrElse {
+		t.helper(helperOptional)
+	}
+	name, ok := t.helpers[base]
+	if !ok {
+		names := make(map[string]bool)
+		for name := range t.pkgNames {
+			names[name] = true
+		}
+		for _, f := range t.files {
+			ast.Inspect(f, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok {
+					names[id.Name] = true
+				}
+				return true
+			})
+		}
+		for _, name := range t.helpers {
+			names[name] = true
+		}
+		name = t.unique(base, names)
+		t.helpers[base] = name
+		t.pkgNames[name] = true
+		if t.names != nil {
+			t.names[name] = true
+		}
+	}
+	return ast.NewIdent(name)
+}
+
+// helperDecls returns the source of the helper declarations in use.
+func (t *translator) helperDecls() string {
+	var bases, pairs []string
+	for base, name := range t.helpers {
+		bases = append(bases, base)
+		pairs = append(pairs, base, name)
+	}
+	sort.Strings(bases)
+	r := strings.NewReplacer(pairs...)
+	var b strings.Builder
+	for _, base := range bases {
+		b.WriteString(r.Replace(helperSource[base]))
+	}
+	return b.String()
+}
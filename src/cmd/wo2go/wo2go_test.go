@@ -0,0 +1,310 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"internal/testenv"
+)
+
+// TestRoundTrip translates the Wo run and rundir tests in
+// $GOROOT/test/wo, checks that the translations are plain Go, runs the
+// resulting Go programs, and checks that they print the same as the Wo
+// programs.
+func TestRoundTrip(t *testing.T) {
+	testenv.MustHaveGoRun(t)
+
+	files, err := filepath.Glob(filepath.Join(testenv.GOROOT(t), "test", "wo", "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := strings.TrimSuffix(filepath.Base(file), ".go")
+		var pkgs []*testPkg
+		switch {
+		case bytes.HasPrefix(src, []byte("// run\n")):
+			pkgs = []*testPkg{{name: "main", files: []string{file}}}
+		case bytes.HasPrefix(src, []byte("// rundir\n")):
+			pkgs = dirPackages(t, strings.TrimSuffix(file, ".go")+".dir")
+		default:
+			continue
+		}
+		t.Run(name, func(t *testing.T) {
+			if reason, ok := untranslatable[name]; ok {
+				t.Skipf("wo2go cannot translate %s", reason)
+			}
+			t.Parallel()
+			roundTrip(t, pkgs)
+		})
+	}
+}
+
+// untranslatable maps the names of the Wo tests that use constructs
+// without a Go equivalent to a description of those constructs.
+var untranslatable = map[string]string{
+	"defaultimport": "default values that are unexported names of another package",
+	"exportimport":  "export modifiers on identifiers that Go would not export",
+}
+
+// A testPkg is a package of a Wo test program.
+type testPkg struct {
+	name  string   // package name
+	files []string // source files
+}
+
+// path returns the import path of p in the module written by
+// writeModule, or "" for the main package.
+func (p *testPkg) path() string {
+	if p.name == "main" {
+		return ""
+	}
+	return "wo2gotest/" + p.name
+}
+
+// dir returns the directory of p in the module written by writeModule.
+func (p *testPkg) dir() string {
+	if p.name == "main" {
+		return "."
+	}
+	return p.name
+}
+
+// dirPackages returns the packages of the rundir test in dir. As for
+// the compiler tests, the files are grouped by package name, and each
+// package only imports packages of files that sort before its own.
+func dirPackages(t *testing.T, dir string) []*testPkg {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pkgs []*testPkg
+	m := make(map[string]*testPkg)
+	for _, file := range files {
+		f, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.PackageClauseOnly)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p := m[f.Name.Name]
+		if p == nil {
+			p = &testPkg{name: f.Name.Name}
+			pkgs = append(pkgs, p)
+			m[p.name] = p
+		}
+		p.files = append(p.files, file)
+	}
+	return pkgs
+}
+
+// roundTrip runs the Wo program consisting of pkgs and its
+// translation, and compares their output.
+func roundTrip(t *testing.T, pkgs []*testPkg) {
+	srcs := make(map[string][]byte)
+	for _, p := range pkgs {
+		for _, file := range p.files {
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			srcs[file] = src
+		}
+	}
+	wodir := writeModule(t, pkgs, srcs)
+	want := goRun(t, wodir)
+
+	// Translate the packages in the module, where their imports resolve.
+	lookup, err := exportLookup(wodir, []string{"./..."})
+	if err != nil {
+		t.Fatal(err)
+	}
+	imp := importer.ForCompiler(fset, "gc", lookup)
+	checked := make(map[string]*types.Package)
+	goImp := importerFunc(func(path string) (*types.Package, error) {
+		if pkg := checked[path]; pkg != nil {
+			return pkg, nil
+		}
+		return imp.Import(path)
+	})
+	gosrcs := make(map[string][]byte)
+	for _, p := range pkgs {
+		var names []string
+		for _, file := range p.files {
+			names = append(names, filepath.Join(wodir, p.dir(), filepath.Base(file)))
+		}
+		out, err := translate(names, imp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, file := range p.files {
+			if formatted, err := format.Source(out[i]); err != nil || !bytes.Equal(formatted, out[i]) {
+				t.Errorf("%s: translation is not gofmt-clean (err = %v)", filepath.Base(file), err)
+			}
+			gosrcs[file] = out[i]
+		}
+		checked[p.path()] = checkGo(t, p, gosrcs, goImp)
+	}
+	if t.Failed() {
+		return
+	}
+
+	got := goRun(t, writeModule(t, pkgs, gosrcs))
+	if !bytes.Equal(got, want) {
+		var translation []byte
+		for _, p := range pkgs {
+			for _, file := range p.files {
+				translation = append(translation, gosrcs[file]...)
+			}
+		}
+		t.Errorf("translation prints\n%s\nwant\n%s\ntranslation:\n%s", got, want, translation)
+	}
+}
+
+// checkGo type-checks the translations srcs of the files of p as Go
+// files, which may not use any Wo features, and returns the package.
+func checkGo(t *testing.T, p *testPkg, srcs map[string][]byte, imp types.Importer) *types.Package {
+	t.Helper()
+	var files []*ast.File
+	for _, file := range p.files {
+		f, err := parser.ParseFile(fset, filepath.Base(file), srcs[file], parser.ParseComments)
+		if err != nil {
+			t.Fatalf("translation is not Go: %v", err)
+		}
+		if f.Wo {
+			t.Errorf("%s: translation is parsed as Wo", filepath.Base(file))
+		}
+		files = append(files, f)
+	}
+	conf := types.Config{Importer: imp, GoVersion: goVersion()}
+	pkg, err := conf.Check(p.path(), fset, files, nil)
+	if err != nil {
+		t.Errorf("translation is not Go: %v", err)
+	}
+	return pkg
+}
+
+type importerFunc func(path string) (*types.Package, error)
+
+func (f importerFunc) Import(path string) (*types.Package, error) { return f(path) }
+
+// goVersion returns the Go version of the current release.
+func goVersion() string {
+	tags := build.Default.ReleaseTags
+	return tags[len(tags)-1]
+}
+
+// writeModule writes the program consisting of pkgs into a new module
+// and returns its directory. The sources of the files are srcs, in
+// which the relative imports of the compiler tests are replaced by
+// imports of the module's packages.
+func writeModule(t *testing.T, pkgs []*testPkg, srcs map[string][]byte) string {
+	t.Helper()
+	dir := t.TempDir()
+	gomod := "module wo2gotest\n\ngo " + strings.TrimPrefix(goVersion(), "go") + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte(gomod), 0o666); err != nil {
+		t.Fatal(err)
+	}
+	for _, p := range pkgs {
+		if err := os.MkdirAll(filepath.Join(dir, p.dir()), 0o777); err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range p.files {
+			src := bytes.ReplaceAll(srcs[file], []byte(`"./`), []byte(`"wo2gotest/`))
+			if err := os.WriteFile(filepath.Join(dir, p.dir(), filepath.Base(file)), src, 0o666); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return dir
+}
+
+// goRun runs the main package in dir and returns its standard output.
+func goRun(t *testing.T, dir string) []byte {
+	t.Helper()
+	cmd := testenv.Command(t, testenv.GoToolPath(t), "run", ".")
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("go run: %v\n%s", err, stderr.Bytes())
+	}
+	return stdout
+}
+
+var nameTests = []struct {
+	name string
+	in   string
+	want string // a line of the translation
+}{
+	{
+		name: "err",
+		in: `func f(err error) int! {
+	return strconv.Atoi("1")!, err
+}`,
+		want: "v, err1 := strconv.Atoi(\"1\")",
+	},
+	{
+		name: "reuse",
+		in: `func f() int! {
+	var a = strconv.Atoi("1")!
+	var b = strconv.Atoi("2")!
+	return a + b, nil
+}`,
+		want: "b, err := strconv.Atoi(\"2\")",
+	},
+	{
+		name: "temps",
+		in: `func f(v map[string]int) int? {
+	return v["a"]? + 1
+}`,
+		want: "v1, ok := v[\"a\"]",
+	},
+	{
+		name: "helper",
+		in: `var woOptional int
+
+func f(x int?) int { return x.OrElse(woOptional) }`,
+		want: "func f(x woOptional1[int]) int { return woOrElse(x, woOptional) }",
+	},
+}
+
+// TestNames checks that the names of temporaries and helpers avoid
+// the names of the translated package.
+func TestNames(t *testing.T) {
+	for _, tt := range nameTests {
+		t.Run(tt.name, func(t *testing.T) {
+			file := filepath.Join(t.TempDir(), "x.wo")
+			src := "package p\n\nimport \"strconv\"\n\nvar _ = strconv.Itoa\n\n" + tt.in + "\n"
+			if err := os.WriteFile(file, []byte(src), 0o666); err != nil {
+				t.Fatal(err)
+			}
+			out, err := translate([]string{file}, importer.ForCompiler(fset, "gc", nil))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, line := range strings.Split(string(out[0]), "\n") {
+				if strings.TrimSpace(line) == tt.want {
+					return
+				}
+			}
+			t.Errorf("translation has no line %q:\n%s", tt.want, out[0])
+		})
+	}
+}
@@ -194,15 +194,26 @@ func isDirective(c string) bool {
 // in a signature.
 // [Field.Names] is nil for unnamed parameters (parameter lists which only contain types)
 // and embedded struct fields. In the latter case, the field name is the type name.
+// [Field.Default] is the default value of a parameter of a Wo function
+// declaration; the parameter type is nil if it is inferred from it.
+// The default value applies to the last name of the field only.
+// [Field.Skip] is the position of the skip modifier of skippable Wo
+// results; it applies to the first name of the field only, or to
+// the type of an unnamed result.
 type Field struct {
 	Doc     *CommentGroup // associated documentation; or nil
 	Names   []*Ident      // field/method/(type) parameter names; or nil
 	Type    Expr          // field/method/parameter type; or nil
 	Tag     *BasicLit     // field tag; or nil
 	Comment *CommentGroup // line comments; or nil
+	Default Expr          // default parameter value (Wo); or nil
+	Skip    token.Pos     // position of "skip" (Wo); or token.NoPos
 }
 
 func (f *Field) Pos() token.Pos {
+	if f.Skip.IsValid() {
+		return f.Skip
+	}
 	if len(f.Names) > 0 {
 		return f.Names[0].Pos()
 	}
@@ -216,6 +227,9 @@ func (f *Field) End() token.Pos {
 	if f.Tag != nil {
 		return f.Tag.End()
 	}
+	if f.Default != nil {
+		return f.Default.End()
+	}
 	if f.Type != nil {
 		return f.Type.End()
 	}
@@ -272,6 +286,29 @@ func (f *FieldList) NumFields() int {
 	return n
 }
 
+// A Variant represents a variant of a Wo enum type. A variant either
+// provides values for the fields shared by all variants of the enum
+// type, or declares fields of its own.
+type Variant struct {
+	Name   *Ident     // variant name
+	Lparen token.Pos  // position of "(" before Values; or token.NoPos
+	Values []Expr     // values of the fields shared by all variants; or nil
+	Rparen token.Pos  // position of ")" after Values; or token.NoPos
+	Fields *FieldList // parenthesized fields of the variant; or nil
+}
+
+func (v *Variant) Pos() token.Pos { return v.Name.Pos() }
+
+func (v *Variant) End() token.Pos {
+	if v.Rparen.IsValid() {
+		return v.Rparen + 1
+	}
+	if v.Fields != nil {
+		return v.Fields.End()
+	}
+	return v.Name.End()
+}
+
 // An expression is represented by a tree consisting of one
 // or more of the following concrete expression nodes.
 type (
@@ -323,6 +360,17 @@ type (
 		Body *BlockStmt // function body
 	}
 
+	// A LambdaExpr node represents a Wo function literal Params -> Body.
+	// The types of the parameters and results are inferred from the
+	// context.
+	LambdaExpr struct {
+		Lparen token.Pos // position of "("; or token.NoPos for a single parameter without parentheses
+		Params []*Ident  // parameter names; or nil
+		Rparen token.Pos // position of ")"; or token.NoPos
+		Arrow  token.Pos // position of "->"
+		Body   Expr      // function body
+	}
+
 	// A CompositeLit node represents a composite literal.
 	CompositeLit struct {
 		Type       Expr      // literal type; or nil
@@ -417,6 +465,40 @@ type (
 		Y     Expr        // right operand
 	}
 
+	// A PostfixExpr node represents a Wo postfix expression X? or X!.
+	// If X is a type, it denotes an optional type or a result type,
+	// respectively; otherwise it unwraps an optional value or
+	// propagates an error.
+	//
+	PostfixExpr struct {
+		X     Expr        // operand
+		OpPos token.Pos   // position of Op
+		Op    token.Token // token.QUESTION or token.NOT
+	}
+
+	// A CondExpr node represents a Wo conditional expression
+	// "if" Cond "then" X "else" Y.
+	//
+	CondExpr struct {
+		If   token.Pos // position of "if" keyword
+		Cond Expr      // condition
+		Then token.Pos // position of "then"
+		X    Expr      // value if Cond is true
+		Else token.Pos // position of "else" keyword
+		Y    Expr      // value if Cond is false
+	}
+
+	// A BindingExpr node represents a Wo conditional binding
+	// "var" Names [Type] "=" Value, which is the condition of an
+	// if or for statement.
+	//
+	BindingExpr struct {
+		Var   token.Pos // position of "var" keyword
+		Names []*Ident  // bound variables
+		Type  Expr      // type of the variables; or nil
+		Value Expr      // bound value
+	}
+
 	// A KeyValueExpr node represents (key : value) pairs
 	// in composite literals.
 	//
@@ -457,18 +539,38 @@ type (
 	// Pointer types are represented via StarExpr nodes.
 
 	// A FuncType node represents a function type.
+	//
+	// A Wo arrow function type Params -> Results has a valid Arrow
+	// position. Its parameter and result lists are not enclosed in
+	// parentheses if they consist of a single type, and Results is
+	// nil if the function has no results ("_").
 	FuncType struct {
 		Func       token.Pos  // position of "func" keyword (token.NoPos if there is no "func")
 		TypeParams *FieldList // type parameters; or nil
 		Params     *FieldList // (incoming) parameters; non-nil
 		Results    *FieldList // (outgoing) results; or nil
+		Arrow      token.Pos  // position of "->" (Wo); or token.NoPos
 	}
 
 	// An InterfaceType node represents an interface type.
+	//
+	// A Wo interface type written in angle brackets has no "interface"
+	// keyword, and the Opening and Closing positions of its Methods
+	// are the positions of "<" and ">".
 	InterfaceType struct {
-		Interface  token.Pos  // position of "interface" keyword
+		Interface  token.Pos  // position of "interface" keyword; or token.NoPos
 		Methods    *FieldList // list of embedded interfaces, methods, or types
 		Incomplete bool       // true if (source) methods or types are missing in the Methods list
+		Compact    bool       // true if written as <...> (Wo)
+	}
+
+	// An EnumType node represents a Wo enum type.
+	EnumType struct {
+		Enum     token.Pos  // position of "enum"
+		Lbrace   token.Pos  // position of "{"
+		Variants []*Variant // list of variants
+		Fields   *FieldList // fields shared by all variants; or nil
+		Rbrace   token.Pos  // position of "}"
 	}
 
 	// A MapType node represents a map type.
@@ -494,6 +596,15 @@ func (x *Ident) Pos() token.Pos    { return x.NamePos }
 func (x *Ellipsis) Pos() token.Pos { return x.Ellipsis }
 func (x *BasicLit) Pos() token.Pos { return x.ValuePos }
 func (x *FuncLit) Pos() token.Pos  { return x.Type.Pos() }
+func (x *LambdaExpr) Pos() token.Pos {
+	if x.Lparen.IsValid() {
+		return x.Lparen
+	}
+	if len(x.Params) > 0 {
+		return x.Params[0].Pos()
+	}
+	return x.Arrow
+}
 func (x *CompositeLit) Pos() token.Pos {
 	if x.Type != nil {
 		return x.Type.Pos()
@@ -510,6 +621,9 @@ func (x *CallExpr) Pos() token.Pos       { return x.Fun.Pos() }
 func (x *StarExpr) Pos() token.Pos       { return x.Star }
 func (x *UnaryExpr) Pos() token.Pos      { return x.OpPos }
 func (x *BinaryExpr) Pos() token.Pos     { return x.X.Pos() }
+func (x *PostfixExpr) Pos() token.Pos    { return x.X.Pos() }
+func (x *CondExpr) Pos() token.Pos       { return x.If }
+func (x *BindingExpr) Pos() token.Pos    { return x.Var }
 func (x *KeyValueExpr) Pos() token.Pos   { return x.Key.Pos() }
 func (x *ArrayType) Pos() token.Pos      { return x.Lbrack }
 func (x *StructType) Pos() token.Pos     { return x.Struct }
@@ -519,9 +633,15 @@ func (x *FuncType) Pos() token.Pos {
 	}
 	return x.Params.Pos() // interface method declarations have no "func" keyword
 }
-func (x *InterfaceType) Pos() token.Pos { return x.Interface }
-func (x *MapType) Pos() token.Pos       { return x.Map }
-func (x *ChanType) Pos() token.Pos      { return x.Begin }
+func (x *InterfaceType) Pos() token.Pos {
+	if x.Interface.IsValid() || x.Methods == nil {
+		return x.Interface
+	}
+	return x.Methods.Pos() // Wo <...> interface types have no "interface" keyword
+}
+func (x *EnumType) Pos() token.Pos { return x.Enum }
+func (x *MapType) Pos() token.Pos  { return x.Map }
+func (x *ChanType) Pos() token.Pos { return x.Begin }
 
 func (x *BadExpr) End() token.Pos { return x.To }
 func (x *Ident) End() token.Pos   { return token.Pos(int(x.NamePos) + len(x.Name)) }
@@ -533,6 +653,7 @@ func (x *Ellipsis) End() token.Pos {
 }
 func (x *BasicLit) End() token.Pos       { return token.Pos(int(x.ValuePos) + len(x.Value)) }
 func (x *FuncLit) End() token.Pos        { return x.Body.End() }
+func (x *LambdaExpr) End() token.Pos     { return x.Body.End() }
 func (x *CompositeLit) End() token.Pos   { return x.Rbrace + 1 }
 func (x *ParenExpr) End() token.Pos      { return x.Rparen + 1 }
 func (x *SelectorExpr) End() token.Pos   { return x.Sel.End() }
@@ -544,6 +665,9 @@ func (x *CallExpr) End() token.Pos       { return x.Rparen + 1 }
 func (x *StarExpr) End() token.Pos       { return x.X.End() }
 func (x *UnaryExpr) End() token.Pos      { return x.X.End() }
 func (x *BinaryExpr) End() token.Pos     { return x.Y.End() }
+func (x *PostfixExpr) End() token.Pos    { return x.OpPos + 1 }
+func (x *CondExpr) End() token.Pos       { return x.Y.End() }
+func (x *BindingExpr) End() token.Pos    { return x.Value.End() }
 func (x *KeyValueExpr) End() token.Pos   { return x.Value.End() }
 func (x *ArrayType) End() token.Pos      { return x.Elt.End() }
 func (x *StructType) End() token.Pos     { return x.Fields.End() }
@@ -551,9 +675,13 @@ func (x *FuncType) End() token.Pos {
 	if x.Results != nil {
 		return x.Results.End()
 	}
+	if x.Arrow.IsValid() {
+		return x.Arrow + 4 // len("-> _")
+	}
 	return x.Params.End()
 }
 func (x *InterfaceType) End() token.Pos { return x.Methods.End() }
+func (x *EnumType) End() token.Pos      { return x.Rbrace + 1 }
 func (x *MapType) End() token.Pos       { return x.Value.End() }
 func (x *ChanType) End() token.Pos      { return x.Value.End() }
 
@@ -564,6 +692,7 @@ func (*Ident) exprNode()          {}
 func (*Ellipsis) exprNode()       {}
 func (*BasicLit) exprNode()       {}
 func (*FuncLit) exprNode()        {}
+func (*LambdaExpr) exprNode()     {}
 func (*CompositeLit) exprNode()   {}
 func (*ParenExpr) exprNode()      {}
 func (*SelectorExpr) exprNode()   {}
@@ -575,12 +704,16 @@ func (*CallExpr) exprNode()       {}
 func (*StarExpr) exprNode()       {}
 func (*UnaryExpr) exprNode()      {}
 func (*BinaryExpr) exprNode()     {}
+func (*PostfixExpr) exprNode()    {}
+func (*CondExpr) exprNode()       {}
+func (*BindingExpr) exprNode()    {}
 func (*KeyValueExpr) exprNode()   {}
 
 func (*ArrayType) exprNode()     {}
 func (*StructType) exprNode()    {}
 func (*FuncType) exprNode()      {}
 func (*InterfaceType) exprNode() {}
+func (*EnumType) exprNode()      {}
 func (*MapType) exprNode()       {}
 func (*ChanType) exprNode()      {}
 
@@ -663,11 +796,15 @@ type (
 	// An AssignStmt node represents an assignment or
 	// a short variable declaration.
 	//
+	// The Wo typed declaration "x T = v" has the Tok DEFINE, a
+	// single Lhs and Rhs, and a non-nil Type; TokPos is the
+	// position of "=".
 	AssignStmt struct {
 		Lhs    []Expr
 		TokPos token.Pos   // position of Tok
 		Tok    token.Token // assignment token, DEFINE
 		Rhs    []Expr
+		Type   Expr // type of a Wo typed declaration; or nil
 	}
 
 	// A GoStmt node represents a go statement.
@@ -705,6 +842,9 @@ type (
 	}
 
 	// An IfStmt node represents an if statement.
+	//
+	// The Wo conditional binding "if var x = v" is represented by a
+	// nil Init and a *BindingExpr Cond.
 	IfStmt struct {
 		If   token.Pos // position of "if" keyword
 		Init Stmt      // initialization statement; or nil
@@ -752,6 +892,9 @@ type (
 	}
 
 	// A ForStmt represents a for statement.
+	//
+	// The Wo conditional binding "for var x = v" is represented by a
+	// *BindingExpr Cond, and a nil Init and Post.
 	ForStmt struct {
 		For  token.Pos // position of "for" keyword
 		Init Stmt      // initialization statement; or nil
@@ -761,12 +904,15 @@ type (
 	}
 
 	// A RangeStmt represents a for statement with a range clause.
+	//
+	// The Wo range clause "Key, Value : X" has the Tok COLON and
+	// no "range" keyword.
 	RangeStmt struct {
 		For        token.Pos   // position of "for" keyword
 		Key, Value Expr        // Key, Value may be nil
 		TokPos     token.Pos   // position of Tok; invalid if Key == nil
-		Tok        token.Token // ILLEGAL if Key == nil, ASSIGN, DEFINE
-		Range      token.Pos   // position of "range" keyword
+		Tok        token.Token // ILLEGAL if Key == nil, ASSIGN, DEFINE, or COLON (Wo)
+		Range      token.Pos   // position of "range" keyword; or token.NoPos
 		X          Expr        // value to range over
 		Body       *BlockStmt
 	}
@@ -981,30 +1127,69 @@ type (
 	//	token.TYPE    *TypeSpec
 	//	token.VAR     *ValueSpec
 	//
+	// In Wo files, a declaration may be preceded by the visibility
+	// modifier "export" or "pkg"; for function declarations, it may
+	// also follow the "func" keyword.
+	//
 	GenDecl struct {
 		Doc    *CommentGroup // associated documentation; or nil
 		TokPos token.Pos     // position of Tok
 		Tok    token.Token   // IMPORT, CONST, TYPE, or VAR
 		Lparen token.Pos     // position of '(', if any
 		Specs  []Spec
-		Rparen token.Pos // position of ')', if any
+		Rparen token.Pos  // position of ')', if any
+		VisPos token.Pos  // position of Vis; or token.NoPos
+		Vis    Visibility // visibility modifier (Wo)
 	}
 
 	// A FuncDecl node represents a function declaration.
 	FuncDecl struct {
-		Doc  *CommentGroup // associated documentation; or nil
-		Recv *FieldList    // receiver (methods); or nil (functions)
-		Name *Ident        // function/method name
-		Type *FuncType     // function signature: type and value parameters, results, and position of "func" keyword
-		Body *BlockStmt    // function body; or nil for external (non-Go) function
+		Doc    *CommentGroup // associated documentation; or nil
+		Recv   *FieldList    // receiver (methods); or nil (functions)
+		Name   *Ident        // function/method name
+		Type   *FuncType     // function signature: type and value parameters, results, and position of "func" keyword
+		Body   *BlockStmt    // function body; or nil for external (non-Go) function
+		VisPos token.Pos     // position of Vis; or token.NoPos
+		Vis    Visibility    // visibility modifier (Wo)
 	}
 )
 
+// A Visibility is the visibility modifier of a Wo package-level
+// declaration, which overrides the capitalization rule for exported
+// identifiers.
+type Visibility int
+
+const (
+	DefaultVis Visibility = iota // no modifier
+	ExportVis                    // export: visible to all importing packages
+	PkgVis                       // pkg: visible to the packages of the same module
+)
+
+func (v Visibility) String() string {
+	switch v {
+	case ExportVis:
+		return "export"
+	case PkgVis:
+		return "pkg"
+	}
+	return "default"
+}
+
 // Pos and End implementations for declaration nodes.
 
-func (d *BadDecl) Pos() token.Pos  { return d.From }
-func (d *GenDecl) Pos() token.Pos  { return d.TokPos }
-func (d *FuncDecl) Pos() token.Pos { return d.Type.Pos() }
+func (d *BadDecl) Pos() token.Pos { return d.From }
+func (d *GenDecl) Pos() token.Pos {
+	if d.VisPos.IsValid() {
+		return d.VisPos
+	}
+	return d.TokPos
+}
+func (d *FuncDecl) Pos() token.Pos {
+	if d.VisPos.IsValid() && d.VisPos < d.Type.Pos() {
+		return d.VisPos
+	}
+	return d.Type.Pos()
+}
 
 func (d *BadDecl) End() token.Pos { return d.To }
 func (d *GenDecl) End() token.Pos {
@@ -1062,6 +1247,7 @@ type File struct {
 	Unresolved         []*Ident        // unresolved identifiers in this file. Deprecated: see Object
 	Comments           []*CommentGroup // list of all comments in the source file
 	GoVersion          string          // minimum Go version required by //go:build or // +build directives
+	Wo                 bool            // whether the file is written in the Wo dialect
 }
 
 // Pos returns the position of the package declaration.
@@ -12,8 +12,22 @@ import (
 	"io"
 	"os"
 	"reflect"
+	"slices"
 )
 
+// woFields are the fields of AST nodes that only represent Wo syntax.
+// They are omitted from the output if they have their zero value, so
+// that the output for Go source doesn't depend on them.
+var woFields = map[reflect.Type][]string{
+	reflect.TypeFor[AssignStmt]():    {"Type"},
+	reflect.TypeFor[Field]():         {"Default", "Skip"},
+	reflect.TypeFor[File]():          {"Wo"},
+	reflect.TypeFor[FuncDecl]():      {"VisPos", "Vis"},
+	reflect.TypeFor[FuncType]():      {"Arrow"},
+	reflect.TypeFor[GenDecl]():       {"VisPos", "Vis"},
+	reflect.TypeFor[InterfaceType](): {"Compact"},
+}
+
 // A FieldFilter may be provided to [Fprint] to control the output.
 type FieldFilter func(name string, value reflect.Value) bool
 
@@ -35,7 +49,8 @@ func NotNilFilter(_ string, v reflect.Value) bool {
 // A non-nil [FieldFilter] f may be provided to control the output:
 // struct fields for which f(fieldname, fieldvalue) is true are
 // printed; all others are filtered from the output. Unexported
-// struct fields are never printed.
+// struct fields are never printed, and neither are fields that only
+// represent Wo syntax if they have their zero value.
 func Fprint(w io.Writer, fset *token.FileSet, x any, f FieldFilter) error {
 	return fprint(w, fset, x, f)
 }
@@ -220,6 +235,9 @@ func (p *printer) print(x reflect.Value) {
 			// values cannot be accessed via reflection
 			if name := t.Field(i).Name; IsExported(name) {
 				value := x.Field(i)
+				if slices.Contains(woFields[t], name) && value.IsZero() {
+					continue
+				}
 				if p.filter == nil || p.filter(name, value) {
 					if first {
 						p.printf("\n")
@@ -5,6 +5,7 @@
 package ast
 
 import (
+	"go/token"
 	"strings"
 	"testing"
 )
@@ -65,6 +66,28 @@ var tests = []struct {
 		1  .  X: 42
 		2  .  Y: 991
 		3  }`},
+
+	// Wo fields are omitted if they are zero
+	{GenDecl{Tok: token.TYPE},
+		`0  ast.GenDecl {
+		1  .  Doc: nil
+		2  .  TokPos: 0
+		3  .  Tok: type
+		4  .  Lparen: 0
+		5  .  Specs: nil
+		6  .  Rparen: 0
+		7  }`},
+	{GenDecl{Tok: token.TYPE, VisPos: 1, Vis: ExportVis},
+		`0  ast.GenDecl {
+		1  .  Doc: nil
+		2  .  TokPos: 0
+		3  .  Tok: type
+		4  .  Lparen: 0
+		5  .  Specs: nil
+		6  .  Rparen: 0
+		7  .  VisPos: 1
+		8  .  Vis: export
+		9  }`},
 }
 
 // Split s into lines, trim whitespace from all lines, and return
@@ -54,6 +54,9 @@ func Walk(v Visitor, node Node) {
 		if n.Type != nil {
 			Walk(v, n.Type)
 		}
+		if n.Default != nil {
+			Walk(v, n.Default)
+		}
 		if n.Tag != nil {
 			Walk(v, n.Tag)
 		}
@@ -64,6 +67,13 @@ func Walk(v Visitor, node Node) {
 	case *FieldList:
 		walkList(v, n.List)
 
+	case *Variant:
+		Walk(v, n.Name)
+		walkList(v, n.Values)
+		if n.Fields != nil {
+			Walk(v, n.Fields)
+		}
+
 	// Expressions
 	case *BadExpr, *Ident, *BasicLit:
 		// nothing to do
@@ -77,6 +87,10 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.Type)
 		Walk(v, n.Body)
 
+	case *LambdaExpr:
+		walkList(v, n.Params)
+		Walk(v, n.Body)
+
 	case *CompositeLit:
 		if n.Type != nil {
 			Walk(v, n.Type)
@@ -130,6 +144,21 @@ func Walk(v Visitor, node Node) {
 		Walk(v, n.X)
 		Walk(v, n.Y)
 
+	case *PostfixExpr:
+		Walk(v, n.X)
+
+	case *CondExpr:
+		Walk(v, n.Cond)
+		Walk(v, n.X)
+		Walk(v, n.Y)
+
+	case *BindingExpr:
+		walkList(v, n.Names)
+		if n.Type != nil {
+			Walk(v, n.Type)
+		}
+		Walk(v, n.Value)
+
 	case *KeyValueExpr:
 		Walk(v, n.Key)
 		Walk(v, n.Value)
@@ -158,6 +187,12 @@ func Walk(v Visitor, node Node) {
 	case *InterfaceType:
 		Walk(v, n.Methods)
 
+	case *EnumType:
+		walkList(v, n.Variants)
+		if n.Fields != nil {
+			Walk(v, n.Fields)
+		}
+
 	case *MapType:
 		Walk(v, n.Key)
 		Walk(v, n.Value)
@@ -191,6 +226,9 @@ func Walk(v Visitor, node Node) {
 
 	case *AssignStmt:
 		walkList(v, n.Lhs)
+		if n.Type != nil {
+			Walk(v, n.Type)
+		}
 		walkList(v, n.Rhs)
 
 	case *GoStmt:
@@ -31,3 +31,36 @@ func TestPreorderBreak(t *testing.T) {
 		}
 	}
 }
+
+func TestWalkIfVar(t *testing.T) {
+	// The condition of a Wo conditional binding is a BindingExpr.
+	src := "package p\nfunc _(m map[string]int) {\n\tif var x = m[\"x\"] {\n\t\t_ = x\n\t}\n}\n"
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "x.wo", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var found, bound bool
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.IfStmt:
+			_, found = n.Cond.(*ast.BindingExpr)
+		case *ast.BindingExpr:
+			ast.Inspect(n, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok && id.Name == "x" {
+					bound = true
+				}
+				return true
+			})
+		}
+		return true
+	})
+	if !found {
+		t.Error("no if statement with a binding condition found")
+	}
+	if !bound {
+		t.Error("bound variable x not visited")
+	}
+}
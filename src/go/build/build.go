@@ -453,10 +453,11 @@ type Package struct {
 
 	// Source files
 	GoFiles           []string // .go source files (excluding CgoFiles, TestGoFiles, XTestGoFiles)
+	WoFiles           []string // .wo source files (excluding TestGoFiles, XTestGoFiles)
 	CgoFiles          []string // .go source files that import "C"
-	IgnoredGoFiles    []string // .go source files ignored for this build (including ignored _test.go files)
-	InvalidGoFiles    []string // .go source files with detected problems (parse error, wrong package name, and so on)
-	IgnoredOtherFiles []string // non-.go source files ignored for this build
+	IgnoredGoFiles    []string // .go and .wo source files ignored for this build (including ignored _test.go and _test.wo files)
+	InvalidGoFiles    []string // .go and .wo source files with detected problems (parse error, wrong package name, and so on)
+	IgnoredOtherFiles []string // non-.go, non-.wo source files ignored for this build
 	CFiles            []string // .c source files
 	CXXFiles          []string // .cc, .cpp and .cxx source files
 	MFiles            []string // .m (Objective-C) source files
@@ -476,16 +477,16 @@ type Package struct {
 	CgoPkgConfig []string // Cgo pkg-config directives
 
 	// Test information
-	TestGoFiles  []string // _test.go files in package
-	XTestGoFiles []string // _test.go files outside package
+	TestGoFiles  []string // _test.go and _test.wo files in package
+	XTestGoFiles []string // _test.go and _test.wo files outside package
 
-	// Go directive comments (//go:zzz...) found in source files.
+	// Go and Wo directive comments (//go:zzz... and //wo:zzz...) found in source files.
 	Directives      []Directive
 	TestDirectives  []Directive
 	XTestDirectives []Directive
 
 	// Dependency information
-	Imports        []string                    // import paths from GoFiles, CgoFiles
+	Imports        []string                    // import paths from GoFiles, WoFiles, CgoFiles
 	ImportPos      map[string][]token.Position // line information for Imports
 	TestImports    []string                    // import paths from TestGoFiles
 	TestImportPos  map[string][]token.Position // line information for TestImports
@@ -497,7 +498,7 @@ type Package struct {
 	//	//go:embed a* b.c
 	// then the list will contain those two strings as separate entries.
 	// (See package embed for more details about //go:embed.)
-	EmbedPatterns        []string                    // patterns from GoFiles, CgoFiles
+	EmbedPatterns        []string                    // patterns from GoFiles, WoFiles, CgoFiles
 	EmbedPatternPos      map[string][]token.Position // line information for EmbedPatterns
 	TestEmbedPatterns    []string                    // patterns from TestGoFiles
 	TestEmbedPatternPos  map[string][]token.Position // line information for TestEmbedPatterns
@@ -505,7 +506,8 @@ type Package struct {
 	XTestEmbedPatternPos map[string][]token.Position // line information for XTestEmbedPatternPos
 }
 
-// A Directive is a Go directive comment (//go:zzz...) found in a source file.
+// A Directive is a Go or Wo directive comment (//go:zzz... or //wo:zzz...)
+// found in a source file.
 type Directive struct {
 	Text string         // full line comment including leading slashes
 	Pos  token.Position // position of comment
@@ -556,6 +558,18 @@ func nameExt(name string) string {
 	return name[i:]
 }
 
+// isSourceFile reports whether name is a Go or Wo source file name.
+// Wo is a dialect of Go; .wo files are compiled together with the
+// .go files of the same package.
+func isSourceFile(name string) bool {
+	return strings.HasSuffix(name, ".go") || strings.HasSuffix(name, ".wo")
+}
+
+// isTestFile reports whether name is a Go or Wo test file name.
+func isTestFile(name string) bool {
+	return strings.HasSuffix(name, "_test.go") || strings.HasSuffix(name, "_test.wo")
+}
+
 var installgoroot = godebug.New("installgoroot")
 
 // Import returns details about the Go package named by the import path,
@@ -898,14 +912,14 @@ Found:
 		ext := nameExt(name)
 
 		info, err := ctxt.matchFile(p.Dir, name, allTags, &p.BinaryOnly, fset)
-		if err != nil && strings.HasSuffix(name, ".go") {
+		if err != nil && isSourceFile(name) {
 			badGoFile(name, err)
 			continue
 		}
 		if info == nil {
 			if strings.HasPrefix(name, "_") || strings.HasPrefix(name, ".") {
 				// not due to build constraints - don't report
-			} else if ext == ".go" {
+			} else if ext == ".go" || ext == ".wo" {
 				p.IgnoredGoFiles = append(p.IgnoredGoFiles, name)
 			} else if fileListForExt(p, ext) != nil {
 				p.IgnoredOtherFiles = append(p.IgnoredOtherFiles, name)
@@ -915,7 +929,7 @@ Found:
 
 		// Going to save the file. For non-Go files, can stop here.
 		switch ext {
-		case ".go":
+		case ".go", ".wo":
 			// keep going
 		case ".S", ".sx":
 			// special case for cgo, handled at end
@@ -945,7 +959,7 @@ Found:
 			}
 		}
 
-		isTest := strings.HasSuffix(name, "_test.go")
+		isTest := isTestFile(name)
 		isXTest := false
 		if isTest && strings.HasSuffix(pkg, "_test") && p.Name != pkg {
 			isXTest = true
@@ -993,6 +1007,10 @@ Found:
 					badGoFile(name, fmt.Errorf("use of cgo in test %s not supported", filename))
 					continue
 				}
+				if ext == ".wo" {
+					badGoFile(name, fmt.Errorf("use of cgo in Wo file %s not supported", filename))
+					continue
+				}
 				isCgo = true
 				if imp.doc != nil {
 					if err := ctxt.saveCgo(filename, p, imp.doc); err != nil {
@@ -1027,6 +1045,11 @@ Found:
 			importMap = testImportPos
 			embedMap = testEmbedPos
 			directives = &p.TestDirectives
+		case ext == ".wo":
+			fileList = &p.WoFiles
+			importMap = importPos
+			embedMap = embedPos
+			directives = &p.Directives
 		default:
 			fileList = &p.GoFiles
 			importMap = importPos
@@ -1076,7 +1099,7 @@ Found:
 	if badGoError != nil {
 		return p, badGoError
 	}
-	if len(p.GoFiles)+len(p.CgoFiles)+len(p.TestGoFiles)+len(p.XTestGoFiles) == 0 {
+	if len(p.GoFiles)+len(p.WoFiles)+len(p.CgoFiles)+len(p.TestGoFiles)+len(p.XTestGoFiles) == 0 {
 		return p, &NoGoError{p.Dir}
 	}
 	return p, pkgerr
@@ -1291,14 +1314,14 @@ func equal(x, y []string) bool {
 	return true
 }
 
-// hasGoFiles reports whether dir contains any files with names ending in .go.
+// hasGoFiles reports whether dir contains any files with names ending in .go or .wo.
 // For a vendor check we must exclude directories that contain no .go files.
 // Otherwise it is not possible to vendor just a/b/c and still import the
 // non-vendored a/b. See golang.org/issue/13832.
 func hasGoFiles(ctxt *Context, dir string) bool {
 	ents, _ := ctxt.readDir(dir)
 	for _, ent := range ents {
-		if !ent.IsDir() && strings.HasSuffix(ent.Name(), ".go") {
+		if !ent.IsDir() && isSourceFile(ent.Name()) {
 			return true
 		}
 	}
@@ -1471,7 +1494,7 @@ func (ctxt *Context) matchFile(dir, name string, allTags map[string]bool, binary
 	}
 	ext := name[i:]
 
-	if ext != ".go" && fileListForExt(&dummyPkg, ext) == nil {
+	if ext != ".go" && ext != ".wo" && fileListForExt(&dummyPkg, ext) == nil {
 		// skip
 		return nil, nil
 	}
@@ -1491,10 +1514,10 @@ func (ctxt *Context) matchFile(dir, name string, allTags map[string]bool, binary
 		return nil, err
 	}
 
-	if strings.HasSuffix(name, ".go") {
+	if isSourceFile(name) {
 		err = readGoInfo(f, info)
-		if strings.HasSuffix(name, "_test.go") {
-			binaryOnly = nil // ignore //go:binary-only-package comments in _test.go files
+		if isTestFile(name) {
+			binaryOnly = nil // ignore //go:binary-only-package comments in test files
 		}
 	} else {
 		binaryOnly = nil // ignore //go:binary-only-package comments in non-Go sources
@@ -805,6 +805,37 @@ func TestAllTagsNonSourceFile(t *testing.T) {
 	}
 }
 
+func TestWoFiles(t *testing.T) {
+	ctxt := Default
+	ctxt.GOARCH = "amd64"
+	ctxt.GOOS = "linux"
+	p, err := ctxt.ImportDir("testdata/wo", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check := func(name string, got, want []string) {
+		t.Helper()
+		if !slices.Equal(got, want) {
+			t.Errorf("%s = %v, want %v", name, got, want)
+		}
+	}
+	check("GoFiles", p.GoFiles, []string{"a.go"})
+	check("WoFiles", p.WoFiles, []string{"b.wo"})
+	check("IgnoredGoFiles", p.IgnoredGoFiles, []string{"c_netbsd_arm.wo", "d.wo"})
+	check("TestGoFiles", p.TestGoFiles, []string{"e_test.wo"})
+	check("XTestGoFiles", p.XTestGoFiles, []string{"f_test.wo"})
+	check("Imports", p.Imports, []string{"fmt", "strings"})
+	check("AllTags", p.AllTags, []string{"arm", "ignore", "netbsd"})
+
+	ctxt.GOARCH = "arm"
+	ctxt.GOOS = "netbsd"
+	p, err = ctxt.ImportDir("testdata/wo", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	check("WoFiles", p.WoFiles, []string{"b.wo", "c_netbsd_arm.wo"})
+}
+
 func TestDirectives(t *testing.T) {
 	p, err := ImportDir("testdata/directives", 0)
 	if err != nil {
@@ -822,7 +853,7 @@ func TestDirectives(t *testing.T) {
 		}
 	}
 	check("Directives", p.Directives,
-		`[{"//go:main1" "testdata/directives/a.go:1:1"} {"//go:plant" "testdata/directives/eve.go:1:1"}]`)
+		`[{"//go:main1" "testdata/directives/a.go:1:1"} {"//go:plant" "testdata/directives/eve.go:1:1"} {"//wo:dialect" "testdata/directives/wo.go:1:1"}]`)
 	check("TestDirectives", p.TestDirectives,
 		`[{"//go:test1" "testdata/directives/a_test.go:1:1"} {"//go:test2" "testdata/directives/b_test.go:1:1"}]`)
 	check("XTestDirectives", p.XTestDirectives,
@@ -496,7 +496,7 @@ func readGoInfo(f io.Reader, info *fileInfo) error {
 			break
 		}
 		for _, c := range group.List {
-			if strings.HasPrefix(c.Text, "//go:") {
+			if strings.HasPrefix(c.Text, "//go:") || strings.HasPrefix(c.Text, "//wo:") {
 				info.directives = append(info.directives, Directive{c.Text, info.fset.Position(c.Slash)})
 			}
 		}
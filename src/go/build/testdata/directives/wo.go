@@ -0,0 +1,3 @@
+//wo:dialect
+
+package p
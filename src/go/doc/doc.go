@@ -200,6 +200,10 @@ func (p *Package) collectFuncs(funcs []*Func) {
 // If the example has a suffix in its name, it is set in the
 // [Example.Suffix] field. [Examples] with malformed names are skipped.
 //
+// The files may include Wo files, whose names end in .wo. Package-level
+// names declared with a Wo visibility modifier are exported according to
+// the modifier rather than their capitalization.
+//
 // Optionally, a single extra argument of type [Mode] can be provided to
 // control low-level aspects of the documentation extraction behavior.
 //
@@ -224,7 +228,7 @@ func NewFromFiles(fset *token.FileSet, files []*ast.File, importPath string, opt
 		panic(fmt.Errorf("doc.NewFromFiles: there must not be more than 1 option argument"))
 	}
 
-	// Collect .go and _test.go files.
+	// Collect .go, .wo, _test.go and _test.wo files.
 	var (
 		goFiles     = make(map[string]*ast.File)
 		testGoFiles []*ast.File
@@ -235,12 +239,12 @@ func NewFromFiles(fset *token.FileSet, files []*ast.File, importPath string, opt
 			return nil, fmt.Errorf("file files[%d] is not found in the provided file set", i)
 		}
 		switch name := f.Name(); {
-		case strings.HasSuffix(name, ".go") && !strings.HasSuffix(name, "_test.go"):
-			goFiles[name] = files[i]
-		case strings.HasSuffix(name, "_test.go"):
+		case strings.HasSuffix(name, "_test.go"), strings.HasSuffix(name, "_test.wo"):
 			testGoFiles = append(testGoFiles, files[i])
+		case strings.HasSuffix(name, ".go"), strings.HasSuffix(name, ".wo"):
+			goFiles[name] = files[i]
 		default:
-			return nil, fmt.Errorf("file files[%d] filename %q does not have a .go extension", i, name)
+			return nil, fmt.Errorf("file files[%d] filename %q does not have a .go or .wo extension", i, name)
 		}
 	}
 
@@ -621,6 +621,8 @@ func lastComment(b *ast.BlockStmt, c []*ast.CommentGroup) (i int, last *ast.Comm
 //     or Foo (with a "bar" suffix).
 //
 // Examples with malformed names are not associated with anything.
+// Names declared with a Wo visibility modifier are exported according
+// to the modifier rather than their capitalization.
 func classifyExamples(p *Package, examples []*Example) {
 	if len(examples) == 0 {
 		return
@@ -629,18 +631,18 @@ func classifyExamples(p *Package, examples []*Example) {
 	ids := make(map[string]*[]*Example)
 	ids[""] = &p.Examples // package-level examples have an empty name
 	for _, f := range p.Funcs {
-		if !token.IsExported(f.Name) {
+		if !isExportedDecl(f.Name, f.Decl.Vis) {
 			continue
 		}
 		ids[f.Name] = &f.Examples
 	}
 	for _, t := range p.Types {
-		if !token.IsExported(t.Name) {
+		if !isExportedDecl(t.Name, t.Decl.Vis) {
 			continue
 		}
 		ids[t.Name] = &t.Examples
 		for _, f := range t.Funcs {
-			if !token.IsExported(f.Name) {
+			if !isExportedDecl(f.Name, f.Decl.Vis) {
 				continue
 			}
 			ids[f.Name] = &f.Examples
@@ -723,3 +725,12 @@ func isExampleSuffix(s string) bool {
 	r, size := utf8.DecodeRuneInString(s)
 	return size > 0 && unicode.IsLower(r)
 }
+
+// isExportedDecl reports whether a name declared with the visibility
+// modifier vis is exported.
+func isExportedDecl(name string, vis ast.Visibility) bool {
+	if vis != ast.DefaultVis {
+		return vis == ast.ExportVis
+	}
+	return token.IsExported(name)
+}
@@ -11,12 +11,13 @@ import (
 	"go/token"
 )
 
-// filterIdentList removes unexported names from list in place
-// and returns the resulting list.
-func filterIdentList(list []*ast.Ident) []*ast.Ident {
+// filterIdentList removes the names from list in place that are not
+// exported according to the exported filter, and returns the
+// resulting list.
+func filterIdentList(list []*ast.Ident, exported Filter) []*ast.Ident {
 	j := 0
 	for _, x := range list {
-		if token.IsExported(x.Name) {
+		if exported(x.Name) {
 			list[j] = x
 			j++
 		}
@@ -54,11 +55,12 @@ func filterExprList(list []ast.Expr, filter Filter, export bool) []ast.Expr {
 	return list[0:j]
 }
 
-// updateIdentList replaces all unexported identifiers with underscore
-// and reports whether at least one exported name exists.
-func updateIdentList(list []*ast.Ident) (hasExported bool) {
+// updateIdentList replaces all identifiers that are not exported
+// according to the exported filter with underscore and reports whether
+// at least one exported name exists.
+func updateIdentList(list []*ast.Ident, exported Filter) (hasExported bool) {
 	for i, x := range list {
-		if token.IsExported(x.Name) {
+		if exported(x.Name) {
 			hasExported = true
 		} else {
 			list[i] = underscore
@@ -67,10 +69,11 @@ func updateIdentList(list []*ast.Ident) (hasExported bool) {
 	return hasExported
 }
 
-// hasExportedName reports whether list contains any exported names.
-func hasExportedName(list []*ast.Ident) bool {
+// hasExportedName reports whether list contains any names that are
+// exported according to the exported filter.
+func hasExportedName(list []*ast.Ident, exported Filter) bool {
 	for _, x := range list {
-		if x.IsExported() {
+		if exported(x.Name) {
 			return true
 		}
 	}
@@ -134,7 +137,7 @@ func (r *reader) filterFieldList(parent *namedType, fields *ast.FieldList, ityp
 				keepField = ityp != nil
 			}
 		} else {
-			field.Names = filterIdentList(field.Names)
+			field.Names = filterIdentList(field.Names, token.IsExported)
 			if len(field.Names) < n {
 				removedFields = true
 			}
@@ -212,7 +215,7 @@ func (r *reader) filterSpec(spec ast.Spec) bool {
 		// always keep imports so we can collect them
 		return true
 	case *ast.ValueSpec:
-		s.Values = filterExprList(s.Values, token.IsExported, true)
+		s.Values = filterExprList(s.Values, r.isExported, true)
 		if len(s.Values) > 0 || s.Type == nil && len(s.Values) == 0 {
 			// If there are values declared on RHS, just replace the unexported
 			// identifiers on the LHS with underscore, so that it matches
@@ -220,12 +223,12 @@ func (r *reader) filterSpec(spec ast.Spec) bool {
 			//
 			// Similarly, if there are no type and values, then this expression
 			// must be following an iota expression, where order matters.
-			if updateIdentList(s.Names) {
+			if updateIdentList(s.Names, r.isExported) {
 				r.filterType(nil, s.Type)
 				return true
 			}
 		} else {
-			s.Names = filterIdentList(s.Names)
+			s.Names = filterIdentList(s.Names, r.isExported)
 			if len(s.Names) > 0 {
 				r.filterType(nil, s.Type)
 				return true
@@ -234,7 +237,7 @@ func (r *reader) filterSpec(spec ast.Spec) bool {
 	case *ast.TypeSpec:
 		// Don't filter type parameters here, by analogy with function parameters
 		// which are not filtered for top-level function declarations.
-		if name := s.Name.Name; token.IsExported(name) {
+		if name := s.Name.Name; r.isExported(name) {
 			r.filterType(r.lookupType(s.Name.Name), s.Type)
 			return true
 		} else if IsPredeclared(name) {
@@ -277,7 +280,7 @@ func (r *reader) filterSpecList(list []ast.Spec, tok token.Token) []ast.Spec {
 				// provide current spec with an explicit type
 				spec.Type = copyConstType(prevType, spec.Pos())
 			}
-			if hasExportedName(spec.Names) {
+			if hasExportedName(spec.Names, r.isExported) {
 				// exported names are preserved so there's no need to propagate the type
 				prevType = nil
 			} else {
@@ -306,6 +309,9 @@ func (r *reader) filterDecl(decl ast.Decl) bool {
 		// conflicting method will be filtered here, too -
 		// thus, removing these methods early will not lead
 		// to the false removal of possible conflicts
+		if d.Recv == nil {
+			return r.isExported(d.Name.Name)
+		}
 		return token.IsExported(d.Name.Name)
 	}
 	return false
@@ -196,10 +196,57 @@ type reader struct {
 	// support for package-local shadowing of predeclared types
 	shadowedPredecl map[string]bool
 	fixmap          map[string][]*ast.InterfaceType
+
+	// Wo visibility modifiers of package-level names
+	vis map[string]ast.Visibility
 }
 
 func (r *reader) isVisible(name string) bool {
-	return r.mode&AllDecls != 0 || token.IsExported(name)
+	return r.mode&AllDecls != 0 || r.isExported(name)
+}
+
+// isExported reports whether the package-level name is exported.
+// Declarations in Wo files may override the capitalization rule with
+// a visibility modifier: names declared with export are exported, and
+// names declared with pkg are not.
+func (r *reader) isExported(name string) bool {
+	if vis, ok := r.vis[name]; ok {
+		return vis == ast.ExportVis
+	}
+	return token.IsExported(name)
+}
+
+// readVisibility records the Wo visibility modifiers of the
+// package-level declarations in src.
+func (r *reader) readVisibility(src *ast.File) {
+	set := func(name *ast.Ident, vis ast.Visibility) {
+		if vis == ast.DefaultVis {
+			return
+		}
+		if r.vis == nil {
+			r.vis = make(map[string]ast.Visibility)
+		}
+		r.vis[name.Name] = vis
+	}
+	for _, decl := range src.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.ValueSpec:
+					for _, name := range s.Names {
+						set(name, d.Vis)
+					}
+				case *ast.TypeSpec:
+					set(s.Name, d.Vis)
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil {
+				set(d.Name, d.Vis)
+			}
+		}
+	}
 }
 
 // lookupType returns the base type with the given name.
@@ -666,6 +713,10 @@ func (r *reader) readPackage(pkg *ast.Package, mode Mode) {
 	}
 	slices.Sort(r.filenames)
 
+	for _, filename := range r.filenames {
+		r.readVisibility(pkg.Files[filename])
+	}
+
 	// process files in sorted order
 	for _, filename := range r.filenames {
 		f := pkg.Files[filename]
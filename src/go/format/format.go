@@ -73,7 +73,11 @@ func Node(dst io.Writer, fset *token.FileSet, node any) error {
 		if err != nil {
 			return err
 		}
-		file, err = parser.ParseFile(fset, "", buf.Bytes(), parserMode)
+		mode := parserMode
+		if file.Wo {
+			mode |= parser.WoDialect
+		}
+		file, err = parser.ParseFile(fset, "", buf.Bytes(), mode)
 		if err != nil {
 			// We should never get here. If we do, provide good diagnostic.
 			return fmt.Errorf("format.Node internal error (%s)", err)
@@ -58,6 +58,46 @@ func TestNode(t *testing.T) {
 	diff(t, buf.Bytes(), src)
 }
 
+// TestNodeWo tests that Node sorts the imports of a Wo file
+// that has no //wo:dialect directive.
+func TestNodeWo(t *testing.T) {
+	const (
+		src = `package p
+
+import (
+	"strings"
+	"fmt"
+)
+
+var f string -> string = s -> strings.ToUpper(s)
+var _ = fmt.Sprint
+`
+		golden = `package p
+
+import (
+	"fmt"
+	"strings"
+)
+
+var f string -> string = s -> strings.ToUpper(s)
+var _ = fmt.Sprint
+`
+	)
+
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "p.wo", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Node(&buf, fset, file); err != nil {
+		t.Fatal("Node failed:", err)
+	}
+
+	diff(t, buf.Bytes(), []byte(golden))
+}
+
 // Node is documented to not modify the AST.
 // Test that it is so even when numbers are normalized.
 func TestNodeNoModify(t *testing.T) {
@@ -5,6 +5,7 @@
 package gcimporter
 
 import (
+	"go/constant"
 	"go/token"
 	"go/types"
 	"internal/godebug"
@@ -239,6 +240,7 @@ func (r *reader) doPkg() *types.Package {
 	name := r.String()
 
 	pkg := types.NewPackage(path, name)
+	pkg.SetModule(r.String())
 	r.p.imports[path] = pkg
 
 	return pkg
@@ -319,6 +321,12 @@ func (r *reader) doTyp() (res types.Type) {
 		return types.NewChan(dir, r.typ())
 	case pkgbits.TypeMap:
 		return types.NewMap(r.typ(), r.typ())
+	case pkgbits.TypeSet:
+		return types.NewSet(r.typ())
+	case pkgbits.TypeOptional:
+		return types.NewOptional(r.typ())
+	case pkgbits.TypeEnum:
+		return r.enumType()
 	case pkgbits.TypePointer:
 		return types.NewPointer(r.typ())
 	case pkgbits.TypeSignature:
@@ -334,6 +342,35 @@ func (r *reader) doTyp() (res types.Type) {
 	}
 }
 
+func (r *reader) enumType() *types.Enum {
+	fields := make([]*types.Var, r.Len())
+	for i := range fields {
+		pos := r.pos()
+		pkg, name := r.selector()
+		fields[i] = types.NewField(pos, pkg, name, r.typ(), false)
+	}
+
+	// The type of the variants is set when the enum becomes the
+	// underlying type of its defined type.
+	variants := make([]*types.Variant, r.Len())
+	for i := range variants {
+		pos := r.pos()
+		pkg, name := r.selector()
+		values := make([]constant.Value, len(fields))
+		for j := range values {
+			values[j] = r.Value()
+		}
+		vfields := make([]*types.Var, r.Len())
+		for j := range vfields {
+			pos := r.pos()
+			pkg, name := r.selector()
+			vfields[j] = types.NewField(pos, pkg, name, r.typ(), false)
+		}
+		variants[i] = types.NewVariant(pos, pkg, name, nil, i, values, vfields)
+	}
+	return types.NewEnum(variants, fields)
+}
+
 func (r *reader) structType() *types.Struct {
 	fields := make([]*types.Var, r.Len())
 	var tags []string
@@ -403,7 +440,45 @@ func (r *reader) signature(recv *types.Var, rtparams, tparams []*types.TypeParam
 	results := r.params()
 	variadic := r.Bool()
 
-	return types.NewSignatureType(recv, rtparams, tparams, params, results, variadic)
+	sig := types.NewSignatureType(recv, rtparams, tparams, params, results, variadic)
+	sig.SetDefaults(r.defaults(params))
+	sig.SetSkippable(r.skips(results))
+	return sig
+}
+
+// defaults reads the default values of the Wo parameters params.
+func (r *reader) defaults(params *types.Tuple) []types.Object {
+	if !r.Bool() {
+		return nil
+	}
+	defaults := make([]types.Object, params.Len())
+	for i := range defaults {
+		if !r.Bool() {
+			continue
+		}
+		switch pkgbits.CodeDefault(r.Code(pkgbits.SyncCodeDefault)) {
+		case pkgbits.DefaultConst:
+			par := params.At(i)
+			defaults[i] = types.NewConst(par.Pos(), nil, "", par.Type(), r.Value())
+		case pkgbits.DefaultNil:
+			defaults[i] = types.Universe.Lookup(r.String())
+		case pkgbits.DefaultObj:
+			defaults[i], _ = r.obj()
+		}
+	}
+	return defaults
+}
+
+// skips reads the skip modifiers of the Wo results results.
+func (r *reader) skips(results *types.Tuple) []bool {
+	if !r.Bool() {
+		return nil
+	}
+	skips := make([]bool, results.Len())
+	for i := range skips {
+		skips[i] = r.Bool()
+	}
+	return skips
 }
 
 func (r *reader) params() *types.Tuple {
@@ -452,6 +527,7 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types.Package, string) {
 	var objPkg *types.Package
 	var objName string
 	var tag pkgbits.CodeObj
+	var vis pkgbits.CodeVis
 	{
 		rname := pr.tempReader(pkgbits.RelocName, idx, pkgbits.SyncObject1)
 
@@ -459,6 +535,7 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types.Package, string) {
 		assert(objName != "")
 
 		tag = pkgbits.CodeObj(rname.Code(pkgbits.SyncCodeObj))
+		vis = pkgbits.CodeVis(rname.Code(pkgbits.SyncCodeVis))
 		pr.retireReader(rname)
 	}
 
@@ -467,6 +544,12 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types.Package, string) {
 		return objPkg, objName
 	}
 
+	if tag == pkgbits.ObjMethod {
+		// Wo methods with type parameters are read with their receiver
+		// base type (see genericMethod).
+		return objPkg, objName
+	}
+
 	// Ignore local types promoted to global scope (#55110).
 	if _, suffix := splitVargenSuffix(objName); suffix != "" {
 		return objPkg, objName
@@ -479,6 +562,7 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types.Package, string) {
 		r.dict = dict
 
 		declare := func(obj types.Object) {
+			setVis(obj, vis)
 			objPkg.Scope().Insert(obj)
 		}
 
@@ -546,17 +630,65 @@ func (pr *pkgReader) objIdx(idx pkgbits.Index) (*types.Package, string) {
 			for i, n := 0, r.Len(); i < n; i++ {
 				named.AddMethod(r.method())
 			}
+			for i, n := 0, r.Len(); i < n; i++ {
+				named.AddMethod(pr.genericMethod(r.Reloc(pkgbits.RelocObj), named.TypeParams().Len()))
+			}
 
 		case pkgbits.ObjVar:
 			pos := r.pos()
 			typ := r.typ()
 			declare(types.NewVar(pos, objPkg, objName, typ))
+
+		case pkgbits.ObjVariant:
+			r.pos()
+			typ := r.typ()
+			declare(typ.Underlying().(*types.Enum).Variant(r.Len()))
+
+		case pkgbits.ObjOverload:
+			pos := r.pos()
+			funcs := make([]*types.Func, r.Len())
+			for i := range funcs {
+				obj, _ := r.obj()
+				funcs[i] = obj.(*types.Func)
+			}
+			declare(types.NewOverload(pos, objPkg, objName, funcs))
 		}
 	}
 
 	return objPkg, objName
 }
 
+// genericMethod reads the Wo method with type parameters with the
+// given index, whose receiver base type has n type parameters.
+func (pr *pkgReader) genericMethod(idx pkgbits.Index, n int) *types.Func {
+	r := pr.newReader(pkgbits.RelocObj, idx, pkgbits.SyncObject1)
+	r.dict = pr.objDictIdx(idx)
+
+	r.Sync(pkgbits.SyncMethod)
+	pos := r.pos()
+	pkg, name := r.selector()
+
+	tparams := r.typeParamNames()
+	sig := r.signature(r.param(), tparams[:n], tparams[n:])
+
+	_ = r.pos() // TODO(mdempsky): Remove; this is a hacker for linker.go.
+	return types.NewFunc(pos, pkg, name, sig)
+}
+
+// setVis sets the visibility of obj to the one encoded by vis.
+func setVis(obj types.Object, vis pkgbits.CodeVis) {
+	var v types.Visibility
+	switch vis {
+	case pkgbits.VisExport:
+		v = types.ExportVis
+	case pkgbits.VisPkg:
+		v = types.PkgVis
+	default:
+		return
+	}
+	obj.(interface{ SetVisibility(types.Visibility) }).SetVisibility(v)
+}
+
 func (pr *pkgReader) objDictIdx(idx pkgbits.Index) *readerDict {
 
 	var dict readerDict
@@ -54,6 +54,7 @@ const (
 	DeclarationErrors                                 // report declaration errors
 	SpuriousErrors                                    // same as AllErrors, for backward-compatibility
 	SkipObjectResolution                              // skip deprecated identifier resolution; see ParseFile
+	WoDialect                                         // parse the source as a Wo file; see ParseFile
 	AllErrors            = SpuriousErrors             // report all errors (not just the first 10 on different lines)
 )
 
@@ -73,6 +74,13 @@ const (
 // all Ident.Obj fields to be nil. Those fields are deprecated; see
 // [ast.Object] for details.
 //
+// A file is parsed as a file written in the Wo dialect of Go if the
+// [WoDialect] mode bit is set, if the filename ends in ".wo", or if the
+// source has a //wo:dialect directive before the package clause. The
+// parser accepts all Wo syntax in such files, and the resulting
+// [ast.File] has its Wo field set; whether the features used are
+// enabled by the directive is checked by the type checker.
+//
 // Position information is recorded in the file set fset, which must not be
 // nil.
 //
@@ -133,12 +141,12 @@ func ParseFile(fset *token.FileSet, filename string, src any, mode Mode) (f *ast
 	return
 }
 
-// ParseDir calls [ParseFile] for all files with names ending in ".go" in the
-// directory specified by path and returns a map of package name -> package
+// ParseDir calls [ParseFile] for all files with names ending in ".go" or
+// ".wo" in the directory specified by path and returns a map of package name -> package
 // AST with all the packages found.
 //
 // If filter != nil, only the files with [fs.FileInfo] entries passing through
-// the filter (and ending in ".go") are considered. The mode bits are passed
+// the filter (and ending in ".go" or ".wo") are considered. The mode bits are passed
 // to [ParseFile] unchanged. Position information is recorded in fset, which
 // must not be nil.
 //
@@ -153,7 +161,7 @@ func ParseDir(fset *token.FileSet, path string, filter func(fs.FileInfo) bool, m
 
 	pkgs = make(map[string]*ast.Package)
 	for _, d := range list {
-		if d.IsDir() || !strings.HasSuffix(d.Name(), ".go") {
+		if d.IsDir() || !strings.HasSuffix(d.Name(), ".go") && !strings.HasSuffix(d.Name(), ".wo") {
 			continue
 		}
 		if filter != nil {
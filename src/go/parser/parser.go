@@ -41,12 +41,22 @@ type parser struct {
 	lineComment *ast.CommentGroup // last line comment
 	top         bool              // in top of file (before package clause)
 	goVersion   string            // minimum Go version found in //go:build comment
+	wo          bool              // whether the file is written in the Wo dialect
 
 	// Next token
 	pos token.Pos   // token position
 	tok token.Token // one token look-ahead
 	lit string      // token literal
 
+	// Token following a semicolon inserted after the closing ">"
+	// of a Wo interface type; valid if pending.tok != token.ILLEGAL
+	pending struct {
+		pos         token.Pos
+		tok         token.Token
+		lit         string
+		leadComment *ast.CommentGroup
+	}
+
 	// Error recovery
 	// (used to limit the number of calls to parser.advance
 	// w/o making scanning progress - avoids potential endless
@@ -68,7 +78,12 @@ type parser struct {
 func (p *parser) init(file *token.File, src []byte, mode Mode) {
 	p.file = file
 	eh := func(pos token.Position, msg string) { p.errors.Add(pos, msg) }
-	p.scanner.Init(p.file, src, eh, scanner.ScanComments)
+	p.wo = mode&WoDialect != 0 || strings.HasSuffix(file.Name(), ".wo") || hasWoDirective(src)
+	scanMode := scanner.ScanComments
+	if p.wo {
+		scanMode |= scanner.ScanWo
+	}
+	p.scanner.Init(p.file, src, eh, scanMode)
 
 	p.top = true
 	p.mode = mode
@@ -76,6 +91,23 @@ func (p *parser) init(file *token.File, src []byte, mode Mode) {
 	p.next()
 }
 
+// hasWoDirective reports whether src has a //wo:dialect directive
+// before the package clause.
+func hasWoDirective(src []byte) bool {
+	var s scanner.Scanner
+	file := token.NewFileSet().AddFile("", -1, len(src))
+	s.Init(file, src, nil, scanner.ScanComments)
+	for {
+		_, tok, lit := s.Scan()
+		if tok != token.COMMENT {
+			return false
+		}
+		if lit == "//wo:dialect" || strings.HasPrefix(lit, "//wo:dialect ") {
+			return true
+		}
+	}
+}
+
 // ----------------------------------------------------------------------------
 // Parsing support
 
@@ -216,6 +248,15 @@ func (p *parser) consumeCommentGroup(n int) (comments *ast.CommentGroup, endline
 // Lead and line comments may be considered documentation that is
 // stored in the AST.
 func (p *parser) next() {
+	if p.pending.tok != token.ILLEGAL {
+		// continue after an inserted semicolon
+		p.pos, p.tok, p.lit = p.pending.pos, p.pending.tok, p.pending.lit
+		p.leadComment = p.pending.leadComment
+		p.lineComment = nil
+		p.pending.tok = token.ILLEGAL
+		return
+	}
+
 	p.leadComment = nil
 	p.lineComment = nil
 	prev := p.pos
@@ -679,7 +720,7 @@ func (p *parser) parseFieldDecl() *ast.Field {
 				}
 			} else {
 				// T P
-				typ = p.parseType()
+				typ = p.unionType(p.parseType())
 			}
 		}
 	case token.MUL:
@@ -779,14 +820,16 @@ func (p *parser) parseDotsType() *ast.Ellipsis {
 	}
 
 	pos := p.expect(token.ELLIPSIS)
-	elt := p.parseType()
+	elt := p.unionType(p.parseType())
 
 	return &ast.Ellipsis{Ellipsis: pos, Elt: elt}
 }
 
 type field struct {
+	skip token.Pos // position of the Wo skip modifier; or token.NoPos
 	name *ast.Ident
 	typ  ast.Expr
+	dflt ast.Expr // Wo default value; or nil
 }
 
 func (p *parser) parseParamDecl(name *ast.Ident, typeSetsOK bool) (f field) {
@@ -801,7 +844,7 @@ func (p *parser) parseParamDecl(name *ast.Ident, typeSetsOK bool) (f field) {
 		p.tok = token.IDENT // force token.IDENT case in switch below
 	} else if typeSetsOK && p.tok == token.TILDE {
 		// "~" ...
-		return field{nil, p.embeddedElem(nil)}
+		return field{typ: p.embeddedElem(nil)}
 	}
 
 	switch p.tok {
@@ -821,6 +864,10 @@ func (p *parser) parseParamDecl(name *ast.Ident, typeSetsOK bool) (f field) {
 		case token.LBRACK:
 			// name "[" type1, ..., typeN "]" or name "[" n "]" type
 			f.name, f.typ = p.parseArrayFieldOrTypeInstance(f.name)
+			if f.name == nil {
+				// name "[" type1, ..., typeN "]"
+				f.typ = p.arrowOrType(p.optionalType(f.typ))
+			}
 
 		case token.ELLIPSIS:
 			// name "..." type
@@ -829,7 +876,7 @@ func (p *parser) parseParamDecl(name *ast.Ident, typeSetsOK bool) (f field) {
 
 		case token.PERIOD:
 			// name "." ...
-			f.typ = p.parseQualifiedIdent(f.name)
+			f.typ = p.arrowOrType(p.optionalType(p.parseQualifiedIdent(f.name)))
 			f.name = nil
 
 		case token.TILDE:
@@ -845,12 +892,39 @@ func (p *parser) parseParamDecl(name *ast.Ident, typeSetsOK bool) (f field) {
 				f.name = nil
 				return
 			}
+			if p.wo {
+				// name "|" ...
+				f.typ = p.unionType(f.name)
+				f.name = nil
+				return
+			}
+
+		case token.QUESTION, token.RARROW:
+			// name "?" ...
+			// name "->" ...
+			f.typ = p.arrowOrType(p.optionalType(f.name))
+			f.name = nil
+
+		case token.LSS:
+			if p.wo {
+				// name type
+				f.typ = p.parseType()
+			}
 		}
 
 	case token.MUL, token.ARROW, token.FUNC, token.LBRACK, token.CHAN, token.MAP, token.STRUCT, token.INTERFACE, token.LPAREN:
 		// type
 		f.typ = p.parseType()
 
+	case token.LSS:
+		if p.wo {
+			// type
+			f.typ = p.parseType()
+			break
+		}
+		p.errorExpected(p.pos, "')'")
+		p.advance(exprEnd)
+
 	case token.ELLIPSIS:
 		// "..." type
 		// (always accepted)
@@ -867,12 +941,24 @@ func (p *parser) parseParamDecl(name *ast.Ident, typeSetsOK bool) (f field) {
 	// [name] type "|"
 	if typeSetsOK && p.tok == token.OR && f.typ != nil {
 		f.typ = p.embeddedElem(f.typ)
+	} else {
+		f.typ = p.unionType(f.typ)
 	}
 
 	return
 }
 
-func (p *parser) parseParameterList(name0 *ast.Ident, typ0 ast.Expr, closing token.Token) (params []*ast.Field) {
+// In Wo files, a parameter may have a default value,
+//
+//	ParameterDecl = [ IdentifierList ] [ "..." ] Type [ "=" Expression ] |
+//	                identifier "=" Expression .
+//
+// and a result may be preceded by a skip modifier:
+//
+//	ResultDecl = [ "skip" ] ParameterDecl .
+//
+// If results is set, the list is a result parameter list.
+func (p *parser) parseParameterList(name0 *ast.Ident, typ0 ast.Expr, closing token.Token, results bool) (params []*ast.Field) {
 	if p.trace {
 		defer un(trace(p, "ParameterList"))
 	}
@@ -903,18 +989,34 @@ func (p *parser) parseParameterList(name0 *ast.Ident, typ0 ast.Expr, closing tok
 			if tparams {
 				typ0 = p.embeddedElem(typ0)
 			}
-			par = field{name0, typ0}
+			par = field{name: name0, typ: typ0}
 		} else {
+			var skip token.Pos // Wo skip modifier, if any
+			if results && name0 == nil && p.tok == token.IDENT && p.lit == "skip" && p.wo {
+				name := p.parseIdent()
+				if p.tok == token.COMMA || p.tok == closing || p.tok == token.PERIOD {
+					// skip is the parameter name or type
+					name0 = name
+				} else {
+					skip = name.NamePos
+				}
+			}
 			par = p.parseParamDecl(name0, tparams)
+			par.skip = skip
+			if !tparams && p.tok == token.ASSIGN && p.wo {
+				// [name] [type] "=" Expression
+				p.next()
+				par.dflt = p.parseRhs()
+			}
 		}
 		name0 = nil // 1st name was consumed if present
 		typ0 = nil  // 1st typ was consumed if present
 		if par.name != nil || par.typ != nil {
 			list = append(list, par)
-			if par.name != nil && par.typ != nil {
+			if par.name != nil && (par.typ != nil || par.dflt != nil) {
 				named++
 			}
-			if par.typ != nil {
+			if par.typ != nil || par.dflt != nil {
 				typed++
 			}
 		}
@@ -959,15 +1061,27 @@ func (p *parser) parseParameterList(name0 *ast.Ident, typ0 ast.Expr, closing tok
 		// some named or we're in a type parameter list => all must be named
 		var errPos token.Pos // left-most error position (or invalid)
 		var typ ast.Expr     // current type (from right to left)
+		var skip token.Pos   // skip modifier of the result that has typ (Wo)
 		for i := len(list) - 1; i >= 0; i-- {
 			if par := &list[i]; par.typ != nil {
 				typ = par.typ
+				skip = par.skip
 				if par.name == nil {
 					errPos = typ.Pos()
 					n := ast.NewIdent("_")
 					n.NamePos = errPos // correct position
 					par.name = n
 				}
+			} else if par.dflt != nil {
+				// The type is inferred from the default value (Wo).
+				typ = nil
+			} else if skip.IsValid() {
+				// An ast.Field cannot express a skip modifier on a name
+				// other than its first, so the type cannot be shared.
+				p.error(skip, "skip modifier must precede the first name of a result declaration")
+				par.typ = &ast.BadExpr{From: par.name.Pos(), To: par.name.End()}
+				typ = nil
+				skip = token.NoPos
 			} else if typ != nil {
 				par.typ = typ
 			} else {
@@ -1012,37 +1126,45 @@ func (p *parser) parseParameterList(name0 *ast.Ident, typ0 ast.Expr, closing tok
 		// parameter list consists of types only
 		for _, par := range list {
 			assert(par.typ != nil, "nil type in unnamed parameter list")
-			params = append(params, &ast.Field{Type: par.typ})
+			params = append(params, &ast.Field{Skip: par.skip, Type: par.typ})
 		}
 		return
 	}
 
 	// If the parameter list consists of named parameters with types,
 	// collect all names with the same types into a single ast.Field.
+	// In Wo files, a skip modifier starts a new field, and a default
+	// value ends it.
 	var names []*ast.Ident
 	var typ ast.Expr
-	addParams := func() {
-		assert(typ != nil, "nil type in named parameter list")
-		field := &ast.Field{Names: names, Type: typ}
+	var skip token.Pos
+	addParams := func(dflt ast.Expr) {
+		assert(typ != nil || dflt != nil, "nil type in named parameter list")
+		field := &ast.Field{Skip: skip, Names: names, Type: typ, Default: dflt}
 		params = append(params, field)
 		names = nil
+		skip = token.NoPos
 	}
 	for _, par := range list {
-		if par.typ != typ {
+		if par.typ != typ || par.skip.IsValid() {
 			if len(names) > 0 {
-				addParams()
+				addParams(nil)
 			}
 			typ = par.typ
+			skip = par.skip
 		}
 		names = append(names, par.name)
+		if par.dflt != nil {
+			addParams(par.dflt)
+		}
 	}
 	if len(names) > 0 {
-		addParams()
+		addParams(nil)
 	}
 	return
 }
 
-func (p *parser) parseParameters(acceptTParams bool) (tparams, params *ast.FieldList) {
+func (p *parser) parseParameters(acceptTParams, results bool) (tparams, params *ast.FieldList) {
 	if p.trace {
 		defer un(trace(p, "Parameters"))
 	}
@@ -1051,7 +1173,7 @@ func (p *parser) parseParameters(acceptTParams bool) (tparams, params *ast.Field
 		opening := p.pos
 		p.next()
 		// [T any](params) syntax
-		list := p.parseParameterList(nil, nil, token.RBRACK)
+		list := p.parseParameterList(nil, nil, token.RBRACK, false)
 		rbrack := p.expect(token.RBRACK)
 		tparams = &ast.FieldList{Opening: opening, List: list, Closing: rbrack}
 		// Type parameter lists must not be empty.
@@ -1065,7 +1187,7 @@ func (p *parser) parseParameters(acceptTParams bool) (tparams, params *ast.Field
 
 	var fields []*ast.Field
 	if p.tok != token.RPAREN {
-		fields = p.parseParameterList(nil, nil, token.RPAREN)
+		fields = p.parseParameterList(nil, nil, token.RPAREN, results)
 	}
 
 	rparen := p.expect(token.RPAREN)
@@ -1080,12 +1202,33 @@ func (p *parser) parseResult() *ast.FieldList {
 	}
 
 	if p.tok == token.LPAREN {
-		_, results := p.parseParameters(false)
+		_, results := p.parseParameters(false, true)
+		if p.tok == token.RARROW {
+			// results is the parameter list of a Wo arrow result type
+			var list []*ast.Field
+			for _, f := range results.List {
+				if len(f.Names) > 0 {
+					p.error(f.Pos(), "arrow function type must have no parameter names")
+				}
+				if f.Skip.IsValid() {
+					p.error(f.Skip, "unexpected skip in parameter list")
+				}
+				list = append(list, &ast.Field{Type: f.Type})
+			}
+			results.List = list
+			typ := p.parseArrowType(results)
+			return &ast.FieldList{List: []*ast.Field{{Type: typ}}}
+		}
 		return results
 	}
 
-	typ := p.tryIdentOrType()
+	typ := p.unionType(p.tryIdentOrType())
 	if typ != nil {
+		if p.tok == token.NOT && p.wo {
+			// Wo result type T!
+			typ = &ast.PostfixExpr{X: typ, OpPos: p.pos, Op: token.NOT}
+			p.next()
+		}
 		list := make([]*ast.Field, 1)
 		list[0] = &ast.Field{Type: typ}
 		return &ast.FieldList{List: list}
@@ -1100,7 +1243,7 @@ func (p *parser) parseFuncType() *ast.FuncType {
 	}
 
 	pos := p.expect(token.FUNC)
-	tparams, params := p.parseParameters(true)
+	tparams, params := p.parseParameters(true, false)
 	if tparams != nil {
 		p.error(tparams.Pos(), "function type must have no type parameters")
 	}
@@ -1132,12 +1275,12 @@ func (p *parser) parseMethodSpec() *ast.Field {
 				//
 				// Interface methods do not have type parameters. We parse them for a
 				// better error message and improved error recovery.
-				_ = p.parseParameterList(name0, nil, token.RBRACK)
+				_ = p.parseParameterList(name0, nil, token.RBRACK, false)
 				_ = p.expect(token.RBRACK)
 				p.error(lbrack, "interface method must have no type parameters")
 
 				// TODO(rfindley) refactor to share code with parseFuncType.
-				_, params := p.parseParameters(false)
+				_, params := p.parseParameters(false, false)
 				results := p.parseResult()
 				idents = []*ast.Ident{ident}
 				typ = &ast.FuncType{
@@ -1167,7 +1310,7 @@ func (p *parser) parseMethodSpec() *ast.Field {
 		case p.tok == token.LPAREN:
 			// ordinary method
 			// TODO(rfindley) refactor to share code with parseFuncType.
-			_, params := p.parseParameters(false)
+			_, params := p.parseParameters(false, false)
 			results := p.parseResult()
 			idents = []*ast.Ident{ident}
 			typ = &ast.FuncType{Func: token.NoPos, Params: params, Results: results}
@@ -1283,6 +1426,199 @@ parseElements:
 	}
 }
 
+// In Wo files, interface types may also be written in angle brackets,
+// with the elements separated by commas:
+//
+//	CompactInterfaceType = "<" [ InterfaceElem { "," InterfaceElem } [ "," ] ] ">" .
+func (p *parser) parseCompactInterfaceType() *ast.InterfaceType {
+	if p.trace {
+		defer un(trace(p, "CompactInterfaceType"))
+	}
+
+	lss := p.expect(token.LSS)
+
+	var list []*ast.Field
+	for p.tok != token.GTR && p.tok != token.SHR && p.tok != token.EOF {
+		var f *ast.Field
+		if p.tok == token.IDENT {
+			f = p.parseMethodSpec()
+			if f.Names == nil {
+				f.Type = p.embeddedElem(f.Type)
+			}
+		} else {
+			f = &ast.Field{Type: p.embeddedElem(nil)}
+		}
+		list = append(list, f)
+		if p.tok != token.COMMA {
+			break
+		}
+		p.next()
+	}
+
+	gtr := p.expectGtr()
+
+	return &ast.InterfaceType{
+		Methods: &ast.FieldList{
+			Opening: lss,
+			List:    list,
+			Closing: gtr,
+		},
+		Compact: true,
+	}
+}
+
+// expectGtr is like expect for the closing ">" of a Wo interface type,
+// but it also accepts ">>", of which it consumes only the first ">":
+// the closing ">" of nested interface types are scanned as ">>".
+// Like the closing "}" of an interface type, the closing ">" may end
+// a line: if it is followed by a newline, a semicolon is inserted.
+func (p *parser) expectGtr() token.Pos {
+	pos := p.pos
+	switch p.tok {
+	case token.GTR:
+		p.next()
+		if p.tok != token.SEMICOLON && (p.tok == token.EOF || p.file.Line(p.pos) > p.file.Line(pos)) {
+			p.pending.pos, p.pending.tok, p.pending.lit = p.pos, p.tok, p.lit
+			p.pending.leadComment = p.leadComment
+			p.pos, p.tok, p.lit = pos+1, token.SEMICOLON, "\n"
+			p.leadComment = nil
+		}
+	case token.SHR:
+		p.pos++
+		p.tok = token.GTR
+	default:
+		p.errorExpected(pos, "',' or '>'")
+	}
+	return pos
+}
+
+// parseEnumType parses a Wo enum type; the "enum" keyword at pos
+// has been consumed already.
+//
+//	EnumType    = "enum" "{" { EnumElem ";" } "}" .
+//	EnumElem    = Variant { "," Variant } [ "," ] | IdentifierList Type .
+//	Variant     = identifier [ "(" [ VariantArgs [ "," ] ] ")" ] .
+//	VariantArgs = ExpressionList | ParameterList .
+func (p *parser) parseEnumType(pos token.Pos) *ast.EnumType {
+	if p.trace {
+		defer un(trace(p, "EnumType"))
+	}
+
+	typ := &ast.EnumType{Enum: pos, Lbrace: p.expect(token.LBRACE)}
+	for p.tok == token.IDENT {
+		p.parseEnumElem(typ)
+	}
+	typ.Rbrace = p.expect(token.RBRACE)
+
+	return typ
+}
+
+// parseEnumElem parses a list of variants or a field declaration and
+// appends it to typ.
+func (p *parser) parseEnumElem(typ *ast.EnumType) {
+	if p.trace {
+		defer un(trace(p, "EnumElem"))
+	}
+
+	doc := p.leadComment
+	pos := p.pos
+	var list []*ast.Variant
+	for {
+		v := &ast.Variant{Name: p.parseIdent()}
+		if p.tok == token.LPAREN {
+			p.parseVariantArgs(v)
+		}
+		list = append(list, v)
+		if p.tok != token.COMMA {
+			break
+		}
+		p.next()
+		if p.tok == token.SEMICOLON || p.tok == token.RBRACE {
+			break
+		}
+	}
+
+	if p.tok != token.SEMICOLON && p.tok != token.RBRACE {
+		// IdentifierList Type
+		f := &ast.Field{Doc: doc, Type: p.parseType()}
+		for _, v := range list {
+			if v.Lparen.IsValid() || v.Fields != nil {
+				p.error(v.Pos(), "variant in enum field declaration")
+			}
+			f.Names = append(f.Names, v.Name)
+		}
+		f.Comment = p.expectSemi()
+		if typ.Fields == nil {
+			typ.Fields = new(ast.FieldList)
+		}
+		typ.Fields.List = append(typ.Fields.List, f)
+		return
+	}
+	p.expectSemi()
+
+	if typ.Fields != nil {
+		p.error(pos, "enum variants must precede fields")
+		return
+	}
+	typ.Variants = append(typ.Variants, list...)
+}
+
+// parseVariantArgs parses the parenthesized values or fields of the
+// variant v. A name followed by the start of a type begins a field
+// declaration; as in a parameter list, names preceding a field
+// declaration share its type. All other arguments are values.
+func (p *parser) parseVariantArgs(v *ast.Variant) {
+	if p.trace {
+		defer un(trace(p, "VariantArgs"))
+	}
+
+	lparen := p.expect(token.LPAREN)
+	var values []ast.Expr
+	var fields []*ast.Field
+	var names []*ast.Ident // values that may be names of a later field declaration
+	mixed := false         // set if there is a value that is not a name
+	p.exprLev++
+	for p.tok != token.RPAREN && p.tok != token.EOF {
+		if p.tok == token.IDENT {
+			name := p.parseIdent()
+			switch p.tok {
+			case token.IDENT, token.MUL, token.LBRACK, token.ARROW, token.FUNC, token.MAP, token.CHAN, token.STRUCT, token.INTERFACE:
+				// name Type
+				fields = append(fields, &ast.Field{Names: append(names, name), Type: p.parseType()})
+				names = nil
+			default:
+				x := p.parseBinaryExpr(p.parsePrimaryExpr(name), token.LowestPrec+1)
+				values = append(values, x)
+				if x == name {
+					names = append(names, name)
+				} else {
+					mixed = true
+				}
+			}
+		} else {
+			values = append(values, p.parseRhs())
+			mixed = true
+		}
+		if !p.atComma("variant", token.RPAREN) {
+			break
+		}
+		p.next()
+	}
+	p.exprLev--
+	rparen := p.expectClosing(token.RPAREN, "variant")
+
+	if fields == nil {
+		v.Lparen, v.Values, v.Rparen = lparen, values, rparen
+		return
+	}
+	if mixed || len(names) > 0 {
+		p.error(lparen, "mixed values and fields in variant")
+		v.Lparen, v.Rparen = lparen, rparen
+		return
+	}
+	v.Fields = &ast.FieldList{Opening: lparen, List: fields, Closing: rparen}
+}
+
 func (p *parser) parseMapType() *ast.MapType {
 	if p.trace {
 		defer un(trace(p, "MapType"))
@@ -1354,12 +1690,133 @@ func (p *parser) parseTypeInstance(typ ast.Expr) ast.Expr {
 	return packIndexExpr(typ, opening, list, closing)
 }
 
+// In Wo files, a type may be followed by ? to denote an optional type,
+// and function types may be written with an arrow:
+//
+//	OptionalType = Type "?" .
+//	ArrowType    = ArrowParams "->" ArrowResult .
+//	ArrowParams  = Type | "(" [ TypeList [ "," ] ] ")" .
+//	ArrowResult  = Type | "_" | "(" [ TypeList [ "," ] ] ")" .
+//
+// Arrow types are right-associative, and "_" stands for no result.
 func (p *parser) tryIdentOrType() ast.Expr {
+	typ := p.tryIdentOrNonOptionalType()
+	if typ != nil {
+		typ = p.arrowOrType(p.optionalType(typ))
+	}
+	return typ
+}
+
+// optionalType returns typ followed by any number of ? as an optional type.
+func (p *parser) optionalType(typ ast.Expr) ast.Expr {
+	for p.tok == token.QUESTION {
+		typ = &ast.PostfixExpr{X: typ, OpPos: p.pos, Op: token.QUESTION}
+		p.next()
+	}
+	return typ
+}
+
+// arrowOrType returns the arrow function type typ -> Result if typ
+// is followed by ->; otherwise it returns typ.
+func (p *parser) arrowOrType(typ ast.Expr) ast.Expr {
+	if p.tok == token.RARROW {
+		return p.parseArrowType(&ast.FieldList{List: []*ast.Field{{Type: typ}}})
+	}
+	return typ
+}
+
+// parseArrowType parses the result of an arrow function type with the
+// given parameters.
+func (p *parser) parseArrowType(params *ast.FieldList) *ast.FuncType {
+	if p.trace {
+		defer un(trace(p, "ArrowType"))
+	}
+
+	typ := &ast.FuncType{Params: params, Arrow: p.expect(token.RARROW)}
+
+	switch {
+	case p.tok == token.IDENT && p.lit == "_":
+		// no result
+		p.next()
+
+	case p.tok == token.LPAREN:
+		lparen := p.pos
+		p.next()
+		list, rparen := p.parseParenTypeList()
+		switch {
+		case p.tok == token.RARROW:
+			res := p.parseArrowType(&ast.FieldList{Opening: lparen, List: list, Closing: rparen})
+			typ.Results = &ast.FieldList{List: []*ast.Field{{Type: res}}}
+		case len(list) == 1:
+			res := p.arrowOrType(p.optionalType(list[0].Type))
+			typ.Results = &ast.FieldList{List: []*ast.Field{{Type: res}}}
+		default:
+			typ.Results = &ast.FieldList{Opening: lparen, List: list, Closing: rparen}
+		}
+
+	default:
+		typ.Results = &ast.FieldList{List: []*ast.Field{{Type: p.parseType()}}}
+	}
+
+	return typ
+}
+
+// parseParenTypeList parses a possibly empty list of types up to and
+// including the closing ")"; the "(" has been consumed already.
+// The last type may be a variadic ...T type.
+func (p *parser) parseParenTypeList() (list []*ast.Field, rparen token.Pos) {
+	for p.tok != token.RPAREN && p.tok != token.EOF {
+		var typ ast.Expr
+		if p.tok == token.ELLIPSIS {
+			typ = p.parseDotsType()
+		} else {
+			typ = p.unionType(p.parseType())
+		}
+		list = append(list, &ast.Field{Type: typ})
+		if !p.atComma("type list", token.RPAREN) {
+			break
+		}
+		p.next()
+	}
+	rparen = p.expectClosing(token.RPAREN, "type list")
+	return
+}
+
+// unionType returns the Wo union type x | ... if x is followed by "|"
+// in a Wo file; otherwise it returns x. Unlike the terms of a type
+// constraint, the terms of a union type cannot be ~ terms.
+//
+//	UnionType = Type "|" Type { "|" Type } .
+func (p *parser) unionType(x ast.Expr) ast.Expr {
+	if !p.wo || x == nil {
+		return x
+	}
+	for p.tok == token.OR {
+		t := new(ast.BinaryExpr)
+		t.X = x
+		t.OpPos = p.pos
+		t.Op = token.OR
+		p.next()
+		t.Y = p.parseType()
+		x = t
+	}
+	return x
+}
+
+func (p *parser) tryIdentOrNonOptionalType() ast.Expr {
 	defer decNestLev(incNestLev(p))
 
 	switch p.tok {
 	case token.IDENT:
-		typ := p.parseTypeName(nil)
+		var ident *ast.Ident
+		if p.wo && p.lit == "enum" {
+			// In Wo files, enum is a keyword if followed by "{".
+			ident = p.parseIdent()
+			if p.tok == token.LBRACE {
+				return p.parseEnumType(ident.NamePos)
+			}
+		}
+		typ := p.parseTypeName(ident)
 		if p.tok == token.LBRACK {
 			typ = p.parseTypeInstance(typ)
 		}
@@ -1382,9 +1839,26 @@ func (p *parser) tryIdentOrType() ast.Expr {
 	case token.LPAREN:
 		lparen := p.pos
 		p.next()
+		if p.wo {
+			// In Wo files, a parenthesized list of types may be
+			// the parameter list of an arrow type.
+			list, rparen := p.parseParenTypeList()
+			if p.tok == token.RARROW {
+				return p.parseArrowType(&ast.FieldList{Opening: lparen, List: list, Closing: rparen})
+			}
+			if len(list) != 1 {
+				p.errorExpected(p.pos, "'->'")
+				return &ast.BadExpr{From: lparen, To: p.pos}
+			}
+			return &ast.ParenExpr{Lparen: lparen, X: list[0].Type, Rparen: rparen}
+		}
 		typ := p.parseType()
 		rparen := p.expect(token.RPAREN)
 		return &ast.ParenExpr{Lparen: lparen, X: typ, Rparen: rparen}
+	case token.LSS:
+		if p.wo {
+			return p.parseCompactInterfaceType()
+		}
 	}
 
 	// no type found
@@ -1461,6 +1935,9 @@ func (p *parser) parseOperand() ast.Expr {
 	switch p.tok {
 	case token.IDENT:
 		x := p.parseIdent()
+		if p.tok == token.RARROW {
+			return p.parseLambdaExpr(token.NoPos, []*ast.Ident{x}, token.NoPos)
+		}
 		return x
 
 	case token.INT, token.FLOAT, token.IMAG, token.CHAR, token.STRING:
@@ -1471,6 +1948,9 @@ func (p *parser) parseOperand() ast.Expr {
 	case token.LPAREN:
 		lparen := p.pos
 		p.next()
+		if p.wo {
+			return p.parseParenExprOrLambda(lparen)
+		}
 		p.exprLev++
 		x := p.parseRhs() // types may be parenthesized: (some type)
 		p.exprLev--
@@ -1479,6 +1959,11 @@ func (p *parser) parseOperand() ast.Expr {
 
 	case token.FUNC:
 		return p.parseFuncTypeOrLit()
+
+	case token.IF:
+		if p.wo {
+			return p.parseCondExpr()
+		}
 	}
 
 	if typ := p.tryIdentOrType(); typ != nil { // do not consume trailing type parameters
@@ -1495,6 +1980,86 @@ func (p *parser) parseOperand() ast.Expr {
 	return &ast.BadExpr{From: pos, To: p.pos}
 }
 
+// parseParenExprOrLambda parses a parenthesized expression or, if
+// the parenthesized list is followed by ->, a Wo function literal;
+// the "(" at lparen has been consumed already.
+func (p *parser) parseParenExprOrLambda(lparen token.Pos) ast.Expr {
+	if p.trace {
+		defer un(trace(p, "ParenExprOrLambda"))
+	}
+
+	var list []ast.Expr
+	p.exprLev++
+	for p.tok != token.RPAREN && p.tok != token.EOF {
+		list = append(list, p.parseRhs()) // types may be parenthesized: (some type)
+		if !p.atComma("parenthesized expression", token.RPAREN) {
+			break
+		}
+		p.next()
+	}
+	p.exprLev--
+	rparen := p.expectClosing(token.RPAREN, "parenthesized expression")
+
+	if p.tok == token.RARROW {
+		params := make([]*ast.Ident, 0, len(list))
+		for _, x := range list {
+			if name, ok := x.(*ast.Ident); ok {
+				params = append(params, name)
+				continue
+			}
+			p.errorExpected(x.Pos(), "parameter name")
+		}
+		return p.parseLambdaExpr(lparen, params, rparen)
+	}
+	if len(list) != 1 {
+		p.errorExpected(p.pos, "'->'")
+		return &ast.BadExpr{From: lparen, To: p.pos}
+	}
+	return &ast.ParenExpr{Lparen: lparen, X: list[0], Rparen: rparen}
+}
+
+// parseLambdaExpr parses a Wo function literal whose parameters have
+// been parsed already; lparen and rparen are the positions of the
+// parentheses around them, if any.
+//
+//	LambdaLit    = LambdaParams "->" Expression .
+//	LambdaParams = identifier | "(" [ IdentifierList [ "," ] ] ")" .
+func (p *parser) parseLambdaExpr(lparen token.Pos, params []*ast.Ident, rparen token.Pos) *ast.LambdaExpr {
+	if p.trace {
+		defer un(trace(p, "LambdaExpr"))
+	}
+
+	arrow := p.expect(token.RARROW)
+	body := p.parseExpr()
+
+	return &ast.LambdaExpr{Lparen: lparen, Params: params, Rparen: rparen, Arrow: arrow, Body: body}
+}
+
+// parseCondExpr parses a Wo conditional expression.
+//
+//	CondExpr = "if" Expression "then" Expression "else" Expression .
+func (p *parser) parseCondExpr() *ast.CondExpr {
+	if p.trace {
+		defer un(trace(p, "CondExpr"))
+	}
+
+	x := &ast.CondExpr{If: p.expect(token.IF)}
+	p.exprLev++
+	x.Cond = p.parseRhs()
+	if p.tok == token.IDENT && p.lit == "then" {
+		x.Then = p.pos
+		p.next()
+	} else {
+		p.errorExpected(p.pos, "then")
+	}
+	x.X = p.parseRhs()
+	p.exprLev--
+	x.Else = p.expect(token.ELSE)
+	x.Y = p.parseRhs()
+
+	return x
+}
+
 func (p *parser) parseSelector(x ast.Expr) ast.Expr {
 	if p.trace {
 		defer un(trace(p, "Selector"))
@@ -1763,6 +2328,17 @@ func (p *parser) parsePrimaryExpr(x ast.Expr) ast.Expr {
 				// already progressed, no need to advance
 			}
 			x = p.parseLiteralValue(x)
+		case token.QUESTION:
+			// x?
+			x = &ast.PostfixExpr{X: x, OpPos: p.pos, Op: token.QUESTION}
+			p.next()
+		case token.NOT:
+			if !p.wo {
+				return x
+			}
+			// x!
+			x = &ast.PostfixExpr{X: x, OpPos: p.pos, Op: token.NOT}
+			p.next()
 		default:
 			return x
 		}
@@ -1915,6 +2491,22 @@ func (p *parser) parseSimpleStmt(mode int) (ast.Stmt, bool) {
 
 	x := p.parseList(false)
 
+	if mode == rangeOk && p.tok == token.COLON && p.wo {
+		// Wo range clause: lhs : x
+		pos := p.pos
+		p.next()
+		y := []ast.Expr{&ast.UnaryExpr{Op: token.RANGE, X: p.parseRhs()}}
+		return &ast.AssignStmt{Lhs: x, TokPos: pos, Tok: token.COLON, Rhs: y}, true
+	}
+
+	if name, ok := x[0].(*ast.Ident); ok && len(x) == 1 && p.wo && p.startsTypedDecl() {
+		// name Type = expr (Wo)
+		typ := p.parseType()
+		pos := p.expect(token.ASSIGN)
+		y := []ast.Expr{p.parseRhs()}
+		return &ast.AssignStmt{Lhs: []ast.Expr{name}, Type: typ, TokPos: pos, Tok: token.DEFINE, Rhs: y}, false
+	}
+
 	switch p.tok {
 	case
 		token.DEFINE, token.ASSIGN, token.ADD_ASSIGN,
@@ -1981,6 +2573,19 @@ func (p *parser) parseSimpleStmt(mode int) (ast.Stmt, bool) {
 	return &ast.ExprStmt{X: x[0]}, false
 }
 
+// startsTypedDecl reports whether the current token, which follows
+// the name on the left of a simple statement, starts the type of a Wo
+// typed declaration name Type = expr. Types that start with a token
+// that could continue the expression, such as [ or *, must be declared
+// with var instead.
+func (p *parser) startsTypedDecl() bool {
+	switch p.tok {
+	case token.IDENT, token.MAP, token.CHAN, token.FUNC, token.STRUCT, token.INTERFACE:
+		return true
+	}
+	return false
+}
+
 func (p *parser) parseCallExpr(callType string) *ast.CallExpr {
 	x := p.parseRhs() // could be a conversion: (some type)(x)
 	if t := ast.Unparen(x); t != x {
@@ -2088,6 +2693,12 @@ func (p *parser) parseIfHeader() (init ast.Stmt, cond ast.Expr) {
 	p.exprLev = -1
 
 	if p.tok != token.SEMICOLON {
+		if p.tok == token.VAR && p.wo {
+			// The Wo conditional binding is the entire header.
+			cond = p.parseBinding()
+			p.exprLev = prevLev
+			return
+		}
 		// accept potential variable declaration but complain
 		if p.tok == token.VAR {
 			p.next()
@@ -2136,6 +2747,25 @@ func (p *parser) parseIfHeader() (init ast.Stmt, cond ast.Expr) {
 	return
 }
 
+// parseBinding parses a Wo conditional binding in an if or for
+// statement header.
+//
+//	VarClause = "var" IdentifierList [ Type ] "=" Expression .
+func (p *parser) parseBinding() *ast.BindingExpr {
+	if p.trace {
+		defer un(trace(p, "Binding"))
+	}
+
+	x := &ast.BindingExpr{Var: p.expect(token.VAR), Names: p.parseIdentList()}
+	if p.tok != token.ASSIGN {
+		x.Type = p.parseType()
+	}
+	p.expect(token.ASSIGN)
+	x.Value = p.parseRhs()
+
+	return x
+}
+
 func (p *parser) parseIfStmt() *ast.IfStmt {
 	defer decNestLev(incNestLev(p))
 
@@ -2346,6 +2976,7 @@ func (p *parser) parseForStmt() ast.Stmt {
 	pos := p.expect(token.FOR)
 
 	var s1, s2, s3 ast.Stmt
+	var bind *ast.BindingExpr
 	var isRange bool
 	if p.tok != token.LBRACE {
 		prevLev := p.exprLev
@@ -2358,11 +2989,14 @@ func (p *parser) parseForStmt() ast.Stmt {
 				y := []ast.Expr{&ast.UnaryExpr{OpPos: pos, Op: token.RANGE, X: p.parseRhs()}}
 				s2 = &ast.AssignStmt{Rhs: y}
 				isRange = true
+			} else if p.tok == token.VAR && p.wo {
+				// The Wo conditional binding is the entire header.
+				bind = p.parseBinding()
 			} else {
 				s2, isRange = p.parseSimpleStmt(rangeOk)
 			}
 		}
-		if !isRange && p.tok == token.SEMICOLON {
+		if !isRange && bind == nil && p.tok == token.SEMICOLON {
 			p.next()
 			s1 = s2
 			s2 = nil
@@ -2410,6 +3044,11 @@ func (p *parser) parseForStmt() ast.Stmt {
 		}
 	}
 
+	if bind != nil {
+		// Wo conditional binding
+		return &ast.ForStmt{For: pos, Cond: bind, Body: body}
+	}
+
 	// regular for statement
 	return &ast.ForStmt{
 		For:  pos,
@@ -2546,7 +3185,7 @@ func (p *parser) parseValueSpec(doc *ast.CommentGroup, keyword token.Token, iota
 		}
 	case token.VAR:
 		if p.tok != token.ASSIGN {
-			typ = p.parseType()
+			typ = p.unionType(p.parseType())
 		}
 		if p.tok == token.ASSIGN {
 			p.next()
@@ -2572,7 +3211,7 @@ func (p *parser) parseGenericType(spec *ast.TypeSpec, openPos token.Pos, name0 *
 		defer un(trace(p, "parseGenericType"))
 	}
 
-	list := p.parseParameterList(name0, typ0, token.RBRACK)
+	list := p.parseParameterList(name0, typ0, token.RBRACK, false)
 	closePos := p.expect(token.RBRACK)
 	spec.TypeParams = &ast.FieldList{Opening: openPos, List: list, Closing: closePos}
 	// Let the type checker decide whether to accept type parameters on aliases:
@@ -2654,6 +3293,7 @@ func (p *parser) parseTypeSpec(doc *ast.CommentGroup, _ token.Token, _ int) ast.
 		}
 		spec.Type = p.parseType()
 	}
+	spec.Type = p.unionType(spec.Type)
 
 	spec.Comment = p.expectSemi()
 
@@ -2771,15 +3411,28 @@ func (p *parser) parseFuncDecl() *ast.FuncDecl {
 
 	var recv *ast.FieldList
 	if p.tok == token.LPAREN {
-		_, recv = p.parseParameters(false)
+		_, recv = p.parseParameters(false, false)
 	}
 
 	ident := p.parseIdent()
 
-	tparams, params := p.parseParameters(true)
-	if recv != nil && tparams != nil {
-		// Method declarations do not have type parameters. We parse them for a
-		// better error message and improved error recovery.
+	var visPos token.Pos
+	var vis ast.Visibility
+	if v := p.visModifier(ident.Name); v != ast.DefaultVis && p.tok == token.IDENT {
+		// In Wo files, the function name may be preceded
+		// by a visibility modifier.
+		if recv != nil {
+			p.error(ident.Pos(), "methods cannot have visibility modifiers")
+		}
+		visPos, vis = ident.Pos(), v
+		ident = p.parseIdent()
+	}
+
+	tparams, params := p.parseParameters(true, false)
+	if recv != nil && tparams != nil && !p.wo {
+		// Method declarations do not have type parameters (except in Wo
+		// files). We parse them for a better error message and improved
+		// error recovery.
 		p.error(tparams.Opening, "method must have no type parameters")
 		tparams = nil
 	}
@@ -2803,9 +3456,11 @@ func (p *parser) parseFuncDecl() *ast.FuncDecl {
 	}
 
 	decl := &ast.FuncDecl{
-		Doc:  doc,
-		Recv: recv,
-		Name: ident,
+		Doc:    doc,
+		VisPos: visPos,
+		Vis:    vis,
+		Recv:   recv,
+		Name:   ident,
 		Type: &ast.FuncType{
 			Func:       pos,
 			TypeParams: tparams,
@@ -2817,11 +3472,54 @@ func (p *parser) parseFuncDecl() *ast.FuncDecl {
 	return decl
 }
 
+// visModifier returns the visibility denoted by the modifier name,
+// or ast.DefaultVis if name is not a modifier in the current file.
+func (p *parser) visModifier(name string) ast.Visibility {
+	if p.wo {
+		switch name {
+		case "export":
+			return ast.ExportVis
+		case "pkg":
+			return ast.PkgVis
+		}
+	}
+	return ast.DefaultVis
+}
+
 func (p *parser) parseDecl(sync map[token.Token]bool) ast.Decl {
 	if p.trace {
 		defer un(trace(p, "Declaration"))
 	}
 
+	// In Wo files, a declaration may start with a visibility modifier.
+	if p.tok == token.IDENT {
+		if vis := p.visModifier(p.lit); vis != ast.DefaultVis {
+			doc := p.leadComment
+			pos := p.pos
+			p.next()
+			switch p.tok {
+			case token.CONST, token.TYPE, token.VAR:
+				d := p.parseDecl(sync).(*ast.GenDecl)
+				d.Doc, d.VisPos, d.Vis = doc, pos, vis
+				return d
+			case token.FUNC:
+				d := p.parseFuncDecl()
+				d.Doc = doc
+				switch {
+				case d.Recv != nil:
+					p.error(d.Pos(), "methods cannot have visibility modifiers")
+				case d.Vis != ast.DefaultVis:
+					p.error(d.VisPos, "multiple visibility modifiers")
+				}
+				d.VisPos, d.Vis = pos, vis
+				return d
+			}
+			p.errorExpected(p.pos, "const, type, var, or func after "+vis.String())
+			p.advance(sync)
+			return &ast.BadDecl{From: pos, To: p.pos}
+		}
+	}
+
 	var f parseSpecFunction
 	switch p.tok {
 	case token.IMPORT:
@@ -2908,6 +3606,7 @@ func (p *parser) parseFile() *ast.File {
 		Imports:   p.imports,
 		Comments:  p.comments,
 		GoVersion: p.goVersion,
+		Wo:        p.wo,
 	}
 	var declErr func(token.Pos, string)
 	if p.mode&DeclarationErrors != 0 {
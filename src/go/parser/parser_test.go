@@ -858,3 +858,40 @@ func TestEmptyFileHasValidStartEnd(t *testing.T) {
 		}
 	}
 }
+
+func TestWoDialect(t *testing.T) {
+	for _, test := range []struct {
+		filename string
+		src      string
+		mode     Mode
+		want     bool
+	}{
+		{"a.go", "package p", 0, false},
+		{"a.wo", "package p", 0, true},
+		{"a.go", "package p", WoDialect, true},
+		{"a.go", "//wo:dialect\npackage p", 0, true},
+		{"a.go", "// Copyright\n\n//wo:dialect ternary,range\n\npackage p", 0, true},
+		{"a.go", "//wo:dialectx\npackage p", 0, false},
+		{"a.go", "package p\n//wo:dialect\n", 0, false},
+		{"a.go", "//wo:note\npackage p\n\n//wo:note\nvar x int", 0, false},
+		{"a.go", "//wo:note\n//wo:dialect\npackage p", 0, true},
+	} {
+		f, err := ParseFile(token.NewFileSet(), test.filename, test.src, SkipObjectResolution|test.mode)
+		if err != nil {
+			t.Errorf("%s: %q: %v", test.filename, test.src, err)
+			continue
+		}
+		if f.Wo != test.want {
+			t.Errorf("%s: %q: got Wo = %v, want %v", test.filename, test.src, f.Wo, test.want)
+		}
+	}
+
+	// Wo syntax is not accepted in Go files.
+	const src = "package p; var _ = if a then b else c"
+	if _, err := ParseFile(token.NewFileSet(), "a.go", src, 0); err == nil {
+		t.Errorf("%q: no error in Go file", src)
+	}
+	if _, err := ParseFile(token.NewFileSet(), "a.wo", src, 0); err != nil {
+		t.Errorf("%q: %v", src, err)
+	}
+}
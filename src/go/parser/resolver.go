@@ -264,6 +264,12 @@ func (r *resolver) Visit(node ast.Node) ast.Visitor {
 		r.walkFuncType(n.Type)
 		r.walkBody(n.Body)
 
+	case *ast.LambdaExpr:
+		r.openScope(n.Pos())
+		defer r.closeScope()
+		r.declare(n, nil, r.topScope, ast.Var, n.Params...)
+		ast.Walk(r, n.Body)
+
 	case *ast.SelectorExpr:
 		ast.Walk(r, n.X)
 		// Note: don't try to resolve n.Sel, as we don't support qualified
@@ -304,6 +310,28 @@ func (r *resolver) Visit(node ast.Node) ast.Visitor {
 		defer r.closeScope()
 		r.walkFieldList(n.Methods, ast.Fun)
 
+	case *ast.EnumType:
+		r.openScope(n.Pos())
+		defer r.closeScope()
+		for _, v := range n.Variants {
+			r.walkExprs(v.Values)
+			if v.Fields != nil {
+				r.openScope(v.Pos())
+				r.walkFieldList(v.Fields, ast.Var)
+				r.closeScope()
+			}
+		}
+		r.walkFieldList(n.Fields, ast.Var)
+
+	case *ast.BindingExpr:
+		// The bound variables are declared in the scope of the
+		// enclosing if or for statement.
+		ast.Walk(r, n.Value)
+		if n.Type != nil {
+			ast.Walk(r, n.Type)
+		}
+		r.declare(n, nil, r.topScope, ast.Var, n.Names...)
+
 	// Statements
 	case *ast.LabeledStmt:
 		r.declare(n, nil, r.labelScope, ast.Lbl, n.Label)
@@ -311,6 +339,9 @@ func (r *resolver) Visit(node ast.Node) ast.Visitor {
 
 	case *ast.AssignStmt:
 		r.walkExprs(n.Rhs)
+		if n.Type != nil {
+			ast.Walk(r, n.Type)
+		}
 		if n.Tok == token.DEFINE {
 			r.shortVarDecl(n)
 		} else {
@@ -335,7 +366,9 @@ func (r *resolver) Visit(node ast.Node) ast.Visitor {
 		if n.Init != nil {
 			ast.Walk(r, n.Init)
 		}
-		ast.Walk(r, n.Cond)
+		if n.Cond != nil {
+			ast.Walk(r, n.Cond)
+		}
 		ast.Walk(r, n.Body)
 		if n.Else != nil {
 			ast.Walk(r, n.Else)
@@ -424,7 +457,7 @@ func (r *resolver) Visit(node ast.Node) ast.Visitor {
 			lhs = append(lhs, n.Value)
 		}
 		if len(lhs) > 0 {
-			if n.Tok == token.DEFINE {
+			if n.Tok == token.DEFINE || n.Tok == token.COLON {
 				// Note: we can't exactly match the behavior of object resolution
 				// during the parsing pass here, as it uses the position of the RANGE
 				// token for the RHS OpPos. That information is not contained within
@@ -528,6 +561,9 @@ func (r *resolver) resolveList(list *ast.FieldList) {
 		if f.Type != nil {
 			ast.Walk(r, f.Type)
 		}
+		if f.Default != nil {
+			ast.Walk(r, f.Default)
+		}
 	}
 }
 
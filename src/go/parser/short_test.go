@@ -122,6 +122,47 @@ func TestValid(t *testing.T) {
 	}
 }
 
+// woValids are valid programs in the Wo dialect.
+var woValids = []string{
+	`package p; var _ = if a then b else c`,
+	`package p; var _ = f(if a then T{} else T{1})`,
+	`package p; func f() { for k, v : m {}; for x : xs {} };`,
+	`package p; var _ int?; var _ []int?; var _ = []int?{1, None}`,
+	`package p; func f() int? { return x? };`,
+	`package p; func f() int! { g()!; return h()! + 1 };`,
+	`package p; type I interface { m() error! }`,
+	`package p; var _ int -> int; var _ int -> int -> int; var _ int -> _`,
+	`package p; var _ (int, string) -> (bool, error); var _ () -> _; var _ (int) -> (bool)`,
+	`package p; func f(int?, io.Reader?, x.T -> bool, func(int) -> int)`,
+	`package p; func f() (int, string) -> bool`,
+	`package p; var _ = x -> x + 1; var _ = (a, b) -> a + b; var _ = () -> 0; var _ = (x,) -> x`,
+	`package p; var _ = f(x -> y -> x + y, 2)`,
+	`package p; type E enum { A, B }`,
+	`package p; type E enum { A(1, "a"), B(2, "b",); n int; s string }`,
+	`package p; type E enum { A(x int), B(s, t string), C }`,
+	`package p; var enum int; var _ = enum + 1`,
+	`package p; type I <M(), io.Reader,>; type J <M() <N()>>`,
+	"package p\nvar _ <M()>\nvar _ int\n",
+	`package p; type U int | string; var x int | string; type S struct { f int | string }`,
+	`package p; func f(x int | string, y ...int | string) int | string`,
+	`package p; export func F() {}; func export f() {}; pkg var x int; export type (T int; U int)`,
+	`package p; func f(x int = 1, y = "a", a, b int = 2) {}`,
+	`package p; func f() (skip int, string, skip error); func g() (skip n int, s string)`,
+	`package p; func f() (skip, skip.T, int); func g(skip int, x skip)`,
+	`package p; func f() (skip, skip int)`,
+	`package p; func f() { if var x = g() {}; for var y, ok int = h() {} };`,
+	`package p; func (T) m[P any](x P) {}`,
+	`package p; func f() { i int = 2; b strings.Builder = g(); l List[int] = nil; o int? = None }`,
+	`package p; func f() { m map[string]int = nil; c chan int = nil; f func() = nil; s struct{} = struct{}{} }`,
+	`package p; func f() { if ok bool = g(); ok {}; for i int = 0; i < n; i++ {}; switch x int = h(); x {} }`,
+}
+
+func TestValidWo(t *testing.T) {
+	for _, src := range woValids {
+		checkErrors(t, src, src, DeclarationErrors|AllErrors|WoDialect, false)
+	}
+}
+
 // TestSingle is useful to track down a problem with a single short test program.
 func TestSingle(t *testing.T) {
 	const src = `package p; var _ = T{}`
@@ -211,3 +252,32 @@ func TestInvalid(t *testing.T) {
 		checkErrors(t, src, src, DeclarationErrors|AllErrors, true)
 	}
 }
+
+// woInvalids are invalid programs in the Wo dialect.
+var woInvalids = []string{
+	`package p; var _ = (a /* ERROR "expected parameter name" */ .b, c) -> 0`,
+	`package p; var _ = (a, b) ; /* ERROR "expected '->'" */`,
+	`package p; var _ = if a b /* ERROR "expected then" */ else c`,
+	`package p; type E enum { n int; A /* ERROR "enum variants must precede fields" */ }`,
+	`package p; type E enum { A /* ERROR "variant in enum field declaration" */ (1), B int }`,
+	`package p; type E enum { A( /* ERROR "mixed values and fields in variant" */ 1, x int) }`,
+	`package p; type E enum { A( /* ERROR "mixed values and fields in variant" */ x int, y) }`,
+	`package p; type I <M() int; /* ERROR "expected ',' or '>'" */ >`,
+	`package p; func (T) export /* ERROR "methods cannot have visibility modifiers" */ m()`,
+	`package p; export func /* ERROR "methods cannot have visibility modifiers" */ (T) m()`,
+	`package p; export func pkg /* ERROR "multiple visibility modifiers" */ f()`,
+	`package p; export import /* ERROR "expected const, type, var, or func after export" */ "fmt"`,
+	`package p; func f() (x /* ERROR "arrow function type must have no parameter names" */ int) -> bool`,
+	`package p; type I interface { m[ /* ERROR "must have no type parameters" */ P any]() }`,
+	`package p; func f(a, x = 1) /* ERROR "missing parameter type" */`,
+	`package p; func f() (a, skip /* ERROR "skip modifier must precede the first name" */ b int)`,
+	`package p; func f() (skip int, string, skip /* ERROR "skip modifier must precede the first name" */ err error)`,
+	`package p; func f() { i int := /* ERROR "expected '='" */ 2 }`,
+	`package p; func f() { a /* ERROR "expected 1 expression" */, b int = 1 }`,
+}
+
+func TestInvalidWo(t *testing.T) {
+	for _, src := range woInvalids {
+		checkErrors(t, src, src, DeclarationErrors|AllErrors|WoDialect, true)
+	}
+}
@@ -359,6 +359,11 @@ func (p *printer) parameters(fields *ast.FieldList, mode paramMode) {
 			} else if i > 0 {
 				p.print(blank)
 			}
+			// skip modifier (Wo)
+			if par.Skip.IsValid() {
+				p.setPos(par.Skip)
+				p.print(&ast.Ident{NamePos: par.Skip, Name: "skip"}, blank)
+			}
 			// parameter names
 			if len(par.Names) > 0 {
 				// Very subtle: If we indented before (ws == ignore), identList
@@ -368,10 +373,19 @@ func (p *printer) parameters(fields *ast.FieldList, mode paramMode) {
 				// by a linebreak call after a type, or in the next multi-line identList
 				// will do the right thing.
 				p.identList(par.Names, ws == indent)
-				p.print(blank)
+				if par.Type != nil {
+					p.print(blank)
+				}
 			}
 			// parameter type
-			p.expr(stripParensAlways(par.Type))
+			if par.Type != nil {
+				p.expr(stripParensAlways(par.Type))
+			}
+			// default value (Wo)
+			if par.Default != nil {
+				p.print(blank, token.ASSIGN, blank)
+				p.expr(par.Default)
+			}
 			prevLine = parLineEnd
 		}
 
@@ -446,15 +460,167 @@ func (p *printer) signature(sig *ast.FuncType) {
 	if n > 0 {
 		// res != nil
 		p.print(blank)
-		if n == 1 && res.List[0].Names == nil {
+		if n == 1 && res.List[0].Names == nil && !res.List[0].Skip.IsValid() && !isUnion(res.List[0].Type) {
 			// single anonymous res; no ()'s
-			p.expr(stripParensAlways(res.List[0].Type))
+			typ := stripParensAlways(res.List[0].Type)
+			if x, _ := typ.(*ast.PostfixExpr); x != nil && x.Op == token.NOT {
+				// Wo result type T! applies to all of T, as in *T!
+				p.expr(x.X)
+				p.setPos(x.OpPos)
+				p.print(token.NOT)
+				return
+			}
+			p.expr(typ)
 			return
 		}
 		p.parameters(res, funcParam)
 	}
 }
 
+// arrowSignature prints the Wo arrow function type sig. A single
+// parameter or result type is not parenthesized unless needed, and
+// "_" stands for an empty result list.
+func (p *printer) arrowSignature(sig *ast.FuncType) {
+	if list := sig.Params.List; len(list) == 1 && list[0].Names == nil && !isOpenType(list[0].Type) {
+		p.expr(stripParensAlways(list[0].Type))
+	} else {
+		p.parameters(sig.Params, funcParam)
+	}
+	p.print(blank)
+	p.setPos(sig.Arrow)
+	p.print(token.RARROW, blank)
+	res := sig.Results
+	switch {
+	case res.NumFields() == 0:
+		p.print(&ast.Ident{NamePos: sig.Arrow, Name: "_"})
+	case len(res.List) == 1 && res.List[0].Names == nil && !isUnion(res.List[0].Type):
+		p.expr(stripParensAlways(res.List[0].Type))
+	default:
+		p.parameters(res, funcParam)
+	}
+}
+
+// isOpenType reports whether the type x ends in a type that would
+// absorb a following "->", as in *T, []T, or func() T, so that x
+// must be parenthesized as the parameter of an arrow function type.
+func isOpenType(x ast.Expr) bool {
+	switch x.(type) {
+	case *ast.StarExpr, *ast.ArrayType, *ast.MapType, *ast.ChanType, *ast.FuncType, *ast.BinaryExpr, *ast.Ellipsis:
+		return true
+	}
+	return false
+}
+
+// isUnion reports whether x is a Wo union type.
+func isUnion(x ast.Expr) bool {
+	b, _ := x.(*ast.BinaryExpr)
+	return b != nil && b.Op == token.OR
+}
+
+// compactInterface prints the Wo interface type x written in angle
+// brackets.
+func (p *printer) compactInterface(x *ast.InterfaceType) {
+	p.setPos(x.Methods.Opening)
+	p.print(token.LSS)
+	for i, f := range x.Methods.List {
+		if i > 0 {
+			p.print(token.COMMA, blank)
+		}
+		if len(f.Names) > 0 {
+			// method
+			p.expr(f.Names[0])
+			p.signature(f.Type.(*ast.FuncType)) // don't print "func"
+		} else {
+			// embedded interface
+			p.expr(f.Type)
+		}
+	}
+	p.setPos(x.Methods.Closing)
+	p.print(token.GTR)
+}
+
+// enumType prints the Wo enum type x. Like a struct type, an enum
+// type without fields that is written on one line stays on one line;
+// otherwise each variant and each field declaration is printed on a
+// line of its own.
+func (p *printer) enumType(x *ast.EnumType) {
+	p.print(&ast.Ident{NamePos: x.Enum, Name: "enum"})
+	var fields []*ast.Field
+	if x.Fields != nil {
+		fields = x.Fields.List
+	}
+	hasComments := p.commentBefore(p.posFor(x.Rbrace))
+	srcIsOneLine := x.Lbrace.IsValid() && x.Rbrace.IsValid() && p.lineFor(x.Lbrace) == p.lineFor(x.Rbrace)
+
+	if !hasComments && len(fields) == 0 && (srcIsOneLine || len(x.Variants) == 0) {
+		p.setPos(x.Lbrace)
+		p.print(token.LBRACE)
+		for i, v := range x.Variants {
+			if i > 0 {
+				p.print(token.COMMA)
+			}
+			p.print(blank)
+			p.variant(v)
+		}
+		if len(x.Variants) > 0 {
+			p.print(blank)
+		}
+		p.setPos(x.Rbrace)
+		p.print(token.RBRACE)
+		return
+	}
+
+	p.print(blank)
+	p.setPos(x.Lbrace)
+	p.print(token.LBRACE, indent, formfeed)
+
+	var line int
+	for i, v := range x.Variants {
+		if i > 0 {
+			p.linebreak(p.lineFor(v.Pos()), 1, ignore, p.linesFrom(line) > 0)
+		}
+		p.recordLine(&line)
+		p.variant(v)
+	}
+
+	sep := vtab
+	if len(fields) == 1 {
+		sep = blank
+	}
+	for i, f := range fields {
+		if i > 0 || len(x.Variants) > 0 {
+			p.linebreak(p.lineFor(f.Pos()), 1, ignore, p.linesFrom(line) > 0)
+		}
+		p.setComment(f.Doc)
+		p.recordLine(&line)
+		p.identList(f.Names, false)
+		p.print(sep)
+		p.expr(f.Type)
+		if f.Comment != nil {
+			p.print(sep)
+			p.setComment(f.Comment)
+		}
+	}
+
+	p.print(unindent, formfeed)
+	p.setPos(x.Rbrace)
+	p.print(token.RBRACE)
+}
+
+// variant prints the variant v of a Wo enum type.
+func (p *printer) variant(v *ast.Variant) {
+	p.expr(v.Name)
+	if v.Lparen.IsValid() {
+		p.setPos(v.Lparen)
+		p.print(token.LPAREN)
+		p.exprList(v.Lparen, v.Values, 1, commaTerm, v.Rparen, false)
+		p.setPos(v.Rparen)
+		p.print(token.RPAREN)
+	} else if v.Fields != nil {
+		p.parameters(v.Fields, funcParam)
+	}
+}
+
 func identListSize(list []*ast.Ident, maxSize int) (size int) {
 	for i, x := range list {
 		if i > 0 {
@@ -827,6 +993,41 @@ func (p *printer) expr1(expr ast.Expr, prec1, depth int) {
 		}
 		p.binaryExpr(x, prec1, cutoff(x, depth), depth)
 
+	case *ast.PostfixExpr:
+		p.expr1(x.X, token.HighestPrec, depth)
+		p.setPos(x.OpPos)
+		p.print(x.Op)
+
+	case *ast.CondExpr:
+		// The else branch of a Wo conditional expression extends
+		// as far as possible.
+		if token.LowestPrec < prec1 {
+			p.print(token.LPAREN)
+			p.expr(x)
+			p.print(token.RPAREN)
+			break
+		}
+		p.print(token.IF, blank)
+		p.expr1(x.Cond, token.LowestPrec, depth)
+		p.print(blank)
+		p.setPos(x.Then)
+		p.print(&ast.Ident{NamePos: x.Then, Name: "then"}, blank)
+		p.expr1(x.X, token.LowestPrec, depth)
+		p.print(blank)
+		p.setPos(x.Else)
+		p.print(token.ELSE, blank)
+		p.expr1(x.Y, token.LowestPrec, depth)
+
+	case *ast.BindingExpr:
+		p.print(token.VAR, blank)
+		p.identList(x.Names, false)
+		if x.Type != nil {
+			p.print(blank)
+			p.expr(x.Type)
+		}
+		p.print(blank, token.ASSIGN, blank)
+		p.expr(x.Value)
+
 	case *ast.KeyValueExpr:
 		p.expr(x.Key)
 		p.setPos(x.Colon)
@@ -878,6 +1079,28 @@ func (p *printer) expr1(expr ast.Expr, prec1, depth int) {
 		p.signature(x.Type)
 		p.funcBody(p.distanceFrom(x.Type.Pos(), startCol), blank, x.Body)
 
+	case *ast.LambdaExpr:
+		// The body of a Wo function literal extends as far as possible.
+		if token.LowestPrec < prec1 {
+			p.print(token.LPAREN)
+			p.expr(x)
+			p.print(token.RPAREN)
+			break
+		}
+		if len(x.Params) != 1 {
+			p.setPos(x.Lparen)
+			p.print(token.LPAREN)
+			p.identList(x.Params, false)
+			p.setPos(x.Rparen)
+			p.print(token.RPAREN)
+		} else {
+			p.expr(x.Params[0])
+		}
+		p.print(blank)
+		p.setPos(x.Arrow)
+		p.print(token.RARROW, blank)
+		p.expr1(x.Body, token.LowestPrec, depth)
+
 	case *ast.ParenExpr:
 		if _, hasParens := x.X.(*ast.ParenExpr); hasParens {
 			// don't print parentheses around an already parenthesized expression
@@ -1052,13 +1275,24 @@ func (p *printer) expr1(expr ast.Expr, prec1, depth int) {
 		p.fieldList(x.Fields, true, x.Incomplete)
 
 	case *ast.FuncType:
+		if x.Arrow.IsValid() {
+			p.arrowSignature(x)
+			break
+		}
 		p.print(token.FUNC)
 		p.signature(x)
 
 	case *ast.InterfaceType:
+		if x.Compact {
+			p.compactInterface(x)
+			break
+		}
 		p.print(token.INTERFACE)
 		p.fieldList(x.Methods, false, x.Incomplete)
 
+	case *ast.EnumType:
+		p.enumType(x)
+
 	case *ast.MapType:
 		p.print(token.MAP, token.LBRACK)
 		p.expr(x.Key)
@@ -1402,8 +1636,15 @@ func (p *printer) stmt(stmt ast.Stmt, nextIsRBrace bool) {
 		}
 		p.exprList(s.Pos(), s.Lhs, depth, 0, s.TokPos, false)
 		p.print(blank)
+		tok := s.Tok
+		if s.Type != nil {
+			// Wo typed declaration
+			p.expr(s.Type)
+			p.print(blank)
+			tok = token.ASSIGN
+		}
 		p.setPos(s.TokPos)
-		p.print(s.Tok, blank)
+		p.print(tok, blank)
 		p.exprList(s.TokPos, s.Rhs, depth, 0, token.NoPos, false)
 
 	case *ast.GoStmt:
@@ -1535,7 +1776,9 @@ func (p *printer) stmt(stmt ast.Stmt, nextIsRBrace bool) {
 			p.setPos(s.TokPos)
 			p.print(s.Tok, blank)
 		}
-		p.print(token.RANGE, blank)
+		if s.Key == nil || s.Tok != token.COLON {
+			p.print(token.RANGE, blank)
+		}
 		p.expr(stripParens(s.X))
 		p.print(blank)
 		p.block(s.Body, 1)
@@ -1735,6 +1978,7 @@ func (p *printer) spec(spec ast.Spec, n int, doIndent bool) {
 func (p *printer) genDecl(d *ast.GenDecl) {
 	p.setComment(d.Doc)
 	p.setPos(d.Pos())
+	p.visibility(d.Vis)
 	p.print(d.Tok, blank)
 
 	if d.Lparen.IsValid() || len(d.Specs) != 1 {
@@ -1920,11 +2164,20 @@ func (p *printer) distanceFrom(startPos token.Pos, startOutCol int) int {
 func (p *printer) funcDecl(d *ast.FuncDecl) {
 	p.setComment(d.Doc)
 	p.setPos(d.Pos())
+	visAfterFunc := d.VisPos.IsValid() && d.VisPos > d.Type.Pos()
+	if !visAfterFunc {
+		p.visibility(d.Vis)
+		p.setPos(d.Type.Pos())
+	}
 	p.print(token.FUNC, blank)
 	// We have to save startCol only after emitting FUNC; otherwise it can be on a
 	// different line (all whitespace preceding the FUNC is emitted only when the
 	// FUNC is emitted).
 	startCol := p.out.Column - len("func ")
+	if visAfterFunc {
+		p.setPos(d.VisPos)
+		p.visibility(d.Vis)
+	}
 	if d.Recv != nil {
 		p.parameters(d.Recv, funcParam) // method: print receiver
 		p.print(blank)
@@ -1934,6 +2187,13 @@ func (p *printer) funcDecl(d *ast.FuncDecl) {
 	p.funcBody(p.distanceFrom(d.Pos(), startCol), vtab, d.Body)
 }
 
+// visibility prints the Wo visibility modifier vis, if any.
+func (p *printer) visibility(vis ast.Visibility) {
+	if vis != ast.DefaultVis {
+		p.print(&ast.Ident{Name: vis.String()}, blank)
+	}
+}
+
 func (p *printer) decl(decl ast.Decl) {
 	switch d := decl.(type) {
 	case *ast.BadDecl:
@@ -878,6 +878,8 @@ func mayCombine(prev token.Token, next byte) (b bool) {
 		b = next == '-' || next == '<' // <- or <<
 	case token.AND:
 		b = next == '&' || next == '^' // && or &^
+	case token.NOT:
+		b = next == '=' // != (Wo postfix !)
 	}
 	return
 }
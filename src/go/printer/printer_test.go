@@ -192,6 +192,7 @@ var data = []entry{
 	{"gobuild5.input", "gobuild5.golden", idempotent},
 	{"gobuild6.input", "gobuild6.golden", idempotent},
 	{"gobuild7.input", "gobuild7.golden", idempotent},
+	{"wo.input", "wo.golden", idempotent},
 }
 
 func TestFiles(t *testing.T) {
@@ -604,6 +605,80 @@ func f()
 	}
 }
 
+// TestParamDefault tests that default values of Wo function parameters
+// are printed, including for parameters whose type is omitted.
+func TestParamDefault(t *testing.T) {
+	src := &ast.File{
+		Name: &ast.Ident{Name: "p"},
+		Decls: []ast.Decl{
+			&ast.FuncDecl{
+				Name: &ast.Ident{Name: "print"},
+				Type: &ast.FuncType{
+					Params: &ast.FieldList{
+						List: []*ast.Field{
+							{Names: []*ast.Ident{{Name: "s"}}, Type: &ast.Ident{Name: "string"}},
+							{Names: []*ast.Ident{{Name: "f"}}, Type: &ast.Ident{Name: "Formatter"}, Default: &ast.Ident{Name: "defaultFormatter"}},
+							{Names: []*ast.Ident{{Name: "stdout"}}, Default: &ast.Ident{Name: "console"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Fprint(&buf, fset, src); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+
+	const want = `package p
+
+func print(s string, f Formatter = defaultFormatter, stdout = console)
+`
+
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s\n", got, want)
+	}
+}
+
+// TestResultSkip tests that skip modifiers of Wo results are printed.
+func TestResultSkip(t *testing.T) {
+	src := &ast.File{
+		Name: &ast.Ident{Name: "p"},
+		Decls: []ast.Decl{
+			&ast.FuncDecl{
+				Name: &ast.Ident{Name: "f"},
+				Type: &ast.FuncType{
+					Params: &ast.FieldList{},
+					Results: &ast.FieldList{
+						List: []*ast.Field{
+							{Skip: 1, Type: &ast.Ident{Name: "int"}},
+							{Type: &ast.Ident{Name: "string"}},
+							{Skip: 1, Type: &ast.Ident{Name: "error"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := Fprint(&buf, fset, src); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.String()
+
+	const want = `package p
+
+func f() (skip int, string, skip error)
+`
+
+	if got != want {
+		t.Fatalf("got:\n%s\nwant:\n%s\n", got, want)
+	}
+}
+
 // TestChanType tests that the tree for <-(<-chan int), without
 // ParenExpr, is correctly formatted with parens.
 // Test case for issue #63362.
@@ -104,6 +104,7 @@ type Mode uint
 const (
 	ScanComments    Mode = 1 << iota // return comments as COMMENT tokens
 	dontInsertSemis                  // do not automatically insert semicolons - for testing only
+	ScanWo                           // recognize the tokens ? and -> of the Wo dialect
 )
 
 // Init prepares the scanner s to tokenize the text src by setting the
@@ -884,6 +885,11 @@ scanAgain:
 				insertSemi = true
 			}
 		case '-':
+			if s.ch == '>' && s.mode&ScanWo != 0 {
+				s.next()
+				tok = token.RARROW
+				break
+			}
 			tok = s.switch3(token.SUB, token.SUB_ASSIGN, '-', token.DEC)
 			if tok == token.DEC {
 				insertSemi = true
@@ -929,6 +935,8 @@ scanAgain:
 			tok = s.switch2(token.ASSIGN, token.EQL)
 		case '!':
 			tok = s.switch2(token.NOT, token.NEQ)
+			// In Wo files, ! may also be a postfix operator.
+			insertSemi = tok == token.NOT && s.mode&ScanWo != 0
 		case '&':
 			if s.ch == '^' {
 				s.next()
@@ -940,6 +948,13 @@ scanAgain:
 			tok = s.switch3(token.OR, token.OR_ASSIGN, '|', token.LOR)
 		case '~':
 			tok = token.TILDE
+		case '?':
+			if s.mode&ScanWo != 0 {
+				insertSemi = true
+				tok = token.QUESTION
+				break
+			}
+			fallthrough
 		default:
 			// next reports unexpected BOMs - don't repeat
 			if ch != bom {
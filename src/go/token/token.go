@@ -128,6 +128,8 @@ const (
 	additional_beg
 	// additional tokens, handled in an ad-hoc manner
 	TILDE
+	QUESTION // ? (Wo)
+	RARROW   // -> (Wo)
 	additional_end
 )
 
@@ -230,7 +232,9 @@ var tokens = [...]string{
 	TYPE:   "type",
 	VAR:    "var",
 
-	TILDE: "~",
+	TILDE:    "~",
+	QUESTION: "?",
+	RARROW:   "->",
 }
 
 // String returns the string corresponding to the token tok.
@@ -305,7 +309,7 @@ func (tok Token) IsLiteral() bool { return literal_beg < tok && tok < literal_en
 // IsOperator returns true for tokens corresponding to operators and
 // delimiters; it returns false otherwise.
 func (tok Token) IsOperator() bool {
-	return (operator_beg < tok && tok < operator_end) || tok == TILDE
+	return (operator_beg < tok && tok < operator_end) || additional_beg < tok && tok < additional_end
 }
 
 // IsKeyword returns true for tokens corresponding to keywords;
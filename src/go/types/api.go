@@ -42,12 +42,14 @@ import (
 // An Error describes a type-checking error; it implements the error interface.
 // A "soft" error is an error that still permits a valid interpretation of a
 // package (such as "unused variable"); "hard" errors may lead to unpredictable
-// behavior if ignored.
+// behavior if ignored. A warning is a soft error that doesn't make
+// type-checking fail; warnings are only reported for Wo files.
 type Error struct {
-	Fset *token.FileSet // file set for interpretation of Pos
-	Pos  token.Pos      // error position
-	Msg  string         // error message
-	Soft bool           // if set, error is "soft"
+	Fset     *token.FileSet // file set for interpretation of Pos
+	Pos      token.Pos      // error position
+	Msg      string         // error message
+	Soft     bool           // if set, error is "soft"
+	Severity Severity       // SeverityError, or SeverityWarning for warnings (which are also soft)
 
 	// go116code is a future API, unexported as the set of error codes is large
 	// and likely to change significantly during experimentation. Tools wishing
@@ -65,6 +67,31 @@ func (err Error) Error() string {
 	return fmt.Sprintf("%s: %s", err.Fset.Position(err.Pos), err.Msg)
 }
 
+// A Severity describes how a diagnostic reported during type-checking
+// affects the outcome of type-checking.
+type Severity int
+
+const (
+	// SeverityError diagnostics are errors: the package is invalid.
+	SeverityError Severity = iota
+
+	// SeverityWarning diagnostics are warnings: they point out likely
+	// mistakes, but the package is still valid. The type checker only
+	// reports warnings in Wo files, for diagnostics that are errors in
+	// Go files, such as unused variables.
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
 // An ArgumentError holds an error associated with an argument index.
 type ArgumentError struct {
 	Index int
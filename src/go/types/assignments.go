@@ -67,6 +67,11 @@ func (check *Checker) assignment(x *operand, T Type, context string) {
 				target = Default(x.typ)
 			}
 		}
+		if x.isNil() && check.isNone(x) && !isOptional(T) {
+			check.errorf(x, IncompatibleAssign, "cannot use None as %s value in %s: %s is not an optional type", T, context, T)
+			x.mode = invalid
+			return
+		}
 		newType, val, code := check.implicitTypeAndValue(x, target)
 		if code != 0 {
 			msg := check.sprintf("cannot use %s as %s value in %s", x, target, context)
@@ -260,6 +265,17 @@ func (check *Checker) assignVar(lhs, rhs ast.Expr, x *operand, context string) {
 		return
 	}
 
+	if x == nil && T != nil && isOptional(T) && check.allowOptional(rhs) {
+		// A comma-ok expression may be assigned to a variable of optional type.
+		list, commaOk := check.multiExpr(rhs, true, 0)
+		list = check.commaOkOptional(T, rhs, list, commaOk, context)
+		if len(list) != 1 {
+			check.assignError([]ast.Expr{rhs}, 1, len(list))
+			return
+		}
+		x = list[0]
+	}
+
 	if x == nil {
 		var target *target
 		// avoid calling ExprString if not needed
@@ -381,6 +397,29 @@ func (check *Checker) returnError(at positioner, lhs []*Var, rhs []*operand) {
 	err.report()
 }
 
+// commaOkOptional converts the two values rhs of the comma-ok expression or
+// call e into a single value of the Wo optional type T if the second value
+// is a boolean: the boolean reports whether the first value is present.
+// Otherwise, it returns rhs unchanged. The result is invalid if the first
+// value cannot be assigned to the element type of T.
+func (check *Checker) commaOkOptional(T Type, e ast.Expr, rhs []*operand, commaOk bool, context string) []*operand {
+	u, _ := under(T).(*Optional)
+	if u == nil || len(rhs) != 2 || !isBoolean(rhs[1].typ) || !check.allowOptional(e) {
+		return rhs
+	}
+	x := rhs[0]
+	if x.mode != invalid {
+		check.assignment(x, u.elem, context)
+	}
+	if x.mode == invalid {
+		return rhs[:1]
+	}
+	if commaOk {
+		check.recordCommaOkTypes(e, []*operand{x, {mode: value, expr: e, typ: Typ[Bool]}})
+	}
+	return []*operand{{mode: value, expr: e, typ: T}}
+}
+
 // initVars type-checks assignments of initialization expressions orig_rhs
 // to variables lhs.
 // If returnStmt is non-nil, initVars type-checks the implicit assignment
@@ -400,9 +439,13 @@ func (check *Checker) initVars(lhs []*Var, orig_rhs []ast.Expr, returnStmt ast.S
 		_, isCall = ast.Unparen(orig_rhs[0]).(*ast.CallExpr)
 	}
 
+	// In Wo files, a comma-ok expression or a call returning
+	// a value and a boolean may initialize an optional variable.
+	optional := l == 1 && r == 1 && lhs[0].typ != nil && isOptional(lhs[0].typ) && check.allowOptional(orig_rhs[0])
+
 	// If we have a n:n mapping from lhs variable to rhs expression,
 	// each value can be assigned to its corresponding variable.
-	if l == r && !isCall {
+	if l == r && !isCall && !optional {
 		var x operand
 		for i, lhs := range lhs {
 			desc := lhs.name
@@ -436,7 +479,17 @@ func (check *Checker) initVars(lhs []*Var, orig_rhs []ast.Expr, returnStmt ast.S
 		return
 	}
 
-	rhs, commaOk := check.multiExpr(orig_rhs[0], l == 2 && returnStmt == nil)
+	// In Wo files, the skippable results of a call may be omitted,
+	// but not from a return statement.
+	want := l
+	if returnStmt != nil {
+		want = 0
+	}
+	rhs, commaOk := check.multiExpr(orig_rhs[0], l == 2 && returnStmt == nil || optional, want)
+	if optional {
+		rhs = check.commaOkOptional(lhs[0].typ, orig_rhs[0], rhs, commaOk, context)
+		commaOk = false
+	}
 	r = len(rhs)
 	if l == r {
 		for i, lhs := range lhs {
@@ -500,7 +553,8 @@ func (check *Checker) assignVars(lhs, orig_rhs []ast.Expr) {
 		return
 	}
 
-	rhs, commaOk := check.multiExpr(orig_rhs[0], l == 2)
+	// In Wo files, the skippable results of a call may be omitted.
+	rhs, commaOk := check.multiExpr(orig_rhs[0], l == 2, l)
 	r = len(rhs)
 	if l == r {
 		for i, lhs := range lhs {
@@ -514,6 +568,22 @@ func (check *Checker) assignVars(lhs, orig_rhs []ast.Expr) {
 		return
 	}
 
+	// In Wo files, a call returning a value and a boolean
+	// may be assigned to an optional variable.
+	if l == 1 && r == 2 && rhs[0].mode != invalid && isBoolean(rhs[1].typ) && check.allowOptional(orig_rhs[0]) {
+		T := check.lhsVar(lhs[0])
+		if !isValid(T) {
+			return
+		}
+		if T != nil && isOptional(T) {
+			rhs = check.commaOkOptional(T, orig_rhs[0], rhs, false, "assignment")
+			check.assignment(rhs[0], T, "assignment")
+			return
+		}
+		check.assignError(orig_rhs, l, r)
+		return
+	}
+
 	// In all other cases we have an assignment mismatch.
 	// Only report a mismatch error if there are no other errors on the rhs.
 	if rhs[0].mode != invalid {
@@ -523,7 +593,10 @@ func (check *Checker) assignVars(lhs, orig_rhs []ast.Expr) {
 	// orig_rhs[0] was already evaluated
 }
 
-func (check *Checker) shortVarDecl(pos positioner, lhs, rhs []ast.Expr) {
+// shortVarDecl checks the short variable declaration lhs := rhs at pos.
+// If header is set, the declaration is in the header of a statement,
+// where Wo files can't use a var declaration instead.
+func (check *Checker) shortVarDecl(pos positioner, lhs, rhs []ast.Expr, header bool) {
 	top := len(check.delayed)
 	scope := check.scope
 
@@ -594,6 +667,11 @@ func (check *Checker) shortVarDecl(pos positioner, lhs, rhs []ast.Expr) {
 		return
 	}
 
+	// In Wo files, := may only declare variables that shadow others.
+	if !hasErr {
+		check.woShortVarDecl(pos, lhs, rhs, lhsVars, newVars, header)
+	}
+
 	// declare new variables
 	// spec: "The scope of a constant or variable identifier declared inside
 	// a function begins at the end of the ConstSpec or VarSpec (ShortVarDecl
@@ -80,6 +80,8 @@ type environment struct {
 	isPanic       map[*ast.CallExpr]bool // set of panic call expressions (used for termination check)
 	hasLabel      bool                   // set if a function makes use of labels (only ~1% of functions); unused outside functions
 	hasCallOrRecv bool                   // set if an expression contains a function call or channel receive operation
+	inRangeFunc   bool                   // set if inside the body of a range-over-func loop
+	callee        ast.Expr               // function expression of the call being checked, if any
 
 	// go/types only
 	exprPos token.Pos // if valid, identifiers are looked up as if at position pos (used by CheckExpr, Eval)
@@ -184,6 +186,11 @@ type Checker struct {
 	unionTypeSets map[*Union]*_TypeSet      // computed type sets for union types
 	mono          monoGraph                 // graph for detecting non-monomorphizable instantiation loops
 
+	woFeatures   map[*token.File]woFeatures // maps Wo files to the Wo features they may use (Go files have no entry)
+	uninitVars   map[*Var]bool              // set of local variables declared without initial value in Wo files
+	uninitNames  map[*ast.Ident]*Var        // maps identifiers declaring or denoting variables in uninitVars to them
+	enumSwitches map[*ast.SwitchStmt]bool   // set of Wo enum switches with a case for every variant
+
 	firstErr error                 // first error encountered
 	methods  map[*TypeName][]*Func // maps package scope type names to associated non-blank (non-interface) methods
 	untyped  map[ast.Expr]exprInfo // map of expressions without final type
@@ -325,6 +332,10 @@ func (check *Checker) initFiles(files []*ast.File) {
 	check.files = nil
 	check.imports = nil
 	check.dotImportMap = nil
+	check.woFeatures = nil
+	check.uninitVars = nil
+	check.uninitNames = nil
+	check.enumSwitches = nil
 
 	check.firstErr = nil
 	check.methods = nil
@@ -398,6 +409,19 @@ func (check *Checker) initFiles(files []*ast.File) {
 			}
 		}
 		versions[file] = v
+
+		if tf := check.fset.File(file.Pos()); tf != nil {
+			f, dir, err := woFileFeatures(tf.Name(), file)
+			if err != nil {
+				check.errorf(dir, UnsupportedFeature, "invalid //wo:dialect directive: %v", err)
+			}
+			if f != 0 {
+				if check.woFeatures == nil {
+					check.woFeatures = make(map[*token.File]woFeatures)
+				}
+				check.woFeatures[tf] = f
+			}
+		}
 	}
 }
 
@@ -507,6 +531,9 @@ func (check *Checker) checkFiles(files []*ast.File) {
 	check.seenPkgMap = nil
 	check.brokenAliases = nil
 	check.unionTypeSets = nil
+	check.uninitVars = nil
+	check.uninitNames = nil
+	check.enumSwitches = nil
 	check.ctxt = nil
 
 	// TODO(rFindley) There's more memory we should release at this point.
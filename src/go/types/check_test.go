@@ -17,7 +17,8 @@
 // error reported at that source position, and that each ERRORx pattern
 // is a regular expression matching the respective error.
 // Consecutive comments may be used to indicate multiple errors reported
-// at the same position.
+// at the same position. Expected warnings are indicated like errors, but
+// with a WARNING indicator instead.
 //
 // For instance, the following test source indicates that an "undeclared"
 // error should be reported for the undeclared variable x:
@@ -82,12 +83,12 @@ func parseFiles(t *testing.T, filenames []string, srcs [][]byte, mode parser.Mod
 	return files, errlist
 }
 
-func unpackError(fset *token.FileSet, err error) (token.Position, string) {
+func unpackError(fset *token.FileSet, err error) (token.Position, string, Severity) {
 	switch err := err.(type) {
 	case *scanner.Error:
-		return err.Pos, err.Msg
+		return err.Pos, err.Msg, SeverityError
 	case Error:
-		return fset.Position(err.Pos), err.Msg
+		return fset.Position(err.Pos), err.Msg, err.Severity
 	}
 	panic("unreachable")
 }
@@ -227,7 +228,7 @@ func testFilesImpl(t *testing.T, filenames []string, srcs [][]byte, manual bool,
 	// collect expected errors
 	errmap := make(map[string]map[int][]comment)
 	for i, filename := range filenames {
-		if m := commentMap(srcs[i], regexp.MustCompile("^ ERRORx? ")); len(m) > 0 {
+		if m := commentMap(srcs[i], regexp.MustCompile("^ (ERRORx?|WARNING) ")); len(m) > 0 {
 			errmap[filename] = m
 		}
 	}
@@ -235,7 +236,7 @@ func testFilesImpl(t *testing.T, filenames []string, srcs [][]byte, manual bool,
 	// match against found errors
 	var indices []int // list indices of matching errors, reused for each error
 	for _, err := range errlist {
-		gotPos, gotMsg := unpackError(fset, err)
+		gotPos, gotMsg, gotSeverity := unpackError(fset, err)
 
 		// find list of errors for the respective error line
 		filename := gotPos.Filename
@@ -250,13 +251,21 @@ func testFilesImpl(t *testing.T, filenames []string, srcs [][]byte, manual bool,
 		indices = indices[:0]
 		for i, want := range errList {
 			pattern, substr := strings.CutPrefix(want.text, " ERROR ")
+			severity := SeverityError
 			if !substr {
 				var found bool
 				pattern, found = strings.CutPrefix(want.text, " ERRORx ")
 				if !found {
-					panic("unreachable")
+					pattern, substr = strings.CutPrefix(want.text, " WARNING ")
+					if !substr {
+						panic("unreachable")
+					}
+					severity = SeverityWarning
 				}
 			}
+			if severity != gotSeverity {
+				continue
+			}
 			unquoted, err := strconv.Unquote(strings.TrimSpace(pattern))
 			if err != nil {
 				t.Errorf("%s:%d:%d: invalid ERROR pattern (cannot unquote %s)", filename, line, want.col, pattern)
@@ -439,6 +448,7 @@ func TestCheck(t *testing.T) {
 func TestSpec(t *testing.T)      { testDirFiles(t, "../../internal/types/testdata/spec", false) }
 func TestExamples(t *testing.T)  { testDirFiles(t, "../../internal/types/testdata/examples", false) }
 func TestFixedbugs(t *testing.T) { testDirFiles(t, "../../internal/types/testdata/fixedbugs", false) }
+func TestWo(t *testing.T)        { testDirFiles(t, "../../internal/types/testdata/wo", false) }
 func TestLocal(t *testing.T)     { testDirFiles(t, "testdata/local", false) }
 
 func testDirFiles(t *testing.T, dir string, manual bool) {
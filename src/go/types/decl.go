@@ -28,6 +28,7 @@ func (check *Checker) declare(scope *Scope, id *ast.Ident, obj Object, pos token
 			return
 		}
 		obj.setScopePos(pos)
+		check.checkShadow(scope, obj)
 	}
 	if id != nil {
 		check.recordDef(id, obj)
@@ -144,6 +145,11 @@ func (check *Checker) objDecl(obj Object, def *TypeName) {
 				obj.typ = Typ[Invalid]
 			}
 
+		case *Variant:
+			if obj.typ == nil {
+				obj.typ = Typ[Invalid]
+			}
+
 		case *Func:
 			if !check.validCycle(obj) {
 				// Don't set obj.typ to Typ[Invalid] here
@@ -192,6 +198,8 @@ func (check *Checker) objDecl(obj Object, def *TypeName) {
 	case *Func:
 		// functions may be recursive - no need to track dependencies
 		check.funcDecl(obj, d)
+	case *Variant:
+		check.variantDecl(obj, d)
 	default:
 		panic("unreachable")
 	}
@@ -367,9 +375,16 @@ type (
 		typ       ast.Expr
 		init      []ast.Expr
 		inherited bool
+		vis       ast.Visibility // Wo visibility modifier of the declaration
+	}
+	varDecl struct {
+		spec *ast.ValueSpec
+		vis  ast.Visibility // Wo visibility modifier of the declaration
+	}
+	typeDecl struct {
+		spec *ast.TypeSpec
+		vis  ast.Visibility // Wo visibility modifier of the declaration
 	}
-	varDecl  struct{ spec *ast.ValueSpec }
-	typeDecl struct{ spec *ast.TypeSpec }
 	funcDecl struct{ decl *ast.FuncDecl }
 )
 
@@ -409,15 +424,15 @@ func (check *Checker) walkDecl(d ast.Decl, f func(decl)) {
 						inherited = false
 					}
 					check.arityMatch(s, last)
-					f(constDecl{spec: s, iota: iota, typ: last.Type, init: last.Values, inherited: inherited})
+					f(constDecl{spec: s, iota: iota, typ: last.Type, init: last.Values, inherited: inherited, vis: d.Vis})
 				case token.VAR:
 					check.arityMatch(s, nil)
-					f(varDecl{s})
+					f(varDecl{s, d.Vis})
 				default:
 					check.errorf(s, InvalidSyntaxTree, "invalid token %s", d.Tok)
 				}
 			case *ast.TypeSpec:
-				f(typeDecl{s})
+				f(typeDecl{s, d.Vis})
 			default:
 				check.errorf(s, InvalidSyntaxTree, "unknown ast.Spec node %T", s)
 			}
@@ -502,6 +517,12 @@ func (check *Checker) varDecl(obj *Var, lhs []*Var, typ, init ast.Expr) {
 
 	if lhs == nil || len(lhs) == 1 {
 		assert(lhs == nil || lhs[0] == obj)
+		if obj.typ != nil && isOptional(obj.typ) || check.maySkip(init) {
+			// The initialization expression may be a comma-ok expression,
+			// or a call with skippable results.
+			check.initVars([]*Var{obj}, []ast.Expr{init}, nil)
+			return
+		}
 		var x operand
 		check.expr(newTarget(obj.typ, obj.name), &x, init)
 		check.initVar(obj, &x, "variable declaration")
@@ -802,6 +823,22 @@ func (check *Checker) collectMethods(obj *TypeName) {
 }
 
 func (check *Checker) checkFieldUniqueness(base *Named) {
+	if t, _ := base.under().(*Enum); t != nil {
+		// The fields of a Wo enum type must be distinct from its method names.
+		for i := 0; i < base.NumMethods(); i++ {
+			m := base.Method(i)
+			if _, fld := t.lookupField(m.pkg, m.name, false); fld != nil {
+				err := check.newError(DuplicateFieldAndMethod)
+				err.addf(m, "field and method with the same name %s", m.name)
+				if fld.pos.IsValid() {
+					err.addAltDecl(fld)
+				}
+				err.report()
+			}
+		}
+		return
+	}
+
 	if t, _ := base.under().(*Struct); t != nil {
 		var mset objset
 		for i := 0; i < base.NumMethods(); i++ {
@@ -956,6 +993,21 @@ func (check *Checker) declStmt(d ast.Decl) {
 				check.declare(check.scope, name, lhs0[i], scopePos)
 			}
 
+			// In Wo files, variables without initial value must be
+			// assigned before they are read (see Checker.uninit).
+			if d.spec.Values == nil && check.allowUninit(d.spec) {
+				if check.uninitVars == nil {
+					check.uninitVars = make(map[*Var]bool)
+					check.uninitNames = make(map[*ast.Ident]*Var)
+				}
+				for i, name := range d.spec.Names {
+					if name.Name != "_" {
+						check.uninitVars[lhs0[i]] = true
+						check.uninitNames[name] = lhs0[i]
+					}
+				}
+			}
+
 		case typeDecl:
 			obj := NewTypeName(d.spec.Name.Pos(), pkg, d.spec.Name.Name, nil)
 			// spec: "The scope of a type identifier declared inside a function
@@ -37,10 +37,11 @@ type errorDesc struct {
 // A new error_ is created with Checker.newError.
 // To report an error_, call error_.report.
 type error_ struct {
-	check *Checker
-	desc  []errorDesc
-	code  Code
-	soft  bool // TODO(gri) eventually determine this from an error code
+	check    *Checker
+	desc     []errorDesc
+	code     Code
+	soft     bool // TODO(gri) eventually determine this from an error code
+	severity Severity
 }
 
 // newError returns a new error_ with the given error code.
@@ -142,10 +143,10 @@ func (err *error_) report() {
 	if multiError {
 		for i := range err.desc {
 			p := &err.desc[i]
-			check.handleError(i, p.posn, err.code, p.msg, err.soft)
+			check.handleError(i, p.posn, err.code, p.msg, err.soft, err.severity)
 		}
 	} else {
-		check.handleError(0, err.posn(), err.code, err.msg(), err.soft)
+		check.handleError(0, err.posn(), err.code, err.msg(), err.soft, err.severity)
 	}
 
 	// make sure the error is not reported twice
@@ -153,7 +154,7 @@ func (err *error_) report() {
 }
 
 // handleError should only be called by error_.report.
-func (check *Checker) handleError(index int, posn positioner, code Code, msg string, soft bool) {
+func (check *Checker) handleError(index int, posn positioner, code Code, msg string, soft bool, severity Severity) {
 	assert(code != 0)
 
 	if index == 0 {
@@ -194,6 +195,7 @@ func (check *Checker) handleError(index int, posn positioner, code Code, msg str
 		Pos:        span.pos,
 		Msg:        stripAnnotations(msg),
 		Soft:       soft,
+		Severity:   severity,
 		go116code:  code,
 		go116start: span.start,
 		go116end:   span.end,
@@ -210,6 +212,14 @@ func (check *Checker) handleError(index int, posn positioner, code Code, msg str
 		e.go116end = span.end
 	}
 
+	// Warnings don't make type-checking fail.
+	if severity == SeverityWarning {
+		if f := check.conf.Error; f != nil {
+			f(e)
+		}
+		return
+	}
+
 	if check.firstErr == nil {
 		check.firstErr = e
 	}
@@ -250,6 +260,16 @@ func (check *Checker) softErrorf(at positioner, code Code, format string, args .
 	err.report()
 }
 
+// warnf reports a warning. Warnings are only reported in Wo files,
+// for diagnostics that are (soft) errors in Go files.
+func (check *Checker) warnf(at positioner, code Code, format string, args ...any) {
+	err := check.newError(code)
+	err.addf(at, format, args...)
+	err.soft = true
+	err.severity = SeverityWarning
+	err.report()
+}
+
 func (check *Checker) versionErrorf(at positioner, v goVersion, format string, args ...any) {
 	msg := check.sprintf(format, args...)
 	err := check.newError(UnsupportedFeature)
@@ -257,6 +277,13 @@ func (check *Checker) versionErrorf(at positioner, v goVersion, format string, a
 	err.report()
 }
 
+func (check *Checker) woErrorf(at positioner, f woFeatures, format string, args ...any) {
+	msg := check.sprintf(format, args...)
+	err := check.newError(UnsupportedFeature)
+	err.addf(at, "%s requires Wo feature %s, which is disabled", msg, f)
+	err.report()
+}
+
 // atPos wraps a token.Pos to implement the positioner interface.
 type atPos token.Pos
 
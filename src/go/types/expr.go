@@ -125,6 +125,141 @@ var op2str2 = [...]string{
 	token.SHL: "shift",
 }
 
+// unwrap typechecks the Wo postfix expression e.X?. If e.X denotes a type,
+// the result is the optional type e.X?. Otherwise e.X must be a value of
+// optional type, a comma-ok expression, or a call returning a value and a
+// boolean, and the result is the present value: if the value is absent,
+// the enclosing function returns None (and zero values for any other
+// results).
+func (check *Checker) unwrap(x *operand, e *ast.PostfixExpr) {
+	check.rawExpr(nil, x, e.X, nil, false)
+	check.exclude(x, 1<<novalue|1<<builtin)
+	if x.mode == invalid {
+		return
+	}
+	if x.mode == typexpr {
+		check.validVarType(e.X, x.typ)
+		if !check.verifyWof(e, woOptional, "optional type") {
+			x.mode = invalid
+			return
+		}
+		x.typ = &Optional{elem: x.typ}
+		return
+	}
+	if !check.verifyWof(atPos(e.OpPos), woOptional, "? operator") {
+		x.mode = invalid
+		return
+	}
+
+	var elem Type
+	if t, _ := x.typ.(*Tuple); t != nil {
+		if t.Len() == 2 && isBoolean(t.vars[1].typ) {
+			elem = t.vars[0].typ
+		}
+	} else if x.mode == mapindex || x.mode == commaok {
+		elem = x.typ
+		check.recordCommaOkTypes(e.X, []*operand{x, {mode: value, expr: e.X, typ: Typ[Bool]}})
+	} else if u, _ := under(x.typ).(*Optional); u != nil {
+		elem = u.elem
+	}
+	if elem == nil {
+		check.errorf(x, InvalidUnwrap, invalidOp+"cannot unwrap %s: not an optional value or comma-ok expression", x)
+		x.mode = invalid
+		return
+	}
+
+	// The enclosing function must be able to return None.
+	switch {
+	case check.sig == nil:
+		check.error(atPos(e.OpPos), InvalidUnwrap, "? operator outside function")
+		x.mode = invalid
+		return
+	case check.inRangeFunc:
+		check.error(atPos(e.OpPos), InvalidUnwrap, "cannot use ? operator in range-over-func loop body")
+		x.mode = invalid
+		return
+	case !hasOptionalResult(check.sig):
+		check.error(atPos(e.OpPos), InvalidUnwrap, "cannot use ? operator in function without optional (last) result")
+		x.mode = invalid
+		return
+	}
+
+	x.mode = value
+	x.typ = elem
+}
+
+// propagate typechecks the Wo error propagation x!, where x is an
+// error or a call returning at most one value followed by an error.
+// If the error is not nil, x! returns it (with zero values for the
+// other results) from the enclosing function.
+func (check *Checker) propagate(x *operand, e *ast.PostfixExpr) {
+	check.rawExpr(nil, x, e.X, nil, false)
+	check.exclude(x, 1<<novalue|1<<builtin|1<<typexpr)
+	if x.mode == invalid {
+		return
+	}
+	if !check.verifyWof(atPos(e.OpPos), woResult, "! operator") {
+		x.mode = invalid
+		return
+	}
+
+	vals := []Type{x.typ}
+	if t, _ := x.typ.(*Tuple); t != nil {
+		vals = vals[:0]
+		for _, v := range t.vars {
+			vals = append(vals, v.typ)
+		}
+	}
+	if !Identical(vals[len(vals)-1], universeError) {
+		check.errorf(x, InvalidPropagate, invalidOp+"cannot use ! operator on %s: last value is not an error", x)
+		x.mode = invalid
+		return
+	}
+	if len(vals) > 2 {
+		check.errorf(x, InvalidPropagate, invalidOp+"cannot use ! operator on %s: more than one value besides the error", x)
+		x.mode = invalid
+		return
+	}
+
+	// The enclosing function must be able to return the error.
+	switch {
+	case check.sig == nil:
+		check.error(atPos(e.OpPos), InvalidPropagate, "! operator outside function")
+		x.mode = invalid
+		return
+	case check.inRangeFunc:
+		check.error(atPos(e.OpPos), InvalidPropagate, "cannot use ! operator in range-over-func loop body")
+		x.mode = invalid
+		return
+	case !hasErrorResult(check.sig):
+		check.error(atPos(e.OpPos), InvalidPropagate, "cannot use ! operator in function without error (last) result")
+		x.mode = invalid
+		return
+	}
+
+	if len(vals) == 1 {
+		x.mode = novalue
+		x.typ = nil
+	} else {
+		x.mode = value
+		x.typ = vals[0]
+	}
+}
+
+// isNone reports whether x is the predeclared Wo value None.
+func (check *Checker) isNone(x *operand) bool {
+	if name, _ := ast.Unparen(x.expr).(*ast.Ident); name != nil {
+		return check.lookup(name.Name) == universeNone
+	}
+	return false
+}
+
+// allowOptional reports whether the Wo optional feature is enabled
+// for the file containing at.
+func (check *Checker) allowOptional(at positioner) bool {
+	return check.allowWo(at, woOptional)
+}
+
 // The unary expression e may be nil. It's passed in for better error messages only.
 func (check *Checker) unary(x *operand, e *ast.UnaryExpr) {
 	check.expr(nil, x, e.X)
@@ -299,6 +434,11 @@ func (check *Checker) updateExprType(x ast.Expr, typ Type, final bool) {
 			check.updateExprType(x.Y, typ, final)
 		}
 
+	case *ast.CondExpr:
+		// The branch types match the result type.
+		check.updateExprType(x.X, typ, final)
+		check.updateExprType(x.Y, typ, final)
+
 	default:
 		panic("unreachable")
 	}
@@ -368,7 +508,20 @@ func (check *Checker) implicitTypeAndValue(x *operand, target Type) (Type, const
 		return nil, nil, InvalidUntypedConversion
 	}
 
+	// The Wo value None is only assignable to optional types.
+	if x.isNil() && check.isNone(x) && !isOptional(target) {
+		return nil, nil, InvalidUntypedConversion
+	}
+
 	switch u := under(target).(type) {
+	case *Optional:
+		if x.isNil() {
+			// Keep None untyped - see comment for interfaces, below.
+			return Typ[UntypedNil], nil, 0
+		}
+		// An untyped value is converted to the element type;
+		// the resulting value is present.
+		return check.implicitTypeAndValue(x, u.elem)
 	case *Basic:
 		if x.mode == constant_ {
 			v, code := check.representation(x, u)
@@ -427,16 +580,22 @@ func (check *Checker) implicitTypeAndValue(x *operand, target Type) (Type, const
 		// Values must have concrete dynamic types. If the value is nil,
 		// keep it untyped (this is important for tools such as go vet which
 		// need the dynamic type for argument checking of say, print
-		// functions)
+		// functions). Wo union types only include nil if one of their
+		// terms does.
 		if x.isNil() {
+			if !hasNil(target) {
+				return nil, nil, InvalidUntypedConversion
+			}
 			return Typ[UntypedNil], nil, 0
 		}
 		// cannot assign untyped values to non-empty interfaces
-		if !u.Empty() {
+		// (in Wo files, union types accept untyped values whose
+		// default type is in their type set)
+		if !u.Empty() && !(u.NumMethods() == 0 && check.allowWo(x, woInterface)) {
 			return nil, nil, InvalidUntypedConversion
 		}
 		return Default(x.typ), nil, 0
-	case *Pointer, *Signature, *Slice, *Map, *Chan:
+	case *Pointer, *Signature, *Slice, *Map, *Set, *Chan:
 		if !x.isNil() {
 			return nil, nil, InvalidUntypedConversion
 		}
@@ -576,7 +735,7 @@ Error:
 // If there is no more specific cause, the result is "".
 func (check *Checker) incomparableCause(typ Type) string {
 	switch under(typ).(type) {
-	case *Slice, *Signature, *Map:
+	case *Slice, *Signature, *Map, *Set, *Optional:
 		return compositeKind(typ) + " can only be compared to nil"
 	}
 	// see if we can extract a more specific error
@@ -904,6 +1063,54 @@ func (check *Checker) matchTypes(x, y *operand) {
 	}
 }
 
+// condExpr type-checks the Wo conditional expression e and sets x to the result.
+// Both branches must be assignable to a common type, which becomes the type of
+// the result. As for binary operations, an untyped branch is converted to the
+// type of the other branch, so untyped constants are defaulted consistently.
+func (check *Checker) condExpr(T *target, x *operand, e *ast.CondExpr) {
+	check.verifyWof(e, woTernary, "conditional expression")
+
+	var c operand
+	check.expr(nil, &c, e.Cond)
+	if c.mode != invalid && !allBoolean(c.typ) {
+		check.error(e.Cond, InvalidCond, "non-boolean condition in conditional expression")
+	}
+
+	var y operand
+	check.expr(T, x, e.X)
+	check.expr(T, &y, e.Y)
+	if x.mode == invalid {
+		return
+	}
+	if y.mode == invalid {
+		x.mode = invalid
+		x.expr = y.expr
+		return
+	}
+
+	check.matchTypes(x, &y)
+	if x.mode == invalid {
+		return
+	}
+
+	if !Identical(x.typ, y.typ) {
+		if ok, _ := y.assignableTo(check, x.typ, nil); ok {
+			// x.typ is the common type
+		} else if ok, _ := x.assignableTo(check, y.typ, nil); ok {
+			x.typ = y.typ
+		} else {
+			if isValid(x.typ) && isValid(y.typ) {
+				check.errorf(e, MismatchedTypes, "mismatched types %s and %s in conditional expression", x.typ, y.typ)
+			}
+			x.mode = invalid
+			return
+		}
+	}
+
+	x.mode = value
+	x.val = nil
+}
+
 // exprKind describes the kind of an expression; the kind
 // determines if an expression is valid in 'statement context'.
 type exprKind int
@@ -969,7 +1176,7 @@ func (check *Checker) nonGeneric(T *target, x *operand) {
 	}
 	var what string
 	switch t := x.typ.(type) {
-	case *Alias, *Named:
+	case *Alias, *Named, *Set:
 		if isGeneric(t) {
 			what = "type"
 		}
@@ -1023,6 +1230,18 @@ func (check *Checker) exprInternal(T *target, x *operand, e ast.Expr, hint Type)
 			goto Error
 		}
 
+	case *ast.LambdaExpr:
+		var sig *Signature
+		if T != nil {
+			sig = T.sig
+		} else if hint != nil {
+			sig, _ = under(hint).(*Signature)
+		}
+		check.lambda(x, e, sig)
+		if x.mode == invalid {
+			goto Error
+		}
+
 	case *ast.CompositeLit:
 		check.compositeLit(x, e, hint)
 		if x.mode == invalid {
@@ -1132,6 +1351,33 @@ func (check *Checker) exprInternal(T *target, x *operand, e ast.Expr, hint Type)
 			goto Error
 		}
 
+	case *ast.PostfixExpr:
+		switch e.Op {
+		case token.QUESTION:
+			// Wo optional type or unwrap
+			check.unwrap(x, e)
+			if x.mode == invalid {
+				goto Error
+			}
+		case token.NOT:
+			// Wo error propagation
+			check.propagate(x, e)
+			if x.mode == invalid {
+				goto Error
+			}
+			x.expr = e
+			return statement // error propagation may appear in statement context
+		default:
+			check.errorf(e, InvalidSyntaxTree, "unknown postfix operator %s", e.Op)
+			goto Error
+		}
+
+	case *ast.CondExpr:
+		check.condExpr(T, x, e)
+		if x.mode == invalid {
+			goto Error
+		}
+
 	case *ast.KeyValueExpr:
 		// key:value expressions are handled in composite literals
 		check.error(e, InvalidSyntaxTree, "no key:value expected")
@@ -1205,6 +1451,22 @@ func keyVal(x constant.Value) interface{} {
 
 // typeAssertion checks x.(T). The type of x must be an interface.
 func (check *Checker) typeAssertion(e ast.Expr, x *operand, T Type, typeSwitch bool) {
+	// Values of Wo union types are represented like other interface
+	// values, so only the types of their terms can be matched at run
+	// time, in type switches.
+	if t, _ := under(T).(*Interface); t != nil && t.typeSet().hasTerms() && check.allowWo(e, woInterface) {
+		if !typeSwitch {
+			check.errorf(e, InvalidAssert, "cannot assert to union type %s (use a type switch)", T)
+			return
+		}
+		for _, term := range t.typeSet().terms {
+			if term.tilde {
+				check.errorf(e, InvalidAssert, "cannot match union type %s with ~ terms in type switch", T)
+				return
+			}
+		}
+	}
+
 	var cause string
 	if check.assertableTo(x.typ, T, &cause) {
 		return // success
@@ -1240,16 +1502,23 @@ func (check *Checker) genericExpr(x *operand, e ast.Expr) {
 // If allowCommaOk is set and e is a map index, comma-ok, or comma-err
 // expression, the result is a two-element list containing the value
 // of e, and an untyped bool value or an error value, respectively.
+// If want > 0 and e is a call of a Wo function with skippable results,
+// these results are omitted from list if exactly want results remain
+// (see Checker.unskipped).
 // If an error occurred, list[0] is not valid.
-func (check *Checker) multiExpr(e ast.Expr, allowCommaOk bool) (list []*operand, commaOk bool) {
+func (check *Checker) multiExpr(e ast.Expr, allowCommaOk bool, want int) (list []*operand, commaOk bool) {
 	var x operand
 	check.rawExpr(nil, &x, e, nil, false)
 	check.exclude(&x, 1<<novalue|1<<builtin|1<<typexpr)
 
 	if t, ok := x.typ.(*Tuple); ok && x.mode != invalid {
 		// multiple values
-		list = make([]*operand, t.Len())
-		for i, v := range t.vars {
+		vars := t.vars
+		if want > 0 && want < len(vars) {
+			vars = check.unskipped(e, vars, want)
+		}
+		list = make([]*operand, len(vars))
+		for i, v := range vars {
 			list[i] = &operand{mode: value, expr: e, typ: v.typ}
 		}
 		return
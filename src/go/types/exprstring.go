@@ -51,6 +51,17 @@ func WriteExpr(buf *bytes.Buffer, x ast.Expr) {
 		WriteExpr(buf, x.Type)
 		buf.WriteString(" literal)") // shortened
 
+	case *ast.LambdaExpr:
+		if len(x.Params) == 1 {
+			buf.WriteString(x.Params[0].Name)
+		} else {
+			buf.WriteByte('(')
+			writeIdentList(buf, x.Params)
+			buf.WriteByte(')')
+		}
+		buf.WriteString(" -> ")
+		WriteExpr(buf, x.Body)
+
 	case *ast.CompositeLit:
 		WriteExpr(buf, x.Type)
 		buf.WriteByte('{')
@@ -117,6 +128,28 @@ func WriteExpr(buf *bytes.Buffer, x ast.Expr) {
 		buf.WriteString(x.Op.String())
 		WriteExpr(buf, x.X)
 
+	case *ast.PostfixExpr:
+		WriteExpr(buf, x.X)
+		buf.WriteString(x.Op.String())
+
+	case *ast.CondExpr:
+		buf.WriteString("if ")
+		WriteExpr(buf, x.Cond)
+		buf.WriteString(" then ")
+		WriteExpr(buf, x.X)
+		buf.WriteString(" else ")
+		WriteExpr(buf, x.Y)
+
+	case *ast.BindingExpr:
+		buf.WriteString("var ")
+		writeIdentList(buf, x.Names)
+		if x.Type != nil {
+			buf.WriteByte(' ')
+			WriteExpr(buf, x.Type)
+		}
+		buf.WriteString(" = ")
+		WriteExpr(buf, x.Value)
+
 	case *ast.BinaryExpr:
 		WriteExpr(buf, x.X)
 		buf.WriteByte(' ')
@@ -137,11 +170,41 @@ func WriteExpr(buf *bytes.Buffer, x ast.Expr) {
 		writeFieldList(buf, x.Fields.List, "; ", false)
 		buf.WriteByte('}')
 
+	case *ast.EnumType:
+		buf.WriteString("enum{")
+		for i, v := range x.Variants {
+			if i > 0 {
+				buf.WriteString("; ")
+			}
+			buf.WriteString(v.Name.Name)
+			if v.Values != nil {
+				buf.WriteByte('(')
+				writeExprList(buf, v.Values)
+				buf.WriteByte(')')
+			}
+			if v.Fields != nil {
+				buf.WriteByte('(')
+				writeFieldList(buf, v.Fields.List, ", ", false)
+				buf.WriteByte(')')
+			}
+		}
+		buf.WriteByte('}')
+
 	case *ast.FuncType:
+		if x.Arrow.IsValid() {
+			writeArrowSigExpr(buf, x)
+			break
+		}
 		buf.WriteString("func")
 		writeSigExpr(buf, x)
 
 	case *ast.InterfaceType:
+		if x.Compact {
+			buf.WriteByte('<')
+			writeFieldList(buf, x.Methods.List, ", ", true)
+			buf.WriteByte('>')
+			break
+		}
 		buf.WriteString("interface{")
 		writeFieldList(buf, x.Methods.List, "; ", true)
 		buf.WriteByte('}')
@@ -192,12 +255,39 @@ func writeSigExpr(buf *bytes.Buffer, sig *ast.FuncType) {
 	buf.WriteByte(')')
 }
 
+// writeArrowSigExpr writes the Wo arrow function type sig.
+func writeArrowSigExpr(buf *bytes.Buffer, sig *ast.FuncType) {
+	if list := sig.Params.List; len(list) == 1 && len(list[0].Names) == 0 {
+		WriteExpr(buf, list[0].Type)
+	} else {
+		buf.WriteByte('(')
+		writeFieldList(buf, list, ", ", false)
+		buf.WriteByte(')')
+	}
+	buf.WriteString(" -> ")
+	res := sig.Results
+	switch n := res.NumFields(); {
+	case n == 0:
+		buf.WriteByte('_')
+	case n == 1 && len(res.List[0].Names) == 0:
+		WriteExpr(buf, res.List[0].Type)
+	default:
+		buf.WriteByte('(')
+		writeFieldList(buf, res.List, ", ", false)
+		buf.WriteByte(')')
+	}
+}
+
 func writeFieldList(buf *bytes.Buffer, list []*ast.Field, sep string, iface bool) {
 	for i, f := range list {
 		if i > 0 {
 			buf.WriteString(sep)
 		}
 
+		if f.Skip.IsValid() {
+			buf.WriteString("skip ")
+		}
+
 		// field list names
 		writeIdentList(buf, f.Names)
 
@@ -208,11 +298,17 @@ func writeFieldList(buf *bytes.Buffer, list []*ast.Field, sep string, iface bool
 		}
 
 		// named fields are separated with a blank from the field type
-		if len(f.Names) > 0 {
-			buf.WriteByte(' ')
+		if f.Type != nil {
+			if len(f.Names) > 0 {
+				buf.WriteByte(' ')
+			}
+			WriteExpr(buf, f.Type)
 		}
 
-		WriteExpr(buf, f.Type)
+		if f.Default != nil {
+			buf.WriteString(" = ")
+			WriteExpr(buf, f.Default)
+		}
 
 		// ignore tag
 	}
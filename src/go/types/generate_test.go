@@ -109,6 +109,14 @@ var filemap = map[string]action{
 	"array.go":          nil,
 	"api_predicates.go": nil,
 	"basic.go":          nil,
+	"binding.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		insertImportPath(f, `"go/token"`)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f, "syntax.VarClause->ast.BindingExpr", "syntax.Pos->token.Pos", "name.Value->name.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast")
+		renameSelectors(f, "NameList->Names")
+	},
 	"builtins.go": func(f *ast.File) {
 		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
 		renameIdents(f, "syntax->ast")
@@ -126,6 +134,26 @@ var filemap = map[string]action{
 	"context.go":      nil,
 	"context_test.go": nil,
 	"conversions.go":  nil,
+	"enum.go":         fixTokenPos,
+	"default.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f, "syntax.Name->ast.Ident", "e.Value->e.Name", "x.Value->x.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast")
+		renameSelectors(f, "ArgList->Args")
+		fixSelValue(f)
+	},
+	"enumdecl.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f,
+			"syntax.Name->ast.Ident", "name.Value->name.Name", "e.Value->e.Name",
+			"ident.Value->ident.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast")
+		renameSelectors(f, "ArgList->Args", "FieldList->Fields", "VariantList->Variants", "ValueList->Values")
+		fixSelValue(f)
+		fixNameValue(f)
+	},
 	"errors_test.go":  func(f *ast.File) { renameIdents(f, "nopos->noposn") },
 	"errsupport.go":   nil,
 	"gccgosizes.go":   nil,
@@ -136,6 +164,13 @@ var filemap = map[string]action{
 	// "initorder.go": fixErrErrorfCall, // disabled for now due to unresolved error_ use implications for gopls
 	"instantiate.go":      func(f *ast.File) { fixTokenPos(f); fixCheckErrorfCall(f) },
 	"instantiate_test.go": func(f *ast.File) { renameImportPath(f, `"cmd/compile/internal/types2"->"go/types"`) },
+	"lambda.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f, "name.Value->name.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast")
+		renameSelectors(f, "ParamList->Params")
+	},
 	"literals.go": func(f *ast.File) {
 		insertImportPath(f, `"go/token"`)
 		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
@@ -157,7 +192,8 @@ var filemap = map[string]action{
 	"object.go": func(f *ast.File) { fixTokenPos(f); renameIdents(f, "NewTypeNameLazy->_NewTypeNameLazy") },
 	// TODO(gri) needs adjustments for TestObjectString - disabled for now
 	// "object_test.go": func(f *ast.File) { renameImportPath(f, `"cmd/compile/internal/types2"->"go/types"`) },
-	"objset.go": nil,
+	"objset.go":   nil,
+	"optional.go": nil,
 	"operand.go": func(f *ast.File) {
 		insertImportPath(f, `"go/token"`)
 		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
@@ -168,6 +204,16 @@ var filemap = map[string]action{
 			"syntax.StringLit->token.STRING") // must happen before renaming identifiers
 		renameIdents(f, "syntax->ast")
 	},
+	"overload.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f,
+			"syntax.Name->ast.Ident", "e.Value->e.Name", "ident.Value->ident.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast", "poser->positioner")
+		renameSelectors(f, "ArgList->Args", "DeclList->Decls")
+		fixSelValue(f)
+		fixNameValue(f)
+	},
 	"package.go":    nil,
 	"pointer.go":    nil,
 	"predicates.go": nil,
@@ -177,9 +223,22 @@ var filemap = map[string]action{
 		renameIdents(f, "syntax->ast")
 		fixAtPosCall(f)
 	},
-	"scope.go":         func(f *ast.File) { fixTokenPos(f); renameIdents(f, "InsertLazy->_InsertLazy") },
-	"selection.go":     nil,
-	"sizes.go":         func(f *ast.File) { renameIdents(f, "IsSyncAtomicAlign64->_IsSyncAtomicAlign64") },
+	"scope.go":     func(f *ast.File) { fixTokenPos(f); renameIdents(f, "InsertLazy->_InsertLazy") },
+	"selection.go": nil,
+	"set.go":       nil,
+	"shadow.go":    func(f *ast.File) { fixWoFeatures(f); removeImportPath(f, `"cmd/compile/internal/syntax"`) },
+	"shortvar.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f, "syntax.Name->ast.Ident", "name.Value->name.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast", "poser->positioner")
+	},
+	"sizes.go": func(f *ast.File) { renameIdents(f, "IsSyncAtomicAlign64->_IsSyncAtomicAlign64") },
+	"skip.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameIdents(f, "syntax->ast")
+	},
 	"slice.go":         nil,
 	"subst.go":         func(f *ast.File) { fixTokenPos(f); renameSelectors(f, "Trace->_Trace") },
 	"termlist.go":      nil,
@@ -192,10 +251,18 @@ var filemap = map[string]action{
 	"typeterm.go":      nil,
 	"typestring.go":    nil,
 	"under.go":         nil,
-	"unify.go":         fixSprintf,
-	"universe.go":      fixGlobalTypVarDecl,
-	"util_test.go":     fixTokenPos,
-	"validtype.go":     func(f *ast.File) { fixTokenPos(f); renameSelectors(f, "Trace->_Trace") },
+	"uninit.go": func(f *ast.File) {
+		fixWoFeatures(f)
+		renameImportPath(f, `"cmd/compile/internal/syntax"->"go/ast"`)
+		renameSelectorExprs(f,
+			"syntax.Name->ast.Ident", "syntax.Operation->ast.UnaryExpr", "n.Value->n.Name") // must happen before renaming identifiers
+		renameIdents(f, "syntax->ast", "poser->positioner")
+		fixSelValue(f)
+	},
+	"unify.go":     fixSprintf,
+	"universe.go":  fixGlobalTypVarDecl,
+	"util_test.go": fixTokenPos,
+	"validtype.go": func(f *ast.File) { fixTokenPos(f); renameSelectors(f, "Trace->_Trace") },
 }
 
 // TODO(gri) We should be able to make these rewriters more configurable/composable.
@@ -310,6 +377,24 @@ func insertImportPath(f *ast.File, path string) {
 	panic("no import declaration present")
 }
 
+// removeImportPath removes the given import path.
+func removeImportPath(f *ast.File, path string) {
+	for _, d := range f.Decls {
+		if g, _ := d.(*ast.GenDecl); g != nil && g.Tok == token.IMPORT {
+			for i, s := range g.Specs {
+				if s.(*ast.ImportSpec).Path.Value == path {
+					g.Specs = append(g.Specs[:i], g.Specs[i+1:]...)
+					if len(g.Specs) == 1 {
+						g.Lparen = token.NoPos // import x rather than import (x)
+					}
+					return
+				}
+			}
+		}
+	}
+	panic("import path not present")
+}
+
 // fixTokenPos changes imports of "cmd/compile/internal/syntax" to "go/token",
 // uses of syntax.Pos to token.Pos, and calls to x.IsKnown() to x.IsValid().
 func fixTokenPos(f *ast.File) {
@@ -353,6 +438,22 @@ func fixSelValue(f *ast.File) {
 	})
 }
 
+// fixNameValue updates the selector x.Name.Value to x.Name.Name.
+func fixNameValue(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.SelectorExpr:
+			if n.Sel.Name == "Value" {
+				if selx, _ := n.X.(*ast.SelectorExpr); selx != nil && selx.Sel.Name == "Name" {
+					n.Sel.Name = "Name"
+					return false
+				}
+			}
+		}
+		return true
+	})
+}
+
 // fixInferSig updates the Checker.infer signature to use a positioner instead of a token.Position
 // as first argument, renames the argument from "pos" to "posn", and updates a few internal uses of
 // "pos" to "posn" and "posn.Pos()" respectively.
@@ -507,6 +608,24 @@ func fixSprintf(f *ast.File) {
 	})
 }
 
+// fixWoFeatures changes uses of the Wo features syntax.WoF in call arguments
+// to the corresponding woF (in types2 the features are declared by package
+// syntax, in go/types they are declared locally).
+func fixWoFeatures(f *ast.File) {
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			for i, arg := range n.Args {
+				// rewrite syntax.WoF to woF
+				if sel, _ := arg.(*ast.SelectorExpr); sel != nil && isIdent(sel.X, "syntax") && strings.HasPrefix(sel.Sel.Name, "Wo") {
+					n.Args[i] = newIdent(sel.Pos(), "wo"+sel.Sel.Name[len("Wo"):])
+				}
+			}
+		}
+		return true
+	})
+}
+
 // asIdent returns x as *ast.Ident if it is an identifier with the given name.
 func asIdent(x ast.Node, name string) *ast.Ident {
 	if ident, _ := x.(*ast.Ident); ident != nil && ident.Name == name {
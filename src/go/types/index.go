@@ -101,6 +101,21 @@ func (check *Checker) indexExpr(x *operand, e *indexedExpr) (isFuncInst bool) {
 		x.expr = e.orig
 		return false
 
+	case *Set:
+		// s[x] reports whether x is an element of s
+		index := check.singleIndex(e)
+		if index == nil {
+			x.mode = invalid
+			return false
+		}
+		var elem operand
+		check.expr(nil, &elem, index)
+		check.assignment(&elem, typ.elem, "set index")
+		x.mode = value
+		x.typ = Typ[Bool]
+		x.expr = e.orig
+		return false
+
 	case *Interface:
 		if !isTypeParam(x.typ) {
 			break
@@ -515,6 +515,46 @@ func (check *Checker) renameTParams(pos token.Pos, tparams []*TypeParam, typ Typ
 	return tparams2, check.subst(pos, typ, renameMap, nil, check.context())
 }
 
+// substMethodTParams is like check.subst(pos, sig, smap, expanding, ctxt)
+// for the signature sig of a Wo method with type parameters, where smap
+// maps the receiver type parameters to the receiver's type arguments.
+// Since the bounds of the method's type parameters may refer to receiver
+// type parameters, they are substituted, too: in that case, the method's
+// type parameters are renamed (as in renameTParams) to carry the new bounds.
+func (check *Checker) substMethodTParams(pos token.Pos, sig *Signature, smap substMap, expanding *Named, ctxt *Context) *Signature {
+	tparams := sig.tparams.list()
+	i := 0
+	for i < len(tparams) && check.subst(pos, tparams[i].bound, smap, nil, ctxt) == tparams[i].bound {
+		i++
+	}
+	if i == len(tparams) {
+		// no bound refers to a receiver type parameter
+		return check.subst(pos, sig, smap, expanding, ctxt).(*Signature)
+	}
+
+	tparams2 := make([]*TypeParam, len(tparams))
+	smap2 := make(substMap, len(smap)+len(tparams))
+	for tpar, targ := range smap {
+		smap2[tpar] = targ
+	}
+	for i, tparam := range tparams {
+		tname := NewTypeName(tparam.Obj().Pos(), tparam.Obj().Pkg(), tparam.Obj().Name(), nil)
+		tparams2[i] = NewTypeParam(tname, nil)
+		smap2[tparam] = tparams2[i]
+	}
+	for i, tparam := range tparams {
+		tparams2[i].bound = check.subst(pos, tparam.bound, smap2, nil, ctxt)
+	}
+
+	sig2 := check.subst(pos, sig, smap2, expanding, ctxt).(*Signature)
+	if sig2 == sig {
+		copy := *sig
+		sig2 = &copy
+	}
+	sig2.tparams = bindTParams(tparams2)
+	return sig2
+}
+
 // typeParamsString produces a string containing all the type parameter names
 // in list suitable for human consumption.
 func typeParamsString(list []*TypeParam) string {
@@ -619,6 +659,15 @@ func (w *tpWalker) isParameterized(typ Type) (res bool) {
 	case *Map:
 		return w.isParameterized(t.key) || w.isParameterized(t.elem)
 
+	case *Set:
+		return w.isParameterized(t.elem)
+
+	case *Optional:
+		return w.isParameterized(t.elem)
+
+	case *Enum:
+		// enum types cannot be generic
+
 	case *Chan:
 		return w.isParameterized(t.elem)
 
@@ -771,6 +820,15 @@ func (w *cycleFinder) typ(typ Type) {
 		w.typ(t.key)
 		w.typ(t.elem)
 
+	case *Set:
+		w.typ(t.elem)
+
+	case *Optional:
+		w.typ(t.elem)
+
+	case *Enum:
+		// nothing to do
+
 	case *Chan:
 		w.typ(t.elem)
 
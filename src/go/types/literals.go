@@ -314,6 +314,47 @@ func (check *Checker) compositeLit(x *operand, e *ast.CompositeLit, hint Type) {
 			check.assignment(x, utyp.elem, "map literal")
 		}
 
+	case *Set:
+		// Prevent crash if the set referred to is not yet set up.
+		// See analogous comment for *Array.
+		if utyp.elem == nil {
+			check.error(e, InvalidTypeCycle, "invalid recursive type")
+			x.mode = invalid
+			return
+		}
+		// Duplicate constant elements are detected like duplicate map keys.
+		elemIsInterface := isNonTypeParamInterface(utyp.elem)
+		visited := make(map[any][]Type, len(e.Elts))
+		for _, e := range e.Elts {
+			if kv, _ := e.(*ast.KeyValueExpr); kv != nil {
+				check.error(kv, InvalidLit, "unexpected key:value element in set literal")
+				check.use(kv.Value)
+				continue
+			}
+			check.exprWithHint(x, e, utyp.elem)
+			check.assignment(x, utyp.elem, "set literal")
+			if x.mode != constant_ {
+				continue
+			}
+			duplicate := false
+			xelem := keyVal(x.val)
+			if elemIsInterface {
+				for _, vtyp := range visited[xelem] {
+					if Identical(vtyp, x.typ) {
+						duplicate = true
+						break
+					}
+				}
+				visited[xelem] = append(visited[xelem], x.typ)
+			} else {
+				_, duplicate = visited[xelem]
+				visited[xelem] = nil
+			}
+			if duplicate {
+				check.errorf(x, DuplicateLitKey, "duplicate element %s in set literal", x.val)
+			}
+		}
+
 	default:
 		// when "using" all elements unpack KeyValueExpr
 		// explicitly because check.use doesn't accept them
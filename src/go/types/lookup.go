@@ -86,6 +86,54 @@ func lookupFieldOrMethod(T Type, addressable bool, pkg *Package, name string, fo
 	return
 }
 
+// BuiltinMethodPath returns the import path of the package whose
+// functions provide the Wo builtin methods of values of type T:
+// "strings" for the string type, "slices" for types whose core type
+// is a slice, and "maps" for types whose core type is a map. For all
+// other types it returns "".
+//
+// In Wo files, the call x.f(args) of a builtin method f of x is a
+// shorthand for the call pkg.f(x, args) of the function f of that
+// package; see IsBuiltinMethod.
+func BuiltinMethodPath(T Type) string {
+	// Only the string type itself: the functions of package strings
+	// don't accept values of defined string types.
+	if t, _ := Unalias(T).(*Basic); t != nil && isString(t) {
+		return "strings"
+	}
+	switch coreType(T).(type) {
+	case *Slice:
+		return "slices"
+	case *Map:
+		return "maps"
+	}
+	return ""
+}
+
+// IsBuiltinMethod reports whether the function fn is a Wo builtin
+// method of values of type T. That is the case if fn is an exported
+// function of the package named by BuiltinMethodPath(T) whose first
+// parameter is a string, a slice, or a map, respectively.
+func IsBuiltinMethod(T Type, fn *Func) bool {
+	path := BuiltinMethodPath(T)
+	if path == "" || fn.pkg == nil || fn.pkg.path != path || !fn.Exported() {
+		return false
+	}
+	sig, _ := fn.typ.(*Signature)
+	if sig == nil || sig.recv != nil || sig.params.Len() == 0 || sig.variadic && sig.params.Len() == 1 {
+		return false
+	}
+	switch t := coreType(sig.params.vars[0].typ).(type) {
+	case *Basic:
+		return path == "strings" && t.kind == String
+	case *Slice:
+		return path == "slices"
+	case *Map:
+		return path == "maps"
+	}
+	return false
+}
+
 // lookupFieldOrMethodImpl is the implementation of lookupFieldOrMethod.
 // Notably, in contrast to lookupFieldOrMethod, it won't find struct fields
 // in base types of defined (*Named) pointer types T. For instance, given
@@ -196,6 +244,17 @@ func lookupFieldOrMethodImpl(T Type, addressable bool, pkg *Package, name string
 					}
 				}
 
+			case *Enum:
+				// look for a matching field (the fields of an embedded
+				// Wo enum type are not promoted)
+				if e.index == nil {
+					if i, f := t.lookupField(pkg, name, foldCase); f != nil {
+						index = []int{i}
+						obj = f
+						indirect = e.indirect
+					}
+				}
+
 			case *Interface:
 				// look for a matching method (interface may be a type parameter)
 				if i, m := t.typeSet().LookupMethod(pkg, name, foldCase); m != nil {
@@ -349,6 +408,7 @@ func (check *Checker) missingMethod(V, T Type, static bool, equivalent func(x, y
 		ambigSel
 		ptrRecv
 		field
+		generic
 	)
 
 	state := ok
@@ -412,6 +472,12 @@ func (check *Checker) missingMethod(V, T Type, static bool, equivalent func(x, y
 				check.objDecl(f, nil)
 			}
 
+			// Wo methods with type parameters don't implement interface methods.
+			if f.typ.(*Signature).tparams != nil {
+				state = generic
+				break
+			}
+
 			if !equivalent(f.typ, m.typ) {
 				state = wrongSig
 				break
@@ -471,6 +537,8 @@ func (check *Checker) missingMethod(V, T Type, static bool, equivalent func(x, y
 			*cause = check.sprintf("(method %s has pointer receiver)", m.Name())
 		case field:
 			*cause = check.sprintf("(%s.%s is a field, not a method)", V, m.Name())
+		case generic:
+			*cause = check.sprintf("(method %s has type parameters)", m.Name())
 		default:
 			panic("unreachable")
 		}
@@ -549,9 +617,23 @@ func (check *Checker) assertableTo(V, T Type, cause *string) bool {
 	// no static check is required if T is an interface
 	// spec: "If T is an interface type, x.(T) asserts that the
 	//        dynamic type of x implements the interface T."
+	Vi, _ := under(V).(*Interface)
 	if IsInterface(T) {
+		// The type sets of Wo union types V and T must intersect.
+		if Ti, _ := under(T).(*Interface); Vi != nil && Ti != nil && Vi.typeSet().hasTerms() && Ti.typeSet().hasTerms() {
+			if Vi.typeSet().terms.intersect(Ti.typeSet().terms).isEmpty() {
+				if cause != nil {
+					*cause = "(type sets are disjoint)"
+				}
+				return false
+			}
+		}
 		return true
 	}
+	// T must be in the type set of a Wo union type V.
+	if Vi != nil && Vi.typeSet().hasTerms() {
+		return check.newAssertableTo(V, T, cause)
+	}
 	// TODO(gri) fix this for generalized interfaces
 	return check.hasAllMethods(T, V, false, Identical, cause)
 }
@@ -0,0 +1,194 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the mangled names of overloaded Wo functions.
+
+package types
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+)
+
+// overloadName returns the mangled name of the overloaded function name
+// with the function type ftyp. The mangled name consists of name, an
+// underscore, and the spellings of the parameter types (see
+// writeTypeSpelling):
+//
+//	func write(s string)                      // write_6string
+//	func write(f Formatter, s string)         // write_9Formatter6string
+//	func write(w *os.File, args ...any)       // write_ptrpkg2os4Filedots3any
+//	func write()                              // write_
+//
+// Spellings are self-delimiting, so overloads with different parameter
+// type expressions have different mangled names. Mangled names only
+// depend on the declaration of the function, so they don't change if
+// other overloads are added or removed.
+func overloadName(name string, ftyp *ast.FuncType) string {
+	var b strings.Builder
+	b.WriteString(name)
+	b.WriteByte('_')
+	for _, f := range ftyp.Params.List {
+		for range max(len(f.Names), 1) {
+			writeTypeSpelling(&b, f.Type)
+		}
+	}
+	return b.String()
+}
+
+// writeTypeSpelling writes the spelling of the type expression e for
+// mangled names of overloaded functions. An identifier is spelled as
+// its length followed by the identifier (see writeIdentSpelling), and
+// other types as a keyword followed by the spellings of their parts:
+//
+//	T, p.T              1T, pkg1p1T
+//	*T, []T, ...T       ptr1T, slice1T, dots1T
+//	[N]T                array1N1T (see writeStringSpelling)
+//	map[K]V             map1K1V
+//	chan T              chan1T (sendchan1T, recvchan1T for chan<- T, <-chan T)
+//	T?, T!              opt1T, result1T
+//	A | B, ~T           or1A1B, tilde1T
+//	G[A, B]             inst2_1G1A1B
+//	interface{}         any
+//
+// Function, struct, and interface types are spelled func, struct, and
+// interface followed by their parameters and results, fields, or
+// elements (see writeFieldSpellings). Expressions that are not types
+// are spelled type.
+func writeTypeSpelling(b *strings.Builder, e ast.Expr) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		writeIdentSpelling(b, e.Name)
+	case *ast.SelectorExpr:
+		x, _ := e.X.(*ast.Ident)
+		if x == nil {
+			b.WriteString("type")
+			return
+		}
+		b.WriteString("pkg")
+		writeIdentSpelling(b, x.Name)
+		writeIdentSpelling(b, e.Sel.Name)
+	case *ast.ParenExpr:
+		writeTypeSpelling(b, e.X)
+	case *ast.IndexExpr:
+		b.WriteString("inst1_")
+		writeTypeSpelling(b, e.X)
+		writeTypeSpelling(b, e.Index)
+	case *ast.IndexListExpr:
+		fmt.Fprintf(b, "inst%d_", len(e.Indices))
+		writeTypeSpelling(b, e.X)
+		for _, a := range e.Indices {
+			writeTypeSpelling(b, a)
+		}
+	case *ast.StarExpr:
+		b.WriteString("ptr")
+		writeTypeSpelling(b, e.X)
+	case *ast.UnaryExpr:
+		if e.Op != token.TILDE {
+			b.WriteString("type")
+			return
+		}
+		b.WriteString("tilde")
+		writeTypeSpelling(b, e.X)
+	case *ast.PostfixExpr:
+		switch e.Op {
+		case token.QUESTION:
+			b.WriteString("opt")
+		case token.NOT:
+			b.WriteString("result")
+		default:
+			b.WriteString("type")
+			return
+		}
+		writeTypeSpelling(b, e.X)
+	case *ast.BinaryExpr:
+		if e.Op != token.OR {
+			b.WriteString("type")
+			return
+		}
+		b.WriteString("or")
+		writeTypeSpelling(b, e.X)
+		writeTypeSpelling(b, e.Y)
+	case *ast.ArrayType:
+		switch e.Len.(type) {
+		case nil:
+			b.WriteString("slice")
+		case *ast.Ellipsis:
+			b.WriteString("array")
+			writeStringSpelling(b, "...")
+		default:
+			b.WriteString("array")
+			writeStringSpelling(b, ExprString(e.Len))
+		}
+		writeTypeSpelling(b, e.Elt)
+	case *ast.Ellipsis:
+		b.WriteString("dots")
+		writeTypeSpelling(b, e.Elt)
+	case *ast.MapType:
+		b.WriteString("map")
+		writeTypeSpelling(b, e.Key)
+		writeTypeSpelling(b, e.Value)
+	case *ast.ChanType:
+		switch e.Dir {
+		case ast.SEND:
+			b.WriteString("sendchan")
+		case ast.RECV:
+			b.WriteString("recvchan")
+		default:
+			b.WriteString("chan")
+		}
+		writeTypeSpelling(b, e.Value)
+	case *ast.FuncType:
+		b.WriteString("func")
+		writeFieldSpellings(b, e.Params, false)
+		writeFieldSpellings(b, e.Results, false)
+	case *ast.StructType:
+		b.WriteString("struct")
+		writeFieldSpellings(b, e.Fields, true)
+		for _, f := range e.Fields.List {
+			var tag string
+			if f.Tag != nil {
+				tag = f.Tag.Value
+			}
+			for range max(len(f.Names), 1) {
+				writeStringSpelling(b, tag)
+			}
+		}
+	case *ast.InterfaceType:
+		if e.Methods.NumFields() == 0 {
+			b.WriteString("any")
+			return
+		}
+		b.WriteString("interface")
+		writeFieldSpellings(b, e.Methods, true)
+	default:
+		b.WriteString("type")
+	}
+}
+
+// writeFieldSpellings writes the number of fields in list, an
+// underscore, and for each field the spelling of its name (if names is
+// set; embedded fields have an empty name) and of its type.
+func writeFieldSpellings(b *strings.Builder, list *ast.FieldList, names bool) {
+	fmt.Fprintf(b, "%d_", list.NumFields())
+	if list == nil {
+		return
+	}
+	for _, f := range list.List {
+		if len(f.Names) == 0 {
+			if names {
+				writeIdentSpelling(b, "")
+			}
+			writeTypeSpelling(b, f.Type)
+		}
+		for _, name := range f.Names {
+			if names {
+				writeIdentSpelling(b, name.Name)
+			}
+			writeTypeSpelling(b, f.Type)
+		}
+	}
+}
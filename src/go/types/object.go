@@ -20,6 +20,7 @@ import (
 // An Object is a named language entity.
 // An Object may be a constant ([Const]), type name ([TypeName]),
 // variable or struct field ([Var]), function or method ([Func]),
+// Wo enum variant ([Variant]), set of overloaded Wo functions ([Overload]),
 // imported package ([PkgName]), label ([Label]),
 // built-in function ([Builtin]),
 // or the predeclared identifier 'nil' ([Nil]).
@@ -32,9 +33,12 @@ type Object interface {
 	Pkg() *Package  // package to which this object belongs; nil for labels and objects in the Universe scope
 	Name() string   // package local object name
 	Type() Type     // object type
-	Exported() bool // reports whether the name starts with a capital letter
+	Exported() bool // reports whether the object is visible to all importing packages
 	Id() string     // object name if exported, qualified name if not exported (see func Id)
 
+	// Visibility returns the visibility of a package-level object.
+	Visibility() Visibility
+
 	// String returns a human-readable string of the object.
 	// Use [ObjectString] to control how package names are formatted in the string.
 	String() string
@@ -107,8 +111,21 @@ type object struct {
 	order_    uint32
 	color_    color
 	scopePos_ token.Pos
+	vis       Visibility
 }
 
+// A Visibility describes which other packages may refer to a
+// package-level object. Objects declared in Wo files may override
+// the capitalization rule for exported identifiers with a visibility
+// modifier.
+type Visibility uint8
+
+const (
+	DefaultVis Visibility = iota // exported if the name starts with a capital letter
+	ExportVis                    // exported (Wo export modifier)
+	PkgVis                       // visible to the packages of the same module (Wo pkg modifier)
+)
+
 // color encodes the color of an object (see Checker.objDecl for details).
 type color uint32
 
@@ -157,10 +174,27 @@ func (obj *object) Name() string { return obj.name }
 // Type returns the object's type.
 func (obj *object) Type() Type { return obj.typ }
 
-// Exported reports whether the object is exported (starts with a capital letter).
+// Exported reports whether the object is exported (starts with a capital letter,
+// or is declared with a Wo export modifier). Objects declared with a Wo pkg
+// modifier are not exported.
 // It doesn't take into account whether the object is in a local (function) scope
 // or not.
-func (obj *object) Exported() bool { return isExported(obj.name) }
+func (obj *object) Exported() bool {
+	switch obj.vis {
+	case ExportVis:
+		return true
+	case PkgVis:
+		return false
+	}
+	return isExported(obj.name)
+}
+
+// Visibility returns the visibility of the object.
+func (obj *object) Visibility() Visibility { return obj.vis }
+
+// SetVisibility sets the visibility of the package-level object.
+// It is intended for use by importers.
+func (obj *object) SetVisibility(vis Visibility) { obj.vis = vis }
 
 // Id is a wrapper for Id(obj.Pkg(), obj.Name()).
 func (obj *object) Id() string { return Id(obj.pkg, obj.name) }
@@ -251,7 +285,7 @@ type PkgName struct {
 // NewPkgName returns a new PkgName object representing an imported package.
 // The remaining arguments set the attributes found with all Objects.
 func NewPkgName(pos token.Pos, pkg *Package, name string, imported *Package) *PkgName {
-	return &PkgName{object{nil, pos, pkg, name, Typ[Invalid], 0, black, nopos}, imported, false}
+	return &PkgName{object{nil, pos, pkg, name, Typ[Invalid], 0, black, nopos, DefaultVis}, imported, false}
 }
 
 // Imported returns the package that was imported.
@@ -267,7 +301,7 @@ type Const struct {
 // NewConst returns a new constant with value val.
 // The remaining arguments set the attributes found with all Objects.
 func NewConst(pos token.Pos, pkg *Package, name string, typ Type, val constant.Value) *Const {
-	return &Const{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos}, val}
+	return &Const{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}, val}
 }
 
 // Val returns the constant's value.
@@ -292,7 +326,7 @@ type TypeName struct {
 // argument for NewNamed, which will set the TypeName's type as a side-
 // effect.
 func NewTypeName(pos token.Pos, pkg *Package, name string, typ Type) *TypeName {
-	return &TypeName{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos}}
+	return &TypeName{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}}
 }
 
 // NewTypeNameLazy returns a new defined type like NewTypeName, but it
@@ -326,6 +360,9 @@ func (obj *TypeName) IsAlias() bool {
 		return obj != t.obj
 	case *TypeParam:
 		return obj != t.obj
+	case *Set:
+		// The predeclared set type is not an alias.
+		return obj != universeSet
 	default:
 		return true
 	}
@@ -337,25 +374,26 @@ type Var struct {
 	embedded bool // if set, the variable is an embedded struct field, and name is the type name
 	isField  bool // var is struct field
 	used     bool // set if the variable was used
+	skip     bool // set if the variable is a Wo result that callers may omit
 	origin   *Var // if non-nil, the Var from which this one was instantiated
 }
 
 // NewVar returns a new variable.
 // The arguments set the attributes found with all Objects.
 func NewVar(pos token.Pos, pkg *Package, name string, typ Type) *Var {
-	return &Var{object: object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos}}
+	return &Var{object: object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}}
 }
 
 // NewParam returns a new variable representing a function parameter.
 func NewParam(pos token.Pos, pkg *Package, name string, typ Type) *Var {
-	return &Var{object: object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos}, used: true} // parameters are always 'used'
+	return &Var{object: object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}, used: true} // parameters are always 'used'
 }
 
 // NewField returns a new variable representing a struct field.
 // For embedded fields, the name is the unqualified type name
 // under which the field is accessible.
 func NewField(pos token.Pos, pkg *Package, name string, typ Type, embedded bool) *Var {
-	return &Var{object: object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos}, embedded: embedded, isField: true}
+	return &Var{object: object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}, embedded: embedded, isField: true}
 }
 
 // Anonymous reports whether the variable is an embedded field.
@@ -405,7 +443,7 @@ func NewFunc(pos token.Pos, pkg *Package, name string, sig *Signature) *Func {
 		// as this would violate object.{Type,color} invariants.
 		// TODO(adonovan): propose to disallow NewFunc with nil *Signature.
 	}
-	return &Func{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos}, false, nil}
+	return &Func{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}, false, nil}
 }
 
 // Signature returns the signature (type) of the function or method.
@@ -478,6 +516,64 @@ func (obj *Func) hasPtrRecv() bool {
 
 func (*Func) isDependency() {} // a function may be a dependency of an initialization expression
 
+// A Variant represents a variant of a Wo enum type.
+// Its Type() is the (defined) enum type.
+type Variant struct {
+	object
+	index  int
+	values []constant.Value // values of the enum's shared fields
+	fields []*Var           // fields specific to the variant; or nil
+}
+
+// NewVariant returns a new variant of the enum type typ. The variant
+// is the index'th variant of the enum; values are the values of the
+// fields shared by all variants of the enum, and fields are the
+// fields specific to the variant.
+func NewVariant(pos token.Pos, pkg *Package, name string, typ Type, index int, values []constant.Value, fields []*Var) *Variant {
+	return &Variant{object{nil, pos, pkg, name, typ, 0, colorFor(typ), nopos, DefaultVis}, index, values, fields}
+}
+
+// Index returns the index of the variant in its enum type,
+// starting at 0.
+func (obj *Variant) Index() int { return obj.index }
+
+// Value returns the value of the i'th shared field of the
+// variant's enum type for the variant.
+func (obj *Variant) Value(i int) constant.Value { return obj.values[i] }
+
+// NumFields returns the number of fields specific to the variant.
+func (obj *Variant) NumFields() int { return len(obj.fields) }
+
+// Field returns the i'th field specific to the variant.
+func (obj *Variant) Field(i int) *Var { return obj.fields[i] }
+
+// An Overload represents a set of overloaded Wo functions with the
+// same name. Each function of the set is a package-level object of its
+// own, whose name is the name of the set mangled with the spelling of
+// the function's parameter types, so that Go code can refer to it:
+//
+//	func write(s string)              // write_6string
+//	func write(f Formatter, s string) // write_9Formatter6string
+//
+// Overloads don't have a valid type.
+type Overload struct {
+	object
+	funcs []*Func
+}
+
+// NewOverload returns a new set of overloaded functions with the given
+// name, which consists of the given functions.
+func NewOverload(pos token.Pos, pkg *Package, name string, funcs []*Func) *Overload {
+	return &Overload{object{nil, pos, pkg, name, Typ[Invalid], 0, black, nopos, DefaultVis}, funcs}
+}
+
+// NumFuncs returns the number of functions in the set.
+func (obj *Overload) NumFuncs() int { return len(obj.funcs) }
+
+// Func returns the i'th function of the set, for 0 <= i < NumFuncs().
+// The functions are in declaration order.
+func (obj *Overload) Func(i int) *Func { return obj.funcs[i] }
+
 // A Label represents a declared label.
 // Labels don't have a type.
 type Label struct {
@@ -543,6 +639,15 @@ func writeObject(buf *bytes.Buffer, obj Object, qf Qualifier) {
 		}
 		return
 
+	case *Variant:
+		buf.WriteString("variant")
+
+	case *Overload:
+		buf.WriteString("overloaded func ")
+		buf.WriteString(packagePrefix(obj.Pkg(), qf))
+		buf.WriteString(obj.Name())
+		return
+
 	case *Label:
 		buf.WriteString("label")
 		typ = nil
@@ -552,7 +657,7 @@ func writeObject(buf *bytes.Buffer, obj Object, qf Qualifier) {
 		typ = nil
 
 	case *Nil:
-		buf.WriteString("nil")
+		buf.WriteString(obj.Name()) // nil or None
 		return
 
 	default:
@@ -638,6 +743,8 @@ func (obj *Const) String() string    { return ObjectString(obj, nil) }
 func (obj *TypeName) String() string { return ObjectString(obj, nil) }
 func (obj *Var) String() string      { return ObjectString(obj, nil) }
 func (obj *Func) String() string     { return ObjectString(obj, nil) }
+func (obj *Variant) String() string  { return ObjectString(obj, nil) }
+func (obj *Overload) String() string { return ObjectString(obj, nil) }
 func (obj *Label) String() string    { return ObjectString(obj, nil) }
 func (obj *Builtin) String() string  { return ObjectString(obj, nil) }
 func (obj *Nil) String() string      { return ObjectString(obj, nil) }
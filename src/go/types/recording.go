@@ -151,6 +151,7 @@ func (check *Checker) recordUse(id *ast.Ident, obj Object) {
 	if m := check.Uses; m != nil {
 		m[id] = obj
 	}
+	check.recordUninitUse(id, obj)
 }
 
 func (check *Checker) recordImplicit(node ast.Node, obj Object) {
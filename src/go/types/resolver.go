@@ -124,6 +124,36 @@ func (check *Checker) declarePkgObj(ident *ast.Ident, obj Object, d *declInfo) {
 	obj.setOrder(uint32(len(check.objMap)))
 }
 
+// visibility returns the visibility of the package-level objects
+// declared at the given position with the Wo visibility modifier vis.
+func (check *Checker) visibility(at positioner, vis ast.Visibility) Visibility {
+	if vis == ast.DefaultVis || !check.verifyWof(at, woExport, "%s modifier", vis) {
+		return DefaultVis
+	}
+	if vis == ast.PkgVis {
+		return PkgVis
+	}
+	return ExportVis
+}
+
+// visible reports whether the package-level object obj of an
+// imported package may be referred to by the package being checked.
+// Objects declared with a Wo pkg modifier are visible to the packages
+// of the same module.
+func (check *Checker) visible(obj Object) bool {
+	return obj.Exported() || obj.Visibility() == PkgVis && obj.Pkg().module == check.pkg.module
+}
+
+// unexportedNameError reports that the package-level object obj of
+// an imported package, denoted by the selector sel, is not visible.
+func (check *Checker) unexportedNameError(sel *ast.Ident, obj Object) {
+	if module := obj.Pkg().module; obj.Visibility() == PkgVis && module != "" {
+		check.errorf(sel, UnexportedName, "name %s is only visible in module %s", sel.Name, module)
+		return
+	}
+	check.errorf(sel, UnexportedName, "name %s not exported by package %s", sel.Name, obj.Pkg().name)
+}
+
 // filename returns a filename suitable for debugging output.
 func (check *Checker) filename(fileNo int) string {
 	file := check.files[fileNo]
@@ -234,6 +264,11 @@ func (check *Checker) collectObjects() {
 	}
 	var methods []methodInfo // collected methods with valid receivers and non-blank _ names
 
+	// Overloaded Wo functions are declared under their mangled names;
+	// the sets of overloaded functions are declared after all files
+	// have been processed.
+	overloads := check.overloadedFuncs()
+
 	fileScopes := make([]*Scope, len(check.files)) // fileScopes[i] corresponds to check.files[i]
 	for fileNo, file := range check.files {
 		check.version = asGoVersion(check.versions[file])
@@ -322,12 +357,13 @@ func (check *Checker) collectObjects() {
 					}
 					// merge imported scope with file scope
 					for name, obj := range imp.scope.elems {
-						// Note: Avoid eager resolve(name, obj) here, so we only
-						// resolve dot-imported objects as needed.
+						// Note: The objects must be resolved to determine their
+						// visibility, which may be set by Wo visibility modifiers.
+						obj = resolve(name, obj)
 
 						// A package scope may contain non-exported objects,
 						// do not import them!
-						if token.IsExported(name) {
+						if check.visible(obj) {
 							// declare dot-imported object
 							// (Do not use check.declare because it modifies the object
 							// via Object.setScopePos, which leads to a race condition;
@@ -351,8 +387,10 @@ func (check *Checker) collectObjects() {
 				}
 			case constDecl:
 				// declare all constants
+				vis := check.visibility(d.spec, d.vis)
 				for i, name := range d.spec.Names {
 					obj := NewConst(name.Pos(), pkg, name.Name, nil, constant.MakeInt64(int64(d.iota)))
+					obj.vis = vis
 
 					var init ast.Expr
 					if i < len(d.init) {
@@ -378,8 +416,10 @@ func (check *Checker) collectObjects() {
 				}
 
 				// declare all variables
+				vis := check.visibility(d.spec, d.vis)
 				for i, name := range d.spec.Names {
 					obj := NewVar(name.Pos(), pkg, name.Name, nil)
+					obj.vis = vis
 					lhs[i] = obj
 
 					di := d1
@@ -396,12 +436,30 @@ func (check *Checker) collectObjects() {
 				}
 			case typeDecl:
 				obj := NewTypeName(d.spec.Name.Pos(), pkg, d.spec.Name.Name, nil)
+				obj.vis = check.visibility(d.spec, d.vis)
 				check.declarePkgObj(d.spec.Name, obj, &declInfo{file: fileScope, version: check.version, tdecl: d.spec})
+
+				// The variants of a Wo enum type are package-level objects
+				// with the visibility of the type.
+				if isEnumDecl(d.spec) {
+					for i, v := range ast.Unparen(d.spec.Type).(*ast.EnumType).Variants {
+						variant := NewVariant(v.Name.Pos(), pkg, v.Name.Name, nil, i, nil, nil)
+						variant.vis = obj.vis
+						check.declarePkgObj(v.Name, variant, &declInfo{file: fileScope, version: check.version, tdecl: d.spec})
+					}
+				}
 			case funcDecl:
 				name := d.decl.Name.Name
+				var ov *Overload
+				if d.decl.Recv == nil {
+					if ov = overloads[name]; ov != nil {
+						name = overloadName(name, d.decl.Type)
+					}
+				}
 				obj := NewFunc(d.decl.Name.Pos(), pkg, name, nil) // signature set later
 				hasTParamError := false                           // avoid duplicate type parameter errors
 				if d.decl.Recv.NumFields() == 0 {
+					obj.vis = check.visibility(d.decl.Name, d.decl.Vis)
 					// regular function
 					if d.decl.Recv != nil {
 						check.error(d.decl.Recv, BadRecv, "method has no receiver")
@@ -430,6 +488,8 @@ func (check *Checker) collectObjects() {
 							// TODO(gri) make this error message consistent with the others above
 							check.softErrorf(obj, MissingInitBody, "missing function body")
 						}
+					} else if ov != nil {
+						check.declareOverload(ov, d.decl.Name, obj)
 					} else {
 						check.declare(pkg.scope, d.decl.Name, obj, nopos)
 					}
@@ -462,6 +522,18 @@ func (check *Checker) collectObjects() {
 		})
 	}
 
+	// declare the sets of overloaded functions (sorted by name for reproducible errors)
+	if overloads != nil {
+		names := make([]string, 0, len(overloads))
+		for name := range overloads {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			check.declare(pkg.scope, nil, overloads[name], nopos)
+		}
+	}
+
 	// verify that objects in package and file scopes have different names
 	for _, scope := range fileScopes {
 		for name, obj := range scope.elems {
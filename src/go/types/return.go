@@ -52,10 +52,12 @@ func (check *Checker) isTerminating(s ast.Stmt, label string) bool {
 		}
 
 	case *ast.SwitchStmt:
-		return check.isTerminatingSwitch(s.Body, label)
+		// A Wo enum switch with a case for every variant is
+		// exhaustive, like a switch with a default case.
+		return check.isTerminatingSwitch(s.Body, label, check.enumSwitches[s])
 
 	case *ast.TypeSwitchStmt:
-		return check.isTerminatingSwitch(s.Body, label)
+		return check.isTerminatingSwitch(s.Body, label, false)
 
 	case *ast.SelectStmt:
 		for _, s := range s.Body.List {
@@ -68,6 +70,12 @@ func (check *Checker) isTerminating(s ast.Stmt, label string) bool {
 		return true
 
 	case *ast.ForStmt:
+		if varClause(s.Cond) != nil {
+			// Like range clauses, Wo conditional bindings guarantee
+			// that the loop terminates, so the loop is not a
+			// terminating statement.
+			return false
+		}
 		if s.Cond == nil && !hasBreak(s.Body, label, true) {
 			return true
 		}
@@ -86,7 +94,7 @@ func (check *Checker) isTerminatingList(list []ast.Stmt, label string) bool {
 	return false // all statements are empty
 }
 
-func (check *Checker) isTerminatingSwitch(body *ast.BlockStmt, label string) bool {
+func (check *Checker) isTerminatingSwitch(body *ast.BlockStmt, label string, exhaustive bool) bool {
 	hasDefault := false
 	for _, s := range body.List {
 		cc := s.(*ast.CaseClause)
@@ -97,7 +105,7 @@ func (check *Checker) isTerminatingSwitch(body *ast.BlockStmt, label string) boo
 			return false
 		}
 	}
-	return hasDefault
+	return hasDefault || exhaustive
 }
 
 // TODO(gri) For nested breakable statements, the current implementation of hasBreak
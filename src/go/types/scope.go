@@ -204,6 +204,7 @@ func (*lazyObject) Pkg() *Package                      { panic("unreachable") }
 func (*lazyObject) Name() string                       { panic("unreachable") }
 func (*lazyObject) Type() Type                         { panic("unreachable") }
 func (*lazyObject) Exported() bool                     { panic("unreachable") }
+func (*lazyObject) Visibility() Visibility             { panic("unreachable") }
 func (*lazyObject) Id() string                         { panic("unreachable") }
 func (*lazyObject) String() string                     { panic("unreachable") }
 func (*lazyObject) order() uint32                      { panic("unreachable") }
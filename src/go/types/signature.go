@@ -30,6 +30,7 @@ type Signature struct {
 	params   *Tuple         // (incoming) parameters from left to right; or nil
 	results  *Tuple         // (outgoing) results from left to right; or nil
 	variadic bool           // true if the last parameter's type is of the form ...T (or string, for append built-in only)
+	defaults []Object       // default values of the parameters (Wo), or nil
 }
 
 // NewSignature returns a new function type for the given receiver, parameters,
@@ -46,8 +47,9 @@ func NewSignature(recv *Var, params, results *Tuple, variadic bool) *Signature {
 // receiver type parameters, type parameters, parameters, and results. If
 // variadic is set, params must hold at least one parameter and the last
 // parameter's core type must be of unnamed slice or bytestring type.
-// If recv is non-nil, typeParams must be empty. If recvTypeParams is
-// non-empty, recv must be non-nil.
+// If recv and typeParams are both non-empty, the signature is the type
+// of a Wo method with type parameters. If recvTypeParams is non-empty,
+// recv must be non-nil.
 func NewSignatureType(recv *Var, recvTypeParams, typeParams []*TypeParam, params, results *Tuple, variadic bool) *Signature {
 	if variadic {
 		n := params.Len()
@@ -67,9 +69,6 @@ func NewSignatureType(recv *Var, recvTypeParams, typeParams []*TypeParam, params
 		sig.rparams = bindTParams(recvTypeParams)
 	}
 	if len(typeParams) != 0 {
-		if recv != nil {
-			panic("function with type parameters cannot have a receiver")
-		}
 		sig.tparams = bindTParams(typeParams)
 	}
 	return sig
@@ -98,6 +97,52 @@ func (s *Signature) Results() *Tuple { return s.results }
 // Variadic reports whether the signature s is variadic.
 func (s *Signature) Variadic() bool { return s.variadic }
 
+// Default returns the default value of the i'th parameter of the Wo
+// signature s, or nil if the parameter has none. The default value is
+// a (usually unnamed) *[Const] whose type is the parameter type or, for
+// a parameter of interface or optional type, the type of the value it
+// holds; the predeclared *[Nil] nil or None; or a package-level *[Var]
+// or *[Func].
+func (s *Signature) Default(i int) Object {
+	if s.defaults == nil {
+		return nil
+	}
+	return s.defaults[i]
+}
+
+// SetDefaults sets the default values of the parameters of s.
+// The list must be nil or have an entry for each parameter, and the
+// entries must be as described for [Signature.Default]. It is intended
+// for use by importers.
+func (s *Signature) SetDefaults(defaults []Object) {
+	if defaults != nil && len(defaults) != s.params.Len() {
+		panic("number of default values does not match number of parameters")
+	}
+	s.defaults = defaults
+}
+
+// Skippable reports whether the i'th result of the Wo signature s has
+// a skip modifier. When assigning the results of a call in a Wo file,
+// skippable results may be omitted if all other results are assigned.
+func (s *Signature) Skippable(i int) bool {
+	return s.results.vars[i].skip
+}
+
+// SetSkippable sets the skip modifiers of the results of s.
+// The list must be nil or have an entry for each result.
+// It is intended for use by importers.
+func (s *Signature) SetSkippable(skips []bool) {
+	if skips == nil {
+		return
+	}
+	if len(skips) != s.results.Len() {
+		panic("number of skip modifiers does not match number of results")
+	}
+	for i, skip := range skips {
+		s.results.vars[i].skip = skip
+	}
+}
+
 func (s *Signature) Underlying() Type { return s }
 func (s *Signature) String() string   { return TypeString(s, nil) }
 
@@ -128,11 +173,16 @@ func (check *Checker) funcType(sig *Signature, recvPar *ast.FieldList, ftyp *ast
 
 	// collect and declare function type parameters
 	if ftyp.TypeParams != nil {
-		// Always type-check method type parameters but complain that they are not allowed.
+		// Always type-check method type parameters but complain that they are not allowed,
+		// except in Wo files.
 		// (A separate check is needed when type-checking interface method signatures because
 		// they don't have a receiver specification.)
 		if recvPar != nil {
-			check.error(ftyp.TypeParams, InvalidMethodTypeParams, "methods cannot have type parameters")
+			if check.isWo(ftyp.TypeParams) {
+				check.verifyWof(ftyp.TypeParams, woMethodTParams, "method type parameters")
+			} else {
+				check.error(ftyp.TypeParams, InvalidMethodTypeParams, "methods cannot have type parameters")
+			}
 		}
 		check.collectTypeParams(&sig.tparams, ftyp.TypeParams)
 	}
@@ -140,6 +190,7 @@ func (check *Checker) funcType(sig *Signature, recvPar *ast.FieldList, ftyp *ast
 	// collect ordinary and result parameters
 	pnames, params, variadic := check.collectParams(ftyp.Params, true)
 	rnames, results, _ := check.collectParams(ftyp.Results, false)
+	defaults := check.paramDefaults(ftyp, params, variadic, append(rparams.list(), sig.tparams.list()...))
 
 	// declare named receiver, ordinary, and result parameters
 	scopePos := ftyp.End() // all parameter's scopes start after the signature
@@ -154,6 +205,7 @@ func (check *Checker) funcType(sig *Signature, recvPar *ast.FieldList, ftyp *ast
 	sig.params = NewTuple(params...)
 	sig.results = NewTuple(results...)
 	sig.variadic = variadic
+	sig.defaults = defaults
 }
 
 // collectRecv extracts the method receiver and its type parameters (if any) from rparam.
@@ -357,28 +409,67 @@ func (check *Checker) collectParams(list *ast.FieldList, variadicOk bool) (names
 	}
 
 	var named, anonymous bool
+	var skips int // number of skippable results
 	for i, field := range list.List {
 		ftype := field.Type
-		if t, _ := ftype.(*ast.Ellipsis); t != nil {
-			ftype = t.Elt
-			if variadicOk && i == len(list.List)-1 && len(field.Names) <= 1 {
-				variadic = true
+		var typ Type
+		if ftype == nil {
+			// The type of a Wo parameter without type is inferred
+			// from its default value (see Checker.paramDefaults).
+			if !variadicOk {
+				typ = Typ[Invalid] // result parameters have no default values
+			}
+		} else {
+			if t, _ := ftype.(*ast.Ellipsis); t != nil {
+				ftype = t.Elt
+				if variadicOk && i == len(list.List)-1 && len(field.Names) <= 1 {
+					variadic = true
+				} else {
+					check.softErrorf(t, MisplacedDotDotDot, "can only use ... with final parameter in list")
+					// ignore ... and continue
+				}
+			}
+			if t, _ := ftype.(*ast.PostfixExpr); t != nil && t.Op == token.NOT && !variadicOk && len(list.List) == 1 && len(field.Names) == 0 {
+				// A Wo result type T! stands for the results (T, error).
+				if !check.verifyWof(atPos(t.OpPos), woResult, "result type %s", ast.Expr(t)) {
+					typ = check.varType(t.X)
+				} else {
+					par := NewParam(field.Pos(), check.pkg, "", check.varType(t.X))
+					check.recordImplicit(field, par)
+					names = []*ast.Ident{nil, nil}
+					params = []*Var{par, NewParam(t.OpPos, check.pkg, "", universeError)}
+					return
+				}
 			} else {
-				check.softErrorf(t, MisplacedDotDotDot, "can only use ... with final parameter in list")
-				// ignore ... and continue
+				typ = check.varType(ftype)
+			}
+		}
+		if field.Default != nil && !variadicOk {
+			check.error(field.Default, InvalidDefault, "result parameters cannot have default values")
+		}
+		skip := false
+		if field.Skip.IsValid() {
+			if variadicOk {
+				check.error(field, InvalidSkip, "only result parameters can be skippable")
+			} else {
+				skip = check.verifyWof(field, woSkip, "skip modifier")
 			}
 		}
-		typ := check.varType(ftype)
 		// The parser ensures that f.Tag is nil and we don't
 		// care if a constructed AST contains a non-nil tag.
 		if len(field.Names) > 0 {
 			// named parameter
-			for _, name := range field.Names {
+			for j, name := range field.Names {
 				if name.Name == "" {
 					check.error(name, InvalidSyntaxTree, "anonymous parameter")
 					// ok to continue
 				}
 				par := NewParam(name.Pos(), check.pkg, name.Name, typ)
+				// The skip modifier applies to the first name.
+				if skip && j == 0 {
+					par.skip = true
+					skips++
+				}
 				// named parameter is declared by caller
 				names = append(names, name)
 				params = append(params, par)
@@ -387,6 +478,10 @@ func (check *Checker) collectParams(list *ast.FieldList, variadicOk bool) (names
 		} else {
 			// anonymous parameter
 			par := NewParam(ftype.Pos(), check.pkg, "", typ)
+			if skip {
+				par.skip = true
+				skips++
+			}
 			check.recordImplicit(field, par)
 			names = append(names, nil)
 			params = append(params, par)
@@ -399,6 +494,13 @@ func (check *Checker) collectParams(list *ast.FieldList, variadicOk bool) (names
 		// ok to continue
 	}
 
+	if skips > 0 && skips == len(params) {
+		check.error(list.List[0], InvalidSkip, "cannot skip all results")
+		for _, par := range params {
+			par.skip = false
+		}
+	}
+
 	// For a variadic function, change the last parameter's type from T to []T.
 	// Since we type-checked T rather than ...T, we also need to retro-actively
 	// record the type for ...T.
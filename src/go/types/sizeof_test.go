@@ -25,7 +25,7 @@ func TestSizeof(t *testing.T) {
 		{Struct{}, 24, 48},
 		{Pointer{}, 8, 16},
 		{Tuple{}, 12, 24},
-		{Signature{}, 28, 56},
+		{Signature{}, 40, 80},
 		{Union{}, 12, 24},
 		{Interface{}, 40, 80},
 		{Map{}, 16, 32},
@@ -35,18 +35,18 @@ func TestSizeof(t *testing.T) {
 		{term{}, 12, 24},
 
 		// Objects
-		{PkgName{}, 48, 88},
-		{Const{}, 48, 88},
-		{TypeName{}, 40, 72},
-		{Var{}, 48, 88},
-		{Func{}, 48, 88},
-		{Label{}, 44, 80},
-		{Builtin{}, 44, 80},
-		{Nil{}, 40, 72},
+		{PkgName{}, 52, 96},
+		{Const{}, 52, 96},
+		{TypeName{}, 44, 80},
+		{Var{}, 52, 96},
+		{Func{}, 52, 96},
+		{Label{}, 48, 88},
+		{Builtin{}, 48, 88},
+		{Nil{}, 44, 80},
 
 		// Misc
 		{Scope{}, 44, 88},
-		{Package{}, 44, 88},
+		{Package{}, 52, 104},
 		{_TypeSet{}, 28, 56},
 	}
 	for _, test := range tests {
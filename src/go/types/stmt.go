@@ -53,6 +53,20 @@ func (check *Checker) funcBody(decl *declInfo, name string, sig *Signature, body
 	// spec: "Implementation restriction: A compiler may make it illegal to
 	// declare a variable inside a function body if the variable is never used."
 	check.usage(sig.scope)
+
+	if check.uninitVars != nil && check.allowUninit(body) {
+		check.uninit(body)
+	}
+}
+
+// unusedf reports an unused variable: as an error in Go files, and
+// as a warning in Wo files.
+func (check *Checker) unusedf(at positioner, format string, args ...any) {
+	if check.allowWo(at, woUnused) {
+		check.warnf(at, UnusedVar, format, args...)
+		return
+	}
+	check.softErrorf(at, UnusedVar, format, args...)
 }
 
 func (check *Checker) usage(scope *Scope) {
@@ -67,7 +81,7 @@ func (check *Checker) usage(scope *Scope) {
 		return cmpPos(a.pos, b.pos)
 	})
 	for _, v := range unused {
-		check.softErrorf(v, UnusedVar, "declared and not used: %s", v.name)
+		check.unusedf(v, "declared and not used: %s", v.name)
 	}
 
 	for _, scope := range scope.children {
@@ -94,11 +108,12 @@ const (
 	// additional context information
 	finalSwitchCase
 	inTypeSwitch
+	inHeader // header of an if, for, switch, or select case, where var declarations are not permitted
 )
 
 func (check *Checker) simpleStmt(s ast.Stmt) {
 	if s != nil {
-		check.stmt(0, s)
+		check.stmt(inHeader, s)
 	}
 }
 
@@ -422,7 +437,7 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 	defer check.processDelayed(len(check.delayed))
 
 	// reset context for statements of inner blocks
-	inner := ctxt &^ (fallthroughOk | finalSwitchCase | inTypeSwitch)
+	inner := ctxt &^ (fallthroughOk | finalSwitchCase | inTypeSwitch | inHeader)
 
 	switch s := s.(type) {
 	case *ast.BadStmt, *ast.EmptyStmt:
@@ -519,10 +534,15 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 				return
 			}
 			if s.Tok == token.DEFINE {
-				check.shortVarDecl(inNode(s, s.TokPos), s.Lhs, s.Rhs)
+				if s.Type != nil {
+					check.typedVarDecl(s)
+					return
+				}
+				check.shortVarDecl(inNode(s, s.TokPos), s.Lhs, s.Rhs, ctxt&inHeader != 0)
 			} else {
 				// regular assignment
 				check.assignVars(s.Lhs, s.Rhs)
+				check.parallelAssign(inNode(s, s.TokPos), s.Lhs, s.Rhs, false)
 			}
 
 		default:
@@ -616,13 +636,22 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 		check.openScope(s, "if")
 		defer check.closeScope()
 
-		check.simpleStmt(s.Init)
-		var x operand
-		check.expr(nil, &x, s.Cond)
-		if x.mode != invalid && !allBoolean(x.typ) {
-			check.error(s.Cond, InvalidCond, "non-boolean condition in if statement")
+		if c := varClause(s.Cond); c != nil {
+			// The bound variables are only in scope in the then-branch.
+			vars := check.binding(c)
+			check.openScope(c, "if var")
+			check.declareBinding(c, vars, s.Body.Pos())
+			check.stmt(inner, s.Body)
+			check.closeScope()
+		} else {
+			check.simpleStmt(s.Init)
+			var x operand
+			check.expr(nil, &x, s.Cond)
+			if x.mode != invalid && !allBoolean(x.typ) {
+				check.error(s.Cond, InvalidCond, "non-boolean condition in if statement")
+			}
+			check.stmt(inner, s.Body)
 		}
-		check.stmt(inner, s.Body)
 		// The parser produces a correct AST but if it was modified
 		// elsewhere the else branch may be invalid. Check again.
 		switch s.Else.(type) {
@@ -646,6 +675,11 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 			// By checking assignment of x to an invisible temporary
 			// (as a compiler would), we get all the relevant checks.
 			check.assignment(&x, nil, "switch expression")
+			if x.mode != invalid && isEnum(x.typ) {
+				check.multipleDefaults(s.Body.List)
+				check.enumSwitchStmt(inner, s, &x)
+				return
+			}
 			if x.mode != invalid && !Comparable(x.typ) && !hasNil(x.typ) {
 				check.errorf(&x, InvalidExprSwitch, "cannot switch on %s (%s is not comparable)", &x, x.typ)
 				x.mode = invalid
@@ -765,7 +799,13 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 			// If lhs exists, declare a corresponding variable in the case-local scope.
 			if lhs != nil {
 				obj := NewVar(lhs.Pos(), check.pkg, lhs.Name, T)
-				check.declare(check.scope, nil, obj, clause.Colon)
+				if len(lhsVars) == 0 {
+					check.declare(check.scope, nil, obj, clause.Colon)
+				} else {
+					// Report Wo shadowing of the lhs variable for the first clause only.
+					check.scope.Insert(obj)
+					obj.setScopePos(clause.Colon)
+				}
 				check.recordImplicit(clause, obj)
 				// For the "declared and not used" error, all lhs variables act as
 				// one; i.e., if any one of them is 'used', all of them are 'used'.
@@ -786,7 +826,7 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 				v.used = true // avoid usage error when checking entire function
 			}
 			if !used {
-				check.softErrorf(lhs, UnusedVar, "%s declared and not used", lhs.Name)
+				check.unusedf(lhs, "%s declared and not used", lhs.Name)
 			}
 		}
 
@@ -829,7 +869,7 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 
 			check.openScope(s, "case")
 			if clause.Comm != nil {
-				check.stmt(inner, clause.Comm)
+				check.stmt(inner|inHeader, clause.Comm)
 			}
 			check.stmtList(inner, clause.Body)
 			check.closeScope()
@@ -840,6 +880,12 @@ func (check *Checker) stmt(ctxt stmtContext, s ast.Stmt) {
 		check.openScope(s, "for")
 		defer check.closeScope()
 
+		if c := varClause(s.Cond); c != nil {
+			check.declareBinding(c, check.binding(c), s.Body.Pos())
+			check.stmt(inner, s.Body)
+			break
+		}
+
 		check.simpleStmt(s.Init)
 		if s.Cond != nil {
 			var x operand
@@ -876,10 +922,15 @@ func (check *Checker) rangeStmt(inner stmtContext, s *ast.RangeStmt) {
 	identName := func(n *identType) string { return n.Name }
 	sKey, sValue := s.Key, s.Value
 	var sExtra ast.Expr = nil // (used only in types2 fork)
-	isDef := s.Tok == token.DEFINE
+	isColon := s.Tok == token.COLON
+	isDef := s.Tok == token.DEFINE || isColon
 	rangeVar := s.X
 	noNewVarPos := inNode(s, s.TokPos)
 
+	if isColon {
+		check.verifyWof(atPos(s.TokPos), woRange, "colon range clause")
+	}
+
 	// Everything from here on is shared between cmd/compile/internal/types2 and go/types.
 
 	// check expression to iterate over
@@ -906,6 +957,12 @@ func (check *Checker) rangeStmt(inner stmtContext, s *ast.RangeStmt) {
 			check.softErrorf(sExtra, InvalidIterVar, "range clause permits at most two iteration variables")
 		}
 		key, val = k, v
+
+		// In a colon range clause, a single iteration variable
+		// denotes the value rather than the key, if there is one.
+		if isColon && sValue == nil && v != nil {
+			sKey, sValue = nil, sKey
+		}
 	}
 
 	// Open the for-statement block scope now, after the range clause.
@@ -941,6 +998,9 @@ func (check *Checker) rangeStmt(inner stmtContext, s *ast.RangeStmt) {
 				if name != "_" {
 					vars = append(vars, obj)
 				}
+			} else if isColon {
+				check.errorf(lhs, BadDecl, "non-name %s on left side of :", lhs)
+				obj = NewVar(lhs.Pos(), check.pkg, "_", nil) // dummy variable
 			} else {
 				check.errorf(lhs, InvalidSyntaxTree, "cannot declare %s", lhs)
 				obj = NewVar(lhs.Pos(), check.pkg, "_", nil) // dummy variable
@@ -975,6 +1035,8 @@ func (check *Checker) rangeStmt(inner stmtContext, s *ast.RangeStmt) {
 			for _, obj := range vars {
 				check.declare(check.scope, nil /* recordDef already called */, obj, scopePos)
 			}
+		} else if isColon {
+			check.error(noNewVarPos, NoNewVar, "no new variables on left side of :")
 		} else {
 			check.error(noNewVarPos, NoNewVar, "no new variables on left side of :=")
 		}
@@ -1018,6 +1080,13 @@ func (check *Checker) rangeStmt(inner stmtContext, s *ast.RangeStmt) {
 		check.assignment(&x, nil, "range clause")
 	}
 
+	if _, ok := coreType(x.typ).(*Signature); ok && x.mode != invalid {
+		defer func(inRangeFunc bool) {
+			check.inRangeFunc = inRangeFunc
+		}(check.inRangeFunc)
+		check.inRangeFunc = true
+	}
+
 	check.stmt(inner, s.Body)
 }
 
@@ -1051,6 +1120,8 @@ func rangeKeyVal(typ Type, allowVersion func(goVersion) bool) (key, val Type, ca
 		return Typ[Int], typ.elem, "", true
 	case *Map:
 		return typ.key, typ.elem, "", true
+	case *Set:
+		return typ.elem, nil, "", true
 	case *Chan:
 		if typ.dir == SendOnly {
 			return bad("receive from send-only channel")
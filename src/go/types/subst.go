@@ -176,6 +176,7 @@ func (subst *subster) typ(typ Type) Type {
 				params:   params,
 				results:  results,
 				variadic: t.variadic,
+				defaults: t.defaults,
 			}
 		}
 
@@ -232,6 +233,21 @@ func (subst *subster) typ(typ Type) Type {
 			return &Map{key: key, elem: elem}
 		}
 
+	case *Set:
+		elem := subst.typ(t.elem)
+		if elem != t.elem {
+			return &Set{elem: elem}
+		}
+
+	case *Optional:
+		elem := subst.typ(t.elem)
+		if elem != t.elem {
+			return &Optional{elem: elem}
+		}
+
+	case *Enum:
+		// enum types cannot be generic
+
 	case *Chan:
 		elem := subst.typ(t.elem)
 		if elem != t.elem {
@@ -265,6 +265,63 @@ func (w *typeWriter) typ(typ Type) {
 		w.byte(']')
 		w.typ(t.elem)
 
+	case *Set:
+		w.string("set")
+		if t.elem != nil {
+			w.byte('[')
+			w.typ(t.elem)
+			w.byte(']')
+		}
+
+	case *Optional:
+		// Parenthesize element types that would otherwise absorb the ?.
+		switch under(t.elem).(type) {
+		case *Signature, *Chan:
+			w.byte('(')
+			w.typ(t.elem)
+			w.byte(')')
+		default:
+			w.typ(t.elem)
+		}
+		w.byte('?')
+
+	case *Enum:
+		w.string("enum{")
+		for i, v := range t.variants {
+			if i > 0 {
+				w.byte(',')
+			}
+			w.string(v.name)
+			if len(v.values) > 0 {
+				w.byte('(')
+				for j, val := range v.values {
+					if j > 0 {
+						w.byte(',')
+					}
+					w.string(val.String())
+				}
+				w.byte(')')
+			} else if len(v.fields) > 0 {
+				w.byte('(')
+				for j, f := range v.fields {
+					if j > 0 {
+						w.byte(',')
+					}
+					w.string(f.name)
+					w.byte(' ')
+					w.typ(f.typ)
+				}
+				w.byte(')')
+			}
+		}
+		for _, f := range t.fields {
+			w.byte(';')
+			w.string(f.name)
+			w.byte(' ')
+			w.typ(f.typ)
+		}
+		w.byte('}')
+
 	case *Chan:
 		var s string
 		var parens bool
@@ -724,6 +724,23 @@ func (u *unifier) nify(x, y Type, mode unifyMode, p *ifacePair) (result bool) {
 			return u.nify(x.key, y.key, emode, p) && u.nify(x.elem, y.elem, emode, p)
 		}
 
+	case *Set:
+		// Two set types unify if their element types unify.
+		if y, ok := y.(*Set); ok {
+			return u.nify(x.elem, y.elem, emode, p)
+		}
+
+	case *Optional:
+		// Two optional types unify if their element types unify.
+		if y, ok := y.(*Optional); ok {
+			return u.nify(x.elem, y.elem, emode, p)
+		}
+
+	case *Enum:
+		// Enum types are only declared by defined types,
+		// so two enum types unify only if they are the same.
+		return x == y
+
 	case *Chan:
 		// Two channel types unify if their value types unify
 		// and if they have the same direction.
@@ -0,0 +1,283 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file implements the statements of the definite-assignment
+// analysis for Wo files (see uninit.go).
+
+package types
+
+import (
+	"go/ast"
+	"go/token"
+)
+
+// stmt returns the set of variables that may not be assigned after
+// executing st in a state described by s. It may modify s. If st is
+// labeled, label is the label name; otherwise label is "".
+func (u *uninitChecker) stmt(s uninitSet, st ast.Stmt, label string) uninitSet {
+	switch st := st.(type) {
+	case nil, *ast.EmptyStmt, *ast.BadStmt:
+		// nothing to do
+
+	case *ast.DeclStmt:
+		d, _ := st.Decl.(*ast.GenDecl)
+		if d == nil || d.Tok != token.VAR {
+			break
+		}
+		for _, spec := range d.Specs {
+			spec, _ := spec.(*ast.ValueSpec)
+			if spec == nil {
+				continue
+			}
+			if spec.Values != nil {
+				u.exprList(s, spec.Values)
+				continue
+			}
+			for _, name := range spec.Names {
+				if v := u.check.uninitNames[name]; v != nil && s != nil {
+					s[v] = true
+				}
+			}
+		}
+
+	case *ast.LabeledStmt:
+		name := st.Label.Name
+		s = join(s, u.gotos[name])
+		delete(u.gotos, name)
+		if u.labels == nil {
+			u.labels = make(map[string]bool)
+		}
+		u.labels[name] = true
+		return u.stmt(s, st.Stmt, name)
+
+	case *ast.BlockStmt:
+		return u.stmtList(s, st.List)
+
+	case *ast.ExprStmt:
+		u.expr(s, st.X)
+		if call, ok := ast.Unparen(st.X).(*ast.CallExpr); ok && u.check.isPanic[call] {
+			return nil
+		}
+
+	case *ast.SendStmt:
+		u.expr(s, st.Chan)
+		u.expr(s, st.Value)
+
+	case *ast.IncDecStmt:
+		u.expr(s, st.X)
+		u.assign(s, st.X)
+
+	case *ast.AssignStmt:
+		u.exprList(s, st.Rhs)
+		for _, lhs := range st.Lhs {
+			if st.Tok != token.ASSIGN && st.Tok != token.DEFINE {
+				u.expr(s, lhs)
+			}
+			u.assign(s, lhs)
+		}
+
+	case *ast.GoStmt:
+		u.expr(s, st.Call)
+
+	case *ast.DeferStmt:
+		u.expr(s, st.Call)
+
+	case *ast.ReturnStmt:
+		u.exprList(s, st.Results)
+		return nil
+
+	case *ast.BranchStmt:
+		switch st.Tok {
+		case token.BREAK:
+			if t := u.target(st.Label, false); t != nil {
+				t.brk = join(t.brk, s)
+			}
+		case token.CONTINUE:
+			if t := u.target(st.Label, true); t != nil {
+				t.cont = join(t.cont, s)
+			}
+		case token.GOTO:
+			// Jumping back to a label cannot add variables to the
+			// set at the label: variables declared after the label
+			// are out of scope there.
+			if name := st.Label.Name; !u.labels[name] {
+				if u.gotos == nil {
+					u.gotos = make(map[string]uninitSet)
+				}
+				u.gotos[name] = join(u.gotos[name], s)
+			}
+		case token.FALLTHROUGH:
+			u.fall = s.clone()
+		}
+		return nil
+
+	case *ast.IfStmt:
+		s = u.stmt(s, st.Init, "")
+		if st.Cond != nil {
+			u.expr(s, st.Cond)
+		}
+		then := u.stmt(s.clone(), st.Body, "")
+		if st.Else != nil {
+			s = u.stmt(s, st.Else, "")
+		}
+		return join(then, s)
+
+	case *ast.SwitchStmt:
+		t := u.push(st, label)
+		s = u.stmt(s, st.Init, "")
+		if st.Tag != nil {
+			u.expr(s, st.Tag)
+		}
+		exit, hasDefault := u.caseClauses(s, st.Body)
+		if !hasDefault && !u.check.enumSwitches[st] {
+			exit = join(exit, s)
+		}
+		u.pop()
+		return join(exit, t.brk)
+
+	case *ast.TypeSwitchStmt:
+		t := u.push(st, label)
+		s = u.stmt(s, st.Init, "")
+		s = u.stmt(s, st.Assign, "")
+		exit, hasDefault := u.caseClauses(s, st.Body)
+		if !hasDefault {
+			exit = join(exit, s)
+		}
+		u.pop()
+		return join(exit, t.brk)
+
+	case *ast.SelectStmt:
+		t := u.push(st, label)
+		var exit uninitSet
+		for _, cc := range st.Body.List {
+			if cc, _ := cc.(*ast.CommClause); cc != nil {
+				exit = join(exit, u.stmtList(u.stmt(s.clone(), cc.Comm, ""), cc.Body))
+			}
+		}
+		u.pop()
+		return join(exit, t.brk)
+
+	case *ast.RangeStmt:
+		// The body cannot add variables to the set at the start of
+		// the loop (variables declared in the body are out of scope
+		// there), so the set at the start of each iteration is s.
+		t := u.push(st, label)
+		u.expr(s, st.X)
+		body := s.clone()
+		for _, lhs := range []ast.Expr{st.Key, st.Value} {
+			if lhs != nil {
+				u.assign(body, lhs)
+			}
+		}
+		u.stmt(body, st.Body, "")
+		u.pop()
+		return join(s, t.brk)
+
+	case *ast.ForStmt:
+		t := u.push(st, label)
+		s = u.stmt(s, st.Init, "")
+		if st.Cond != nil {
+			u.expr(s, st.Cond)
+		}
+		post := join(u.stmt(s.clone(), st.Body, ""), t.cont)
+		if post != nil {
+			u.stmt(post, st.Post, "")
+		}
+		u.pop()
+		if st.Cond == nil {
+			return t.brk
+		}
+		return join(s, t.brk)
+
+	default:
+		panic("unreachable")
+	}
+
+	return s
+}
+
+// caseClauses returns the joined sets at the ends of the clauses in
+// body of a switch statement entered with s, and whether there is a
+// default clause.
+func (u *uninitChecker) caseClauses(s uninitSet, body *ast.BlockStmt) (exit uninitSet, hasDefault bool) {
+	var fall uninitSet
+	for _, cc := range body.List {
+		cc, _ := cc.(*ast.CaseClause)
+		if cc == nil {
+			continue
+		}
+		if cc.List == nil {
+			hasDefault = true
+		} else {
+			u.exprList(s, cc.List)
+		}
+		u.fall = nil
+		exit = join(exit, u.stmtList(join(s.clone(), fall), cc.Body))
+		fall = u.fall
+	}
+	u.fall = nil
+	return
+}
+
+// target returns the statement targeted by a break statement, or by a
+// continue statement if cont is set, with the given label (which may
+// be nil). The result is nil if there is no such statement.
+func (u *uninitChecker) target(label *ast.Ident, cont bool) *uninitTarget {
+	for i := len(u.targets) - 1; i >= 0; i-- {
+		t := u.targets[i]
+		if label != nil {
+			if t.label == label.Name {
+				return t
+			}
+			continue
+		}
+		switch t.stmt.(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			return t
+		}
+		if !cont {
+			return t
+		}
+	}
+	return nil
+}
+
+func (u *uninitChecker) exprList(s uninitSet, list []ast.Expr) {
+	for _, e := range list {
+		u.expr(s, e)
+	}
+}
+
+// capture removes the variables assigned in the body of a function
+// literal from s.
+func (u *uninitChecker) capture(s uninitSet, body ast.Node) {
+	ast.Inspect(body, func(n ast.Node) bool {
+		var lhs []ast.Expr // expressions assigned to by n
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			lhs = n.Lhs
+		case *ast.IncDecStmt:
+			lhs = []ast.Expr{n.X}
+		case *ast.RangeStmt:
+			lhs = []ast.Expr{n.Key, n.Value}
+		case *ast.UnaryExpr:
+			if n.Op == token.AND {
+				lhs = []ast.Expr{n.X}
+			}
+		case *ast.SelectorExpr:
+			if u.ptrRecv(n) {
+				lhs = []ast.Expr{n.X}
+			}
+		}
+		for _, x := range lhs {
+			if x == nil {
+				continue
+			}
+			if v, _ := u.path(x); v != nil {
+				delete(s, v)
+			}
+		}
+		return true
+	})
+}
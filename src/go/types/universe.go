@@ -30,6 +30,8 @@ var (
 	universeAnyAlias   *TypeName
 	universeError      Type
 	universeComparable Object
+	universeSet        Object
+	universeNone       Object
 )
 
 // Typ contains the predeclared *Basic types indexed by their
@@ -146,6 +148,9 @@ func defPredeclaredTypes() {
 		typ.SetUnderlying(ityp)
 		def(obj)
 	}
+
+	// type set[T comparable] // Wo only
+	def(NewTypeName(nopos, nil, "set", &Set{}))
 }
 
 var predeclaredConsts = [...]struct {
@@ -166,6 +171,13 @@ func defPredeclaredConsts() {
 
 func defPredeclaredNil() {
 	def(&Nil{object{name: "nil", typ: Typ[UntypedNil], color_: black}})
+
+	// None is the absent value of Wo optional types. It is inserted
+	// directly because def enters exported names into package unsafe.
+	none := &Nil{object{name: "None", typ: Typ[UntypedNil], color_: black}}
+	if Universe.Insert(none) != nil {
+		panic("double declaration of predeclared identifier")
+	}
 }
 
 // A builtinId is the id of a builtin function.
@@ -192,6 +204,17 @@ const (
 	_Real
 	_Recover
 
+	// Wo set methods
+	_SetAdd
+	_SetDelete
+
+	// Wo optional methods
+	_OptionalIsPresent
+	_OptionalOrElse
+
+	// Wo builtin methods of strings, slices and maps
+	_BuiltinMethod
+
 	// package unsafe
 	_Add
 	_Alignof
@@ -233,6 +256,14 @@ var predeclaredFuncs = [...]struct {
 	_Real:    {"real", 1, false, expression},
 	_Recover: {"recover", 0, false, statement},
 
+	_SetAdd:    {"add", 1, false, statement},
+	_SetDelete: {"delete", 1, false, statement},
+
+	_OptionalIsPresent: {"IsPresent", 0, false, expression},
+	_OptionalOrElse:    {"OrElse", 1, false, expression},
+
+	_BuiltinMethod: {"method", 0, true, statement},
+
 	_Add:        {"Add", 2, false, expression},
 	_Alignof:    {"Alignof", 1, false, expression},
 	_Offsetof:   {"Offsetof", 1, false, expression},
@@ -252,6 +283,9 @@ func defPredeclaredFuncs() {
 		if id == _Assert || id == _Trace {
 			continue // only define these in testing environment
 		}
+		if id == _SetAdd || id == _SetDelete || id == _OptionalIsPresent || id == _OptionalOrElse || id == _BuiltinMethod {
+			continue // selected from a set, optional, string, slice or map value, see Checker.selector
+		}
 		def(newBuiltin(id))
 	}
 }
@@ -282,6 +316,8 @@ func init() {
 	universeRune = Universe.Lookup("rune").Type()
 	universeError = Universe.Lookup("error").Type()
 	universeComparable = Universe.Lookup("comparable")
+	universeSet = Universe.Lookup("set")
+	universeNone = Universe.Lookup("None")
 }
 
 // Objects with names containing blanks are internal and not entered into
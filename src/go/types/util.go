@@ -13,6 +13,7 @@ import (
 	"go/ast"
 	"go/constant"
 	"go/token"
+	. "internal/types/errors"
 )
 
 const isTypes2 = false
@@ -56,3 +57,131 @@ func endPos(n ast.Node) token.Pos { return n.End() }
 func makeFromLiteral(lit string, kind token.Token) constant.Value {
 	return constant.MakeFromLiteral(lit, kind, 0)
 }
+
+// lambdaBody returns the function body of the Wo function literal x:
+// { return x.Body } if the function type of x has results, and
+// { x.Body } otherwise.
+func lambdaBody(x *ast.LambdaExpr, results bool) *ast.BlockStmt {
+	var s ast.Stmt
+	if results {
+		s = &ast.ReturnStmt{Return: x.Body.Pos(), Results: []ast.Expr{x.Body}}
+	} else {
+		s = &ast.ExprStmt{X: x.Body}
+	}
+	return &ast.BlockStmt{Lbrace: x.Body.Pos(), List: []ast.Stmt{s}, Rbrace: x.Body.End()}
+}
+
+// varClause returns the Wo conditional binding cond, or nil if cond
+// is not one. In the syntax tree, a conditional binding is the
+// condition of an if or for statement.
+func varClause(cond ast.Expr) *ast.BindingExpr {
+	c, _ := cond.(*ast.BindingExpr)
+	return c
+}
+
+// paramDefaultExprs returns the default values of the Wo parameters
+// of ftyp, by parameter; parameters without default value have nil.
+func paramDefaultExprs(ftyp *ast.FuncType) []ast.Expr {
+	if ftyp.Params == nil {
+		return nil
+	}
+	// The default value of a field applies to its last name.
+	var dflts []ast.Expr
+	for _, field := range ftyp.Params.List {
+		for range len(field.Names) - 1 {
+			dflts = append(dflts, nil)
+		}
+		dflts = append(dflts, field.Default)
+	}
+	return dflts
+}
+
+// defaultArg sets x.expr to an identifier denoting the default value
+// obj of an argument omitted from the call, positioned at the closing
+// parenthesis of the call. Unlike the arguments in call.Args, the
+// identifier is not part of the syntax tree and is not recorded.
+func (check *Checker) defaultArg(call *ast.CallExpr, x *operand, obj Object) {
+	name := obj.Name()
+	if c, _ := obj.(*Const); c != nil {
+		name = c.val.String()
+	}
+	x.expr = &ast.Ident{NamePos: call.Rparen, Name: name}
+}
+
+// typedVarDeclParts returns the declared name and the init expression
+// of the Wo typed declaration s. If s is invalid, typedVarDeclParts
+// reports an error and returns a nil name.
+func (check *Checker) typedVarDeclParts(s *ast.AssignStmt) (*ast.Ident, ast.Expr) {
+	var name *ast.Ident
+	if len(s.Lhs) == 1 && len(s.Rhs) == 1 {
+		name, _ = s.Lhs[0].(*ast.Ident)
+	}
+	if name == nil {
+		check.error(s, InvalidSyntaxTree, "invalid typed declaration")
+		check.use(s.Lhs...)
+		check.use(s.Rhs...)
+		return nil, nil
+	}
+	return name, s.Rhs[0]
+}
+
+// fieldNames returns the names of the fields in list and their type
+// expressions.
+func fieldNames(list *ast.FieldList) (names []*ast.Ident, typs []ast.Expr) {
+	if list == nil {
+		return
+	}
+	for _, f := range list.List {
+		for _, name := range f.Names {
+			names = append(names, name)
+			typs = append(typs, f.Type)
+		}
+	}
+	return
+}
+
+// numFields returns the number of fields in list.
+func numFields(list *ast.FieldList) int { return list.NumFields() }
+
+// isEnumDecl reports whether s declares a Wo enum type (which may
+// have implicit methods, see Checker.enumMethods).
+func isEnumDecl(s *ast.TypeSpec) bool {
+	_, ok := ast.Unparen(s.Type).(*ast.EnumType)
+	return ok && !s.Assign.IsValid() && s.TypeParams == nil
+}
+
+// caseClauses returns the case clauses of the switch statement s.
+// Invalid entries are reported and nil.
+func (check *Checker) caseClauses(s *ast.SwitchStmt) []*ast.CaseClause {
+	clauses := make([]*ast.CaseClause, len(s.Body.List))
+	for i, c := range s.Body.List {
+		clause, _ := c.(*ast.CaseClause)
+		if clause == nil {
+			check.error(c, InvalidSyntaxTree, "incorrect expression switch case")
+		}
+		clauses[i] = clause
+	}
+	return clauses
+}
+
+// caseExprs returns the expressions of the case clause, or nil for
+// the default clause.
+func caseExprs(clause *ast.CaseClause) []ast.Expr { return clause.List }
+
+// firstTypeParam returns the first type parameter of the function
+// declaration d, or nil.
+func firstTypeParam(d *ast.FuncDecl) positioner {
+	if d.Type.TypeParams.NumFields() == 0 {
+		return nil
+	}
+	return d.Type.TypeParams.List[0]
+}
+
+// addressOperand returns the operand x of the address operation &x,
+// or nil if x is not an address operation.
+func addressOperand(x *ast.UnaryExpr) ast.Expr {
+	if x.Op == token.AND {
+		return x.X
+	}
+	return nil
+}
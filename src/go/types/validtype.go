@@ -52,6 +52,18 @@ func (check *Checker) validType0(pos token.Pos, typ Type, nest, path []*Named) b
 	case *Array:
 		return check.validType0(pos, t.elem, nest, path)
 
+	case *Optional:
+		return check.validType0(pos, t.elem, nest, path)
+
+	case *Enum:
+		for _, v := range t.variants {
+			for _, f := range v.fields {
+				if !check.validType0(pos, f.typ, nest, path) {
+					return false
+				}
+			}
+		}
+
 	case *Struct:
 		for _, f := range t.fields {
 			if !check.validType0(pos, f.typ, nest, path) {
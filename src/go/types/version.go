@@ -64,3 +64,26 @@ func (check *Checker) verifyVersionf(at positioner, v goVersion, format string,
 	}
 	return true
 }
+
+// isWo reports whether the file containing at is written in the Wo dialect.
+func (check *Checker) isWo(at positioner) bool {
+	return check.woFeatures[check.fset.File(at.Pos())] != 0
+}
+
+// allowWo reports whether the file containing at is written in the
+// Wo dialect and may use the Wo feature f.
+func (check *Checker) allowWo(at positioner, f woFeatures) bool {
+	return check.woFeatures[check.fset.File(at.Pos())]&f != 0
+}
+
+// verifyWof is like allowWo but also accepts a format string and arguments
+// which are used to report an error if allowWo returns false.
+// It is used for syntax that is only accepted in Wo files, which
+// may only be rejected because f was disabled by a //wo:dialect directive.
+func (check *Checker) verifyWof(at positioner, f woFeatures, format string, args ...interface{}) bool {
+	if !check.allowWo(at, f) {
+		check.woErrorf(at, f, format, args...)
+		return false
+	}
+	return true
+}
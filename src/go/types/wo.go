@@ -0,0 +1,156 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file determines the Wo language features that files may use.
+
+package types
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+)
+
+// woFeatures describes a set of Wo language features.
+// It corresponds to cmd/compile/internal/syntax.Features.
+type woFeatures uint64
+
+// Wo language features.
+const (
+	woTernary        woFeatures = 1 << iota // if x then y else z expressions
+	woRange                                 // for k, v : x range clauses
+	woSet                                   // predeclared set[T] type
+	woOptional                              // T? optional types and postfix ?
+	woResult                                // T! result types and postfix !
+	woArrow                                 // A -> B function types and x -> e function literals
+	woEnum                                  // enum types
+	woInterface                             // <M()> interface literals and union value types
+	woUnused                                // unused variables are warnings, not errors
+	woUninit                                // variables must be assigned before they are read
+	woExport                                // export and pkg visibility modifiers
+	woOverload                              // function overloading
+	woDefault                               // default parameter values
+	woSkip                                  // skip result slots
+	woIfVar                                 // if var x = f() {} conditional bindings
+	woBuiltinMethods                        // methods on strings, slices and maps
+	woMethodTParams                         // type parameters on methods
+	woShadow                                // no shadowing of predeclared identifiers and imports
+	woAssign                                // x T = v declarations, := only for shadowing, no parallel assignment
+
+	allWoFeatures woFeatures = 1<<iota - 1
+)
+
+var woFeatureNames = [...]string{
+	"ternary",
+	"range",
+	"set",
+	"optional",
+	"result",
+	"arrow",
+	"enum",
+	"interface",
+	"unused",
+	"uninit",
+	"export",
+	"overload",
+	"default",
+	"skip",
+	"ifvar",
+	"builtinmethods",
+	"methodtparams",
+	"shadow",
+	"assign",
+}
+
+// String returns the comma-separated names of the features in f,
+// "all" if f contains all features, or "none" if f is empty.
+func (f woFeatures) String() string {
+	if f == 0 {
+		return "none"
+	}
+	if f == allWoFeatures {
+		return "all"
+	}
+	var names []string
+	for i, name := range woFeatureNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, ",")
+}
+
+// parseWoFeatures parses the comma-separated list of Wo feature names
+// of a //wo:dialect directive. The list has the syntax accepted by
+// cmd/compile/internal/syntax.ParseFeatures.
+func parseWoFeatures(list string) (woFeatures, error) {
+	list = strings.TrimSpace(list)
+	if list == "" {
+		return allWoFeatures, nil
+	}
+	var f woFeatures
+	if strings.HasPrefix(list, "-") {
+		f = allWoFeatures
+	}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		remove := strings.HasPrefix(name, "-")
+		name = strings.TrimPrefix(name, "-")
+		var x woFeatures
+		switch name {
+		case "all":
+			x = allWoFeatures
+		case "none":
+			f = 0
+			continue
+		default:
+			for i, n := range woFeatureNames {
+				if n == name {
+					x = 1 << i
+					break
+				}
+			}
+			if x == 0 {
+				return 0, fmt.Errorf("unknown Wo feature %q", name)
+			}
+		}
+		if remove {
+			f &^= x
+		} else {
+			f |= x
+		}
+	}
+	return f, nil
+}
+
+// woFileFeatures returns the Wo features that the file with the given
+// name may use. A file is written in the Wo dialect if it was parsed as
+// such (see go/parser.ParseFile), if its name ends in ".wo", or if it
+// has a //wo:dialect directive before the package clause; Wo files may
+// use all features unless the directive restricts them. Go files may
+// use none. The directive is only seen if the file was parsed with
+// comments. If its list of features is invalid, the directive comment
+// is returned with the error.
+func woFileFeatures(name string, file *ast.File) (woFeatures, *ast.Comment, error) {
+	f := woFeatures(0)
+	if file.Wo || strings.HasSuffix(name, ".wo") {
+		f = allWoFeatures
+	}
+	for _, g := range file.Comments {
+		if g.Pos() > file.Package {
+			break
+		}
+		for _, c := range g.List {
+			list, ok := strings.CutPrefix(c.Text, "//wo:dialect")
+			if !ok || list != "" && list[0] != ' ' {
+				continue
+			}
+			var err error
+			if f, err = parseWoFeatures(list); err != nil {
+				return allWoFeatures, c, err
+			}
+		}
+	}
+	return f, nil, nil
+}
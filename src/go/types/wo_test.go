@@ -0,0 +1,305 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types_test
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"slices"
+	"strings"
+	"testing"
+
+	. "go/types"
+)
+
+func TestUnusedVarWarning(t *testing.T) {
+	const body = "package p; func _() { var x = 1 }"
+	for _, test := range []struct {
+		filename, src string
+		want          Severity
+	}{
+		{"p.go", body, SeverityError},
+		{"p.wo", body, SeverityWarning},
+		{"p.go", "//wo:dialect\n" + body, SeverityWarning},
+	} {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, test.filename, test.src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []Error
+		conf := Config{Error: func(err error) { got = append(got, err.(Error)) }}
+		_, err = conf.Check(f.Name.Name, fset, []*ast.File{f}, nil)
+		if len(got) != 1 {
+			t.Errorf("%s: got %d errors, want 1", test.filename, len(got))
+			continue
+		}
+		if got[0].Severity != test.want || !got[0].Soft {
+			t.Errorf("%s: got severity %s, Soft = %t; want severity %s, Soft = true", test.filename, got[0].Severity, got[0].Soft, test.want)
+		}
+		// Warnings don't fail type-checking.
+		if (err != nil) != (test.want == SeverityError) {
+			t.Errorf("%s: Check returned %v", test.filename, err)
+		}
+	}
+}
+
+func TestUnknownBuiltinMethod(t *testing.T) {
+	// Without an importer, package strings is unavailable, as it is in the
+	// compiler when the build system found no function named Frobnicate.
+	const src = "//wo:dialect\npackage p; func _(s string) { s.Frobnicate() }"
+	var errs []string
+	conf := Config{Error: func(err error) { errs = append(errs, err.(Error).Msg) }}
+	typecheck(src, &conf, nil)
+	if want := []string{"s.Frobnicate undefined (type string has no field or method Frobnicate)"}; !slices.Equal(errs, want) {
+		t.Errorf("got errors %q, want %q", errs, want)
+	}
+}
+
+func TestVisibility(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+export func แมว() {}
+func export lower() {}
+pkg func Get() {}
+pkg var v int
+export type T enum{ x, y }
+func hidden() {}
+`
+	const bsrc = `package b
+
+import "a"
+
+var _ = a.แมว
+var _ = a.lower
+var _ = a.Get
+var _ = a.v
+var _ a.T = a.x
+var _ = a.hidden
+`
+	const csrc = `package c
+
+import . "a"
+
+var _ = lower
+var _ = Get
+`
+	check := func(path, module, src string, conf *Config) (*Package, []string) {
+		var errs []string
+		conf.Error = func(err error) {
+			errs = append(errs, err.(Error).Msg)
+		}
+		fset := token.NewFileSet()
+		pkg := NewPackage(path, "")
+		pkg.SetModule(module)
+		NewChecker(conf, fset, pkg, nil).Files([]*ast.File{mustParse(fset, src)})
+		return pkg, errs
+	}
+
+	a, errs := check("a", "example.com/m", asrc, &Config{})
+	if len(errs) > 0 {
+		t.Fatalf("package a: %v", errs)
+	}
+	if obj := a.Scope().Lookup("v"); obj.Visibility() != PkgVis || obj.Exported() {
+		t.Errorf("a.v: got visibility %d, exported %t; want PkgVis, false", obj.Visibility(), obj.Exported())
+	}
+	if obj := a.Scope().Lookup("x"); obj.Visibility() != ExportVis || !obj.Exported() {
+		t.Errorf("a.x: got visibility %d, exported %t; want ExportVis, true", obj.Visibility(), obj.Exported())
+	}
+
+	for _, test := range []struct {
+		module string
+		want   []string
+	}{
+		{"example.com/m", []string{
+			"name hidden not exported by package a",
+		}},
+		{"example.com/n", []string{
+			"name Get is only visible in module example.com/m",
+			"name v is only visible in module example.com/m",
+			"name hidden not exported by package a",
+		}},
+	} {
+		_, errs := check("b", test.module, bsrc, &Config{Importer: importHelper{pkg: a}})
+		if !slices.Equal(errs, test.want) {
+			t.Errorf("package b in module %s: got errors %q, want %q", test.module, errs, test.want)
+		}
+	}
+
+	_, errs = check("c", "example.com/n", csrc, &Config{Importer: importHelper{pkg: a}})
+	if want := []string{"undefined: Get"}; !slices.Equal(errs, want) {
+		t.Errorf("package c: got errors %q, want %q", errs, want)
+	}
+}
+
+func TestOverload(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+func Print(s string) string       { return s }
+func Print(n int, s string) string { return s }
+`
+	const bsrc = `//wo:dialect
+package b
+
+import "a"
+
+var x = a.Print("x")
+var y = a.Print(1, "y")
+`
+	const csrc = `package c
+
+import "a"
+
+var _ = a.Print_3int6string(1, "y")
+var _ = a.Print("x")
+`
+	a := mustTypecheck(asrc, nil, nil)
+	ov, _ := a.Scope().Lookup("Print").(*Overload)
+	if ov == nil || ov.NumFuncs() != 2 {
+		t.Fatalf("a.Print: got %v, want overloaded func with 2 functions", a.Scope().Lookup("Print"))
+	}
+	for i, want := range []string{"Print_6string", "Print_3int6string"} {
+		if got := ov.Func(i).Name(); got != want {
+			t.Errorf("a.Print: function %d has name %s, want %s", i, got, want)
+		}
+		if a.Scope().Lookup(want) != ov.Func(i) {
+			t.Errorf("a.%s is not declared in the package scope", want)
+		}
+	}
+
+	conf := Config{Importer: importHelper{pkg: a}}
+	info := Info{Uses: make(map[*ast.Ident]Object)}
+	mustTypecheck(bsrc, &conf, &info)
+	var got []string
+	for id, obj := range info.Uses {
+		if id.Name == "Print" {
+			got = append(got, obj.Name())
+		}
+	}
+	slices.Sort(got)
+	if want := []string{"Print_3int6string", "Print_6string"}; !slices.Equal(got, want) {
+		t.Errorf("package b: a.Print denotes %v, want %v", got, want)
+	}
+
+	var errs []string
+	conf.Error = func(err error) { errs = append(errs, err.(Error).Msg) }
+	typecheck(csrc, &conf, nil)
+	if want := []string{"cannot use overloaded function Print in Go file (use one of Print_6string, Print_3int6string)"}; !slices.Equal(errs, want) {
+		t.Errorf("package c: got errors %q, want %q", errs, want)
+	}
+}
+
+func TestDefault(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+var Console any
+
+func Print(s string, n = 2, out = Console, f func() = nil) {}
+`
+	const bsrc = `//wo:dialect
+package b
+
+import "a"
+
+func _() { a.Print("x") }
+`
+	const csrc = `package c
+
+import "a"
+
+func _() { a.Print("x") }
+`
+	a := mustTypecheck(asrc, nil, nil)
+	sig := a.Scope().Lookup("Print").Type().(*Signature)
+	for i, want := range []string{"<nil>", "const 2", "var a.Console any", "nil"} {
+		got := fmt.Sprint(sig.Default(i))
+		if c, ok := sig.Default(i).(*Const); ok {
+			got = "const " + c.Val().String()
+		}
+		if got != want {
+			t.Errorf("a.Print: default value of parameter %d is %s, want %s", i, got, want)
+		}
+	}
+	if got := sig.Params().At(1).Type(); got != Typ[Int] {
+		t.Errorf("a.Print: parameter n has type %s, want int", got)
+	}
+
+	// The omitted arguments are not added to the syntax tree.
+	conf := Config{Importer: importHelper{pkg: a}}
+	info := Info{Types: make(map[ast.Expr]TypeAndValue)}
+	fset := token.NewFileSet()
+	f := mustParse(fset, bsrc)
+	if _, err := conf.Check("b", fset, []*ast.File{f}, &info); err != nil {
+		t.Fatal(err)
+	}
+	var call *ast.CallExpr
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n, ok := n.(*ast.CallExpr); ok {
+			call = n
+		}
+		return call == nil
+	})
+	if got, want := len(call.Args), 1; got != want {
+		t.Fatalf("package b: call has %d arguments, want %d", got, want)
+	}
+	if tv := info.Types[call]; !tv.IsVoid() {
+		t.Errorf("package b: call is %v, want no value", tv)
+	}
+
+	var errs []string
+	conf.Error = func(err error) { errs = append(errs, err.(Error).Msg) }
+	typecheck(csrc, &conf, nil)
+	if len(errs) == 0 || !strings.HasPrefix(errs[0], "not enough arguments in call to a.Print") {
+		t.Errorf("package c: got errors %q, want not enough arguments", errs)
+	}
+}
+
+func TestSkip(t *testing.T) {
+	const asrc = `//wo:dialect
+package a
+
+func Get() (skip n int, s string, skip err error) { return }
+`
+	const bsrc = `//wo:dialect
+package b
+
+import "a"
+
+var _ string = a.Get()
+`
+	const csrc = `package c
+
+import "a"
+
+var _ string = a.Get()
+`
+	a := mustTypecheck(asrc, nil, nil)
+	sig := a.Scope().Lookup("Get").Type().(*Signature)
+	for i, want := range []bool{true, false, true} {
+		if got := sig.Skippable(i); got != want {
+			t.Errorf("a.Get: result %d is skippable = %v, want %v", i, got, want)
+		}
+	}
+	if got, want := sig.String(), "func() (n int, s string, err error)"; got != want {
+		t.Errorf("a.Get has type %s, want %s", got, want)
+	}
+
+	conf := Config{Importer: importHelper{pkg: a}}
+	if _, err := typecheck(bsrc, &conf, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	var errs []string
+	conf.Error = func(err error) { errs = append(errs, err.(Error).Msg) }
+	typecheck(csrc, &conf, nil)
+	if len(errs) == 0 || !strings.HasPrefix(errs[0], "multiple-value a.Get() (value of type (n int, s string, err error)) in single-value context") {
+		t.Errorf("package c: got errors %q, want multiple-value error", errs)
+	}
+}
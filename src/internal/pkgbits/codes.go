@@ -56,6 +56,9 @@ const (
 	TypeInterface
 	TypeUnion
 	TypeTypeParam
+	TypeSet
+	TypeOptional
+	TypeEnum
 )
 
 // A CodeObj distinguishes among go/types.Object encodings.
@@ -74,4 +77,39 @@ const (
 	ObjFunc
 	ObjVar
 	ObjStub
+	ObjVariant
+	ObjOverload
+	ObjMethod
+)
+
+// A CodeVis distinguishes among the visibilities of package-level
+// objects, which may be set by Wo visibility modifiers.
+type CodeVis int
+
+func (c CodeVis) Marker() SyncMarker { return SyncCodeVis }
+func (c CodeVis) Value() int         { return int(c) }
+
+// Note: These values are public and cannot be changed without
+// updating the go/types importers.
+
+const (
+	VisDefault CodeVis = iota // exported if capitalized
+	VisExport                 // export modifier
+	VisPkg                    // pkg modifier
+)
+
+// A CodeDefault distinguishes among the default values of Wo function
+// parameters.
+type CodeDefault int
+
+func (c CodeDefault) Marker() SyncMarker { return SyncCodeDefault }
+func (c CodeDefault) Value() int         { return int(c) }
+
+// Note: These values are public and cannot be changed without
+// updating the go/types importers.
+
+const (
+	DefaultConst CodeDefault = iota // constant value
+	DefaultNil                      // predeclared nil or None
+	DefaultObj                      // package-level variable or function
 )
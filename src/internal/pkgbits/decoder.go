@@ -515,5 +515,18 @@ func (pr *PkgDecoder) PeekObj(idx Index) (string, string, CodeObj) {
 	return path, name, tag
 }
 
+// PeekVis returns the visibility of the object at the specified index.
+func (pr *PkgDecoder) PeekVis(idx Index) CodeVis {
+	r := pr.TempDecoder(RelocName, idx, SyncObject1)
+	r.Sync(SyncSym)
+	r.Sync(SyncPkg)
+	r.Reloc(RelocPkg)
+	_ = r.String()
+	r.Code(SyncCodeObj)
+	vis := CodeVis(r.Code(SyncCodeVis))
+	pr.RetireDecoder(&r)
+	return vis
+}
+
 // Version reports the version of the bitstream.
 func (w *Decoder) Version() Version { return w.common.version }
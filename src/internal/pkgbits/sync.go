@@ -133,4 +133,7 @@ const (
 	SyncMultiExpr
 	SyncRType
 	SyncConvRTTI
+
+	SyncCodeVis
+	SyncCodeDefault
 )
@@ -156,6 +156,24 @@ func _() {
 	_ = x[TypeTooLarge-149]
 	_ = x[InvalidMinMaxOperand-150]
 	_ = x[TooNew-151]
+	_ = x[InvalidUnwrap-152]
+	_ = x[InvalidPropagate-153]
+	_ = x[InvalidLambda-154]
+	_ = x[InvalidEnum-155]
+	_ = x[NonExhaustiveSwitch-156]
+	_ = x[UnassignedVar-157]
+	_ = x[InvalidOverload-158]
+	_ = x[NoMatchingOverload-159]
+	_ = x[AmbiguousOverload-160]
+	_ = x[InvalidDefault-161]
+	_ = x[InvalidSkip-162]
+	_ = x[InvalidBinding-163]
+	_ = x[InvalidGenericMethod-164]
+	_ = x[ShadowedPredeclared-165]
+	_ = x[ShadowedImport-166]
+	_ = x[NonShadowingShortVarDecl-167]
+	_ = x[MixedShortVarDecl-168]
+	_ = x[ParallelAssign-169]
 }
 
 const (
@@ -164,7 +182,7 @@ const (
 	_Code_name_2 = "InvalidPtrEmbedBadRecvInvalidRecvDuplicateFieldAndMethodDuplicateMethodInvalidBlankInvalidIotaMissingInitBodyInvalidInitSigInvalidInitDeclInvalidMainDeclTooManyValuesNotAnExprTruncatedFloatNumericOverflowUndefinedOpMismatchedTypesDivByZeroNonNumericIncDecUnaddressableOperandInvalidIndirectionNonIndexableOperandInvalidIndexSwappedSliceIndicesNonSliceableOperandInvalidSliceExprInvalidShiftCountInvalidShiftOperandInvalidReceiveInvalidSendDuplicateLitKeyMissingLitKeyInvalidLitIndexOversizeArrayLitMixedStructLitInvalidStructLitMissingLitFieldDuplicateLitFieldUnexportedLitFieldInvalidLitFieldUntypedLitInvalidLitAmbiguousSelectorUndeclaredImportedNameUnexportedNameUndeclaredNameMissingFieldOrMethodBadDotDotDotSyntaxNonVariadicDotDotDotMisplacedDotDotDot"
 	_Code_name_3 = "InvalidDotDotDotUncalledBuiltinInvalidAppendInvalidCapInvalidCloseInvalidCopyInvalidComplexInvalidDeleteInvalidImagInvalidLenSwappedMakeArgsInvalidMakeInvalidRealInvalidAssertImpossibleAssertInvalidConversionInvalidUntypedConversionBadOffsetofSyntaxInvalidOffsetofUnusedExprUnusedVarMissingReturnWrongResultCountOutOfScopeResultInvalidCondInvalidPostDecl"
 	_Code_name_4 = "InvalidIterVarInvalidRangeExprMisplacedBreakMisplacedContinueMisplacedFallthroughDuplicateCaseDuplicateDefaultBadTypeKeywordInvalidTypeSwitchInvalidExprSwitchInvalidSelectCaseUndeclaredLabelDuplicateLabelMisplacedLabelUnusedLabelJumpOverDeclJumpIntoBlockInvalidMethodExprWrongArgCountInvalidCallUnusedResultsInvalidDeferInvalidGoBadDeclRepeatedDeclInvalidUnsafeAddInvalidUnsafeSliceUnsupportedFeatureNotAGenericTypeWrongTypeArgCountCannotInferTypeArgsInvalidTypeArgInvalidInstanceCycleInvalidUnionMisplacedConstraintIfaceInvalidMethodTypeParamsMisplacedTypeParamInvalidUnsafeSliceDataInvalidUnsafeString"
-	_Code_name_5 = "InvalidClearTypeTooLargeInvalidMinMaxOperandTooNew"
+	_Code_name_5 = "InvalidClearTypeTooLargeInvalidMinMaxOperandTooNewInvalidUnwrapInvalidPropagateInvalidLambdaInvalidEnumNonExhaustiveSwitchUnassignedVarInvalidOverloadNoMatchingOverloadAmbiguousOverloadInvalidDefaultInvalidSkipInvalidBindingInvalidGenericMethodShadowedPredeclaredShadowedImportNonShadowingShortVarDeclMixedShortVarDeclParallelAssign"
 )
 
 var (
@@ -172,7 +190,7 @@ var (
 	_Code_index_2 = [...]uint16{0, 15, 22, 33, 56, 71, 83, 94, 109, 123, 138, 153, 166, 175, 189, 204, 215, 230, 239, 255, 275, 293, 312, 324, 343, 362, 378, 395, 414, 428, 439, 454, 467, 482, 498, 512, 528, 543, 560, 578, 593, 603, 613, 630, 652, 666, 680, 700, 718, 738, 756}
 	_Code_index_3 = [...]uint16{0, 16, 31, 44, 54, 66, 77, 91, 104, 115, 125, 140, 151, 162, 175, 191, 208, 232, 249, 264, 274, 283, 296, 312, 328, 339, 354}
 	_Code_index_4 = [...]uint16{0, 14, 30, 44, 61, 81, 94, 110, 124, 141, 158, 175, 190, 204, 218, 229, 241, 254, 271, 284, 295, 308, 320, 329, 336, 348, 364, 382, 400, 415, 432, 451, 465, 485, 497, 521, 544, 562, 584, 603}
-	_Code_index_5 = [...]uint8{0, 12, 24, 44, 50}
+	_Code_index_5 = [...]uint16{0, 12, 24, 44, 50, 63, 79, 92, 103, 122, 135, 150, 168, 185, 199, 210, 224, 244, 263, 277, 301, 318, 332}
 )
 
 func (i Code) String() string {
@@ -191,7 +209,7 @@ func (i Code) String() string {
 	case 108 <= i && i <= 146:
 		i -= 108
 		return _Code_name_4[_Code_index_4[i]:_Code_index_4[i+1]]
-	case 148 <= i && i <= 151:
+	case 148 <= i && i <= 169:
 		i -= 148
 		return _Code_name_5[_Code_index_5[i]:_Code_index_5[i+1]]
 	default:
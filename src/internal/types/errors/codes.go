@@ -935,6 +935,7 @@ const (
 	UnusedExpr
 
 	// UnusedVar occurs when a variable is declared but unused.
+	// In Wo files, it is reported as a warning.
 	//
 	// Example:
 	//  func f() {
@@ -1482,4 +1483,156 @@ const (
 	// errors. The solution is to rebuild the application with a
 	// newer Go release.
 	TooNew
+
+	// InvalidUnwrap occurs when the Wo postfix ? operator is applied
+	// to an operand that is not an optional value, a comma-ok expression,
+	// or a call returning a value and a boolean, or when it is used in
+	// a function whose last result is not an optional type.
+	//
+	// For instance, in a Wo file, x? is invalid if x has type int, or
+	// if it appears in a function without results.
+	InvalidUnwrap
+
+	// InvalidPropagate occurs when the Wo postfix ! operator is applied
+	// to an operand that is not an error or a call returning at most one
+	// value followed by an error, or when it is used in a function whose
+	// last result is not of type error.
+	//
+	// For instance, in a Wo file, f()! is invalid if f returns (int, bool),
+	// or if it appears in a function without results.
+	InvalidPropagate
+
+	// InvalidLambda occurs when the function type of a Wo function
+	// literal cannot be inferred from its context, or when the literal
+	// does not have the parameters of that function type.
+	//
+	// For instance, in a Wo file, var f = x -> x is invalid because the
+	// type of x is unknown, and so is var _ func(int) bool = (a, b) -> a < b.
+	InvalidLambda
+
+	// InvalidEnum occurs when a Wo enum type is declared or used
+	// incorrectly, for instance when an enum type is not declared at
+	// package level, when a variant does not provide constant values
+	// for the fields shared by all variants, or when a switch on a
+	// value of an enum type whose variants have fields has a case
+	// that is not a variant.
+	//
+	// For instance, in a Wo file, type E enum{A(1), B; n int} is
+	// invalid because B does not provide a value for n.
+	InvalidEnum
+
+	// NonExhaustiveSwitch occurs when a switch on a value of a Wo enum
+	// type has neither a default case nor a case for every variant of
+	// the enum type.
+	//
+	// For instance, in a Wo file, given type E enum{A, B}, the statement
+	// switch e { case A: } is invalid because there is no case for B.
+	NonExhaustiveSwitch
+
+	// UnassignedVar occurs when a local variable declared without an
+	// initial value is read in a Wo file before it is assigned on every
+	// path leading to the read.
+	//
+	// For instance, in a Wo file, var s string; s += "." is invalid
+	// because s is read before it is assigned.
+	UnassignedVar
+
+	// InvalidOverload occurs when a set of overloaded Wo functions is
+	// declared incorrectly, or used other than by calling one of its
+	// functions from a Wo file. Such uses must refer to the functions by
+	// their mangled names.
+	//
+	// For instance, given the Wo functions func f(int) and func f(string),
+	// var g = f is invalid, but var g = f_int is valid.
+	InvalidOverload
+
+	// NoMatchingOverload occurs when no function of a set of overloaded
+	// Wo functions accepts the arguments of a call.
+	//
+	// For instance, given the Wo functions func f(int) and func f(string),
+	// the call f(1.5) is invalid.
+	NoMatchingOverload
+
+	// AmbiguousOverload occurs when more than one function of a set of
+	// overloaded Wo functions accepts the arguments of a call, and none
+	// of them has parameter types identical to the argument types.
+	//
+	// For instance, given the Wo functions func f(any) and func f(error),
+	// the call f(nil) is ambiguous.
+	AmbiguousOverload
+
+	// InvalidDefault occurs when a Wo function parameter has an invalid
+	// default value. Default values must be constants, nil, or
+	// package-level variables or functions; they are only permitted for
+	// the last parameters of a function declaration, excluding a
+	// variadic parameter.
+	//
+	// For instance, in a Wo file, func f(x int = 1, y int) is invalid
+	// because y follows a parameter with a default value.
+	InvalidDefault
+
+	// InvalidSkip occurs when a skip modifier is used outside the
+	// result list of a Wo function type, or when all results of a
+	// function are skippable, which leaves nothing to assign.
+	//
+	// For instance, in a Wo file, func f() (skip int, skip error) is
+	// invalid.
+	InvalidSkip
+
+	// InvalidBinding occurs when the value of a Wo conditional binding
+	// in an if or for header neither is an optional value or comma-ok
+	// expression nor yields values followed by a bool or an error, or
+	// when the number of bound variables does not match.
+	//
+	// For instance, in a Wo file, if var x = len(s) {} is invalid.
+	InvalidBinding
+
+	// InvalidGenericMethod occurs when a Wo method with type parameters
+	// is used other than by calling it: generic methods have no method
+	// values or method expressions.
+	//
+	// For instance, given the Wo method func (l List) Map[T any](func(int) T) List,
+	// f := l.Map[string] is invalid, but l.Map[string](f) is valid.
+	InvalidGenericMethod
+
+	// ShadowedPredeclared occurs when a Wo file declares an identifier
+	// with the name of a predeclared identifier, which the declaration
+	// would shadow.
+	//
+	// For instance, in a Wo file, var int int = 1 and var rune = 'W' are
+	// invalid.
+	ShadowedPredeclared
+
+	// ShadowedImport occurs when a Wo file declares a local identifier
+	// with the name of a package imported by the file, which the
+	// declaration would shadow.
+	//
+	// For instance, in a Wo file that imports "strings", var strings = []string{}
+	// is invalid inside a function.
+	ShadowedImport
+
+	// NonShadowingShortVarDecl occurs when a short variable declaration
+	// in a Wo file declares a variable that does not shadow a variable
+	// of an enclosing scope. Wo reserves := for shadowing; other
+	// variables are declared with var, or with the typed form x T = v.
+	//
+	// For instance, in a Wo file, func f() { x := 1 } is invalid, but
+	// func f() { var x = 1 } and func f() { x int = 1 } are valid.
+	NonShadowingShortVarDecl
+
+	// MixedShortVarDecl occurs when a short variable declaration in a
+	// Wo file both declares new variables and assigns to variables
+	// already declared in the same scope.
+	//
+	// For instance, in a Wo file, given var err error,
+	// n, err := w.Write(b) is invalid.
+	MixedShortVarDecl
+
+	// ParallelAssign occurs when an assignment or short variable
+	// declaration in a Wo file assigns several values at once, which
+	// Wo only permits for the results of a multi-valued expression.
+	//
+	// For instance, in a Wo file, p, q = 20, 30 is invalid, but
+	// v, ok = m[k] is valid.
+	ParallelAssign
 )
@@ -12,6 +12,7 @@ import (
 	"go/parser"
 	"go/token"
 	"internal/testenv"
+	"internal/types/errors"
 	"reflect"
 	"strings"
 	"testing"
@@ -44,6 +45,22 @@ func TestErrorCodeExamples(t *testing.T) {
 	})
 }
 
+func TestCodeByName(t *testing.T) {
+	walkCodes(t, func(name string, value int, spec *ast.ValueSpec) {
+		if name == "_" || value <= 0 {
+			return // removed code or InvalidSyntaxTree
+		}
+		if got, ok := errors.CodeByName(name); !ok || int(got) != value {
+			t.Errorf("CodeByName(%q) = %d, %v; want %d, true", name, got, ok, value)
+		}
+	})
+	for _, name := range []string{"", "NoSuchCode", "Code(1)"} {
+		if got, ok := errors.CodeByName(name); ok {
+			t.Errorf("CodeByName(%q) = %d, true; want false", name, got)
+		}
+	}
+}
+
 func walkCodes(t *testing.T, f func(string, int, *ast.ValueSpec)) {
 	t.Helper()
 	fset := token.NewFileSet()
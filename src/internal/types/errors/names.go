@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package errors
+
+import "strings"
+
+// CodeByName returns the error code with the given name, such as
+// "UnusedVar", and reports whether there is such a code.
+func CodeByName(name string) (Code, bool) {
+	if name == "" || strings.HasPrefix(name, "Code(") {
+		return 0, false
+	}
+	for c := Code(1); c <= lastCode; c++ {
+		if c.String() == name {
+			return c, true
+		}
+	}
+	return 0, false
+}
+
+// lastCode is the error code with the largest value.
+// It must be updated when codes are added.
+const lastCode = ParallelAssign
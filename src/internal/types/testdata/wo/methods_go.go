@@ -0,0 +1,12 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Builtin methods are only available in Wo files.
+
+package p
+
+func _(s string, xs []int) {
+	_ = s.Contains /* ERROR "s.Contains undefined" */ ("a")
+	_ = xs.Contains /* ERROR "xs.Contains undefined" */ (1)
+}
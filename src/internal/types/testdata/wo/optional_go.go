@@ -0,0 +1,14 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// None is only predeclared in Wo files.
+
+package p
+
+var _ = None /* ERROR "undefined: None" */
+
+func _() {
+	None := 0
+	_ = None
+}
@@ -0,0 +1,14 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The set type is only predeclared in Wo files.
+
+package p
+
+var _ set /* ERROR "undefined: set" */ [int]
+
+func _() {
+	set := 0
+	_ = set
+}
@@ -0,0 +1,15 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Go files may shadow predeclared identifiers and imported packages.
+
+package p
+
+import "strings"
+
+func _() {
+	rune := 'W'
+	strings := strings.Fields(string(rune))
+	_ = strings
+}
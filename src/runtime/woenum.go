@@ -0,0 +1,23 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+// An enumTextError reports that the text passed to the UnmarshalText
+// method of a Wo enum type is not the name of one of its variants.
+type enumTextError struct {
+	typ  string // qualified name of the enum type
+	text string
+}
+
+func (e *enumTextError) Error() string {
+	return "wo: " + e.typ + " has no variant " + `"` + e.text + `"`
+}
+
+// enumtexterror returns the error of the UnmarshalText method that
+// the compiler provides for the Wo enum type typ if text is not the
+// name of a variant.
+func enumtexterror(typ, text string) error {
+	return &enumTextError{typ, text}
+}
@@ -0,0 +1,29 @@
+// asmcheck
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package codegen
+
+// Simple conditional expressions compile to conditional moves.
+
+func condint(x, y int) int {
+	// amd64:"CMOVQ(LT|GT)"
+	// arm64:"CSEL\t(LT|GT)"
+	return if x < y then x else y
+}
+
+func condconst(b bool) int {
+	// amd64:"CMOVQNE"
+	// arm64:"CSEL\tNE"
+	return if b then 99 else 1
+}
+
+func condptr(p, q *int) *int {
+	// amd64:"CMOVQ(EQ|NE)"
+	// arm64:"CSEL\t(EQ|NE)"
+	return if p == nil then q else p
+}
@@ -0,0 +1,133 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test arrow function types and lambdas.
+
+package main
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type Pred int -> bool
+
+func apply(f int -> int, x int) int { return f(x) }
+
+func compose(f, g int -> int) int -> int {
+	return x -> f(g(x))
+}
+
+func counter() () -> int {
+	var n = 0
+	return () -> inc(&n)
+}
+
+func inc(p *int) int {
+	*p++
+	return *p
+}
+
+func mapSlice[T, U any](s []T, f T -> U) []U {
+	var r []U = nil
+	for _, x := range s {
+		r = append(r, f(x))
+	}
+	return r
+}
+
+var isZero Pred = v -> v == 0
+
+var calls []string
+
+func record(s string) int {
+	calls = append(calls, s)
+	return len(calls)
+}
+
+func main() {
+	if !isZero(0) || isZero(1) {
+		panic("bad package-level lambda")
+	}
+
+	var add (int, int) -> int = (a, b) -> a + b
+	if add(2, 3) != 5 {
+		panic("bad two-parameter lambda")
+	}
+
+	if got int = apply(x -> x*10, 4); got != 40 {
+		panic(fmt.Sprint("bad lambda argument: ", got))
+	}
+	if got int = compose(x -> x+1, x -> x*2)(5); got != 11 {
+		panic(fmt.Sprint("bad curried lambda: ", got))
+	}
+
+	// Lambdas without results are expression statements.
+	var log string -> _ = s -> record(s)
+	log("a")
+	log("b")
+	if got string = strings.Join(calls, ","); got != "a,b" {
+		panic(fmt.Sprint("bad lambda without result: ", got))
+	}
+	var effect () -> _ = () -> println()
+	_ = effect
+
+	// Closures capture variables.
+	var next = counter()
+	next()
+	if got int = next(); got != 2 {
+		panic(fmt.Sprint("bad captured variable: ", got))
+	}
+	var base = 100
+	var plus = []Pred{v -> v > base, v -> v < -base}
+	base = 0
+	if !plus[0](1) || !plus[1](-1) {
+		panic("bad capture by reference")
+	}
+
+	// Lambdas in range-over-func loop bodies capture the
+	// per-iteration variables.
+	var fs = [2]func() int{}
+	for i, v := range slices.All([]int{10, 20}) {
+		fs[i] = () -> v + i
+	}
+	if fs[0]() != 10 || fs[1]() != 21 {
+		panic("bad lambda in range-over-func loop")
+	}
+
+	// Parameter types are inferred from generic calls.
+	var s = []int{3, 0, 1, 2}
+	if i int = slices.IndexFunc(s, v -> v == 0); i != 1 {
+		panic(fmt.Sprint("bad IndexFunc: ", i))
+	}
+	var names = []string{"bob", "ann", "cid"}
+	slices.SortFunc(names, (a, b) -> strings.Compare(a, b))
+	if got string = strings.Join(names, ","); got != "ann,bob,cid" {
+		panic(fmt.Sprint("bad SortFunc: ", got))
+	}
+	sort.Slice(s, (i, j) -> s[i] > s[j])
+	if !slices.Equal(s, []int{3, 2, 1, 0}) {
+		panic(fmt.Sprint("bad sort.Slice: ", s))
+	}
+	var got = mapSlice(s, func(x int) string { return fmt.Sprint(x) })
+	if strings.Join(got, "") != "3210" {
+		panic(fmt.Sprint("bad mapSlice: ", got))
+	}
+	var flags = mapSlice[int, bool](s, v -> v > 1)
+	if !slices.Equal(flags, []bool{true, true, false, false}) {
+		panic(fmt.Sprint("bad explicit instantiation: ", flags))
+	}
+
+	// Variadic function types.
+	var join (string, ...string) -> string = (sep, parts) -> strings.Join(parts, sep)
+	if got string = join("-", "a", "b"); got != "a-b" {
+		panic(fmt.Sprint("bad variadic lambda: ", got))
+	}
+}
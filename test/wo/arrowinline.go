@@ -0,0 +1,32 @@
+// errorcheck -0 -m
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test that lambdas are inlined and escape like function literals.
+
+package p
+
+func apply(f int -> int, x int) int { // ERROR "can inline apply" "f does not escape"
+	return f(x)
+}
+
+func lambda() int { // ERROR "can inline lambda"
+	return apply(x -> x+1, 2) // ERROR "inlining call to apply" "can inline lambda.func1" "inlining call to lambda.func1"
+}
+
+func literal() int { // ERROR "can inline literal"
+	return apply(func(x int) int { return x + 1 }, 2) // ERROR "inlining call to apply" "can inline literal.func1" "inlining call to literal.func1"
+}
+
+func escapes(n int) int -> int { // ERROR "can inline escapes"
+	return x -> x + n // ERROR "can inline escapes.func1" "func literal escapes to heap"
+}
+
+func noescape(n int) int { // ERROR "can inline noescape"
+	var f int -> int = x -> x * n // ERROR "can inline noescape.func1"
+	return f(2)                   // ERROR "inlining call to noescape.func1"
+}
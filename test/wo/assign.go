@@ -0,0 +1,82 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test typed declarations and shadowing short variable declarations.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type point struct{ x, y int }
+
+func main() {
+	// Typed declarations convert untyped values to the declared type.
+	i int = 2
+	f float64 = 3
+	r rune = 'w'
+	if fmt.Sprintf("%T %T %T", i, f, r) != "int float64 int32" {
+		panic("bad typed declaration")
+	}
+
+	// Named, generic, and composite types.
+	b strings.Builder = strings.Builder{}
+	b.WriteString("wo")
+	s fmt.Stringer = &b
+	m map[string]int = map[string]int{"a": 1}
+	p point = point{1, 2}
+	inc func(int) int = func(x int) int { return x + i }
+	if s.String() != "wo" || m["a"] != 1 || p.y != 2 || inc(1) != 3 {
+		panic("bad typed declaration of a composite type")
+	}
+	var o int? = None
+	n int? = 4
+	if o.IsPresent() || n.OrElse(0) != 4 {
+		panic("bad typed declaration of an optional type")
+	}
+
+	// Typed declarations in statement headers.
+	var sum = 0
+	for k int = 0; k < 4; k++ {
+		sum += k
+	}
+	if sum != 6 {
+		panic(fmt.Sprint("bad for loop: ", sum))
+	}
+	if d float64 = f / 2; d != 1.5 {
+		panic(fmt.Sprint("bad if header: ", d))
+	}
+	switch c byte = "wo"[1]; c {
+	case 'o':
+	default:
+		panic(fmt.Sprint("bad switch header: ", c))
+	}
+
+	// := shadows a variable of an enclosing scope.
+	{
+		i := "inner"
+		if i != "inner" {
+			panic("bad shadowing")
+		}
+	}
+	if i := i * 10; i != 20 {
+		panic(fmt.Sprint("bad shadowing in header: ", i))
+	}
+	if i != 2 {
+		panic(fmt.Sprint("shadowing changed outer variable: ", i))
+	}
+
+	// Several variables are assigned the results of one call.
+	var q, ok = m["b"]
+	q, ok = m["a"]
+	if q != 1 || !ok {
+		panic("bad comma-ok assignment")
+	}
+}
@@ -0,0 +1,38 @@
+// errorcheck
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test that := may only declare variables that shadow others and
+// that values may not be assigned in parallel.
+
+package p
+
+func f() (int, error) { return 0, nil }
+
+func g(n int) int {
+	x := n                   // ERROR "x := n does not shadow a variable \(use var x = n or x int = n\)"
+	for i := 0; i < n; i++ { // ERROR "i := 0 does not shadow a variable \(use i int = 0\)"
+		x += i
+	}
+	return x
+}
+
+func h() error {
+	var _, err = f()
+	n, err := f() // ERROR "n, err := f\(\) declares n but assigns to err \(declare with var n int first, then assign with =\)"
+	_ = n
+	return err
+}
+
+func swap() (int, int) {
+	var p, q int
+	p, q = 20, 30 // ERROR "parallel assignment p, q = 20, 30 \(assign each variable in its own statement\)"
+	{
+		p, q := q, p // ERROR "parallel declaration p, q := q, p \(declare each variable in its own statement\)"
+		return p, q
+	}
+}
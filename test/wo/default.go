@@ -0,0 +1,88 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test default parameter values.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+type Formatter func(string) string
+
+func defaultFormatter(s string) string { return "[" + s + "]" }
+
+var console io.Writer = new(strings.Builder)
+
+func write(s string, formatter Formatter = defaultFormatter, stdout io.Writer = console) {
+	io.WriteString(stdout, formatter(s))
+}
+
+func sum(a, b int = 2, c = 3.5, xs ...int) float64 {
+	var s = float64(a + b)
+	for _, x := range xs {
+		s += float64(x)
+	}
+	return s + c
+}
+
+func opt(x int? = None, y int? = 7) (int, int) {
+	return x.OrElse(-1), y.OrElse(-1)
+}
+
+type T struct{ n int }
+
+func (t T) add(x int = 10) int { return t.n + x }
+
+// Default values are evaluated at each call.
+var counter = 1
+
+func next(n int = counter) int {
+	counter++
+	return n
+}
+
+func check(got, want any) {
+	if got != want {
+		panic(fmt.Sprintf("got %v, want %v", got, want))
+	}
+}
+
+func main() {
+	write("a")
+	write("b", strings.ToUpper)
+	var b strings.Builder
+	write("c", s -> defaultFormatter(defaultFormatter(s)), &b)
+	check(console.(*strings.Builder).String(), "[a]B")
+	check(b.String(), "[[c]]")
+
+	check(sum(1), 6.5)
+	check(sum(1, 1), 5.5)
+	check(sum(1, 1, 1), 3.0)
+	check(sum(1, 1, 1, 4, 5), 12.0)
+
+	var x, y = opt()
+	check(x, -1)
+	check(y, 7)
+	x, y = opt(1, None)
+	check(x, 1)
+	check(y, -1)
+
+	var t = T{1}
+	check(t.add(), 11)
+	check(T.add(t), 11)
+	var f = t.add
+	check(f(), 11)
+
+	check(next(), 1)
+	check(next(), 2)
+	check(next(0), 0)
+}
@@ -0,0 +1,22 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+import "strconv"
+
+var base = 10
+
+func prefix(s string) string { return "#" + s }
+
+func Format(n int, b int = base, f func(string) string = prefix, sep = ",", p *int = nil) string {
+	if p != nil {
+		return "p"
+	}
+	return f(strconv.FormatInt(int64(n), b)) + sep
+}
+
+func SetBase(b int) { base = b }
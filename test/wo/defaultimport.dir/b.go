@@ -0,0 +1,11 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package b
+
+import "./a"
+
+func F(n int) string { return a.Format(n) + a.Format(n, 2) + a.Format(n, 16, s -> s, ";") }
@@ -0,0 +1,23 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"./a"
+	"./b"
+)
+
+func main() {
+	if got := b.F(10); got != "#10,#1010,a;" {
+		panic("b.F(10) = " + got)
+	}
+	a.SetBase(8)
+	if got := b.F(10); got != "#12,#1010,a;" {
+		panic("b.F(10) = " + got)
+	}
+	if got := a.Format(10, 10, nil, "", new(int)); got != "p" {
+		panic("a.Format = " + got)
+	}
+}
@@ -0,0 +1,10 @@
+// rundir
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that default parameter values are filled in for calls from
+// other Wo packages, and that Go files must pass all arguments.
+
+package ignore
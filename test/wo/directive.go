@@ -0,0 +1,19 @@
+// compile
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:note this is a Go file
+
+// Test that //wo: directives other than //wo:dialect
+// are ignored in Go files.
+
+package p
+
+//wo:note not a directive
+
+func f(x int) int {
+	//wo:note neither is this
+	return x
+}
@@ -0,0 +1,135 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test enum types, their fields, and switches on enum values.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"unsafe"
+)
+
+type Color enum{ Red, Green, Blue }
+
+type Planet enum {
+	Mercury(3.303e+23, 2.4397e6, "hot")
+	Earth(5.976e+24, 6.37814e6, "home")
+
+	mass, radius float64
+	note         string
+}
+
+type File enum {
+	Closed
+	Open(contents string)
+	Moved(from, to string)
+}
+
+func describe(f File) string {
+	switch f {
+	case Closed:
+		return "closed"
+	case Open(contents):
+		return "open: " + contents
+	case Moved(from, to):
+		return "moved from " + from + " to " + to
+	}
+}
+
+func warm(c Color) bool {
+	switch c {
+	case Red:
+		return true
+	case Green, Blue:
+		return false
+	}
+}
+
+type Suit enum{ Hearts, Spades }
+
+// String replaces the String method that Suit has implicitly.
+func (s Suit) String() string {
+	return "suit " + s.name
+}
+
+type Item struct {
+	Color Color
+	File  File
+}
+
+func main() {
+	var c = Green
+	if c.name != "Green" || c.pos != 1 || Blue.pos != 2 || Red.name != "Red" {
+		panic(fmt.Sprint("bad predeclared fields: ", c.name, c.pos))
+	}
+	if c == Red || c != Green {
+		panic("bad comparison")
+	}
+	if !warm(Red) || warm(c) {
+		panic("bad switch")
+	}
+	if unsafe.Sizeof(c) != 1 {
+		panic("bad size")
+	}
+	var p = &c
+	if p.name != "Green" {
+		panic("bad field of pointer")
+	}
+
+	var e = Earth
+	if e.mass != 5.976e+24 || e.note != "home" || Mercury.radius != 2.4397e6 || e.name != "Earth" {
+		panic("bad shared fields")
+	}
+
+	var files = []File{Closed, Open("text"), Moved("a", "b")}
+	var got []string = nil
+	for _, f := range files {
+		got = append(got, describe(f))
+	}
+	if s string = fmt.Sprint(got); s != "[closed open: text moved from a to b]" {
+		panic("bad sum switch: " + s)
+	}
+	if files[1] != Open("text") || files[1] == Open("other") || files[0] != Closed || files[2].pos != 2 {
+		panic("bad sum comparison")
+	}
+
+	// Enum values print as the names of their variants.
+	if s string = fmt.Sprint(Blue, files); s != "Blue [Closed Open Moved]" {
+		panic("bad fmt: " + s)
+	}
+	if s string = fmt.Sprintf("%v %s", e, Closed); s != "Earth Closed" {
+		panic("bad fmt: " + s)
+	}
+	var b, err = json.Marshal(Item{Blue, Open("x")})
+	if err != nil || string(b) != `{"Color":"Blue","File":"Open"}` {
+		panic(fmt.Sprint("bad json: ", string(b), err))
+	}
+	var item Item
+	if err = json.Unmarshal([]byte(`{"Color":"Green","File":"Moved"}`), &item); err != nil || item != (Item{Green, Moved("", "")}) {
+		panic(fmt.Sprint("bad json decoding: ", item, err))
+	}
+	err = json.Unmarshal([]byte(`{"Color":"Purple"}`), &item)
+	if err == nil || err.Error() != `wo: main.Color has no variant "Purple"` || item.Color != Green {
+		panic(fmt.Sprint("bad json decoding error: ", err))
+	}
+	var f File = Open("x")
+	if err = f.UnmarshalText([]byte("Closed")); err != nil || f != Closed {
+		panic(fmt.Sprint("bad UnmarshalText method: ", f, err))
+	}
+
+	// Explicitly declared methods replace the implicit ones.
+	if s string = fmt.Sprint(Spades); s != "suit Spades" {
+		panic("bad String method: " + s)
+	}
+	var text, terr = Hearts.MarshalText()
+	if terr != nil || string(text) != "Hearts" {
+		panic(fmt.Sprint("bad MarshalText method: ", string(text), terr))
+	}
+}
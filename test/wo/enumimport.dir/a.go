@@ -0,0 +1,34 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+type Level enum {
+	Low(1)
+	High(10)
+
+	weight int
+}
+
+type Shape enum {
+	Point
+	Circle(radius float64)
+	Rect(w, h float64)
+}
+
+// Area is small enough to be inlined into its callers.
+func Area(s Shape) float64 {
+	switch s {
+	case Circle(r):
+		return 3 * r * r
+	case Rect(w, h):
+		return w * h
+	default:
+		return 0
+	}
+}
+
+func Weight(l Level) int { return l.weight }
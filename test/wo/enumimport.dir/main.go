@@ -0,0 +1,40 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package main
+
+import (
+	"fmt"
+
+	"./a"
+)
+
+func main() {
+	if a.Area(a.Rect(2, 3)) != 6 || a.Area(a.Circle(1)) != 3 || a.Area(a.Point) != 0 {
+		panic("bad Area")
+	}
+	if a.Weight(a.High) != 10 || a.Low.name != "Low" || a.High.pos != 1 {
+		panic("bad Level")
+	}
+	var s = a.Circle(2)
+	switch s {
+	case a.Point:
+		panic("bad switch")
+	case a.Circle(r):
+		if r != 2 {
+			panic("bad binding")
+		}
+	case a.Rect(_, _):
+		panic("bad switch")
+	}
+	if str string = fmt.Sprint(s, a.Low); str != "Circle Low" {
+		panic("bad fmt: " + str)
+	}
+	var l = a.Low
+	if err error = l.UnmarshalText([]byte("High")); err != nil || l != a.High {
+		panic(fmt.Sprint("bad UnmarshalText: ", l, err))
+	}
+}
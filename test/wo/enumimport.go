@@ -0,0 +1,9 @@
+// rundir
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that enum types and their variants survive export data.
+
+package ignore
@@ -0,0 +1,27 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+export func แมว() string { return "meow" }
+
+func export lower() int { return 1 }
+
+export const (
+	zero = iota
+	one
+)
+
+export type point struct{ X, Y int }
+
+export type color enum{ red, green }
+
+pkg var counter int
+
+func pkg Get() int {
+	counter++
+	return counter
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package b
+
+import "./a"
+
+// F is inlined into main, which then refers to a.lower and a.Get
+// through the export data of b.
+func F() int { return a.lower() + a.Get() }
+
+func P() a.point { return a.point{X: a.one} }
@@ -0,0 +1,29 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+
+	"./a"
+	"./b"
+)
+
+func main() {
+	if a.แมว() != "meow" || a.lower() != 1 || a.zero != 0 {
+		panic("bad exported objects")
+	}
+	if b.F() != 2 || a.Get() != 2 || a.counter != 2 {
+		panic("bad pkg objects")
+	}
+	var p a.point = b.P()
+	if p.X != 1 {
+		panic("bad point")
+	}
+	var c a.color = a.green
+	if s := fmt.Sprint(c); s != "green" {
+		panic("bad color " + s)
+	}
+}
@@ -0,0 +1,10 @@
+// rundir
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that objects declared with visibility modifiers can be
+// imported, also through the export data of another package.
+
+package ignore
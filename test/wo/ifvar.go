@@ -0,0 +1,189 @@
+// run
+
+//wo:dialect
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test conditional bindings in if and for headers.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var calls int
+
+func lookup(m map[string]int, k string) (int, bool) {
+	calls++
+	var v, ok = m[k]
+	return v, ok
+}
+
+func split(s string) (string, string, bool) {
+	for i := range len(s) {
+		if s[i] == '=' {
+			return s[:i], s[i+1:], true
+		}
+	}
+	return "", "", false
+}
+
+type T struct{ name string }
+
+func open(name string) (*T, error) {
+	if name == "" {
+		return nil, errors.New("no name")
+	}
+	return &T{name}, nil
+}
+
+func (t *T) String() string { return t.name }
+
+func first(xs []int) int? {
+	if len(xs) == 0 {
+		return None
+	}
+	return xs[0]
+}
+
+// get is small enough to be inlined.
+func get(m map[string]int, k string) int {
+	if var v = m[k] {
+		return v
+	}
+	return -1
+}
+
+func main() {
+	var m = map[string]int{"a": 1}
+	check(fmt.Sprint(get(m, "a"), get(m, "b")), "1 -1")
+
+	var got = ""
+	if var n = lookup(m, "a") {
+		got = fmt.Sprint("a", n)
+	} else {
+		panic("lookup a")
+	}
+	if var n = lookup(m, "b") {
+		panic(fmt.Sprint("lookup b: ", n))
+	} else if var k, v = split("x=y") {
+		got += k + v
+	}
+	check(got, "a1xy")
+	if calls != 2 {
+		panic(fmt.Sprint("calls = ", calls))
+	}
+
+	if var t = open("file") {
+		check(t.name, "file")
+	} else {
+		panic("open")
+	}
+	if var t = open("") {
+		panic(t)
+	}
+	if var s fmt.Stringer = open("str") {
+		check(s.String(), "str")
+	} else {
+		panic("open str")
+	}
+	if var n = strconv.Atoi("x") {
+		panic(n)
+	}
+
+	if var v = m["a"] {
+		check(fmt.Sprint(v), "1")
+	} else {
+		panic("m[a]")
+	}
+	if var v = m["b"] {
+		panic(v)
+	}
+
+	var x any = "s"
+	if var s = x.(string) {
+		check(s, "s")
+	} else {
+		panic("x.(string)")
+	}
+	if var i any = x.(fmt.Stringer) {
+		panic(i)
+	}
+
+	var ch = make(chan string, 1)
+	ch <- "c"
+	close(ch)
+	if var s = <-ch {
+		check(s, "c")
+	} else {
+		panic("<-ch")
+	}
+	if var s = <-ch {
+		panic(s)
+	}
+
+	if var n = first([]int{4, 5}) {
+		check(fmt.Sprint(n), "4")
+	} else {
+		panic("first")
+	}
+	if var n any = first([]int{6}) {
+		check(fmt.Sprint(n), "6")
+	} else {
+		panic("first any")
+	}
+	if var n = first(nil) {
+		panic(n)
+	}
+
+	// for loops rebind the variables before each iteration.
+	var xs = []int{1, 2, 3, 4, 5}
+	var pop = func() int? {
+		if len(xs) == 0 {
+			return None
+		}
+		x := xs[0]
+		xs = xs[1:]
+		return x
+	}
+	var funcs = []func() int{}
+	var sum = 0
+	for var x = pop() {
+		if x == 2 {
+			continue
+		}
+		sum += x
+		funcs = append(funcs, func() int { return x })
+	}
+	check(fmt.Sprint(sum, len(xs)), "13 0")
+	for i, f := range funcs {
+		if f() != []int{1, 3, 4, 5}[i] {
+			panic(fmt.Sprint("funcs ", i, " = ", f()))
+		}
+	}
+
+	xs = []int{1, 2, 3}
+	var n = 0
+outer:
+	for var x = pop() {
+		for {
+			if x == 2 {
+				break outer
+			}
+			break
+		}
+		n++
+	}
+	check(fmt.Sprint(n, len(xs)), "1 1")
+}
+
+func check(got, want string) {
+	if got != want {
+		panic(fmt.Sprintf("got %q, want %q", got, want))
+	}
+}
@@ -0,0 +1,84 @@
+// run
+
+//wo:dialect
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test builtin methods of strings, slices and maps.
+
+package main
+
+type list []int
+
+func contains[S ~[]E, E comparable](s S, x E) bool {
+	return s.Contains(x)
+}
+
+func main() {
+	var s = "Hello, World"
+	if !s.Contains("World") || s.Contains("world") {
+		panic("Contains")
+	}
+	if got string = s.ToUpper(); got != "HELLO, WORLD" {
+		panic("ToUpper: " + got)
+	}
+	var got = s.Split(", ")
+	if len(got) != 2 || got[1] != "World" {
+		panic("Split")
+	}
+	if got string = "  x ".TrimSpace().Repeat(3); got != "xxx" {
+		panic("TrimSpace: " + got)
+	}
+	if !(s + "!").HasSuffix("!") {
+		panic("HasSuffix")
+	}
+
+	var xs = []int{3, 1, 2}
+	if xs.Index(1) != 1 || !xs.Contains(2) || xs.Contains(4) {
+		panic("Index")
+	}
+	var ys = xs.Clone()
+	ys.Sort()
+	if !ys.Equal([]int{1, 2, 3}) || xs[0] != 3 {
+		panic("Sort")
+	}
+	ys = ys.Insert(0, xs...)
+	if len(ys) != 6 || ys[0] != 3 {
+		panic("Insert")
+	}
+	var l = list{2, 1}
+	l.SortFunc(func(a, b int) int { return b - a })
+	if l[0] != 2 || !contains(l, 1) || contains(l, 3) {
+		panic("list")
+	}
+
+	var m = map[string]int{"a": 1, "b": 2}
+	var c = m.Clone()
+	c.DeleteFunc(func(k string, v int) bool { return v > 1 })
+	if len(c) != 1 || len(m) != 2 {
+		panic("DeleteFunc")
+	}
+	var n = 0
+	for k := range m.Keys() {
+		n += len(k)
+	}
+	if n != 2 {
+		panic("Keys")
+	}
+	if !m.Equal(map[string]int{"b": 2, "a": 1}) {
+		panic("Equal")
+	}
+
+	// The receiver is evaluated before the arguments.
+	var order []int
+	var get = func(i int) []int {
+		order = append(order, i)
+		return xs
+	}
+	_ = get(1).Contains(get(2)[0])
+	if len(order) != 2 || order[0] != 1 {
+		panic("order")
+	}
+}
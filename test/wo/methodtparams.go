@@ -0,0 +1,172 @@
+// run
+
+//wo:dialect
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test methods with type parameters.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+type List[T any] struct {
+	elems []T
+}
+
+func Of[T any](elems ...T) List[T] { return List[T]{elems} }
+
+func (l List[T]) Map[U any](f func(T) U) List[U] {
+	var r = List[U]{}
+	for _, x := range l.elems {
+		r.elems = append(r.elems, f(x))
+	}
+	return r
+}
+
+func (l *List[T]) Filter[_ any](keep func(T) bool) {
+	var elems = l.elems[:0]
+	for _, x := range l.elems {
+		if keep(x) {
+			elems = append(elems, x)
+		}
+	}
+	l.elems = elems
+}
+
+func (l List[T]) Fold[A any](acc A, f func(A, T) A) A {
+	for _, x := range l.elems {
+		acc = f(acc, x)
+	}
+	return acc
+}
+
+func (l List[T]) Append[S ~[]T](s S) List[T] {
+	l.elems = append(append([]T(nil), l.elems...), s...)
+	return l
+}
+
+// Zip uses receiver and method type parameters in a local type and a closure.
+func (l List[T]) Zip[U any](m List[U]) []string {
+	type pair struct {
+		x T
+		y U
+	}
+	var show = func(p pair) string { return fmt.Sprint(p.x, ":", p.y) }
+	var r = []string{}
+	for i := range min(len(l.elems), len(m.elems)) {
+		r = append(r, show(pair{l.elems[i], m.elems[i]}))
+	}
+	return r
+}
+
+// Swap calls itself with its type arguments swapped.
+func (l List[T]) Swap[U, V any](n int, u U, v V) string {
+	if n == 0 {
+		return fmt.Sprintf("%d %v %v", len(l.elems), u, v)
+	}
+	return l.Swap(n-1, v, u)
+}
+
+type Counter int
+
+func (c *Counter) Add[N ~int | ~int64](n N) {
+	*c += Counter(n)
+}
+
+func (c Counter) As[T ~int | ~float64]() T { return T(c) }
+
+func (c Counter) String() string { return "counter " + strconv.Itoa(int(c)) }
+
+type Named struct {
+	Counter
+	name string
+}
+
+func pair() (int, string) { return 1, "one" }
+
+func (c Counter) Pair[K comparable, V any](k K, v V) map[K]V { return map[K]V{k: v} }
+
+func sum[T ~int](l List[T]) T {
+	return l.Fold(0, func(a T, x T) T { return a + x })
+}
+
+type Mapper interface {
+	Map(func(int) int) List[int]
+}
+
+func main() {
+	var l = Of(1, 2, 3, 4)
+	var got = l.Map(strconv.Itoa).elems
+	if fmt.Sprint(got) != "[1 2 3 4]" || reflect.TypeOf(got) != reflect.TypeOf([]string{}) {
+		panic(fmt.Sprint("Map: ", got))
+	}
+	var halves = l.Map[float64](func(x int) float64 { return float64(x) / 2 }).elems
+	if fmt.Sprint(halves) != "[0.5 1 1.5 2]" {
+		panic(fmt.Sprint("Map[float64]: ", halves))
+	}
+	var m = Of(1, 2, 3, 4)
+	m.Filter[int](func(x int) bool { return x%2 == 0 })
+	if fmt.Sprint(m.elems) != "[2 4]" {
+		panic(fmt.Sprint("Filter: ", m.elems))
+	}
+	if got string = l.Fold("", func(s string, x int) string { return s + strconv.Itoa(x) }); got != "1234" {
+		panic("Fold: " + got)
+	}
+	if got int = sum(Of(1, 2, 3)); got != 6 {
+		panic(fmt.Sprint("sum: ", got))
+	}
+	type ints []int
+	var appended = Of(1).Append(ints{2, 3}).elems
+	if fmt.Sprint(appended) != "[1 2 3]" {
+		panic(fmt.Sprint("Append: ", appended))
+	}
+	got = Of(1, 2).Zip(Of("a", "b", "c"))
+	if fmt.Sprint(got) != "[1:a 2:b]" {
+		panic(fmt.Sprint("Zip: ", got))
+	}
+	if got string = l.Swap(3, "a", 1.5); got != "4 1.5 a" {
+		panic("Swap: " + got)
+	}
+
+	var c Counter
+	c.Add(2)
+	c.Add(int64(3))
+	defer func() {
+		if c != 10 {
+			panic(fmt.Sprint("deferred Add: ", c))
+		}
+	}()
+	defer c.Add(5)
+	if got float64 = c.As[float64](); got != 5 {
+		panic(fmt.Sprint("As: ", got))
+	}
+
+	// Methods with type parameters are promoted through embedded fields.
+	var n = &Named{name: "n"}
+	n.Add(7)
+	if got int = n.As[int](); got != 7 {
+		panic(fmt.Sprint("promoted As: ", got))
+	}
+	if got map[int]string = n.Pair(pair()); got[1] != "one" {
+		panic(fmt.Sprint("Pair: ", got))
+	}
+
+	// They are not in the method set.
+	if got int = reflect.TypeOf(c).NumMethod(); got != 1 {
+		panic(fmt.Sprint("NumMethod: ", got))
+	}
+	var _, ok = any(l).(Mapper)
+	if ok {
+		panic("List[int] implements Mapper")
+	}
+	if got string = fmt.Sprint(c); got != "counter 5" {
+		panic("String: " + got)
+	}
+}
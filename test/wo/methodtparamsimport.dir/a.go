@@ -0,0 +1,46 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+type List[T any] struct {
+	elems []T
+}
+
+func Of[T any](elems ...T) List[T] { return List[T]{elems} }
+
+func (l List[T]) Elems() []T { return l.elems }
+
+func (l List[T]) Map[U any](f func(T) U) List[U] {
+	var r = List[U]{}
+	for _, x := range l.elems {
+		r.elems = append(r.elems, f(x))
+	}
+	return r
+}
+
+func (l List[T]) fold[A any](acc A, f func(A, T) A) A {
+	for _, x := range l.elems {
+		acc = f(acc, x)
+	}
+	return acc
+}
+
+// Len is inlined into importing packages and calls the unexported fold.
+func (l List[T]) Len() int {
+	return l.fold(0, func(n int, _ T) int { return n + 1 })
+}
+
+type Box struct {
+	v any
+}
+
+func (b *Box) Set[T any](v T) { b.v = v }
+
+func (b Box) Get[T any]() (T, bool) {
+	var v, ok = b.v.(T)
+	return v, ok
+}
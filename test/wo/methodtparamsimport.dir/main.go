@@ -0,0 +1,38 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"./a"
+)
+
+type Getter interface {
+	Get() (int, bool)
+}
+
+func main() {
+	l := a.Of(1, 2, 3)
+	if got := l.Map(strconv.Itoa).Elems(); fmt.Sprint(got) != "[1 2 3]" {
+		panic(fmt.Sprint("Map: ", got))
+	}
+	if got := l.Map[float64](func(x int) float64 { return float64(x) }).Len(); got != 3 {
+		panic(fmt.Sprint("Len: ", got))
+	}
+
+	var b a.Box
+	b.Set("x")
+	if v, ok := b.Get[string](); !ok || v != "x" {
+		panic("Get[string]")
+	}
+	if _, ok := b.Get[int](); ok {
+		panic("Get[int]")
+	}
+	if _, ok := any(b).(Getter); ok {
+		panic("Box implements Getter")
+	}
+}
@@ -0,0 +1,24 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package b
+
+import "./a"
+
+type Index int?
+
+func Position(list []string, s string) int? {
+	return a.Find(list, s)
+}
+
+// Next is small enough to be inlined into its callers.
+func Next(list []string, s string) int? {
+	return Position(list, s)? + 1
+}
+
+func Lookup[K comparable, V any](m map[K]V, k K) V? {
+	return m[k]
+}
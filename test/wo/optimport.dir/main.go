@@ -0,0 +1,29 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package main
+
+import (
+	"./a"
+	"./b"
+)
+
+func main() {
+	var list = []string{"x", "y"}
+	if b.Position(list, "y").OrElse(-1) != 1 || b.Position(list, "z") != None {
+		panic("bad Position")
+	}
+	if b.Next(list, "x").OrElse(-1) != 1 || b.Next(list, "z").IsPresent() {
+		panic("bad Next")
+	}
+	var i b.Index = a.Find(list, "x")
+	if !i.IsPresent() {
+		panic("bad Index")
+	}
+	if b.Lookup(map[string]int{"k": 2}, "k").OrElse(0) != 2 || b.Lookup(map[int]bool{}, 1).IsPresent() {
+		panic("bad Lookup")
+	}
+}
@@ -0,0 +1,10 @@
+// rundir
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that optional types survive export data and that Go
+// functions returning (T, bool) can be used as optional values.
+
+package ignore
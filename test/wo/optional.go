@@ -0,0 +1,149 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test optional types and the ? operator.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+var ages = map[string]int{"ann": 31, "bob": 0}
+
+func age(name string) int? {
+	return ages[name]
+}
+
+func nextAge(name string) int? {
+	return age(name)? + 1
+}
+
+// atoi is an ordinary comma-ok function.
+func atoi(s string) (int, bool) {
+	var n, err = strconv.Atoi(s)
+	return n, err == nil
+}
+
+func sum(a, b string) int? {
+	return atoi(a)? + atoi(b)?
+}
+
+func first[T any](list []T) T? {
+	if len(list) == 0 {
+		return None
+	}
+	return list[0]
+}
+
+func firstDoubled[T any](list []T) ([]T, T?) {
+	var x = first(list)?
+	return []T{x, x}, x
+}
+
+type point struct{ x, y int }
+
+func lookupPoint(m map[string]point, k string) (n int, p point?) {
+	n = len(m)
+	p = m[k]
+	return
+}
+
+var calls []string
+
+func call[T any](name string, x T) T {
+	calls = append(calls, name)
+	return x
+}
+
+var global string? = map[int]string{1: "one"}[1]
+
+func main() {
+	// Comma-ok map, receive, and type assertion results.
+	var v = age("ann")
+	if !v.IsPresent() || v.OrElse(-1) != 31 {
+		panic(fmt.Sprint("bad map lookup: ", v))
+	}
+	if v := age("bob"); !v.IsPresent() || v.OrElse(-1) != 0 {
+		panic(fmt.Sprint("bad zero map lookup: ", v))
+	}
+	if v := age("cid"); v.IsPresent() || v != None || v.OrElse(-1) != -1 {
+		panic(fmt.Sprint("bad missing map lookup: ", v))
+	}
+
+	var ch = make(chan string, 1)
+	ch <- "hi"
+	var r string? = <-ch
+	close(ch)
+	var closed string? = <-ch
+	if r.OrElse("") != "hi" || closed != nil {
+		panic("bad receive")
+	}
+
+	var x any = 1.5
+	var f float64? = x.(float64)
+	var s string? = x.(string)
+	if f.OrElse(0) != 1.5 || s.IsPresent() {
+		panic("bad type assertion")
+	}
+
+	// Conversions to an optional type.
+	var e error? = errors.New("boom")
+	if e.OrElse(nil).Error() != "boom" {
+		panic("bad interface optional")
+	}
+	e = None
+	if e.IsPresent() {
+		panic("bad None assignment")
+	}
+	var n int? = 3
+	n = 4
+	if n.OrElse(0) != 4 || global.OrElse("") != "one" {
+		panic("bad assignment")
+	}
+
+	// The ? operator returns None from the enclosing function.
+	if nextAge("ann").OrElse(0) != 32 || nextAge("cid").IsPresent() {
+		panic("bad ? operator")
+	}
+	if sum("1", "2").OrElse(0) != 3 || sum("1", "x").IsPresent() || sum("x", "1").IsPresent() {
+		panic("bad ? operator on comma-ok call")
+	}
+	var l, first = firstDoubled([]string{"x"})
+	if len(l) != 2 || first.OrElse("") != "x" {
+		panic("bad ? operator in generic function")
+	}
+	l, first = firstDoubled([]string(nil))
+	if l != nil || first != None {
+		panic("bad ? operator in generic function")
+	}
+	var add = func(s string) int? { return atoi(s)? + 10 }
+	if add("5").OrElse(0) != 15 || add("").IsPresent() {
+		panic("bad ? operator in closure")
+	}
+
+	// Named results.
+	var pts = map[string]point{"o": {}}
+	var count, p = lookupPoint(pts, "o")
+	if count != 1 || !p.IsPresent() {
+		panic("bad named optional result")
+	}
+	_, p = lookupPoint(pts, "z")
+	if p.IsPresent() {
+		panic("bad named optional result")
+	}
+
+	// OrElse evaluates its operands in order.
+	calls = nil
+	_ = call("a", age("ann")).OrElse(call("b", 0))
+	if fmt.Sprint(calls) != "[a b]" {
+		panic(fmt.Sprint("bad evaluation order: ", calls))
+	}
+}
@@ -0,0 +1,77 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test function overloading.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+type Formatter func(string) string
+
+func upper(s string) string { return strings.ToUpper(s) }
+
+func write(s string) string { return write(upper, s) }
+
+func write(f Formatter, s string) string {
+	var b strings.Builder
+	write(&b, f, s)
+	return b.String()
+}
+
+func write(w io.Writer, f Formatter, s string) int {
+	var n, _ = io.WriteString(w, f(s))
+	return n
+}
+
+func show(x any) string     { return "any" }
+func show(x int) string     { return "int" }
+func show(x error) string   { return "error" }
+func show(xs ...int) string { return fmt.Sprint("...int", len(xs)) }
+
+func check(got, want string) {
+	if got != want {
+		panic(fmt.Sprintf("got %q, want %q", got, want))
+	}
+}
+
+func funcName(f any) string {
+	return runtime.FuncForPC(reflect.ValueOf(f).Pointer()).Name()
+}
+
+func main() {
+	check(write("wo"), "WO")
+	check(write(s -> s + "!", "wo"), "wo!")
+	var b strings.Builder
+	if n int = write(&b, strings.ToLower, "WO"); n != 2 || b.String() != "wo" {
+		panic("bad write")
+	}
+
+	check(show(1), "int")
+	check(show(int8(1)), "any")
+	check(show(1.5), "any")
+	check(show(io.EOF), "error")
+	check(show(), "...int0")
+	check(show(1, 2), "...int2")
+	check(show([]int{1, 2, 3}...), "...int3")
+
+	// The overloads can be referred to by their mangled names.
+	var f func(string) string = write_6string
+	check(f("x"), "X")
+	check(show_3int(0), "int")
+	check(show_dots3int(1), "...int1")
+
+	// The mangled names appear in stack traces.
+	check(funcName(write_9Formatter6string), "main.write_9Formatter6string")
+}
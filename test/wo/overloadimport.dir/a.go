@@ -0,0 +1,15 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+import "strconv"
+
+func Format(n int) string { return strconv.Itoa(n) }
+
+func Format(n int, base int) string { return strconv.FormatInt(int64(n), base) }
+
+func Format(b bool) string { return strconv.FormatBool(b) }
@@ -0,0 +1,15 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package b
+
+import "./a"
+
+// F is inlined into main, which then refers to a.Format_3int3int
+// through the export data of b.
+func F(n int) string { return a.Format(n, 2) }
+
+func G() string { return a.Format(true) }
@@ -0,0 +1,19 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"./a"
+	"./b"
+)
+
+func main() {
+	if a.Format_3int(10) != "10" || a.Format_3int3int(10, 16) != "a" || a.Format_4bool(false) != "false" {
+		panic("bad mangled names")
+	}
+	if b.F(5) != "101" || b.G() != "true" {
+		panic("bad overloaded calls")
+	}
+}
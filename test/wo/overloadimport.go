@@ -0,0 +1,10 @@
+// rundir
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that overloaded functions can be called from other packages,
+// by Wo files through their set and by Go files by mangled name.
+
+package ignore
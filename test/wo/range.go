@@ -0,0 +1,110 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test colon range clauses.
+
+package main
+
+import (
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+)
+
+func pairs() iter.Seq2[string, int] {
+	return func(yield func(string, int) bool) {
+		_ = yield("a", 1) && yield("b", 2) && yield("c", 3)
+	}
+}
+
+func check(got, want string) {
+	if got != want {
+		panic(fmt.Sprintf("got %q, want %q", got, want))
+	}
+}
+
+func main() {
+	var nums = []int{10, 20, 30}
+
+	var s = ""
+	for v : nums {
+		s += fmt.Sprint(v, " ")
+	}
+	check(s, "10 20 30 ")
+
+	s = ""
+	for i, v : nums {
+		s += fmt.Sprint(i, "=", v, " ")
+	}
+	check(s, "0=10 1=20 2=30 ")
+
+	s = ""
+	for c : "héllo" {
+		s += string(c)
+	}
+	check(s, "héllo")
+
+	s = ""
+	for v : map[string]int{"x": 1} {
+		s += fmt.Sprint(v)
+	}
+	check(s, "1")
+
+	// Ranges with a single value.
+	s = ""
+	for i : 3 {
+		s += fmt.Sprint(i)
+	}
+	check(s, "012")
+
+	var ch = make(chan int, 2)
+	ch <- 7
+	ch <- 8
+	close(ch)
+	s = ""
+	for v : ch {
+		s += fmt.Sprint(v)
+	}
+	check(s, "78")
+
+	// Range-over-func iterators.
+	s = ""
+	for v : slices.Values(nums) {
+		s += fmt.Sprint(v, " ")
+	}
+	check(s, "10 20 30 ")
+
+	s = ""
+	for v : pairs() {
+		if v == 3 {
+			break
+		}
+		s += fmt.Sprint(v, " ")
+	}
+	check(s, "1 2 ")
+
+	s = ""
+	for k, v : pairs() {
+		s += fmt.Sprint(k, v, " ")
+	}
+	check(s, "a1 b2 c3 ")
+
+	s = ""
+	for k : maps.Keys(map[string]bool{"k": true}) {
+		s += k
+	}
+	check(s, "k")
+
+	// Each iteration has its own variables.
+	var fs []func() int = nil
+	for v : nums {
+		fs = append(fs, func() int { return v })
+	}
+	check(fmt.Sprint(fs[0](), fs[2]()), "10 30")
+}
@@ -0,0 +1,150 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test result types and the ! operator.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+)
+
+var errZero = errors.New("division by zero")
+
+func div(n, d int) int! {
+	if d == 0 {
+		return 0, errZero
+	}
+	return n / d, nil
+}
+
+func mean(list ...string) float64! {
+	var sum = 0
+	for s : list {
+		sum += strconv.Atoi(s)!
+	}
+	return float64(div(sum, len(list))!), nil
+}
+
+var closed []string
+
+type file struct{ name string }
+
+func (f *file) Close() error {
+	closed = append(closed, f.name)
+	if f.name == "bad" {
+		return errors.New("close failed")
+	}
+	return nil
+}
+
+func open(name string) *file! {
+	if name == "" {
+		return nil, os.ErrNotExist
+	}
+	return &file{name}, nil
+}
+
+func use(names ...string) (n int, err error) {
+	for name : names {
+		var f = open(name)!
+		f.Close()!
+		n++
+	}
+	return n, nil
+}
+
+func exist(names ...string) error {
+	for name : names {
+		open(name)! // the value is discarded
+	}
+	return nil
+}
+
+func generic[T any](f func() (T, error)) (string, T, error) {
+	var x = f()!
+	return fmt.Sprint(x), x, nil
+}
+
+func main() {
+	var v, err = div(7, 2)
+	if v != 3 || err != nil {
+		panic(fmt.Sprint("bad div: ", v, err))
+	}
+
+	var m float64
+	m, err = mean("1", "2", "6")
+	if m != 3 || err != nil {
+		panic(fmt.Sprint("bad mean: ", m, err))
+	}
+	m, err = mean("1", "x")
+	if m != 0 || !errors.Is(err, strconv.ErrSyntax) {
+		panic(fmt.Sprint("bad mean error: ", m, err))
+	}
+	m, err = mean()
+	if m != 0 || err != errZero {
+		panic(fmt.Sprint("bad mean error: ", m, err))
+	}
+
+	// Errors without values.
+	closed = nil
+	var n int
+	n, err = use("a", "b")
+	if n != 2 || err != nil || fmt.Sprint(closed) != "[a b]" {
+		panic(fmt.Sprint("bad use: ", n, err, closed))
+	}
+	closed = nil
+	n, err = use("a", "bad", "c")
+	if n != 0 || err == nil || fmt.Sprint(closed) != "[a bad]" {
+		panic(fmt.Sprint("bad use error: ", n, err, closed))
+	}
+	n, err = use("a", "")
+	if n != 0 || err != os.ErrNotExist {
+		panic(fmt.Sprint("bad use error: ", n, err))
+	}
+
+	if err error = exist("a", "b"); err != nil {
+		panic(fmt.Sprint("bad exist: ", err))
+	}
+	if err error = exist("a", ""); err != os.ErrNotExist {
+		panic(fmt.Sprint("bad exist error: ", err))
+	}
+
+	// Generic functions and closures.
+	var s string
+	var x int
+	s, x, err = generic(func() (int, error) { return 4, nil })
+	if s != "4" || x != 4 || err != nil {
+		panic("bad generic")
+	}
+	s, x, err = generic(func() (int, error) { return 4, errZero })
+	if s != "" || x != 0 || err != errZero {
+		panic("bad generic error")
+	}
+	var double = func(s string) int! {
+		return 2 * strconv.Atoi(s)!, nil
+	}
+	v, err = double("21")
+	if v != 42 || err != nil {
+		panic("bad closure")
+	}
+	_, err = double("z")
+	if err == nil {
+		panic("bad closure error")
+	}
+
+	// Result types are ordinary (T, error) results.
+	var f func(int, int) (int, error) = div
+	_, err = f(1, 0)
+	if err != errZero {
+		panic("bad function value")
+	}
+}
@@ -0,0 +1,13 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package a is written in Go.
+
+package a
+
+import "strconv"
+
+func Parse(s string) (int, error) {
+	return strconv.Atoi(s)
+}
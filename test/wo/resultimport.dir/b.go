@@ -0,0 +1,28 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package b
+
+import (
+	"errors"
+
+	"./a"
+)
+
+var ErrNegative = errors.New("negative")
+
+// Positive is small enough to be inlined into its callers.
+func Positive(s string) int! {
+	var n = a.Parse(s)!
+	if n < 0 {
+		return 0, ErrNegative
+	}
+	return n, nil
+}
+
+type Parser func(string) int!
+
+var P Parser = a.Parse
@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package main is written in Go.
+
+package main
+
+import "./b"
+
+func main() {
+	if n, err := b.Positive("12"); n != 12 || err != nil {
+		panic("bad Positive")
+	}
+	if _, err := b.Positive("-1"); err != b.ErrNegative {
+		panic("bad Positive error")
+	}
+	if _, err := b.Positive("x"); err == nil {
+		panic("bad Positive parse error")
+	}
+	var p func(string) (int, error) = b.P
+	if n, err := p("3"); n != 3 || err != nil {
+		panic("bad Parser")
+	}
+}
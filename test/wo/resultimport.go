@@ -0,0 +1,10 @@
+// rundir
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test that Wo result types are ordinary (T, error) results
+// in both directions between Go and Wo packages.
+
+package ignore
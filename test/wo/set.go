@@ -0,0 +1,124 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test the predeclared set type.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+type Names set[string]
+
+func sorted[T int | string](s set[T]) []T {
+	var list []T = nil
+	for x := range s {
+		list = append(list, x)
+	}
+	slices.Sort(list)
+	return list
+}
+
+func contains[T comparable](s set[T], x T) bool {
+	return s[x]
+}
+
+var calls []string
+
+func call[T any](name string, x T) T {
+	calls = append(calls, name)
+	return x
+}
+
+func main() {
+	var s = set[int]{2, 7}
+	if len(s) != 2 || !s[2] || !s[7] || s[3] {
+		panic(fmt.Sprint("bad set literal: ", sorted(s)))
+	}
+
+	// Membership tests are plain boolean expressions.
+	var n = 0
+	for i := range 10 {
+		if s[i] {
+			n += i
+		}
+	}
+	if n != 9 {
+		panic(fmt.Sprint("bad membership sum: ", n))
+	}
+	if !contains(s, 7) || contains(s, 8) {
+		panic("bad generic membership test")
+	}
+
+	// Adding and removing elements.
+	var t = make(set[int])
+	for x : []int{1, 2, 3, 2, 1} {
+		t.add(x)
+	}
+	var got = sorted(t)
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		panic(fmt.Sprint("bad add: ", got))
+	}
+	s.delete(2)
+	s.delete(100)
+	got = sorted(s)
+	if !slices.Equal(got, []int{7}) {
+		panic(fmt.Sprint("bad delete: ", got))
+	}
+	clear(s)
+	if len(s) != 0 {
+		panic("bad clear")
+	}
+
+	// A nil set has no elements.
+	var empty set[int] = nil
+	if empty != nil || len(empty) != 0 || empty[0] {
+		panic("bad nil set")
+	}
+	for range empty {
+		panic("nil set has elements")
+	}
+	empty.delete(0)
+
+	// Named set types.
+	var names = Names{"b", "a", "c"}
+	var sortedNames = sorted(set[string](names))
+	if !slices.Equal(sortedNames, []string{"a", "b", "c"}) {
+		panic(fmt.Sprint("bad named set: ", sortedNames))
+	}
+
+	// Set operands are evaluated left to right.
+	calls = nil
+	if call("a", names)[call("b", "a")] && call("c", true) {
+		calls = append(calls, "d")
+	}
+	if !slices.Equal(calls, []string{"a", "b", "c", "d"}) {
+		panic(fmt.Sprint("bad evaluation order: ", calls))
+	}
+	calls = nil
+	call("e", names).delete(call("f", "b"))
+	call("g", names).add(call("h", "d"))
+	if !slices.Equal(calls, []string{"e", "f", "g", "h"}) || names["b"] || !names["d"] {
+		panic(fmt.Sprint("bad evaluation order: ", calls))
+	}
+
+	// Interface elements.
+	var x, y any = 1, "1"
+	var u = set[any]{x, y, 1.5}
+	if len(u) != 3 || !u[1] || !u["1"] || u[int8(1)] {
+		panic("bad interface set")
+	}
+
+	// Sets are represented like maps with zero-size elements.
+	if typ reflect.Type = reflect.TypeOf(set[int]{}); typ.Kind() != reflect.Map || typ.Elem().Size() != 0 {
+		panic(fmt.Sprint("bad representation: ", typ))
+	}
+}
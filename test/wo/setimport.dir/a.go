@@ -0,0 +1,25 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+type Set[T comparable] set[T]
+
+func Of[T comparable](list ...T) Set[T] {
+	var s = make(Set[T])
+	for x : list {
+		s.add(x)
+	}
+	return s
+}
+
+func Primes() set[int] {
+	return set[int]{2, 3, 5, 7}
+}
+
+func (s Set[T]) Has(x T) bool {
+	return s[x]
+}
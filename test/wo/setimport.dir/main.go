@@ -0,0 +1,29 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package main
+
+import "./a"
+
+func main() {
+	var p = a.Primes()
+	if !p[7] || p[9] {
+		panic("bad imported set")
+	}
+	p.delete(7)
+	if len(p) != 3 {
+		panic("bad delete")
+	}
+
+	var s = a.Of("x", "y")
+	if !s.Has("x") || s["z"] {
+		panic("bad generic set")
+	}
+	s.add("z")
+	if !s.Has("z") || len(s) != 3 {
+		panic("bad add")
+	}
+}
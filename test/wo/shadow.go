@@ -0,0 +1,26 @@
+// errorcheck
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test that declarations may not shadow predeclared identifiers
+// or imported packages.
+
+package p
+
+import "strings"
+
+var int int = 1 // ERROR "int shadows predeclared identifier int|int is not a type"
+
+func f() string {
+	var rune = 'W'                       // ERROR "rune shadows predeclared identifier rune"
+	var strings = []string{string(rune)} // ERROR "strings shadows import of package .strings."
+	return strings[0]
+}
+
+func g(s string) []string {
+	return strings.Fields(s)
+}
@@ -0,0 +1,106 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test skip result slots.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+var calls int
+
+func f() (skip int, string, skip error) {
+	calls++
+	return calls, fmt.Sprint("f", calls), errors.New("ignored")
+}
+
+func lookup(m map[string]int, k string) (skip v int, ok bool) {
+	v, ok = m[k]
+	return
+}
+
+func split(s string) (string, skip int, string) {
+	for i int = 0; i < len(s); i++ {
+		if s[i] == '=' {
+			return s[:i], i, s[i+1:]
+		}
+	}
+	return s, -1, ""
+}
+
+type T struct{ n int }
+
+func (t *T) next() (skip n int, s string) {
+	t.n++
+	return t.n, fmt.Sprint("t", t.n)
+}
+
+func pair[P any](p P) (skip error, P) { return nil, p }
+
+// Package-level variables may omit results, too.
+var pkgVar = f()
+
+var pkgKey, pkgValue = split("k=v")
+
+func main() {
+	check(pkgVar, "f1")
+	check(pkgKey+pkgValue, "kv")
+
+	var s = f()
+	check(s, "f2")
+	s = f()
+	check(s, "f3")
+	var t = f()
+	check(t, "f4")
+	var a any = f()
+	check(a.(string), "f5")
+	var n, u, err = f()
+	if n != 6 || u != "f6" || err == nil {
+		panic("f")
+	}
+	if calls != 6 {
+		panic(fmt.Sprint("calls = ", calls))
+	}
+
+	var m = map[string]int{"a": 1}
+	var ok = lookup(m, "a")
+	if !ok {
+		panic("lookup a")
+	}
+	if ok = lookup(m, "b"); ok {
+		panic("lookup b")
+	}
+
+	var k, v = split("x=y")
+	check(k+v, "xy")
+
+	var p T
+	var x = p.next()
+	check(x, "t1")
+	x = p.next()
+	check(x, "t2")
+	var next = p.next
+	x = next()
+	check(x, "t3")
+
+	var i = pair(42)
+	if i != 42 {
+		panic("pair")
+	}
+	var opt string? = f()
+	check(opt.OrElse(""), "f7")
+}
+
+func check(got, want string) {
+	if got != want {
+		panic(fmt.Sprintf("got %q, want %q", got, want))
+	}
+}
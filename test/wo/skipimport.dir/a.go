@@ -0,0 +1,14 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package a
+
+import "strconv"
+
+func Parse(s string) (skip n int, text string, skip err error) {
+	n, err = strconv.Atoi(s)
+	return n, "<" + s + ">", err
+}
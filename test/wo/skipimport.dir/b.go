@@ -0,0 +1,14 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+package b
+
+import "./a"
+
+func F(s string) string {
+	var text = a.Parse(s)
+	return text
+}
@@ -0,0 +1,20 @@
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"./a"
+	"./b"
+)
+
+func main() {
+	if got := b.F("12"); got != "<12>" {
+		panic(got)
+	}
+	n, text, err := a.Parse("x")
+	if n != 0 || text != "<x>" || err == nil {
+		panic(text)
+	}
+}
@@ -0,0 +1,64 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test conditional expressions.
+
+package main
+
+import "fmt"
+
+type S struct{ a, b, c, d int }
+
+var calls []int
+
+func f(x int) int {
+	calls = append(calls, x)
+	return x
+}
+
+func pick(high bool) int { return if high then 99 else 1 }
+
+func sel(b bool, x, y S) S { return if b then x else y }
+
+func check(got, want any) {
+	if got != want {
+		panic(fmt.Sprintf("got %v (%T), want %v (%T)", got, got, want, want))
+	}
+}
+
+func main() {
+	check(pick(true), 99)
+	check(pick(false), 1)
+
+	// Untyped branches default consistently.
+	var x = if pick(true) > 50 then 1 else 2.5
+	check(x, 1.0)
+	var y = if pick(false) > 50 then 'a' else 1
+	check(y, rune(1))
+
+	// Non-constant branches.
+	check(sel(true, S{1, 2, 3, 4}, S{5, 6, 7, 8}), S{1, 2, 3, 4})
+	check(sel(false, S{1, 2, 3, 4}, S{5, 6, 7, 8}), S{5, 6, 7, 8})
+	var e error = if x > 0 then nil else fmt.Errorf("x")
+	check(e, nil)
+	var a any = if x > 0 then any("s") else 1
+	check(a, "s")
+
+	// Only the selected branch is evaluated.
+	var r = if x > 10 then f(1) else if x > 0 then f(2) else f(3)
+	check(r, 2)
+	check(fmt.Sprint(calls), "[2]")
+
+	// Conditional expressions in closures and package-level variables.
+	var collatz = func(n int) int { return if n%2 == 0 then n / 2 else 3*n + 1 }
+	check(collatz(6), 3)
+	check(collatz(7), 22)
+	check(v, "big")
+}
+
+var v = if len(calls) == 0 then "big" else "small"
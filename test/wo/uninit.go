@@ -0,0 +1,34 @@
+// errorcheck
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test that variables must be assigned before they are read.
+
+package p
+
+func f(c bool) string {
+	var s string
+	s += "." // ERROR "use of unassigned variable s"
+
+	var t string
+	if c {
+		t = "a"
+	}
+	return t // ERROR "use of unassigned variable t"
+}
+
+func g(c bool) string {
+	var s string
+	if c {
+		s = "a"
+	} else {
+		s = "b"
+	}
+	var n int? = None
+	_ = n
+	return s
+}
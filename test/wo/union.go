@@ -0,0 +1,107 @@
+// run
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test compact interface types and union types.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Lengther <Length(<>) int>
+
+type list []int
+
+func (l list) Length(<>) int { return len(l) }
+
+type Value int | string | []byte
+
+func size(v Value) int {
+	switch v := v.(type) {
+	case int:
+		return v
+	case string:
+		return len(v)
+	case []byte:
+		return 2 * len(v)
+	}
+	return -1
+}
+
+func kind(x int | string | bool) string {
+	switch x.(type) {
+	case int | bool:
+		return "int or bool"
+	case string:
+		return "string"
+	}
+	return "nil"
+}
+
+type myInt int
+
+func (i myInt) String() string { return fmt.Sprint("myInt(", int(i), ")") }
+
+type Stringer <int | myInt | string, String() string>
+
+func str(x any) string {
+	switch x := x.(type) {
+	case Stringer:
+		return x.String()
+	case int | string:
+		return fmt.Sprint(x)
+	}
+	return "?"
+}
+
+func join(vs ...int8 | int16) string {
+	var b strings.Builder
+	for _, v := range vs {
+		fmt.Fprint(&b, v, ";")
+	}
+	return b.String()
+}
+
+func main() {
+	var l Lengther = list{1, 2, 3}
+	if n int = l.Length(nil); n != 3 {
+		panic(fmt.Sprint("bad Length: ", n))
+	}
+
+	var vs = []Value{4, "hello", []byte("ab"), nil}
+	var got []int = nil
+	for _, v := range vs {
+		got = append(got, size(v))
+	}
+	if s string = fmt.Sprint(got); s != "[4 5 4 -1]" {
+		panic("bad type switch: " + s)
+	}
+	var s, ok = vs[1].(string)
+	if !ok || s != "hello" {
+		panic("bad type assertion")
+	}
+	_, ok = vs[0].(string)
+	if ok {
+		panic("bad type assertion")
+	}
+	if vs[0] != Value(4) || vs[1] == vs[0] || vs[3] != nil {
+		panic("bad comparison")
+	}
+
+	if k string = kind(true) + ", " + kind("x"); k != "int or bool, string" {
+		panic("bad union case: " + k)
+	}
+	if s string = str(myInt(1)) + str(2) + str("s") + str(3.0); s != "myInt(1)2s?" {
+		panic("bad union case with methods: " + s)
+	}
+	if s string = join(int8(1), int16(-2)); s != "1;-2;" {
+		panic("bad variadic union: " + s)
+	}
+}
@@ -0,0 +1,21 @@
+// errorcheck -0
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test that unused variables are warnings, not errors.
+
+package p
+
+func _() {
+	var x = 1 // ERROR "warning: declared and not used: x"
+}
+
+func _(a any) {
+	switch b := a.(type) { // ERROR "warning: b declared and not used"
+	case int:
+	}
+}
@@ -0,0 +1,15 @@
+// errorcheck -Werror=UnusedVar
+
+// Copyright 2026 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//wo:dialect
+
+// Test that -Werror reports unused variables as errors.
+
+package p
+
+func _() {
+	var x = 1 // ERROR "^declared and not used: x"
+}